@@ -17,13 +17,16 @@ import (
 	"github.com/specvital/web/src/backend/common/config"
 	"github.com/specvital/web/src/backend/common/middleware"
 	"github.com/specvital/web/src/backend/common/server"
+	"github.com/specvital/web/src/backend/common/tracing"
 	"github.com/specvital/web/src/backend/internal/api"
 )
 
 const (
 	apiTimeout      = 10 * time.Minute
-	authRateLimit   = 5 // requests per minute for auth endpoints
 	shutdownTimeout = 10 * time.Second
+
+	// ingestMaxBodyBytes bounds CI inventory uploads to /api/analyze/{owner}/{repo}/ingest.
+	ingestMaxBodyBytes = 16 << 20
 )
 
 func main() {
@@ -50,6 +53,18 @@ func run() error {
 		return fmt.Errorf("failed to get allowed origins: %w", err)
 	}
 
+	shutdownTracing, err := tracing.Setup(ctx, tracing.ConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	defer func() {
+		flushCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+	}()
+
 	app, err := server.NewApp(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create app: %w", err)
@@ -60,34 +75,41 @@ func run() error {
 		}
 	}()
 
-	router := newRouter(origins, app.RouteRegistrars(), app.APIHandler(), app.AuthMiddleware, app.WebhookHandler())
+	if err := app.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start app: %w", err)
+	}
+
+	router := newRouter(origins, app.RouteRegistrars(), app.APIHandler(), app.APIMiddlewares(), app.AuthMiddleware, app.RateLimiters(), app.WebhookHandler())
 
 	return startServer(router)
 }
 
-func newRouter(origins []string, registrars []server.RouteRegistrar, apiHandler api.StrictServerInterface, authMiddleware *middleware.AuthMiddleware, webhookHandler api.WebhookHandlers) *chi.Mux {
+func newRouter(origins []string, registrars []server.RouteRegistrar, apiHandler api.StrictServerInterface, apiMiddlewares []api.StrictMiddlewareFunc, authMiddleware *middleware.AuthMiddleware, rateLimiters server.RateLimiters, webhookHandler api.WebhookHandlers) *chi.Mux {
 	r := chi.NewRouter()
 
 	r.Use(chimiddleware.RequestID)
 	r.Use(chimiddleware.RealIP)
+	r.Use(middleware.Tracing())
 	r.Use(middleware.Logger())
+	r.Use(middleware.Metrics())
 	r.Use(chimiddleware.Recoverer)
 	r.Use(middleware.SecurityHeaders())
 	r.Use(middleware.CORS(origins))
 	r.Use(chimiddleware.Timeout(apiTimeout))
 	r.Use(middleware.Compress())
+	r.Use(middleware.BodyLimit("/ingest", ingestMaxBodyBytes))
 	r.Use(authMiddleware.OptionalAuth)
+	r.Use(middleware.UserRateLimit(rateLimiters.User, rateLimiters.UserLimits))
 
-	authLimiter := middleware.NewIPRateLimiter(authRateLimit)
 	r.Route("/api/auth", func(authRouter chi.Router) {
-		authRouter.Use(middleware.RateLimit(authLimiter))
+		authRouter.Use(middleware.RateLimit(rateLimiters.Auth))
 	})
 
 	for _, reg := range registrars {
 		reg.RegisterRoutes(r)
 	}
 
-	strictHandler := api.NewStrictHandler(apiHandler, nil)
+	strictHandler := api.NewStrictHandler(apiHandler, apiMiddlewares)
 	api.HandlerFromMux(strictHandler, r)
 
 	if webhookHandler != nil {
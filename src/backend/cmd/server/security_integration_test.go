@@ -7,8 +7,16 @@ import (
 	"time"
 
 	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/common/ratelimit"
 )
 
+func newTestRateLimiter(t *testing.T, limit int, window time.Duration) *ratelimit.Limiter {
+	t.Helper()
+	store := ratelimit.NewMemoryStore(time.Minute)
+	t.Cleanup(func() { _ = store.Close() })
+	return ratelimit.NewLimiter(store, "auth", limit, window)
+}
+
 func TestSecurityHeadersIntegration(t *testing.T) {
 	// Create a minimal router with just security headers
 	handler := middleware.SecurityHeaders()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -36,7 +44,7 @@ func TestSecurityHeadersIntegration(t *testing.T) {
 }
 
 func TestRateLimitingIntegration(t *testing.T) {
-	limiter := middleware.NewIPRateLimiter(60) // 60 requests per minute
+	limiter := newTestRateLimiter(t, 1, time.Minute)
 	handler := middleware.RateLimit(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte("success"))
@@ -52,7 +60,7 @@ func TestRateLimitingIntegration(t *testing.T) {
 		t.Errorf("first request: expected status %d, got %d", http.StatusOK, rec1.Code)
 	}
 
-	// Second immediate request should be rate limited (burst=1)
+	// Second immediate request should be rate limited
 	req2 := httptest.NewRequest(http.MethodGet, "/api/auth/login", nil)
 	req2.Header.Set("X-Real-IP", "192.168.1.1")
 	rec2 := httptest.NewRecorder()
@@ -73,13 +81,13 @@ func TestRateLimitingIntegration(t *testing.T) {
 	}
 }
 
-func TestRateLimitWithTokenRefill(t *testing.T) {
+func TestRateLimitWindowSlides(t *testing.T) {
 	// Skip in short mode (test takes >1 second)
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode")
 	}
 
-	limiter := middleware.NewIPRateLimiter(60) // 60 requests per minute = 1 per second
+	limiter := newTestRateLimiter(t, 1, 500*time.Millisecond)
 	handler := middleware.RateLimit(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	}))
@@ -106,7 +114,7 @@ func TestRateLimitWithTokenRefill(t *testing.T) {
 		t.Fatalf("second request should be rate limited: got status %d", rec2.Code)
 	}
 
-	// Wait for token refill (1 second + buffer)
+	// Wait until the first hit has slid out of the window
 	time.Sleep(1100 * time.Millisecond)
 
 	// Third request after waiting: allowed
@@ -116,12 +124,12 @@ func TestRateLimitWithTokenRefill(t *testing.T) {
 	handler.ServeHTTP(rec3, req3)
 
 	if rec3.Code != http.StatusOK {
-		t.Errorf("third request after refill: expected status %d, got %d", http.StatusOK, rec3.Code)
+		t.Errorf("third request after window slides: expected status %d, got %d", http.StatusOK, rec3.Code)
 	}
 }
 
 func TestCombinedMiddlewares(t *testing.T) {
-	limiter := middleware.NewIPRateLimiter(60)
+	limiter := newTestRateLimiter(t, 1, time.Minute)
 
 	// Stack middlewares in order: security headers -> rate limit -> handler
 	handler := middleware.SecurityHeaders()(
@@ -1,36 +1,165 @@
 package health
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
 	"github.com/specvital/web/src/backend/common/logger"
 )
 
-const statusOK = "ok"
+const (
+	statusOK          = "ok"
+	statusDegraded    = "degraded"
+	statusFail        = "fail"
+	statusUnavailable = "unavailable"
+
+	defaultCheckTimeout = 2 * time.Second
+)
 
 type Response struct {
 	Status string `json:"status"`
 }
 
+// ReadinessResponse reports the outcome of every dependency check.
+type ReadinessResponse struct {
+	Components []ComponentStatus `json:"components"`
+	Status     string            `json:"status"`
+}
+
+type ComponentStatus struct {
+	Critical  bool    `json:"critical"`
+	LatencyMs float64 `json:"latencyMs"`
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+}
+
+// Probe checks a single dependency, returning nil when it is usable.
+type Probe func(ctx context.Context) error
+
+// Check is a named readiness probe. A failing critical check makes the
+// instance unready; other failures only mark it degraded.
+type Check struct {
+	Critical bool
+	Name     string
+	Probe    Probe
+}
+
 type Handler struct {
-	logger *logger.Logger
+	checks       []Check
+	checkTimeout time.Duration
+	logger       *logger.Logger
 }
 
-func NewHandler(logger *logger.Logger) *Handler {
-	return &Handler{logger: logger}
+func NewHandler(logger *logger.Logger, checks ...Check) *Handler {
+	return &Handler{
+		checks:       checks,
+		checkTimeout: defaultCheckTimeout,
+		logger:       logger,
+	}
 }
 
 func (h *Handler) RegisterRoutes(r chi.Router) {
 	r.Get("/health", h.handleHealth)
+	r.Get("/health/live", h.handleLive)
+	r.Get("/health/ready", h.handleReady)
 }
 
+// handleHealth is kept for existing probes and always reports ok.
 func (h *Handler) handleHealth(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, r, http.StatusOK, Response{Status: statusOK})
+}
+
+// handleLive reports whether the process can serve requests at all. It runs
+// no dependency checks, so an outage of a shared dependency does not get
+// every instance restarted at once.
+func (h *Handler) handleLive(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, r, http.StatusOK, Response{Status: statusOK})
+}
+
+func (h *Handler) handleReady(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	components := make([]ComponentStatus, len(h.checks))
+
+	var wg sync.WaitGroup
+	for i, check := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = h.run(ctx, check)
+		}()
+	}
+	wg.Wait()
+
+	response := ReadinessResponse{Components: components, Status: statusOK}
+	status := http.StatusOK
+	for _, c := range components {
+		if c.Status == statusOK {
+			continue
+		}
+		if c.Critical {
+			response.Status = statusUnavailable
+			status = http.StatusServiceUnavailable
+			break
+		}
+		response.Status = statusDegraded
+	}
+
+	h.writeJSON(w, r, status, response)
+}
+
+func (h *Handler) run(ctx context.Context, check Check) ComponentStatus {
+	ctx, cancel := context.WithTimeout(ctx, h.checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Probe(ctx)
+	latency := time.Since(start)
+
+	result := ComponentStatus{
+		Critical:  check.Critical,
+		LatencyMs: float64(latency.Microseconds()) / 1000,
+		Name:      check.Name,
+		Status:    statusOK,
+	}
+	if err != nil {
+		// Errors are logged rather than returned; the endpoint is public.
+		h.logger.Warn(ctx, "readiness check failed", "check", check.Name, "error", err)
+		result.Status = statusFail
+	}
+	return result
+}
+
+func (h *Handler) writeJSON(w http.ResponseWriter, r *http.Request, status int, body any) {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(Response{Status: statusOK}); err != nil {
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
 		h.logger.Error(r.Context(), "failed to encode health response", "error", err)
 	}
 }
+
+// Cached wraps probe so its result is reused for ttl. Use it for checks
+// against rate-limited external services.
+func Cached(probe Probe, ttl time.Duration) Probe {
+	var (
+		mu        sync.Mutex
+		checkedAt time.Time
+		lastErr   error
+	)
+	return func(ctx context.Context) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !checkedAt.IsZero() && time.Since(checkedAt) < ttl {
+			return lastErr
+		}
+		lastErr = probe(ctx)
+		checkedAt = time.Now()
+		return lastErr
+	}
+}
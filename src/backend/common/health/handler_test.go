@@ -1,10 +1,13 @@
 package health
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
@@ -39,3 +42,114 @@ func TestHandleHealth(t *testing.T) {
 		t.Errorf("expected status %s, got %s", statusOK, response.Status)
 	}
 }
+
+func serve(t *testing.T, handler *Handler, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestHandleLive(t *testing.T) {
+	failing := Check{Critical: true, Name: "database", Probe: func(context.Context) error {
+		return errors.New("connection refused")
+	}}
+
+	w := serve(t, NewHandler(logger.New(), failing), "/health/live")
+	if w.Code != http.StatusOK {
+		t.Errorf("liveness must not depend on checks, got status %d", w.Code)
+	}
+}
+
+func TestHandleReady(t *testing.T) {
+	ok := func(context.Context) error { return nil }
+	fail := func(context.Context) error { return errors.New("unreachable") }
+
+	tests := []struct {
+		name       string
+		checks     []Check
+		wantCode   int
+		wantStatus string
+	}{
+		{
+			name:       "all checks pass",
+			checks:     []Check{{Critical: true, Name: "database", Probe: ok}, {Name: "github_app", Probe: ok}},
+			wantCode:   http.StatusOK,
+			wantStatus: statusOK,
+		},
+		{
+			name:       "critical check fails",
+			checks:     []Check{{Critical: true, Name: "database", Probe: fail}, {Name: "github_app", Probe: ok}},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: statusUnavailable,
+		},
+		{
+			name:       "non-critical check fails",
+			checks:     []Check{{Critical: true, Name: "database", Probe: ok}, {Name: "github_app", Probe: fail}},
+			wantCode:   http.StatusOK,
+			wantStatus: statusDegraded,
+		},
+		{
+			name:       "no checks configured",
+			wantCode:   http.StatusOK,
+			wantStatus: statusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(t, NewHandler(logger.New(), tt.checks...), "/health/ready")
+			if w.Code != tt.wantCode {
+				t.Errorf("expected status %d, got %d", tt.wantCode, w.Code)
+			}
+
+			var response ReadinessResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if response.Status != tt.wantStatus {
+				t.Errorf("expected status %q, got %q", tt.wantStatus, response.Status)
+			}
+			if len(response.Components) != len(tt.checks) {
+				t.Fatalf("expected %d components, got %d", len(tt.checks), len(response.Components))
+			}
+			for i, c := range response.Components {
+				if c.Name != tt.checks[i].Name || c.Critical != tt.checks[i].Critical {
+					t.Errorf("component %d does not match its check: %+v", i, c)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleReady_CheckTimeout(t *testing.T) {
+	handler := NewHandler(logger.New(), Check{Critical: true, Name: "database", Probe: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	handler.checkTimeout = 10 * time.Millisecond
+
+	w := serve(t, handler, "/health/ready")
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected timed out check to fail readiness, got status %d", w.Code)
+	}
+}
+
+func TestCached(t *testing.T) {
+	calls := 0
+	probe := Cached(func(context.Context) error {
+		calls++
+		return errors.New("bad credentials")
+	}, time.Hour)
+
+	for range 3 {
+		if err := probe(context.Background()); err == nil {
+			t.Fatal("expected cached error")
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected probe to run once within ttl, ran %d times", calls)
+	}
+}
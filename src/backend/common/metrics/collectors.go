@@ -0,0 +1,198 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const queueDepthTimeout = 5 * time.Second
+
+// activeJobStates are the River job states that count towards queue depth.
+// Keep in sync with the filter in ReadQueueDepths.
+var activeJobStates = []string{"available", "pending", "retryable", "running", "scheduled"}
+
+// poolCollector reports pgxpool statistics read at scrape time.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	acquiredConns        *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	idleConns            *prometheus.Desc
+	maxConns             *prometheus.Desc
+	newConnsCount        *prometheus.Desc
+	totalConns           *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		pool:                 pool,
+		acquireCount:         desc("acquire_total", "Successful connection acquisitions."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		acquiredConns:        desc("acquired_connections", "Connections currently in use."),
+		canceledAcquireCount: desc("canceled_acquire_total", "Acquisitions cancelled by their context."),
+		constructingConns:    desc("constructing_connections", "Connections currently being established."),
+		emptyAcquireCount:    desc("empty_acquire_total", "Acquisitions that had to wait for a connection."),
+		idleConns:            desc("idle_connections", "Idle connections in the pool."),
+		maxConns:             desc("max_connections", "Maximum pool size."),
+		newConnsCount:        desc("new_connections_total", "Connections opened by the pool."),
+		totalConns:           desc("total_connections", "Connections currently open."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquireCount
+	ch <- c.acquireDuration
+	ch <- c.acquiredConns
+	ch <- c.canceledAcquireCount
+	ch <- c.constructingConns
+	ch <- c.emptyAcquireCount
+	ch <- c.idleConns
+	ch <- c.maxConns
+	ch <- c.newConnsCount
+	ch <- c.totalConns
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.constructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.newConnsCount, prometheus.CounterValue, float64(stat.NewConnsCount()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+}
+
+// QueueDepth is the number of jobs in one state on one queue.
+type QueueDepth struct {
+	Count int64
+	Queue string
+	State string
+}
+
+// QueueDepthReader counts active River jobs in a schema.
+type QueueDepthReader interface {
+	ReadQueueDepths(ctx context.Context, schema string) ([]QueueDepth, error)
+}
+
+// QueueSet is a group of queues whose jobs live in the same River schema.
+// An empty Schema means the connection's search path.
+type QueueSet struct {
+	Queues []string
+	Schema string
+}
+
+// queueDepthCollector reports active job counts per queue and state. Every
+// known queue is reported, with zeros for states that have no jobs, so
+// alerts do not go silent when a queue drains.
+type queueDepthCollector struct {
+	depth  *prometheus.Desc
+	reader QueueDepthReader
+	sets   []QueueSet
+}
+
+func newQueueDepthCollector(reader QueueDepthReader, sets []QueueSet) *queueDepthCollector {
+	return &queueDepthCollector{
+		depth: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "river", "queue_depth"),
+			"Active River jobs by queue and state.",
+			[]string{"queue", "state"}, nil,
+		),
+		reader: reader,
+		sets:   sets,
+	}
+}
+
+func (c *queueDepthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.depth
+}
+
+func (c *queueDepthCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), queueDepthTimeout)
+	defer cancel()
+
+	for _, set := range c.sets {
+		depths, err := c.reader.ReadQueueDepths(ctx, set.Schema)
+		if err != nil {
+			// Skip the set rather than failing the whole scrape.
+			slog.Warn("failed to read queue depths", "schema", set.Schema, "error", err)
+			continue
+		}
+
+		counts := make(map[string]map[string]int64, len(set.Queues))
+		for _, name := range set.Queues {
+			counts[name] = make(map[string]int64, len(activeJobStates))
+			for _, state := range activeJobStates {
+				counts[name][state] = 0
+			}
+		}
+		for _, d := range depths {
+			if counts[d.Queue] == nil {
+				counts[d.Queue] = make(map[string]int64, len(activeJobStates))
+			}
+			counts[d.Queue][d.State] = d.Count
+		}
+
+		for name, states := range counts {
+			for state, count := range states {
+				ch <- prometheus.MustNewConstMetric(c.depth, prometheus.GaugeValue, float64(count), name, state)
+			}
+		}
+	}
+}
+
+// PostgresQueueDepthReader reads queue depths from River's job table.
+type PostgresQueueDepthReader struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresQueueDepthReader(pool *pgxpool.Pool) *PostgresQueueDepthReader {
+	return &PostgresQueueDepthReader{pool: pool}
+}
+
+func (r *PostgresQueueDepthReader) ReadQueueDepths(ctx context.Context, schema string) ([]QueueDepth, error) {
+	table := "river_job"
+	if schema != "" {
+		table = pgx.Identifier{schema, "river_job"}.Sanitize()
+	}
+
+	// River's schema is chosen at runtime, so this cannot be a sqlc query.
+	rows, err := r.pool.Query(ctx, `
+		SELECT queue, state::text, COUNT(*)
+		FROM `+table+`
+		WHERE state IN ('available', 'pending', 'retryable', 'running', 'scheduled')
+		GROUP BY queue, state`)
+	if err != nil {
+		return nil, fmt.Errorf("count jobs in %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var depths []QueueDepth
+	for rows.Next() {
+		var d QueueDepth
+		if err := rows.Scan(&d.Queue, &d.State, &d.Count); err != nil {
+			return nil, fmt.Errorf("scan queue depth: %w", err)
+		}
+		depths = append(depths, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("count jobs in %s: %w", table, err)
+	}
+	return depths, nil
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+type HandlerConfig struct {
+	// Pool is reported as db_pool_* metrics when set.
+	Pool *pgxpool.Pool
+	// QueueDepths reports river_queue_depth for QueueSets when set.
+	QueueDepths QueueDepthReader
+	QueueSets   []QueueSet
+	// Token, when set, must be presented as a bearer token to scrape.
+	Token string
+}
+
+// Handler serves the Prometheus scrape endpoint.
+type Handler struct {
+	handler http.Handler
+	token   string
+}
+
+func NewHandler(cfg HandlerConfig) *Handler {
+	scrape := prometheus.NewRegistry()
+	if cfg.Pool != nil {
+		scrape.MustRegister(newPoolCollector(cfg.Pool))
+	}
+	if cfg.QueueDepths != nil && len(cfg.QueueSets) > 0 {
+		scrape.MustRegister(newQueueDepthCollector(cfg.QueueDepths, cfg.QueueSets))
+	}
+
+	return &Handler{
+		handler: promhttp.HandlerFor(prometheus.Gatherers{registry, scrape}, promhttp.HandlerOpts{
+			ErrorHandling: promhttp.ContinueOnError,
+		}),
+		token: cfg.Token,
+	}
+}
+
+func (h *Handler) RegisterRoutes(r chi.Router) {
+	r.Get("/metrics", h.handleMetrics)
+}
+
+func (h *Handler) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	if h.token != "" {
+		expected := "Bearer " + h.token
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(expected)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+	}
+	h.handler.ServeHTTP(w, r)
+}
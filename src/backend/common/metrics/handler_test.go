@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type stubQueueDepthReader struct {
+	depths map[string][]QueueDepth
+	err    error
+}
+
+func (s *stubQueueDepthReader) ReadQueueDepths(_ context.Context, schema string) ([]QueueDepth, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.depths[schema], nil
+}
+
+func scrape(t *testing.T, handler *Handler, authorization string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := chi.NewRouter()
+	handler.RegisterRoutes(r)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandleMetrics(t *testing.T) {
+	ObserveHTTPRequest(http.MethodGet, "/api/analyze/{owner}/{repo}", http.StatusOK, 20*time.Millisecond)
+	RecordEnqueue("analysis_default", "")
+	RecordRateLimitRejection("ip")
+	ObserveGitLsRemote(time.Second, GitErrorNotFound, errors.New("not found"))
+	RecordCommitCacheLookup(CommitCacheHit)
+	RecordCommitCacheInvalidation()
+	RecordWebhookEvent("installation", "created")
+	RecordRetentionPurge("spec_documents", 3)
+	SetRetentionPurgeCandidates("analyses", 5)
+	SetRetentionPurgeCandidates("analyses", 4)
+
+	w := scrape(t, NewHandler(HandlerConfig{}), "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	body := w.Body.String()
+	for _, want := range []string{
+		`specvital_web_http_requests_total{method="GET",route="/api/analyze/{owner}/{repo}",status="200"}`,
+		`specvital_web_queue_enqueued_total{queue="analysis_default",tier="none"}`,
+		`specvital_web_rate_limit_rejections_total{limiter="ip"}`,
+		`specvital_web_git_ls_remote_errors_total{reason="not_found"}`,
+		`specvital_web_commit_cache_lookups_total{result="hit"}`,
+		`specvital_web_commit_cache_invalidations_total`,
+		`specvital_web_webhook_events_total{action="created",event="installation"}`,
+		`specvital_web_retention_purged_rows_total{table="spec_documents"} 3`,
+		`specvital_web_retention_purge_candidate_rows{table="analyses"} 4`,
+		`go_goroutines`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected metrics output to contain %s", want)
+		}
+	}
+}
+
+func TestHandleMetrics_Token(t *testing.T) {
+	handler := NewHandler(HandlerConfig{Token: "scrape-secret"})
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "missing token", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", authorization: "Bearer nope", wantStatus: http.StatusUnauthorized},
+		{name: "valid token", authorization: "Bearer scrape-secret", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := scrape(t, handler, tt.authorization)
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestHandleMetrics_QueueDepth(t *testing.T) {
+	reader := &stubQueueDepthReader{depths: map[string][]QueueDepth{
+		"": {{Queue: "analysis_priority", State: "available", Count: 3}},
+		"river_web": {
+			{Queue: "web_batch", State: "running", Count: 2},
+		},
+	}}
+	handler := NewHandler(HandlerConfig{
+		QueueDepths: reader,
+		QueueSets: []QueueSet{
+			{Queues: []string{"analysis_priority", "analysis_default"}},
+			{Queues: []string{"web_batch"}, Schema: "river_web"},
+		},
+	})
+
+	body := scrape(t, handler, "").Body.String()
+	for _, want := range []string{
+		`specvital_web_river_queue_depth{queue="analysis_priority",state="available"} 3`,
+		`specvital_web_river_queue_depth{queue="analysis_default",state="available"} 0`,
+		`specvital_web_river_queue_depth{queue="web_batch",state="running"} 2`,
+		`specvital_web_river_queue_depth{queue="web_batch",state="scheduled"} 0`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected metrics output to contain %s", want)
+		}
+	}
+}
+
+func TestHandleMetrics_QueueDepthErrorDoesNotFailScrape(t *testing.T) {
+	handler := NewHandler(HandlerConfig{
+		QueueDepths: &stubQueueDepthReader{err: errors.New("db unavailable")},
+		QueueSets:   []QueueSet{{Queues: []string{"analysis_default"}}},
+	})
+
+	w := scrape(t, handler, "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if strings.Contains(w.Body.String(), "specvital_web_river_queue_depth{") {
+		t.Error("expected no queue depth samples when the reader fails")
+	}
+}
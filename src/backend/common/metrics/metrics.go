@@ -0,0 +1,194 @@
+// Package metrics exposes Prometheus metrics for the web service.
+//
+// Instrumentation points record into a process-wide registry through the
+// helpers in this package; state that is cheaper to read on demand (DB pool,
+// queue depths) is collected at scrape time by the Handler.
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const namespace = "specvital_web"
+
+// RouteUnmatched labels requests that did not match a registered route, so
+// arbitrary paths cannot inflate label cardinality.
+const RouteUnmatched = "unmatched"
+
+// Git ls-remote error reasons.
+const (
+	GitErrorForbidden = "forbidden"
+	GitErrorNotFound  = "not_found"
+	GitErrorOther     = "other"
+	GitErrorTimeout   = "timeout"
+)
+
+// Commit SHA cache lookup results.
+const (
+	CommitCacheCoalesced = "coalesced"
+	CommitCacheHit       = "hit"
+	CommitCacheMiss      = "miss"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method and route pattern.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	enqueues = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "queue_enqueued_total",
+		Help:      "Jobs enqueued by queue name and plan tier.",
+	}, []string{"queue", "tier"})
+
+	rateLimitRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limit_rejections_total",
+		Help:      "Requests rejected by a rate limiter.",
+	}, []string{"limiter"})
+
+	gitLsRemoteDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "git_ls_remote_duration_seconds",
+		Help:      "Latency of git ls-remote calls by result.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2, 5, 10, 30},
+	}, []string{"result"})
+
+	gitLsRemoteErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "git_ls_remote_errors_total",
+		Help:      "Failed git ls-remote calls by reason.",
+	}, []string{"reason"})
+
+	commitCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "commit_cache_lookups_total",
+		Help:      "Latest-commit lookups by cache result. Coalesced misses joined another caller's git lookup.",
+	}, []string{"result"})
+
+	commitCacheInvalidations = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "commit_cache_invalidations_total",
+		Help:      "Repositories dropped from the commit cache after a push.",
+	})
+
+	webhookEvents = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhook_events_total",
+		Help:      "GitHub App webhook deliveries by event type and action.",
+	}, []string{"event", "action"})
+
+	retentionPurgedRows = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "retention_purged_rows_total",
+		Help:      "Rows deleted by the retention purge by table.",
+	}, []string{"table"})
+
+	retentionPurgeCandidates = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "retention_purge_candidate_rows",
+		Help:      "Rows the last dry-run retention purge would have deleted, by table.",
+	}, []string{"table"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		enqueues,
+		rateLimitRejections,
+		gitLsRemoteDuration,
+		gitLsRemoteErrors,
+		commitCacheLookups,
+		commitCacheInvalidations,
+		webhookEvents,
+		retentionPurgedRows,
+		retentionPurgeCandidates,
+	)
+}
+
+// ObserveHTTPRequest records a served request. route must be a route
+// pattern (or RouteUnmatched), never a raw path.
+func ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+// RecordEnqueue counts a job inserted into queue on behalf of a user on tier.
+// Anonymous and system jobs have an empty tier and are labelled "none".
+func RecordEnqueue(queue, tier string) {
+	if tier == "" {
+		tier = "none"
+	}
+	enqueues.WithLabelValues(queue, tier).Inc()
+}
+
+// RecordRateLimitRejection counts a request rejected by the named limiter.
+func RecordRateLimitRejection(limiter string) {
+	rateLimitRejections.WithLabelValues(limiter).Inc()
+}
+
+// ObserveGitLsRemote records the latency of a git ls-remote call. reason is
+// one of the GitError* constants and is ignored when err is nil.
+func ObserveGitLsRemote(duration time.Duration, reason string, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+		if reason == "" {
+			reason = GitErrorOther
+		}
+		gitLsRemoteErrors.WithLabelValues(reason).Inc()
+	}
+	gitLsRemoteDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// RecordCommitCacheLookup counts a commit cache lookup. result is one of the
+// CommitCache* constants.
+func RecordCommitCacheLookup(result string) {
+	commitCacheLookups.WithLabelValues(result).Inc()
+}
+
+// RecordCommitCacheInvalidation counts a repository dropped from the commit cache.
+func RecordCommitCacheInvalidation() {
+	commitCacheInvalidations.Inc()
+}
+
+// RecordWebhookEvent counts a verified webhook delivery.
+func RecordWebhookEvent(event, action string) {
+	if event == "" {
+		event = "unknown"
+	}
+	if action == "" {
+		action = "none"
+	}
+	webhookEvents.WithLabelValues(event, action).Inc()
+}
+
+// RecordRetentionPurge counts rows deleted from table by the retention purge.
+func RecordRetentionPurge(table string, rows int64) {
+	retentionPurgedRows.WithLabelValues(table).Add(float64(rows))
+}
+
+// SetRetentionPurgeCandidates records the rows of table a dry-run purge found.
+// Each dry run reports the same expired rows again, so this is a gauge holding
+// the last run's value rather than a counter.
+func SetRetentionPurgeCandidates(table string, rows int64) {
+	retentionPurgeCandidates.WithLabelValues(table).Set(float64(rows))
+}
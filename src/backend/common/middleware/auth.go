@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -55,15 +56,25 @@ func WithRefreshToken(ctx context.Context, token string) context.Context {
 	return context.WithValue(ctx, refreshTokenKey, token)
 }
 
+// PersonalAccessTokenAuthenticator resolves tokens sent as
+// "Authorization: Bearer" to the claims of the token's owner.
+type PersonalAccessTokenAuthenticator interface {
+	AuthenticatePersonalAccessToken(ctx context.Context, token string) (*entity.Claims, error)
+}
+
 type AuthMiddleware struct {
 	accessCookieName  string
+	personalTokens    PersonalAccessTokenAuthenticator
 	refreshCookieName string
 	tokenManager      port.TokenManager
 }
 
-func NewAuthMiddleware(tokenManager port.TokenManager, accessCookieName, refreshCookieName string) *AuthMiddleware {
+// NewAuthMiddleware creates the auth middleware. personalTokens may be nil, in
+// which case bearer tokens are rejected.
+func NewAuthMiddleware(tokenManager port.TokenManager, personalTokens PersonalAccessTokenAuthenticator, accessCookieName, refreshCookieName string) *AuthMiddleware {
 	return &AuthMiddleware{
 		accessCookieName:  accessCookieName,
+		personalTokens:    personalTokens,
 		refreshCookieName: refreshCookieName,
 		tokenManager:      tokenManager,
 	}
@@ -102,6 +113,11 @@ func (m *AuthMiddleware) OptionalAuth(next http.Handler) http.Handler {
 		claims, err := m.extractClaims(r)
 		if err == nil && claims != nil {
 			ctx = WithClaims(ctx, claims)
+		} else if bearerToken(r) != "" {
+			// An explicit token that fails must not silently degrade to an
+			// anonymous request; scripts would otherwise see confusing 404s.
+			writeUnauthorized(w, "invalid or expired access token")
+			return
 		}
 
 		if refreshCookie, err := r.Cookie(m.refreshCookieName); err == nil {
@@ -120,6 +136,13 @@ func isAPIRoute(path string) bool {
 }
 
 func (m *AuthMiddleware) extractClaims(r *http.Request) (*entity.Claims, error) {
+	if token := bearerToken(r); token != "" {
+		if m.personalTokens == nil {
+			return nil, errors.New("personal access tokens are not enabled")
+		}
+		return m.personalTokens.AuthenticatePersonalAccessToken(r.Context(), token)
+	}
+
 	cookie, err := r.Cookie(m.accessCookieName)
 	if err != nil {
 		return nil, err
@@ -133,6 +156,14 @@ func (m *AuthMiddleware) extractClaims(r *http.Request) (*entity.Claims, error)
 	return claims, nil
 }
 
+func bearerToken(r *http.Request) string {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
 func writeUnauthorized(w http.ResponseWriter, detail string) {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(http.StatusUnauthorized)
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -16,7 +18,7 @@ func TestRequireAuth_ValidToken(t *testing.T) {
 	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
 	token, _ := jwtManager.GenerateAccessToken("user-123", "testuser")
 
-	m := NewAuthMiddleware(jwtManager, "auth_token", "refresh_token")
+	m := NewAuthMiddleware(jwtManager, nil, "auth_token", "refresh_token")
 
 	var capturedUserID string
 	handler := m.RequireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -40,7 +42,7 @@ func TestRequireAuth_ValidToken(t *testing.T) {
 
 func TestRequireAuth_MissingCookie(t *testing.T) {
 	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
-	m := NewAuthMiddleware(jwtManager, "auth_token", "refresh_token")
+	m := NewAuthMiddleware(jwtManager, nil, "auth_token", "refresh_token")
 
 	handler := m.RequireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		t.Error("handler should not be called")
@@ -61,7 +63,7 @@ func TestRequireAuth_MissingCookie(t *testing.T) {
 
 func TestRequireAuth_InvalidToken(t *testing.T) {
 	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
-	m := NewAuthMiddleware(jwtManager, "auth_token", "refresh_token")
+	m := NewAuthMiddleware(jwtManager, nil, "auth_token", "refresh_token")
 
 	handler := m.RequireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		t.Error("handler should not be called")
@@ -85,7 +87,7 @@ func TestOptionalAuth_ValidToken(t *testing.T) {
 	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
 	token, _ := jwtManager.GenerateAccessToken("user-123", "testuser")
 
-	m := NewAuthMiddleware(jwtManager, "auth_token", "refresh_token")
+	m := NewAuthMiddleware(jwtManager, nil, "auth_token", "refresh_token")
 
 	var capturedUserID string
 	handler := m.OptionalAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -109,7 +111,7 @@ func TestOptionalAuth_ValidToken(t *testing.T) {
 
 func TestOptionalAuth_MissingCookie(t *testing.T) {
 	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
-	m := NewAuthMiddleware(jwtManager, "auth_token", "refresh_token")
+	m := NewAuthMiddleware(jwtManager, nil, "auth_token", "refresh_token")
 
 	var capturedUserID string
 	handler := m.OptionalAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -132,7 +134,7 @@ func TestOptionalAuth_MissingCookie(t *testing.T) {
 
 func TestOptionalAuth_InvalidToken(t *testing.T) {
 	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
-	m := NewAuthMiddleware(jwtManager, "auth_token", "refresh_token")
+	m := NewAuthMiddleware(jwtManager, nil, "auth_token", "refresh_token")
 
 	var capturedUserID string
 	handler := m.OptionalAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -158,7 +160,7 @@ func TestOptionalAuth_NonAPIRoute_SkipsAuth(t *testing.T) {
 	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
 	token, _ := jwtManager.GenerateAccessToken("user-123", "testuser")
 
-	m := NewAuthMiddleware(jwtManager, "auth_token", "refresh_token")
+	m := NewAuthMiddleware(jwtManager, nil, "auth_token", "refresh_token")
 
 	var capturedUserID string
 	handler := m.OptionalAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -182,6 +184,74 @@ func TestOptionalAuth_NonAPIRoute_SkipsAuth(t *testing.T) {
 	}
 }
 
+type stubPersonalTokens struct {
+	claims *entity.Claims
+}
+
+func (s *stubPersonalTokens) AuthenticatePersonalAccessToken(_ context.Context, token string) (*entity.Claims, error) {
+	if s.claims == nil || token != "svp_valid" {
+		return nil, errors.New("invalid token")
+	}
+	return s.claims, nil
+}
+
+func TestOptionalAuth_BearerToken(t *testing.T) {
+	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
+	personalTokens := &stubPersonalTokens{claims: &entity.Claims{
+		PersonalAccessTokenID: "pat-123",
+		Scopes:                []entity.Scope{entity.ScopeSpecRead},
+		Subject:               "user-456",
+	}}
+
+	m := NewAuthMiddleware(jwtManager, personalTokens, "auth_token", "refresh_token")
+
+	var capturedUserID string
+	handler := m.OptionalAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedUserID = GetUserID(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	// Bearer token takes precedence over the session cookie
+	sessionToken, _ := jwtManager.GenerateAccessToken("user-123", "testuser")
+	req := httptest.NewRequest(http.MethodGet, "/api/test", nil)
+	req.Header.Set("Authorization", "Bearer svp_valid")
+	req.AddCookie(&http.Cookie{Name: "auth_token", Value: sessionToken})
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d", rec.Code)
+	}
+	if capturedUserID != "user-456" {
+		t.Errorf("expected userID user-456, got %s", capturedUserID)
+	}
+}
+
+func TestOptionalAuth_InvalidBearerToken(t *testing.T) {
+	jwtManager, _ := authadapter.NewJWTTokenManager(testSecret)
+	m := NewAuthMiddleware(jwtManager, &stubPersonalTokens{}, "auth_token", "refresh_token")
+
+	called := false
+	handler := m.OptionalAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/test", nil)
+	req.Header.Set("Authorization", "Bearer svp_unknown")
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", rec.Code)
+	}
+	if called {
+		t.Error("expected handler not to be called")
+	}
+}
+
 func TestGetClaims_NoClaims(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	claims := GetClaims(req.Context())
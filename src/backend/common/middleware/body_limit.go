@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/specvital/web/src/backend/internal/api"
+)
+
+// BodyLimit caps the request body of POST requests whose path ends with
+// pathSuffix. Requests declaring a larger Content-Length are rejected with 413
+// up front; chunked bodies are cut off at limit and fail to decode.
+func BodyLimit(pathSuffix string, limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, pathSuffix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if r.ContentLength > limit {
+				writePayloadTooLarge(w, fmt.Sprintf("request body exceeds %d bytes", limit))
+				return
+			}
+
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writePayloadTooLarge(w http.ResponseWriter, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	_ = json.NewEncoder(w).Encode(api.NewPayloadTooLarge(detail))
+}
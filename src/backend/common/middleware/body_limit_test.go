@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBodyLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		chunked    bool
+		wantStatus int
+		wantRead   bool
+	}{
+		{name: "within limit", method: http.MethodPost, path: "/api/analyze/o/r/ingest", body: "12345", wantStatus: http.StatusOK, wantRead: true},
+		{name: "declared length over limit", method: http.MethodPost, path: "/api/analyze/o/r/ingest", body: "1234567890", wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "chunked body over limit", method: http.MethodPost, path: "/api/analyze/o/r/ingest", body: "1234567890", chunked: true, wantStatus: http.StatusOK},
+		{name: "other path unaffected", method: http.MethodPost, path: "/api/analysis-batches", body: "1234567890", wantStatus: http.StatusOK, wantRead: true},
+		{name: "other method unaffected", method: http.MethodPut, path: "/api/analyze/o/r/ingest", body: "1234567890", wantStatus: http.StatusOK, wantRead: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			readOK := false
+			handler := BodyLimit("/ingest", 8)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, err := io.ReadAll(r.Body)
+				readOK = err == nil
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantStatus == http.StatusOK && readOK != tt.wantRead {
+				t.Errorf("expected body read ok=%v, got %v", tt.wantRead, readOK)
+			}
+		})
+	}
+}
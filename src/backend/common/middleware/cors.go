@@ -26,10 +26,10 @@ var (
 func CORS(origins []string) func(http.Handler) http.Handler {
 	return cors.Handler(cors.Options{
 		AllowCredentials: true,
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "If-None-Match", "X-CSRF-Token"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedOrigins:   origins,
-		ExposedHeaders:   []string{"Link"},
+		ExposedHeaders:   []string{"ETag", "Link"},
 		MaxAge:           corsMaxAge,
 	})
 }
@@ -3,6 +3,7 @@ package middleware
 import (
 	"log/slog"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5/middleware"
@@ -36,7 +37,7 @@ func Logger() func(http.Handler) http.Handler {
 			slog.Info("http request",
 				"request_id", middleware.GetReqID(r.Context()),
 				"method", r.Method,
-				"path", r.URL.Path,
+				"path", redactPath(r.URL.Path),
 				"status", rw.status,
 				"size", rw.size,
 				"duration", time.Since(start).String(),
@@ -45,3 +46,14 @@ func Logger() func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// sharedSpecPathPrefix is followed by a share link token, which grants read
+// access to a spec document and must not end up in logs.
+const sharedSpecPathPrefix = "/api/spec-view/shared/"
+
+func redactPath(path string) string {
+	if strings.HasPrefix(path, sharedSpecPathPrefix) && len(path) > len(sharedSpecPathPrefix) {
+		return sharedSpecPathPrefix + "[redacted]"
+	}
+	return path
+}
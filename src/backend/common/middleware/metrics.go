@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/specvital/web/src/backend/common/metrics"
+)
+
+// Metrics records request counts and latencies labelled by the matched chi
+// route pattern rather than the raw path, keeping label cardinality bounded.
+func Metrics() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rw, r)
+
+			route := metrics.RouteUnmatched
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				if pattern := rctx.RoutePattern(); pattern != "" {
+					route = pattern
+				}
+			}
+			metrics.ObserveHTTPRequest(r.Method, route, rw.status, time.Since(start))
+		})
+	}
+}
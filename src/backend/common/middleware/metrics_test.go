@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/specvital/web/src/backend/common/metrics"
+)
+
+func TestMetrics_LabelsByRoutePattern(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Metrics())
+	r.Get("/api/repos/{owner}/{repo}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	for _, path := range []string{"/api/repos/octocat/hello", "/api/repos/octocat/world", "/no/such/route"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	metricsRouter := chi.NewRouter()
+	metrics.NewHandler(metrics.HandlerConfig{}).RegisterRoutes(metricsRouter)
+	w := httptest.NewRecorder()
+	metricsRouter.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := w.Body.String()
+
+	if !strings.Contains(body, `specvital_web_http_requests_total{method="GET",route="/api/repos/{owner}/{repo}",status="418"} 2`) {
+		t.Error("expected requests to be counted under the route pattern")
+	}
+	if !strings.Contains(body, `specvital_web_http_requests_total{method="GET",route="unmatched",status="404"}`) {
+		t.Error("expected unmatched requests to be labelled unmatched")
+	}
+	if strings.Contains(body, "octocat") || strings.Contains(body, "/no/such/route") {
+		t.Error("raw paths must not appear in metric labels")
+	}
+}
@@ -1,125 +1,90 @@
 package middleware
 
 import (
+	"context"
 	"encoding/json"
+	"log/slog"
 	"net/http"
-	"sync"
-	"time"
-
-	"golang.org/x/time/rate"
 
+	"github.com/specvital/web/src/backend/common/metrics"
+	"github.com/specvital/web/src/backend/common/ratelimit"
 	"github.com/specvital/web/src/backend/internal/api"
 )
 
-const (
-	defaultRateLimit       = 5
-	defaultBurst           = 1
-	defaultCleanupTTL      = 10 * time.Minute
-	defaultCleanupInterval = 5 * time.Minute
-)
-
+// RateLimiter is satisfied by *ratelimit.Limiter.
 type RateLimiter interface {
-	Allow(key string) bool
-	Stop()
+	Allow(ctx context.Context, key string) (ratelimit.Decision, error)
 }
 
-type limiterEntry struct {
-	limiter  *rate.Limiter
-	lastSeen time.Time
+// UserRateLimiter is satisfied by *ratelimit.Limiter.
+type UserRateLimiter interface {
+	AllowLimit(ctx context.Context, key string, limit int) (ratelimit.Decision, error)
 }
 
-type ipRateLimiter struct {
-	limiters map[string]*limiterEntry
-	mu       sync.Mutex
-	rate     rate.Limit
-	burst    int
-	ttl      time.Duration
-	stopCh   chan struct{}
+// UserRateLimitLookup resolves the request limit for a user.
+// A limit of zero or less means the user is not limited.
+type UserRateLimitLookup interface {
+	GetUserRateLimit(ctx context.Context, userID string) (int, error)
 }
 
-func NewIPRateLimiter(requestsPerMinute int) RateLimiter {
-	if requestsPerMinute <= 0 {
-		requestsPerMinute = defaultRateLimit
-	}
-
-	ratePerSecond := rate.Limit(float64(requestsPerMinute) / 60.0)
-
-	rl := &ipRateLimiter{
-		limiters: make(map[string]*limiterEntry),
-		rate:     ratePerSecond,
-		burst:    defaultBurst,
-		ttl:      defaultCleanupTTL,
-		stopCh:   make(chan struct{}),
+// RateLimit limits requests per client IP and reports the limit state in
+// RateLimit-* response headers.
+func RateLimit(limiter RateLimiter) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			decision, err := limiter.Allow(r.Context(), getClientIP(r))
+			serveRateLimited(w, r, next, "ip", decision, err)
+		})
 	}
-
-	go rl.cleanupLoop()
-
-	return rl
 }
 
-func (rl *ipRateLimiter) Allow(ip string) bool {
-	now := time.Now()
+// UserRateLimit limits authenticated requests per user, with the limit
+// resolved by lookup. It must run after OptionalAuth; anonymous requests
+// pass through unchanged.
+func UserRateLimit(limiter UserRateLimiter, lookup UserRateLimitLookup) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			userID := GetUserID(ctx)
+			if userID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
 
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
+			limit, err := lookup.GetUserRateLimit(ctx, userID)
+			if err != nil {
+				slog.Warn("failed to resolve user rate limit, allowing request", "user_id", userID, "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if limit <= 0 {
+				next.ServeHTTP(w, r)
+				return
+			}
 
-	entry, exists := rl.limiters[ip]
-	if !exists {
-		entry = &limiterEntry{
-			limiter:  rate.NewLimiter(rl.rate, rl.burst),
-			lastSeen: now,
-		}
-		rl.limiters[ip] = entry
-	} else {
-		entry.lastSeen = now
+			decision, err := limiter.AllowLimit(ctx, userID, limit)
+			serveRateLimited(w, r, next, "user", decision, err)
+		})
 	}
-
-	return entry.limiter.Allow()
 }
 
-func (rl *ipRateLimiter) cleanupLoop() {
-	ticker := time.NewTicker(defaultCleanupInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			rl.cleanup()
-		case <-rl.stopCh:
-			return
-		}
+// serveRateLimited applies a limiter decision. name labels rejections in the
+// rate limit metrics.
+func serveRateLimited(w http.ResponseWriter, r *http.Request, next http.Handler, name string, decision ratelimit.Decision, err error) {
+	if err != nil {
+		slog.Warn("rate limiter unavailable, allowing request", "error", err)
+		next.ServeHTTP(w, r)
+		return
 	}
-}
 
-func (rl *ipRateLimiter) Stop() {
-	close(rl.stopCh)
-}
-
-func (rl *ipRateLimiter) cleanup() {
-	now := time.Now()
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
-
-	for ip, entry := range rl.limiters {
-		if now.Sub(entry.lastSeen) > rl.ttl {
-			delete(rl.limiters, ip)
-		}
+	ratelimit.SetHeaders(w.Header(), decision)
+	if !decision.Allowed {
+		metrics.RecordRateLimitRejection(name)
+		writeTooManyRequests(w, "rate limit exceeded")
+		return
 	}
-}
 
-func RateLimit(limiter RateLimiter) func(http.Handler) http.Handler {
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ip := getClientIP(r)
-
-			if !limiter.Allow(ip) {
-				writeTooManyRequests(w, "rate limit exceeded")
-				return
-			}
-
-			next.ServeHTTP(w, r)
-		})
-	}
+	next.ServeHTTP(w, r)
 }
 
 func getClientIP(r *http.Request) string {
@@ -131,7 +96,6 @@ func getClientIP(r *http.Request) string {
 
 func writeTooManyRequests(w http.ResponseWriter, detail string) {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.Header().Set("Retry-After", "60")
 	w.WriteHeader(http.StatusTooManyRequests)
 	_ = json.NewEncoder(w).Encode(api.NewTooManyRequests(detail))
 }
@@ -1,15 +1,17 @@
 package middleware
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
-	"golang.org/x/time/rate"
-
+	"github.com/specvital/web/src/backend/common/ratelimit"
 	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
 )
 
 // mockRateLimiter is a test double for RateLimiter interface.
@@ -17,12 +19,13 @@ type mockRateLimiter struct {
 	allowFunc func(key string) bool
 }
 
-func (m *mockRateLimiter) Allow(key string) bool {
-	return m.allowFunc(key)
-}
-
-func (m *mockRateLimiter) Stop() {
-	// No-op for mock
+func (m *mockRateLimiter) Allow(_ context.Context, key string) (ratelimit.Decision, error) {
+	allowed := m.allowFunc(key)
+	d := ratelimit.Decision{Allowed: allowed, Limit: 5, ResetAfter: 30 * time.Second}
+	if allowed {
+		d.Remaining = 4
+	}
+	return d, nil
 }
 
 func TestRateLimit(t *testing.T) {
@@ -136,90 +139,62 @@ func TestRateLimitWithRealIP(t *testing.T) {
 	}
 }
 
-func TestIPRateLimiter(t *testing.T) {
-	t.Run("allow requests within rate limit", func(t *testing.T) {
-		limiter := NewIPRateLimiter(60) // 60 requests per minute = 1 per second
-		defer limiter.Stop()
-
-		ip := "192.168.1.1"
-		if !limiter.Allow(ip) {
-			t.Error("expected first request to be allowed")
-		}
-	})
-
-	t.Run("block requests exceeding burst", func(t *testing.T) {
-		limiter := NewIPRateLimiter(60) // 60 requests per minute
-		defer limiter.Stop()
-
-		ip := "192.168.1.2"
-
-		// First request should be allowed (within burst)
-		if !limiter.Allow(ip) {
-			t.Error("expected first request to be allowed")
-		}
-
-		// Second immediate request should be blocked (burst = 1)
-		if limiter.Allow(ip) {
-			t.Error("expected second immediate request to be blocked")
-		}
-	})
+func TestRateLimitHeaders(t *testing.T) {
+	tests := []struct {
+		name            string
+		allowed         bool
+		expectRemaining string
+		expectRetry     string
+	}{
+		{name: "allowed request", allowed: true, expectRemaining: "4", expectRetry: ""},
+		{name: "rejected request", allowed: false, expectRemaining: "0", expectRetry: "30"},
+	}
 
-	t.Run("separate rate limits per IP", func(t *testing.T) {
-		limiter := NewIPRateLimiter(60)
-		defer limiter.Stop()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := &mockRateLimiter{allowFunc: func(string) bool { return tt.allowed }}
+			handler := RateLimit(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
 
-		ip1 := "192.168.1.1"
-		ip2 := "192.168.1.2"
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", nil))
 
-		// Both IPs should have their own limiters
-		if !limiter.Allow(ip1) {
-			t.Error("expected first request from IP1 to be allowed")
-		}
-		if !limiter.Allow(ip2) {
-			t.Error("expected first request from IP2 to be allowed")
-		}
+			if got := rec.Header().Get("RateLimit-Limit"); got != "5" {
+				t.Errorf("expected RateLimit-Limit 5, got %q", got)
+			}
+			if got := rec.Header().Get("RateLimit-Remaining"); got != tt.expectRemaining {
+				t.Errorf("expected RateLimit-Remaining %q, got %q", tt.expectRemaining, got)
+			}
+			if got := rec.Header().Get("RateLimit-Reset"); got != "30" {
+				t.Errorf("expected RateLimit-Reset 30, got %q", got)
+			}
+			if got := rec.Header().Get("Retry-After"); got != tt.expectRetry {
+				t.Errorf("expected Retry-After %q, got %q", tt.expectRetry, got)
+			}
+		})
+	}
+}
 
-		// Second requests should be blocked for both
-		if limiter.Allow(ip1) {
-			t.Error("expected second immediate request from IP1 to be blocked")
-		}
-		if limiter.Allow(ip2) {
-			t.Error("expected second immediate request from IP2 to be blocked")
-		}
-	})
+type failingRateLimiter struct{}
 
-	t.Run("use default rate when invalid", func(t *testing.T) {
-		limiter := NewIPRateLimiter(0) // Invalid rate
-		defer limiter.Stop()
+func (failingRateLimiter) Allow(context.Context, string) (ratelimit.Decision, error) {
+	return ratelimit.Decision{Allowed: true}, errors.New("store unavailable")
+}
 
-		// Should use default rate (5 per minute)
-		ipLimiter, ok := limiter.(*ipRateLimiter)
-		if !ok {
-			t.Fatal("expected ipRateLimiter type")
-		}
+func TestRateLimitFailsOpen(t *testing.T) {
+	handler := RateLimit(failingRateLimiter{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
 
-		expectedRate := rate.Limit(float64(defaultRateLimit) / 60.0)
-		if ipLimiter.rate != expectedRate {
-			t.Errorf("expected rate %v, got %v", expectedRate, ipLimiter.rate)
-		}
-	})
-}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", nil))
 
-func TestIPRateLimiterConcurrency(t *testing.T) {
-	limiter := NewIPRateLimiter(60)
-	defer limiter.Stop()
-	ip := "192.168.1.1"
-
-	done := make(chan bool)
-	for range 10 {
-		go func() {
-			limiter.Allow(ip)
-			done <- true
-		}()
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
 	}
-
-	for range 10 {
-		<-done
+	if got := rec.Header().Get("RateLimit-Limit"); got != "" {
+		t.Errorf("expected no rate limit headers, got RateLimit-Limit %q", got)
 	}
 }
 
@@ -261,8 +236,9 @@ func TestGetClientIP(t *testing.T) {
 }
 
 func BenchmarkRateLimit(b *testing.B) {
-	limiter := NewIPRateLimiter(6000)
-	defer limiter.Stop()
+	store := ratelimit.NewMemoryStore(time.Minute)
+	defer func() { _ = store.Close() }()
+	limiter := ratelimit.NewLimiter(store, "bench", 6000, time.Minute)
 
 	handler := RateLimit(limiter)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -276,40 +252,77 @@ func BenchmarkRateLimit(b *testing.B) {
 	}
 }
 
-func TestIPRateLimiterIntegration(t *testing.T) {
-	// Integration test: verify actual rate limiting behavior over time
-	limiter := NewIPRateLimiter(60) // 60 requests per minute = 1 per second
-	defer limiter.Stop()
-	ip := "192.168.1.1"
+type mockUserRateLimiter struct {
+	limits []int
+}
 
-	// First request allowed
-	if !limiter.Allow(ip) {
-		t.Fatal("expected first request to be allowed")
-	}
+func (m *mockUserRateLimiter) AllowLimit(_ context.Context, _ string, limit int) (ratelimit.Decision, error) {
+	m.limits = append(m.limits, limit)
+	return ratelimit.Decision{Allowed: false, Limit: limit, ResetAfter: 12 * time.Second}, nil
+}
 
-	// Immediate second request blocked (burst exhausted)
-	if limiter.Allow(ip) {
-		t.Fatal("expected immediate second request to be blocked")
-	}
+type mockUserRateLimitLookup struct {
+	err   error
+	limit int
+}
 
-	// Wait for token to refill (rate is 1 per second)
-	time.Sleep(1100 * time.Millisecond)
+func (m *mockUserRateLimitLookup) GetUserRateLimit(_ context.Context, _ string) (int, error) {
+	return m.limit, m.err
+}
 
-	// Request should be allowed after waiting
-	if !limiter.Allow(ip) {
-		t.Error("expected request to be allowed after token refill")
+func TestUserRateLimit(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	serve := func(limiter *mockUserRateLimiter, lookup *mockUserRateLimitLookup, userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		if userID != "" {
+			req = req.WithContext(WithClaims(req.Context(), &entity.Claims{Subject: userID}))
+		}
+		rec := httptest.NewRecorder()
+		UserRateLimit(limiter, lookup)(next).ServeHTTP(rec, req)
+		return rec
 	}
-}
 
-func TestIPRateLimiterStop(t *testing.T) {
-	limiter := NewIPRateLimiter(60)
+	t.Run("applies the user's limit", func(t *testing.T) {
+		limiter := &mockUserRateLimiter{}
+		rec := serve(limiter, &mockUserRateLimitLookup{limit: 300}, "user-1")
 
-	// Should be able to stop without panic
-	limiter.Stop()
+		if rec.Code != http.StatusTooManyRequests {
+			t.Errorf("expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+		}
+		if len(limiter.limits) != 1 || limiter.limits[0] != 300 {
+			t.Errorf("expected limit 300 to be applied, got %v", limiter.limits)
+		}
+		if got := rec.Header().Get("Retry-After"); got != "12" {
+			t.Errorf("expected Retry-After 12, got %q", got)
+		}
+	})
 
-	// After stop, Allow should still work (graceful degradation)
-	// Note: cleanup goroutine is stopped, but Allow still functions
-	if !limiter.Allow("192.168.1.1") {
-		t.Error("expected request to be allowed after stop")
-	}
+	t.Run("skips anonymous requests", func(t *testing.T) {
+		limiter := &mockUserRateLimiter{}
+		rec := serve(limiter, &mockUserRateLimitLookup{limit: 300}, "")
+
+		if rec.Code != http.StatusOK || len(limiter.limits) != 0 {
+			t.Errorf("expected anonymous request to pass unlimited, got %d %v", rec.Code, limiter.limits)
+		}
+	})
+
+	t.Run("skips unlimited users", func(t *testing.T) {
+		limiter := &mockUserRateLimiter{}
+		rec := serve(limiter, &mockUserRateLimitLookup{limit: 0}, "user-1")
+
+		if rec.Code != http.StatusOK || len(limiter.limits) != 0 {
+			t.Errorf("expected unlimited user to pass, got %d %v", rec.Code, limiter.limits)
+		}
+	})
+
+	t.Run("fails open when the limit lookup fails", func(t *testing.T) {
+		limiter := &mockUserRateLimiter{}
+		rec := serve(limiter, &mockUserRateLimitLookup{err: errors.New("db down")}, "user-1")
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+	})
 }
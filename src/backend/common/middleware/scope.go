@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+)
+
+// RequireTokenScopes limits requests authenticated with a personal access
+// token to the operations listed in required, keyed by operation ID. Any
+// operation not listed (e.g. token management itself) is session-only.
+// Cookie sessions are never restricted.
+func RequireTokenScopes(required map[string]entity.Scope) api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		scope, scoped := required[operationID]
+
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+			claims := GetClaims(ctx)
+			if claims == nil || !claims.IsPersonalAccessToken() {
+				return f(ctx, w, r, request)
+			}
+
+			if !scoped {
+				writeForbidden(w, "this operation is not available to personal access tokens")
+				return nil, nil
+			}
+			if !claims.HasScope(scope) {
+				writeForbidden(w, "personal access token lacks the "+string(scope)+" scope")
+				return nil, nil
+			}
+			return f(ctx, w, r, request)
+		}
+	}
+}
+
+func writeForbidden(w http.ResponseWriter, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(http.StatusForbidden)
+	_ = json.NewEncoder(w).Encode(api.NewForbidden(detail))
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+)
+
+func TestRequireTokenScopes(t *testing.T) {
+	required := map[string]entity.Scope{
+		"GetSpecDocument":       entity.ScopeSpecRead,
+		"RequestSpecGeneration": entity.ScopeSpecWrite,
+	}
+	tokenClaims := &entity.Claims{
+		PersonalAccessTokenID: "pat-123",
+		Scopes:                []entity.Scope{entity.ScopeSpecRead},
+		Subject:               "user-123",
+	}
+	sessionClaims := &entity.Claims{Subject: "user-123"}
+
+	tests := []struct {
+		name        string
+		claims      *entity.Claims
+		operationID string
+		wantCalled  bool
+	}{
+		{name: "anonymous request", operationID: "RequestSpecGeneration", wantCalled: true},
+		{name: "session is unrestricted", claims: sessionClaims, operationID: "CreatePersonalAccessToken", wantCalled: true},
+		{name: "token with scope", claims: tokenClaims, operationID: "GetSpecDocument", wantCalled: true},
+		{name: "token without scope", claims: tokenClaims, operationID: "RequestSpecGeneration"},
+		{name: "token on session-only operation", claims: tokenClaims, operationID: "CreatePersonalAccessToken"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			next := func(_ context.Context, _ http.ResponseWriter, _ *http.Request, _ any) (any, error) {
+				called = true
+				return nil, nil
+			}
+			handler := RequireTokenScopes(required)(next, tt.operationID)
+
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = WithClaims(ctx, tt.claims)
+			}
+			req := httptest.NewRequest(http.MethodGet, "/api/test", nil).WithContext(ctx)
+			rec := httptest.NewRecorder()
+
+			if _, err := handler(ctx, rec, req, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if called != tt.wantCalled {
+				t.Errorf("expected called=%v, got %v", tt.wantCalled, called)
+			}
+			if !tt.wantCalled && rec.Code != http.StatusForbidden {
+				t.Errorf("expected status 403, got %d", rec.Code)
+			}
+		})
+	}
+}
@@ -29,6 +29,33 @@ const (
 	QueueSpecViewScheduled = BaseQueueSpecView + SuffixScheduled
 )
 
+// Queues worked by the web service itself (not the worker service).
+// QueueWebMaintenance carries operational jobs such as bulk re-analysis;
+// QueueWebBatch carries user-requested batch submissions so they are not
+// stuck behind long maintenance runs.
+const (
+	QueueWebBatch       = "web_batch"
+	QueueWebMaintenance = "web_maintenance"
+)
+
+// WorkerQueues returns the queues in the shared River schema, worked by the
+// worker service.
+func WorkerQueues() []string {
+	return []string{
+		QueueAnalysisPriority,
+		QueueAnalysisDefault,
+		QueueAnalysisScheduled,
+		QueueSpecViewPriority,
+		QueueSpecViewDefault,
+		QueueSpecViewScheduled,
+	}
+}
+
+// WebQueues returns the queues worked by the web service's own River client.
+func WebQueues() []string {
+	return []string{QueueWebBatch, QueueWebMaintenance}
+}
+
 // SelectQueue determines the target queue based on plan tier and scheduling status.
 // Priority queue is for paying users (pro, pro_plus, enterprise).
 // Default queue is for free tier users.
@@ -1,97 +1,102 @@
 package ratelimit
 
 import (
-	"sync"
+	"context"
+	"math"
+	"net/http"
+	"strconv"
 	"time"
 )
 
-// IPRateLimiter provides in-memory rate limiting per IP address.
-// Uses a simple fixed window counter with automatic cleanup of stale entries.
-type IPRateLimiter struct {
-	counters    map[string]*counter
-	limit       int
-	window      time.Duration
-	mu          sync.RWMutex
-	cleanupTick time.Duration
-	stopCleanup chan struct{}
+// Store persists per-window hit counters. Implementations backed by shared
+// storage let every replica enforce the same limit.
+type Store interface {
+	// Count returns the hits recorded for key in the window starting at windowStart.
+	Count(ctx context.Context, key string, windowStart time.Time) (int, error)
+	// Increment records a hit for key in the window starting at windowStart
+	// unless the window already holds maxHits. It returns the new count and
+	// whether the hit was recorded. Counters may be discarded after expiresAt.
+	Increment(ctx context.Context, key string, windowStart time.Time, maxHits int, expiresAt time.Time) (int, bool, error)
 }
 
-type counter struct {
-	count     int
-	expiresAt time.Time
+// Decision is the outcome of a single rate limit check.
+type Decision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
 }
 
-// NewIPRateLimiter creates a new rate limiter.
-// limit: maximum requests per window
-// window: time window duration (e.g., 1 minute, 1 hour)
-func NewIPRateLimiter(limit int, window time.Duration) *IPRateLimiter {
-	rl := &IPRateLimiter{
-		counters:    make(map[string]*counter),
-		limit:       limit,
-		window:      window,
-		cleanupTick: window * 2,
-		stopCleanup: make(chan struct{}),
-	}
+// Limiter enforces a sliding window limit on top of a Store.
+// The previous window's hits are weighted by how much of it still overlaps
+// the sliding window, which smooths out bursts at window boundaries.
+type Limiter struct {
+	limit  int
+	name   string
+	now    func() time.Time
+	store  Store
+	window time.Duration
+}
 
-	go rl.cleanup()
+// NewLimiter creates a limiter allowing limit hits per window for each key.
+// name namespaces the keys so several limiters can share one store.
+func NewLimiter(store Store, name string, limit int, window time.Duration) *Limiter {
+	return &Limiter{
+		limit:  limit,
+		name:   name,
+		now:    time.Now,
+		store:  store,
+		window: window,
+	}
+}
 
-	return rl
+// Allow records a hit for key and reports whether it is within the limit.
+// On store errors the hit is allowed and the error returned so callers can
+// log it; a broken store must not take the API down.
+func (l *Limiter) Allow(ctx context.Context, key string) (Decision, error) {
+	return l.AllowLimit(ctx, key, l.limit)
 }
 
-// Allow checks if the request from the given IP should be allowed.
-// Returns true if allowed, false if rate limited.
-func (rl *IPRateLimiter) Allow(ip string) bool {
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
+// AllowLimit is Allow with a per-call limit, for keys whose limit depends on
+// the caller (e.g. the user's plan).
+func (l *Limiter) AllowLimit(ctx context.Context, key string, limit int) (Decision, error) {
+	now := l.now()
+	windowStart := now.Truncate(l.window)
+	elapsed := now.Sub(windowStart)
+	bucket := l.name + ":" + key
+
+	previous, err := l.store.Count(ctx, bucket, windowStart.Add(-l.window))
+	if err != nil {
+		return Decision{Allowed: true}, err
+	}
 
-	now := time.Now()
+	weighted := float64(previous) * float64(l.window-elapsed) / float64(l.window)
+	maxHits := int(math.Floor(float64(limit) - weighted))
 
-	c, exists := rl.counters[ip]
-	if !exists || now.After(c.expiresAt) {
-		rl.counters[ip] = &counter{
-			count:     1,
-			expiresAt: now.Add(rl.window),
-		}
-		return true
+	count, ok, err := l.store.Increment(ctx, bucket, windowStart, maxHits, windowStart.Add(2*l.window))
+	if err != nil {
+		return Decision{Allowed: true}, err
 	}
 
-	if c.count >= rl.limit {
-		return false
+	decision := Decision{
+		Allowed:    ok,
+		Limit:      limit,
+		ResetAfter: l.window - elapsed,
 	}
-
-	c.count++
-	return true
-}
-
-// cleanup periodically removes expired entries to prevent memory leaks.
-func (rl *IPRateLimiter) cleanup() {
-	ticker := time.NewTicker(rl.cleanupTick)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			rl.removeExpired()
-		case <-rl.stopCleanup:
-			return
-		}
+	if ok {
+		decision.Remaining = maxHits - count
 	}
+	return decision, nil
 }
 
-func (rl *IPRateLimiter) removeExpired() {
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
-
-	now := time.Now()
-	for ip, c := range rl.counters {
-		if now.After(c.expiresAt) {
-			delete(rl.counters, ip)
-		}
+// SetHeaders writes the RateLimit-* headers for d, plus Retry-After when the
+// request was rejected.
+func SetHeaders(h http.Header, d Decision) {
+	reset := strconv.Itoa(int(math.Ceil(d.ResetAfter.Seconds())))
+	h.Set("RateLimit-Limit", strconv.Itoa(d.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(d.Remaining))
+	h.Set("RateLimit-Reset", reset)
+	if !d.Allowed {
+		h.Set("Retry-After", reset)
 	}
 }
-
-// Close stops the cleanup goroutine. Implements io.Closer.
-func (rl *IPRateLimiter) Close() error {
-	close(rl.stopCleanup)
-	return nil
-}
@@ -1,69 +1,195 @@
 package ratelimit
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 )
 
-func TestIPRateLimiter_Allow(t *testing.T) {
-	limiter := NewIPRateLimiter(3, 100*time.Millisecond)
-	defer func() { _ = limiter.Close() }()
+func newTestLimiter(t *testing.T, limit int, window time.Duration, now *time.Time) *Limiter {
+	t.Helper()
+	store := NewMemoryStore(time.Hour)
+	t.Cleanup(func() { _ = store.Close() })
 
-	ip := "192.168.1.1"
+	limiter := NewLimiter(store, "test", limit, window)
+	limiter.now = func() time.Time { return *now }
+	return limiter
+}
 
-	if !limiter.Allow(ip) {
-		t.Error("first request should be allowed")
-	}
-	if !limiter.Allow(ip) {
-		t.Error("second request should be allowed")
-	}
-	if !limiter.Allow(ip) {
-		t.Error("third request should be allowed")
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(t, 3, time.Minute, &now)
+	ctx := context.Background()
+
+	for i, wantRemaining := range []int{2, 1, 0} {
+		d, err := limiter.Allow(ctx, "192.168.1.1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !d.Allowed {
+			t.Fatalf("request %d should be allowed", i+1)
+		}
+		if d.Remaining != wantRemaining {
+			t.Errorf("request %d: expected remaining %d, got %d", i+1, wantRemaining, d.Remaining)
+		}
 	}
 
-	if limiter.Allow(ip) {
+	d, _ := limiter.Allow(ctx, "192.168.1.1")
+	if d.Allowed {
 		t.Error("fourth request should be rate limited")
 	}
+	if d.Limit != 3 || d.Remaining != 0 {
+		t.Errorf("expected limit 3 remaining 0, got %d/%d", d.Limit, d.Remaining)
+	}
+	if d.ResetAfter != time.Minute {
+		t.Errorf("expected reset after 1m, got %v", d.ResetAfter)
+	}
 }
 
-func TestIPRateLimiter_WindowReset(t *testing.T) {
-	limiter := NewIPRateLimiter(2, 50*time.Millisecond)
-	defer func() { _ = limiter.Close() }()
+func TestLimiter_SlidingWindow(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(t, 4, time.Minute, &now)
+	ctx := context.Background()
 
-	ip := "192.168.1.1"
+	for range 4 {
+		if d, _ := limiter.Allow(ctx, "ip"); !d.Allowed {
+			t.Fatal("requests within the limit should be allowed")
+		}
+	}
 
-	if !limiter.Allow(ip) {
-		t.Error("first request should be allowed")
+	// A quarter into the next window, 3 of the previous 4 hits still count.
+	now = now.Add(75 * time.Second)
+	d, _ := limiter.Allow(ctx, "ip")
+	if !d.Allowed || d.Remaining != 0 {
+		t.Fatalf("expected exactly one allowed request, got %+v", d)
 	}
-	if !limiter.Allow(ip) {
-		t.Error("second request should be allowed")
+	if d, _ := limiter.Allow(ctx, "ip"); d.Allowed {
+		t.Error("expected weighted previous window to block the request")
 	}
-	if limiter.Allow(ip) {
-		t.Error("third request should be rate limited")
+
+	// Two windows later nothing from the first window remains.
+	now = now.Add(2 * time.Minute)
+	if d, _ := limiter.Allow(ctx, "ip"); !d.Allowed || d.Remaining != 3 {
+		t.Errorf("expected a fresh limit, got %+v", d)
 	}
+}
 
-	time.Sleep(60 * time.Millisecond)
+func TestLimiter_AllowLimit(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(t, 1, time.Minute, &now)
+	ctx := context.Background()
 
-	if !limiter.Allow(ip) {
-		t.Error("request after window reset should be allowed")
+	for i := range 3 {
+		d, _ := limiter.AllowLimit(ctx, "user-1", 3)
+		if !d.Allowed || d.Limit != 3 {
+			t.Fatalf("request %d: expected allowed with limit 3, got %+v", i+1, d)
+		}
+	}
+	if d, _ := limiter.AllowLimit(ctx, "user-1", 3); d.Allowed {
+		t.Error("expected per-call limit to be enforced")
 	}
 }
 
-func TestIPRateLimiter_DifferentIPs(t *testing.T) {
-	limiter := NewIPRateLimiter(1, 100*time.Millisecond)
-	defer func() { _ = limiter.Close() }()
+func TestLimiter_DifferentKeys(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newTestLimiter(t, 1, time.Minute, &now)
+	ctx := context.Background()
 
-	ip1 := "192.168.1.1"
-	ip2 := "192.168.1.2"
-
-	if !limiter.Allow(ip1) {
+	if d, _ := limiter.Allow(ctx, "192.168.1.1"); !d.Allowed {
 		t.Error("first IP first request should be allowed")
 	}
-	if limiter.Allow(ip1) {
+	if d, _ := limiter.Allow(ctx, "192.168.1.1"); d.Allowed {
 		t.Error("first IP second request should be rate limited")
 	}
-
-	if !limiter.Allow(ip2) {
+	if d, _ := limiter.Allow(ctx, "192.168.1.2"); !d.Allowed {
 		t.Error("second IP should be allowed (separate limit)")
 	}
 }
+
+func TestLimiter_SharedStoreNamespaces(t *testing.T) {
+	store := NewMemoryStore(time.Hour)
+	defer func() { _ = store.Close() }()
+
+	auth := NewLimiter(store, "auth", 1, time.Minute)
+	analyze := NewLimiter(store, "analyze", 1, time.Minute)
+	ctx := context.Background()
+
+	if d, _ := auth.Allow(ctx, "ip"); !d.Allowed {
+		t.Error("auth request should be allowed")
+	}
+	if d, _ := analyze.Allow(ctx, "ip"); !d.Allowed {
+		t.Error("analyze limit should not be consumed by auth requests")
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Count(context.Context, string, time.Time) (int, error) {
+	return 0, errors.New("store unavailable")
+}
+
+func (failingStore) Increment(context.Context, string, time.Time, int, time.Time) (int, bool, error) {
+	return 0, false, errors.New("store unavailable")
+}
+
+func TestLimiter_FailsOpen(t *testing.T) {
+	limiter := NewLimiter(failingStore{}, "test", 1, time.Minute)
+
+	d, err := limiter.Allow(context.Background(), "ip")
+	if err == nil {
+		t.Error("expected store error to be returned")
+	}
+	if !d.Allowed {
+		t.Error("expected request to be allowed when the store fails")
+	}
+}
+
+func TestMemoryStore_RemoveExpired(t *testing.T) {
+	store := NewMemoryStore(time.Hour)
+	defer func() { _ = store.Close() }()
+	ctx := context.Background()
+
+	now := time.Now()
+	_, _, _ = store.Increment(ctx, "old", now, 1, now.Add(-time.Second))
+	_, _, _ = store.Increment(ctx, "new", now, 1, now.Add(time.Hour))
+
+	store.removeExpired(now)
+
+	if n, _ := store.Count(ctx, "old", now); n != 0 {
+		t.Errorf("expected expired counter to be removed, got %d", n)
+	}
+	if n, _ := store.Count(ctx, "new", now); n != 1 {
+		t.Errorf("expected live counter to be kept, got %d", n)
+	}
+}
+
+func TestSetHeaders(t *testing.T) {
+	t.Run("allowed", func(t *testing.T) {
+		h := http.Header{}
+		SetHeaders(h, Decision{Allowed: true, Limit: 10, Remaining: 7, ResetAfter: 1500 * time.Millisecond})
+
+		if got := h.Get("RateLimit-Limit"); got != "10" {
+			t.Errorf("expected RateLimit-Limit 10, got %q", got)
+		}
+		if got := h.Get("RateLimit-Remaining"); got != "7" {
+			t.Errorf("expected RateLimit-Remaining 7, got %q", got)
+		}
+		if got := h.Get("RateLimit-Reset"); got != "2" {
+			t.Errorf("expected RateLimit-Reset 2, got %q", got)
+		}
+		if got := h.Get("Retry-After"); got != "" {
+			t.Errorf("expected no Retry-After, got %q", got)
+		}
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		h := http.Header{}
+		SetHeaders(h, Decision{Limit: 10, ResetAfter: 30 * time.Second})
+
+		if got := h.Get("Retry-After"); got != "30" {
+			t.Errorf("expected Retry-After 30, got %q", got)
+		}
+	})
+}
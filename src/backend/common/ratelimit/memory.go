@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps counters in process memory. Limits are per replica and
+// reset on restart; use PostgresStore when running more than one instance.
+type MemoryStore struct {
+	counters    map[memoryKey]*memoryCounter
+	mu          sync.Mutex
+	stopCleanup chan struct{}
+}
+
+type memoryKey struct {
+	key         string
+	windowStart int64
+}
+
+type memoryCounter struct {
+	count     int
+	expiresAt time.Time
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates a store that drops expired counters every cleanupInterval.
+func NewMemoryStore(cleanupInterval time.Duration) *MemoryStore {
+	s := &MemoryStore{
+		counters:    make(map[memoryKey]*memoryCounter),
+		stopCleanup: make(chan struct{}),
+	}
+
+	go s.cleanup(cleanupInterval)
+
+	return s
+}
+
+func (s *MemoryStore) Count(_ context.Context, key string, windowStart time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c, ok := s.counters[memoryKey{key, windowStart.UnixNano()}]; ok {
+		return c.count, nil
+	}
+	return 0, nil
+}
+
+func (s *MemoryStore) Increment(_ context.Context, key string, windowStart time.Time, maxHits int, expiresAt time.Time) (int, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := memoryKey{key, windowStart.UnixNano()}
+	c, ok := s.counters[k]
+	if !ok {
+		c = &memoryCounter{expiresAt: expiresAt}
+	}
+	if c.count >= maxHits {
+		return c.count, false, nil
+	}
+
+	c.count++
+	s.counters[k] = c
+	return c.count, true, nil
+}
+
+func (s *MemoryStore) cleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.removeExpired(time.Now())
+		case <-s.stopCleanup:
+			return
+		}
+	}
+}
+
+func (s *MemoryStore) removeExpired(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, c := range s.counters {
+		if now.After(c.expiresAt) {
+			delete(s.counters, k)
+		}
+	}
+}
+
+// Close stops the cleanup goroutine. Implements io.Closer.
+func (s *MemoryStore) Close() error {
+	close(s.stopCleanup)
+	return nil
+}
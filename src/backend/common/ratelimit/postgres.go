@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+)
+
+// PostgresStore keeps counters in the rate_limit_counters table so every
+// replica shares the same limits and they survive restarts.
+type PostgresStore struct {
+	queries     *db.Queries
+	stopCleanup chan struct{}
+}
+
+var _ Store = (*PostgresStore)(nil)
+
+// NewPostgresStore creates a store that deletes expired counters every cleanupInterval.
+func NewPostgresStore(queries *db.Queries, cleanupInterval time.Duration) *PostgresStore {
+	s := &PostgresStore{
+		queries:     queries,
+		stopCleanup: make(chan struct{}),
+	}
+
+	go s.cleanup(cleanupInterval)
+
+	return s
+}
+
+func (s *PostgresStore) Count(ctx context.Context, key string, windowStart time.Time) (int, error) {
+	count, err := s.queries.GetRateLimitHitCount(ctx, db.GetRateLimitHitCountParams{
+		BucketKey:   key,
+		WindowStart: pgtype.Timestamptz{Time: windowStart, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (s *PostgresStore) Increment(ctx context.Context, key string, windowStart time.Time, maxHits int, expiresAt time.Time) (int, bool, error) {
+	count, err := s.queries.HitRateLimitCounter(ctx, db.HitRateLimitCounterParams{
+		BucketKey:   key,
+		WindowStart: pgtype.Timestamptz{Time: windowStart, Valid: true},
+		ExpiresAt:   pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		MaxHits:     int32(maxHits),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return maxHits, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return int(count), true, nil
+}
+
+func (s *PostgresStore) cleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.removeExpired()
+		case <-s.stopCleanup:
+			return
+		}
+	}
+}
+
+func (s *PostgresStore) removeExpired() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	now := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+	if _, err := s.queries.DeleteExpiredRateLimitCounters(ctx, now); err != nil {
+		slog.Warn("failed to delete expired rate limit counters", "error", err)
+	}
+}
+
+// Close stops the cleanup goroutine. Implements io.Closer.
+func (s *PostgresStore) Close() error {
+	close(s.stopCleanup)
+	return nil
+}
@@ -6,10 +6,14 @@ import (
 	"io"
 	"time"
 
+	"github.com/riverqueue/river"
+
 	"github.com/specvital/web/src/backend/common/docs"
 	"github.com/specvital/web/src/backend/common/health"
 	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/metrics"
 	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/common/queue"
 	"github.com/specvital/web/src/backend/common/ratelimit"
 	"github.com/specvital/web/src/backend/internal/api"
 	"github.com/specvital/web/src/backend/internal/client"
@@ -17,6 +21,7 @@ import (
 	"github.com/specvital/web/src/backend/internal/infra"
 	analyzeradapter "github.com/specvital/web/src/backend/modules/analyzer/adapter"
 	analyzerhandler "github.com/specvital/web/src/backend/modules/analyzer/handler"
+	analyzerjob "github.com/specvital/web/src/backend/modules/analyzer/job"
 	analyzerusecase "github.com/specvital/web/src/backend/modules/analyzer/usecase"
 	authadapter "github.com/specvital/web/src/backend/modules/auth/adapter"
 	authhandler "github.com/specvital/web/src/backend/modules/auth/handler"
@@ -33,7 +38,11 @@ import (
 	bookmarkuc "github.com/specvital/web/src/backend/modules/user/usecase/bookmark"
 	historyuc "github.com/specvital/web/src/backend/modules/user/usecase/history"
 
+	retentionadapter "github.com/specvital/web/src/backend/modules/retention/adapter"
+	retentionjob "github.com/specvital/web/src/backend/modules/retention/job"
+	retentionusecase "github.com/specvital/web/src/backend/modules/retention/usecase"
 	specviewadapter "github.com/specvital/web/src/backend/modules/spec-view/adapter"
+	specviewrender "github.com/specvital/web/src/backend/modules/spec-view/adapter/render"
 	specviewhandler "github.com/specvital/web/src/backend/modules/spec-view/handler"
 	specviewusecase "github.com/specvital/web/src/backend/modules/spec-view/usecase"
 	subscriptionadapter "github.com/specvital/web/src/backend/modules/subscription/adapter"
@@ -42,13 +51,26 @@ import (
 	usageadapter "github.com/specvital/web/src/backend/modules/usage/adapter"
 	usagehandler "github.com/specvital/web/src/backend/modules/usage/handler"
 	usageusecase "github.com/specvital/web/src/backend/modules/usage/usecase"
+	workspaceadapter "github.com/specvital/web/src/backend/modules/workspace/adapter"
+	workspacehandler "github.com/specvital/web/src/backend/modules/workspace/handler"
+	workspaceusecase "github.com/specvital/web/src/backend/modules/workspace/usecase"
+)
+
+const (
+	commitCacheStatsInterval = 5 * time.Minute
+	// githubAppCheckInterval spaces out readiness calls to the GitHub API.
+	githubAppCheckInterval = 5 * time.Minute
 )
 
 type Handlers struct {
-	API     api.StrictServerInterface
-	Docs    *docs.Handler
-	Health  *health.Handler
-	Webhook api.WebhookHandlers
+	API            api.StrictServerInterface
+	APIMiddlewares []api.StrictMiddlewareFunc
+	Docs           *docs.Handler
+	Health         *health.Handler
+	Metrics        *metrics.Handler
+	PersonalTokens middleware.PersonalAccessTokenAuthenticator
+	RateLimiters   RateLimiters
+	Webhook        api.WebhookHandlers
 }
 
 type App struct {
@@ -71,6 +93,7 @@ func NewApp(ctx context.Context) (*App, error) {
 	}
 	authMiddleware := middleware.NewAuthMiddleware(
 		container.JWTManager,
+		handlers.PersonalTokens,
 		authhandler.AccessCookieName,
 		authhandler.RefreshCookieName,
 	)
@@ -119,6 +142,14 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 	initiateOAuthUC := authusecase.NewInitiateOAuthUseCase(container.GitHubOAuth, stateStore)
 	refreshTokenUC := authusecase.NewRefreshTokenUseCase(refreshTokenRepo, authRepo, container.JWTManager)
 
+	personalAccessTokenRepo := authadapter.NewPersonalAccessTokenPostgresRepository(queries)
+	createPersonalAccessTokenUC := authusecase.NewCreatePersonalAccessTokenUseCase(personalAccessTokenRepo, container.JWTManager)
+	listPersonalAccessTokensUC := authusecase.NewListPersonalAccessTokensUseCase(personalAccessTokenRepo)
+	revokePersonalAccessTokenUC := authusecase.NewRevokePersonalAccessTokenUseCase(personalAccessTokenRepo)
+	personalAccessTokenAuthenticator := authadapter.NewPersonalAccessTokenAuthenticatorAdapter(
+		authusecase.NewAuthenticatePersonalAccessTokenUseCase(personalAccessTokenRepo, container.JWTManager),
+	)
+
 	var devLoginUC *authusecase.DevLoginUseCase
 	if container.Environment != "production" {
 		devLoginUC = authusecase.NewDevLoginUseCase(
@@ -130,15 +161,18 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 	}
 
 	authHandler, err := authhandler.NewHandler(&authhandler.HandlerConfig{
-		CookieDomain:        container.CookieDomain,
-		CookieSecure:        container.SecureCookie,
-		DevLogin:            devLoginUC,
-		FrontendURL:         container.FrontendURL,
-		GetCurrentUser:      getCurrentUserUC,
-		HandleOAuthCallback: handleOAuthCallbackUC,
-		InitiateOAuth:       initiateOAuthUC,
-		Logger:              log,
-		RefreshToken:        refreshTokenUC,
+		CookieDomain:              container.CookieDomain,
+		CookieSecure:              container.SecureCookie,
+		CreatePersonalAccessToken: createPersonalAccessTokenUC,
+		DevLogin:                  devLoginUC,
+		FrontendURL:               container.FrontendURL,
+		GetCurrentUser:            getCurrentUserUC,
+		HandleOAuthCallback:       handleOAuthCallbackUC,
+		InitiateOAuth:             initiateOAuthUC,
+		ListPersonalAccessTokens:  listPersonalAccessTokensUC,
+		Logger:                    log,
+		RefreshToken:              refreshTokenUC,
+		RevokePersonalAccessToken: revokePersonalAccessTokenUC,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("create auth handler: %w", err)
@@ -168,9 +202,19 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 		return nil, nil, fmt.Errorf("create user handler: %w", err)
 	}
 
+	ghAppRepo := ghappadapter.NewPostgresRepository(queries)
+	getInstallationTokenUC := ghappusecase.NewGetInstallationTokenUseCase(container.GitHubAppClient, ghAppRepo)
+	installationTokenSource := ghappadapter.NewInstallationTokenSource(ghAppRepo, getInstallationTokenUC)
+	commitCache := client.NewCachingGitClient(
+		client.NewSelectingGitClient(container.GitClient, client.NewGitHubAPIGitClient(), installationTokenSource),
+		container.CommitCacheTTL,
+		commitCacheStatsInterval,
+	)
+	closers = append(closers, commitCache)
+
 	analyzerRepo := analyzeradapter.NewPostgresRepository(queries)
 	analyzerQueue := analyzeradapter.NewRiverQueueService(container.River.Client(), analyzerRepo)
-	analyzerGitClient := analyzeradapter.NewGitClientAdapter(container.GitClient)
+	analyzerGitClient := analyzeradapter.NewGitClientAdapter(commitCache)
 	systemConfig := analyzeradapter.NewSystemConfigPostgres(queries)
 
 	analyzeRepositoryUC := analyzerusecase.NewAnalyzeRepositoryUseCase(analyzerGitClient, analyzerQueue, analyzerRepo, systemConfig, tokenProvider, container.DB, reservationRepo)
@@ -181,8 +225,47 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 	getRepositoryStatsUC := analyzerusecase.NewGetRepositoryStatsUseCase(analyzerRepo)
 	reanalyzeRepositoryUC := analyzerusecase.NewReanalyzeRepositoryUseCase(analyzerGitClient, analyzerQueue, analyzerRepo, tokenProvider)
 
-	anonymousRateLimiter := ratelimit.NewIPRateLimiter(10, time.Minute)
-	closers = append(closers, anonymousRateLimiter)
+	bulkReanalysisConfig := analyzeradapter.BulkReanalysisConfigFromEnv()
+	bulkReanalysisRepo := analyzeradapter.NewBulkReanalysisPostgresRepository(queries)
+	bulkReanalysisScheduler := analyzeradapter.NewRiverBulkReanalysisScheduler(container.RiverWorker.Client())
+
+	startBulkReanalysisUC := analyzerusecase.NewStartBulkReanalysisUseCase(bulkReanalysisRepo, bulkReanalysisScheduler, systemConfig)
+	processBulkReanalysisUC := analyzerusecase.NewProcessBulkReanalysisUseCase(analyzerQueue, bulkReanalysisRepo, bulkReanalysisConfig.EnqueueRate)
+	detectParserUpgradeUC := analyzerusecase.NewDetectParserUpgradeUseCase(bulkReanalysisRepo, startBulkReanalysisUC, systemConfig)
+	getBulkReanalysisRunUC := analyzerusecase.NewGetBulkReanalysisRunUseCase(bulkReanalysisRepo)
+	listBulkReanalysisRunsUC := analyzerusecase.NewListBulkReanalysisRunsUseCase(bulkReanalysisRepo)
+
+	river.AddWorker(container.RiverWorker.Workers(), analyzerjob.NewBulkReanalysisWorker(processBulkReanalysisUC, bulkReanalysisRepo))
+	river.AddWorker(container.RiverWorker.Workers(), analyzerjob.NewParserUpgradeWatcher(detectParserUpgradeUC))
+	if bulkReanalysisConfig.AutoEnabled {
+		container.RiverWorker.Client().PeriodicJobs().Add(analyzerjob.NewParserUpgradeCheckPeriodicJob(bulkReanalysisConfig.CheckInterval))
+	}
+
+	purgeConfig := retentionadapter.PurgeConfigFromEnv()
+	purgeExpiredDataUC := retentionusecase.NewPurgeExpiredDataUseCase(
+		retentionadapter.NewPostgresRepository(queries),
+		purgeConfig.BatchSize,
+		purgeConfig.AnalysisGracePeriod,
+	)
+	river.AddWorker(container.RiverWorker.Workers(), retentionjob.NewPurgeWorker(purgeExpiredDataUC, purgeConfig.DryRun))
+	container.RiverWorker.Client().PeriodicJobs().Add(retentionjob.NewPurgePeriodicJob(purgeConfig.Interval))
+
+	adminHandler, err := analyzerhandler.NewAdminHandler(&analyzerhandler.AdminHandlerConfig{
+		AdminUserIDs:           container.AdminUserIDs,
+		GetBulkReanalysisRun:   getBulkReanalysisRunUC,
+		ListBulkReanalysisRuns: listBulkReanalysisRunsUC,
+		Logger:                 log,
+		StartBulkReanalysis:    startBulkReanalysisUC,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create admin handler: %w", err)
+	}
+
+	rateLimitStore := newRateLimitStore(queries)
+	closers = append(closers, rateLimitStore)
+	anonymousRateLimiter := ratelimit.NewLimiter(rateLimitStore, "analyze-anonymous", anonymousAnalyzeRateLimit, time.Minute)
+	authRateLimiter := ratelimit.NewLimiter(rateLimitStore, "auth", authRateLimit, time.Minute)
+	userRateLimiter := ratelimit.NewLimiter(rateLimitStore, "user", subscriptionadapter.DefaultAPIRateLimitPerMinute, time.Minute)
 
 	tierLookup := subscriptionadapter.NewTierLookupAdapter(subscriptionRepo)
 
@@ -196,16 +279,13 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 		getRepositoryStatsUC,
 		reanalyzeRepositoryUC,
 		historyRepo,
-		anonymousRateLimiter,
 		tierLookup,
 	)
 
 	githubRepo := githubadapter.NewPostgresRepository(container.DB, queries)
 	githubClientFactory := githubadapter.NewGitHubClientFactory(client.NewGitHubClientFactory())
 
-	ghAppRepo := ghappadapter.NewPostgresRepository(queries)
 	installationLookup := githubadapter.NewInstallationLookupAdapter(ghAppRepo)
-	getInstallationTokenUC := ghappusecase.NewGetInstallationTokenUseCase(container.GitHubAppClient, ghAppRepo)
 	installationTokenProvider := githubadapter.NewInstallationTokenProviderAdapter(getInstallationTokenUC)
 
 	listUserReposUC := githubusecase.NewListUserReposUseCase(githubClientFactory, githubRepo, tokenProvider)
@@ -222,7 +302,45 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 		return nil, nil, fmt.Errorf("create github handler: %w", err)
 	}
 
-	handleWebhookUC := ghappusecase.NewHandleWebhookUseCase(ghAppRepo)
+	analysisBatchRepo := analyzeradapter.NewAnalysisBatchPostgresRepository(container.DB, queries)
+	analysisBatchScheduler := analyzeradapter.NewRiverAnalysisBatchScheduler(container.RiverWorker.Client())
+	orgRepositoryLister := githubadapter.NewOrgRepositoryListerAdapter(listOrgReposUC)
+	startAnalysisBatchUC := analyzerusecase.NewStartAnalysisBatchUseCase(orgRepositoryLister, analysisBatchRepo, analysisBatchScheduler, checkQuotaUC)
+	processAnalysisBatchUC := analyzerusecase.NewProcessAnalysisBatchUseCase(analyzeRepositoryUC, analysisBatchRepo, tierLookup)
+	getAnalysisBatchUC := analyzerusecase.NewGetAnalysisBatchUseCase(analysisBatchRepo)
+	river.AddWorker(container.RiverWorker.Workers(), analyzerjob.NewAnalysisBatchWorker(processAnalysisBatchUC))
+
+	analysisBatchHandler, err := analyzerhandler.NewBatchHandler(&analyzerhandler.BatchHandlerConfig{
+		GetAnalysisBatch:   getAnalysisBatchUC,
+		Logger:             log,
+		StartAnalysisBatch: startAnalysisBatchUC,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create analysis batch handler: %w", err)
+	}
+
+	ingestAnalysisUC := analyzerusecase.NewIngestAnalysisUseCase(
+		analyzeradapter.NewAnalysisIngestPostgresRepository(container.DB, queries),
+		analyzeradapter.NewGitHubRepositoryAccessChecker(client.NewGitHubClientFactory(), installationTokenSource, tokenProvider),
+	)
+	ingestHandler, err := analyzerhandler.NewIngestHandler(&analyzerhandler.IngestHandlerConfig{
+		IngestAnalysis: ingestAnalysisUC,
+		Logger:         log,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create analysis ingest handler: %w", err)
+	}
+
+	getOrgDashboardUC := analyzerusecase.NewGetOrgDashboardUseCase(githubadapter.NewOrgAccessCheckerAdapter(githubRepo), analyzerRepo)
+	orgDashboardHandler, err := analyzerhandler.NewOrgDashboardHandler(&analyzerhandler.OrgDashboardHandlerConfig{
+		GetOrgDashboard: getOrgDashboardUC,
+		Logger:          log,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create org dashboard handler: %w", err)
+	}
+
+	handleWebhookUC := ghappusecase.NewHandleWebhookUseCase(ghAppRepo, commitCache)
 	webhookVerifier, err := ghappadapter.NewWebhookVerifier(container.GitHubAppWebhookSecret)
 	if err != nil {
 		return nil, nil, fmt.Errorf("create webhook verifier: %w", err)
@@ -249,29 +367,92 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 		return nil, nil, fmt.Errorf("create github-app api handler: %w", err)
 	}
 
+	workspaceRepo := workspaceadapter.NewPostgresRepository(queries)
+	workspaceHandler, err := workspacehandler.NewHandler(&workspacehandler.HandlerConfig{
+		AcceptInvitation:  workspaceusecase.NewAcceptInvitationUseCase(workspaceRepo),
+		CreateWorkspace:   workspaceusecase.NewCreateWorkspaceUseCase(workspaceRepo),
+		DeclineInvitation: workspaceusecase.NewDeclineInvitationUseCase(workspaceRepo),
+		InviteMember:      workspaceusecase.NewInviteMemberUseCase(workspaceRepo),
+		ListInvitations:   workspaceusecase.NewListInvitationsUseCase(workspaceRepo),
+		ListMembers:       workspaceusecase.NewListMembersUseCase(workspaceRepo),
+		ListWorkspaces:    workspaceusecase.NewListWorkspacesUseCase(workspaceRepo),
+		Logger:            log,
+		RemoveMember:      workspaceusecase.NewRemoveMemberUseCase(workspaceRepo),
+		UpdateMemberRole:  workspaceusecase.NewUpdateMemberRoleUseCase(workspaceRepo),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("create workspace handler: %w", err)
+	}
+	workspaceAccess := workspaceadapter.NewSpecViewAccessAdapter(workspaceRepo)
+
 	specViewRepo := specviewadapter.NewPostgresRepository(queries)
 	specViewQueue := specviewadapter.NewRiverQueueService(container.River.Client())
 
 	getSpecDocumentUC := specviewusecase.NewGetSpecDocumentUseCase(specViewRepo)
-	requestGenerationUC := specviewusecase.NewRequestGenerationUseCase(specViewRepo, specViewQueue, checkQuotaUC, container.DB, reservationRepo)
-	getGenerationStatusUC := specviewusecase.NewGetGenerationStatusUseCase(specViewRepo)
-	getVersionsUC := specviewusecase.NewGetVersionsUseCase(specViewRepo)
+	getWorkspaceSpecUC := specviewusecase.NewGetWorkspaceSpecDocumentUseCase(specViewRepo, workspaceAccess, specViewRepo)
+	requestGenerationUC := specviewusecase.NewRequestGenerationUseCase(specViewRepo, specViewQueue, checkQuotaUC, container.DB, reservationRepo, workspaceAccess, specViewRepo)
+	getGenerationStatusUC := specviewusecase.NewGetGenerationStatusUseCase(specViewRepo, workspaceAccess, specViewRepo)
+	cancelGenerationUC := specviewusecase.NewCancelGenerationUseCase(specViewRepo, specViewQueue, container.DB, reservationRepo, workspaceAccess, specViewRepo)
+	getVersionsUC := specviewusecase.NewGetVersionsUseCase(specViewRepo, workspaceAccess, specViewRepo)
 	getCacheAvailabilityUC := specviewusecase.NewGetCacheAvailabilityUseCase(specViewRepo)
 	getCachePredictionUC := specviewusecase.NewGetCachePredictionUseCase(specViewRepo)
 	getSpecByRepositoryUC := specviewusecase.NewGetSpecByRepositoryUseCase(specViewRepo)
 	getVersionHistoryByRepoUC := specviewusecase.NewGetVersionHistoryByRepositoryUseCase(specViewRepo)
+	getSpecDiffUC := specviewusecase.NewGetSpecDiffUseCase(specViewRepo, workspaceAccess, specViewRepo)
+	getSpecDiffByRepositoryUC := specviewusecase.NewGetSpecDiffByRepositoryUseCase(specViewRepo)
+	searchSpecsUC := specviewusecase.NewSearchSpecsUseCase(specViewRepo, workspaceAccess)
+	getSpecEditsUC := specviewusecase.NewGetSpecEditsUseCase(specViewRepo, workspaceAccess)
+	editBehaviorUC := specviewusecase.NewEditBehaviorUseCase(specViewRepo, workspaceAccess)
+	revertBehaviorEditUC := specviewusecase.NewRevertBehaviorEditUseCase(specViewRepo, workspaceAccess)
+	editFeatureUC := specviewusecase.NewEditFeatureUseCase(specViewRepo, workspaceAccess)
+	revertFeatureEditUC := specviewusecase.NewRevertFeatureEditUseCase(specViewRepo, workspaceAccess)
+	getSpecCommentsUC := specviewusecase.NewGetSpecCommentsUseCase(specViewRepo, workspaceAccess)
+	createCommentThreadUC := specviewusecase.NewCreateCommentThreadUseCase(specViewRepo, workspaceAccess)
+	addCommentUC := specviewusecase.NewAddCommentUseCase(specViewRepo, workspaceAccess)
+	updateCommentUC := specviewusecase.NewUpdateCommentUseCase(specViewRepo, workspaceAccess)
+	deleteCommentUC := specviewusecase.NewDeleteCommentUseCase(specViewRepo, workspaceAccess)
+	resolveCommentThreadUC := specviewusecase.NewResolveCommentThreadUseCase(specViewRepo, workspaceAccess)
+	createShareLinkUC := specviewusecase.NewCreateShareLinkUseCase(specViewRepo, workspaceAccess)
+	listShareLinksUC := specviewusecase.NewListShareLinksUseCase(specViewRepo, workspaceAccess)
+	revokeShareLinkUC := specviewusecase.NewRevokeShareLinkUseCase(specViewRepo, workspaceAccess)
+	getSharedSpecUC := specviewusecase.NewGetSharedSpecUseCase(specViewRepo, specViewRepo)
+	specExportRenderers := specviewrender.Renderers()
+	exportSpecDocumentUC := specviewusecase.NewExportSpecDocumentUseCase(specViewRepo, specViewRepo, specExportRenderers, workspaceAccess, specViewRepo)
+	exportSpecByRepositoryUC := specviewusecase.NewExportSpecByRepositoryUseCase(getSpecByRepositoryUC, specViewRepo, specExportRenderers)
 
 	specViewHandler, err := specviewhandler.NewHandler(&specviewhandler.HandlerConfig{
+		AddComment:              addCommentUC,
+		CancelGeneration:        cancelGenerationUC,
+		CreateCommentThread:     createCommentThreadUC,
+		CreateShareLink:         createShareLinkUC,
+		DeleteComment:           deleteCommentUC,
+		EditBehavior:            editBehaviorUC,
+		EditFeature:             editFeatureUC,
+		ExportSpecByRepository:  exportSpecByRepositoryUC,
+		ExportSpecDocument:      exportSpecDocumentUC,
 		GetCacheAvailability:    getCacheAvailabilityUC,
 		GetCachePrediction:      getCachePredictionUC,
 		GetGenerationStatus:     getGenerationStatusUC,
+		GetSharedSpec:           getSharedSpecUC,
 		GetSpecByRepository:     getSpecByRepositoryUC,
+		GetSpecComments:         getSpecCommentsUC,
+		GetSpecDiff:             getSpecDiffUC,
+		GetSpecDiffByRepository: getSpecDiffByRepositoryUC,
 		GetSpecDocument:         getSpecDocumentUC,
+		GetSpecEdits:            getSpecEditsUC,
 		GetVersionHistoryByRepo: getVersionHistoryByRepoUC,
 		GetVersions:             getVersionsUC,
+		GetWorkspaceSpec:        getWorkspaceSpecUC,
+		ListShareLinks:          listShareLinksUC,
 		Logger:                  log,
 		RequestGeneration:       requestGenerationUC,
+		ResolveCommentThread:    resolveCommentThreadUC,
+		RevertBehaviorEdit:      revertBehaviorEditUC,
+		RevertFeatureEdit:       revertFeatureEditUC,
+		RevokeShareLink:         revokeShareLinkUC,
+		SearchSpecs:             searchSpecsUC,
 		TierLookup:              tierLookup,
+		UpdateComment:           updateCommentUC,
 	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("create spec-view handler: %w", err)
@@ -297,24 +478,82 @@ func initHandlers(ctx context.Context, container *infra.Container) (*Handlers, [
 		return nil, nil, fmt.Errorf("create subscription handler: %w", err)
 	}
 
-	apiHandlers := api.NewAPIHandlers(analyzerHandler, userHandler, authHandler, userHandler, githubHandler, ghAppAPIHandler, subscriptionHandler, analyzerHandler, specViewHandler, subscriptionHandler, usageHandler, userHandler, webhookHandler)
+	healthHandler := health.NewHandler(log,
+		health.Check{Critical: true, Name: "database", Probe: container.DB.Ping},
+		health.Check{Critical: true, Name: "river", Probe: func(ctx context.Context) error {
+			if err := container.River.Ping(ctx); err != nil {
+				return err
+			}
+			return container.RiverWorker.Ping(ctx)
+		}},
+		health.Check{Critical: true, Name: "system_config", Probe: func(ctx context.Context) error {
+			_, err := systemConfig.GetParserVersion(ctx)
+			return err
+		}},
+		health.Check{Name: "github_app", Probe: health.Cached(container.GitHubAppClient.VerifyCredentials, githubAppCheckInterval)},
+	)
+
+	metricsHandler := metrics.NewHandler(metrics.HandlerConfig{
+		Pool:        container.DB,
+		QueueDepths: metrics.NewPostgresQueueDepthReader(container.DB),
+		QueueSets: []metrics.QueueSet{
+			{Queues: queue.WorkerQueues()},
+			{Queues: queue.WebQueues(), Schema: container.RiverWorker.Schema()},
+		},
+		Token: container.MetricsToken,
+	})
+
+	apiHandlers := api.NewAPIHandlers(adminHandler, analyzerHandler, analysisBatchHandler, userHandler, ingestHandler, authHandler, userHandler, githubHandler, ghAppAPIHandler, orgDashboardHandler, subscriptionHandler, analyzerHandler, specViewHandler, subscriptionHandler, usageHandler, userHandler, webhookHandler, workspaceHandler)
 
 	return &Handlers{
-		API:     apiHandlers,
-		Docs:    docs.NewHandler(),
-		Health:  health.NewHandler(log),
+		API: apiHandlers,
+		APIMiddlewares: []api.StrictMiddlewareFunc{
+			analyzerhandler.AnonymousAnalyzeRateLimit(anonymousRateLimiter, log),
+			api.ConditionalGET(map[string]api.ValidatorFunc{
+				"AnalyzeRepository":           analyzerHandler.AnalyzeRepositoryValidator,
+				"GetSpecDocumentByRepository": specViewHandler.GetSpecDocumentByRepositoryValidator,
+			}),
+			middleware.RequireTokenScopes(personalAccessTokenScopes),
+		},
+		Docs:           docs.NewHandler(),
+		Health:         healthHandler,
+		Metrics:        metricsHandler,
+		PersonalTokens: personalAccessTokenAuthenticator,
+		RateLimiters: RateLimiters{
+			Auth:       authRateLimiter,
+			User:       userRateLimiter,
+			UserLimits: subscriptionadapter.NewRateLimitLookupAdapter(subscriptionRepo, subscriptionadapter.DefaultAPIRateLimitPerMinute),
+		},
 		Webhook: webhookHandler,
 	}, closers, nil
 }
 
+// Start launches background job processing owned by the web service.
+func (a *App) Start(ctx context.Context) error {
+	if err := a.infra.RiverWorker.Start(ctx); err != nil {
+		return fmt.Errorf("start river worker: %w", err)
+	}
+	return nil
+}
+
 func (a *App) APIHandler() api.StrictServerInterface {
 	return a.Handlers.API
 }
 
+// APIMiddlewares returns strict middlewares to wrap the API handler with.
+func (a *App) APIMiddlewares() []api.StrictMiddlewareFunc {
+	return a.Handlers.APIMiddlewares
+}
+
+func (a *App) RateLimiters() RateLimiters {
+	return a.Handlers.RateLimiters
+}
+
 func (a *App) RouteRegistrars() []RouteRegistrar {
 	return []RouteRegistrar{
 		a.Handlers.Docs,
 		a.Handlers.Health,
+		a.Handlers.Metrics,
 	}
 }
 
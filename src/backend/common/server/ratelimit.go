@@ -0,0 +1,45 @@
+package server
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/common/ratelimit"
+	"github.com/specvital/web/src/backend/internal/db"
+)
+
+const (
+	anonymousAnalyzeRateLimit = 10 // requests per minute for signed-out analyze
+	authRateLimit             = 5  // requests per minute for auth endpoints
+	rateLimitCleanupInterval  = 5 * time.Minute
+)
+
+// RateLimiters groups the rate limiters the router mounts as HTTP middleware.
+type RateLimiters struct {
+	Auth       middleware.RateLimiter
+	User       middleware.UserRateLimiter
+	UserLimits middleware.UserRateLimitLookup
+}
+
+// rateLimitStore is a ratelimit.Store that owns a cleanup goroutine.
+type rateLimitStore interface {
+	ratelimit.Store
+	Close() error
+}
+
+// newRateLimitStore selects the counter store from RATE_LIMIT_STORE.
+// Postgres is the default so limits hold across replicas; "memory" keeps
+// per-process counters for local development.
+func newRateLimitStore(queries *db.Queries) rateLimitStore {
+	switch v := os.Getenv("RATE_LIMIT_STORE"); v {
+	case "", "postgres":
+		return ratelimit.NewPostgresStore(queries, rateLimitCleanupInterval)
+	case "memory":
+		return ratelimit.NewMemoryStore(rateLimitCleanupInterval)
+	default:
+		slog.Warn("invalid RATE_LIMIT_STORE, using postgres", "value", v)
+		return ratelimit.NewPostgresStore(queries, rateLimitCleanupInterval)
+	}
+}
@@ -0,0 +1,50 @@
+package server
+
+import "github.com/specvital/web/src/backend/modules/auth/domain/entity"
+
+// personalAccessTokenScopes lists the operations personal access tokens may
+// call and the scope each one needs. Everything else is session-only.
+var personalAccessTokenScopes = map[string]entity.Scope{
+	"GetAnalysisBatch":   entity.ScopeAnalysisRead,
+	"GetAnalysisHistory": entity.ScopeAnalysisRead,
+	"GetAnalysisStatus":  entity.ScopeAnalysisRead,
+	"GetOrgDashboard":    entity.ScopeAnalysisRead,
+	"GetRepositoryStats": entity.ScopeAnalysisRead,
+	"GetUpdateStatus":    entity.ScopeAnalysisRead,
+
+	"AnalyzeRepository":   entity.ScopeAnalysisWrite,
+	"IngestAnalysis":      entity.ScopeAnalysisWrite,
+	"ReanalyzeRepository": entity.ScopeAnalysisWrite,
+	"StartAnalysisBatch":  entity.ScopeAnalysisWrite,
+
+	"ExportSpecDocument":             entity.ScopeSpecRead,
+	"ExportSpecDocumentByRepository": entity.ScopeSpecRead,
+	"GetSpecCacheAvailability":       entity.ScopeSpecRead,
+	"GetSpecCachePrediction":         entity.ScopeSpecRead,
+	"GetSpecComments":                entity.ScopeSpecRead,
+	"GetSpecDiff":                    entity.ScopeSpecRead,
+	"GetSpecDiffByRepository":        entity.ScopeSpecRead,
+	"GetSpecDocument":                entity.ScopeSpecRead,
+	"GetSpecDocumentByRepository":    entity.ScopeSpecRead,
+	"GetSpecEdits":                   entity.ScopeSpecRead,
+	"GetSpecGenerationStatus":        entity.ScopeSpecRead,
+	"GetSpecVersions":                entity.ScopeSpecRead,
+	"GetVersionHistoryByRepository":  entity.ScopeSpecRead,
+	"ListSpecShareLinks":             entity.ScopeSpecRead,
+	"SearchSpecs":                    entity.ScopeSpecRead,
+
+	"AddSpecComment":           entity.ScopeSpecWrite,
+	"CancelSpecGeneration":     entity.ScopeSpecWrite,
+	"CreateSpecCommentThread":  entity.ScopeSpecWrite,
+	"CreateSpecShareLink":      entity.ScopeSpecWrite,
+	"DeleteSpecComment":        entity.ScopeSpecWrite,
+	"EditSpecBehavior":         entity.ScopeSpecWrite,
+	"EditSpecFeature":          entity.ScopeSpecWrite,
+	"ReopenSpecCommentThread":  entity.ScopeSpecWrite,
+	"RequestSpecGeneration":    entity.ScopeSpecWrite,
+	"ResolveSpecCommentThread": entity.ScopeSpecWrite,
+	"RevertSpecBehaviorEdit":   entity.ScopeSpecWrite,
+	"RevertSpecFeatureEdit":    entity.ScopeSpecWrite,
+	"RevokeSpecShareLink":      entity.ScopeSpecWrite,
+	"UpdateSpecComment":        entity.ScopeSpecWrite,
+}
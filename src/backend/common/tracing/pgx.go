@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const sqlcNamePrefix = "-- name: "
+
+type querySpanKey struct{}
+
+var _ pgx.QueryTracer = (*QueryTracer)(nil)
+
+// QueryTracer records pgx queries as spans named after their sqlc query.
+// Queries without a parent span are skipped so River's background polling
+// on the shared pool does not produce a stream of root spans.
+type QueryTracer struct{}
+
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{}
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if !trace.SpanFromContext(ctx).SpanContext().IsValid() {
+		return ctx
+	}
+
+	ctx, span := Tracer().Start(ctx, queryName(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return context.WithValue(ctx, querySpanKey{}, span)
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	// Only end spans started by TraceQueryStart, never the caller's span.
+	span, ok := ctx.Value(querySpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	err := data.Err
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = nil
+	}
+	End(span, err)
+}
+
+// queryName returns the sqlc query name from the "-- name: X :one" header
+// sqlc prepends to generated statements, or "db.query" for ad-hoc SQL.
+func queryName(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if !strings.HasPrefix(sql, sqlcNamePrefix) {
+		return "db.query"
+	}
+	line, _, _ := strings.Cut(sql[len(sqlcNamePrefix):], "\n")
+	name, _, _ := strings.Cut(line, " ")
+	if name == "" {
+		return "db.query"
+	}
+	return "db." + name
+}
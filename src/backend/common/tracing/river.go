@@ -0,0 +1,119 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// JobMetadataKey is the River job metadata key holding the W3C trace context
+// of the enqueueing request, e.g. {"trace_context": {"traceparent": "..."}}.
+// Workers extract it to continue the trace.
+const JobMetadataKey = "trace_context"
+
+var (
+	_ rivertype.JobInsertMiddleware = (*RiverMiddleware)(nil)
+	_ rivertype.WorkerMiddleware    = (*RiverMiddleware)(nil)
+)
+
+// RiverMiddleware traces job inserts as producer spans, propagating their
+// context through job metadata, and traces jobs worked by this process as
+// consumer spans continuing that context.
+type RiverMiddleware struct {
+	river.MiddlewareDefaults
+}
+
+func NewRiverMiddleware() *RiverMiddleware {
+	return &RiverMiddleware{}
+}
+
+func (m *RiverMiddleware) InsertMany(ctx context.Context, manyParams []*rivertype.JobInsertParams, doInner func(context.Context) ([]*rivertype.JobInsertResult, error)) ([]*rivertype.JobInsertResult, error) {
+	name := "river.insert"
+	attrs := []attribute.KeyValue{attribute.Int("messaging.batch.message_count", len(manyParams))}
+	if len(manyParams) == 1 {
+		name += " " + manyParams[0].Kind
+		attrs = append(attrs,
+			attribute.String("messaging.destination.name", manyParams[0].Queue),
+			attribute.String("river.job.kind", manyParams[0].Kind),
+		)
+	}
+
+	ctx, span := Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attrs...),
+	)
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) > 0 {
+		for _, params := range manyParams {
+			metadata, err := withTraceContext(params.Metadata, carrier)
+			if err != nil {
+				End(span, err)
+				return nil, err
+			}
+			params.Metadata = metadata
+		}
+	}
+
+	results, err := doInner(ctx)
+	End(span, err)
+	return results, err
+}
+
+func (m *RiverMiddleware) Work(ctx context.Context, job *rivertype.JobRow, doInner func(context.Context) error) error {
+	ctx = ExtractJobContext(ctx, job.Metadata)
+	ctx, span := Tracer().Start(ctx, "river.work "+job.Kind,
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.destination.name", job.Queue),
+			attribute.String("river.job.kind", job.Kind),
+			attribute.Int64("river.job.id", job.ID),
+			attribute.Int("river.job.attempt", job.Attempt),
+		),
+	)
+
+	err := doInner(ctx)
+	End(span, err)
+	return err
+}
+
+// ExtractJobContext returns ctx carrying the trace context stored in job
+// metadata under JobMetadataKey, or ctx unchanged when there is none.
+func ExtractJobContext(ctx context.Context, metadata []byte) context.Context {
+	if len(metadata) == 0 {
+		return ctx
+	}
+
+	var fields struct {
+		TraceContext propagation.MapCarrier `json:"trace_context"`
+	}
+	if err := json.Unmarshal(metadata, &fields); err != nil || len(fields.TraceContext) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, fields.TraceContext)
+}
+
+func withTraceContext(metadata []byte, carrier propagation.MapCarrier) ([]byte, error) {
+	fields := map[string]json.RawMessage{}
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &fields); err != nil {
+			return nil, fmt.Errorf("decode job metadata: %w", err)
+		}
+	}
+
+	encoded, err := json.Marshal(carrier)
+	if err != nil {
+		return nil, fmt.Errorf("encode trace context: %w", err)
+	}
+	fields[JobMetadataKey] = encoded
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,205 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/riverqueue/river/rivertype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	prevProvider := otel.GetTracerProvider()
+	prevPropagator := otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func TestQueryName(t *testing.T) {
+	tests := []struct {
+		sql  string
+		want string
+	}{
+		{sql: "-- name: GetUserByID :one\nSELECT 1", want: "db.GetUserByID"},
+		{sql: "\n-- name: ListRepos :many\nSELECT 1", want: "db.ListRepos"},
+		{sql: "SELECT queue FROM river_job", want: "db.query"},
+		{sql: "-- name: \nSELECT 1", want: "db.query"},
+	}
+
+	for _, tt := range tests {
+		if got := queryName(tt.sql); got != tt.want {
+			t.Errorf("queryName(%q) = %q, want %q", tt.sql, got, tt.want)
+		}
+	}
+}
+
+func TestQueryTracer(t *testing.T) {
+	recorder := setupRecorder(t)
+	tracer := NewQueryTracer()
+
+	t.Run("skips queries without a parent span", func(t *testing.T) {
+		ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "-- name: Poll :many\nSELECT 1"})
+		tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+		if n := len(recorder.Ended()); n != 0 {
+			t.Fatalf("expected no spans, got %d", n)
+		}
+	})
+
+	t.Run("records child span without ending the parent", func(t *testing.T) {
+		parentCtx, parent := Tracer().Start(context.Background(), "parent")
+
+		ctx := tracer.TraceQueryStart(parentCtx, nil, pgx.TraceQueryStartData{SQL: "-- name: GetUser :one\nSELECT 1"})
+		tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1"), Err: pgx.ErrNoRows})
+
+		ended := recorder.Ended()
+		if len(ended) != 1 {
+			t.Fatalf("expected 1 ended span, got %d", len(ended))
+		}
+		if ended[0].Name() != "db.GetUser" {
+			t.Errorf("unexpected span name %q", ended[0].Name())
+		}
+		if ended[0].Status().Code == codes.Error {
+			t.Error("ErrNoRows must not mark the span as failed")
+		}
+		if ended[0].Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Error("expected query span to be a child of the caller's span")
+		}
+		parent.End()
+	})
+}
+
+func TestRiverMiddleware_PropagatesTraceContext(t *testing.T) {
+	recorder := setupRecorder(t)
+	middleware := NewRiverMiddleware()
+
+	ctx, parent := Tracer().Start(context.Background(), "request")
+	params := &rivertype.JobInsertParams{Kind: "analysis", Queue: "analysis_default", Metadata: []byte(`{"source":"api"}`)}
+
+	_, err := middleware.InsertMany(ctx, []*rivertype.JobInsertParams{params}, func(context.Context) ([]*rivertype.JobInsertResult, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parent.End()
+
+	var metadata map[string]json.RawMessage
+	if err := json.Unmarshal(params.Metadata, &metadata); err != nil {
+		t.Fatalf("invalid metadata: %v", err)
+	}
+	if string(metadata["source"]) != `"api"` {
+		t.Errorf("existing metadata was not preserved: %s", params.Metadata)
+	}
+
+	job := &rivertype.JobRow{ID: 1, Kind: "analysis", Queue: "analysis_default", Metadata: params.Metadata}
+	var workedTraceID trace.TraceID
+	err = middleware.Work(context.Background(), job, func(ctx context.Context) error {
+		workedTraceID = trace.SpanContextFromContext(ctx).TraceID()
+		return errors.New("boom")
+	})
+	if err == nil {
+		t.Fatal("expected worker error to be returned")
+	}
+
+	if workedTraceID != parent.SpanContext().TraceID() {
+		t.Error("expected worker span to continue the enqueueing trace")
+	}
+
+	names := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		names[span.Name()] = span
+	}
+	if _, ok := names["river.insert analysis"]; !ok {
+		t.Error("expected producer span for the insert")
+	}
+	if work, ok := names["river.work analysis"]; !ok || work.Status().Code != codes.Error {
+		t.Error("expected failed consumer span for the job")
+	}
+}
+
+func TestExtractJobContext_IgnoresInvalidMetadata(t *testing.T) {
+	setupRecorder(t)
+	for _, metadata := range [][]byte{nil, []byte(`{}`), []byte(`not json`)} {
+		ctx := ExtractJobContext(context.Background(), metadata)
+		if trace.SpanContextFromContext(ctx).IsValid() {
+			t.Errorf("expected no span context for metadata %q", metadata)
+		}
+	}
+}
+
+func TestTransport(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	var gotTraceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotTraceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport(nil)}
+	resp, err := client.Get(server.URL + "/repos/octocat/hello")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if gotTraceparent != "" {
+		t.Error("trace context must not be sent to third-party APIs")
+	}
+	ended := recorder.Ended()
+	if len(ended) != 1 || ended[0].Name() != "HTTP GET" {
+		t.Fatalf("expected one HTTP GET span, got %d", len(ended))
+	}
+	if ended[0].Status().Code != codes.Error {
+		t.Error("expected 404 to mark the span as failed")
+	}
+}
+
+func TestSetup(t *testing.T) {
+	setupRecorder(t)
+
+	t.Run("no endpoint leaves tracing disabled", func(t *testing.T) {
+		shutdown, err := Setup(context.Background(), Config{ServiceName: "test"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := shutdown(context.Background()); err != nil {
+			t.Errorf("unexpected shutdown error: %v", err)
+		}
+	})
+
+	t.Run("endpoint installs an exporting provider", func(t *testing.T) {
+		t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://127.0.0.1:1")
+		shutdown, err := Setup(context.Background(), ConfigFromEnv())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider); !ok {
+			t.Error("expected SDK tracer provider to be installed")
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_ = shutdown(ctx)
+	})
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+const (
+	// Pinned responses embed a few per-viewer fields (e.g. isInMyHistory), so
+	// they are cached privately for a bounded time rather than marked immutable.
+	pinnedCacheControl = "private, max-age=3600"
+	latestCacheControl = "private, no-cache"
+)
+
+// CacheableResponse is a 200 response whose encoded body can be served with an
+// ETag. Final reports whether the representation is complete; in-progress
+// states (e.g. a document still generating) only ever get weak validators.
+type CacheableResponse interface {
+	CacheableBody() (body []byte, final bool, err error)
+}
+
+// ValidatorFunc returns a value that changes whenever the operation's response
+// body would, computed without running the handler (e.g. from a row's ID and
+// last update). ok is false when the request has no such validator.
+type ValidatorFunc func(ctx context.Context, request any) (validator string, ok bool, err error)
+
+type conditionalOperation struct {
+	// isPinned reports whether the request pins a specific commit or version.
+	isPinned func(request any) bool
+	// pinnedCacheControl is sent with final pinned responses.
+	pinnedCacheControl string
+}
+
+// conditionalOperations lists the operations that support conditional GET.
+// A pinned spec document version still changes when its edits change, so it
+// gets a strong ETag but is revalidated on every use like a "latest" response.
+var conditionalOperations = map[string]conditionalOperation{
+	"AnalyzeRepository": {
+		isPinned: func(request any) bool {
+			req, ok := request.(AnalyzeRepositoryRequestObject)
+			return ok && req.Params.Commit != nil
+		},
+		pinnedCacheControl: pinnedCacheControl,
+	},
+	"GetSpecDocument": {
+		isPinned: func(request any) bool {
+			req, ok := request.(GetSpecDocumentRequestObject)
+			return ok && req.Params.Version != nil
+		},
+		pinnedCacheControl: latestCacheControl,
+	},
+	"GetSpecDocumentByRepository": {
+		isPinned: func(request any) bool {
+			req, ok := request.(GetSpecDocumentByRepositoryRequestObject)
+			return ok && (req.Params.DocumentID != nil || req.Params.Version != nil)
+		},
+		pinnedCacheControl: latestCacheControl,
+	},
+}
+
+// ConditionalGET adds ETag and Cache-Control headers to cacheable responses and
+// answers If-None-Match with 304 Not Modified.
+//
+// Responses for a pinned commit or version get a strong ETag; "latest"
+// responses get a weak one. Both must be revalidated on every use, except for
+// a pinned analysis, which is cached for a bounded time. The body is
+// encoded once here and written directly, so the handler's Visit method is
+// skipped for these responses.
+//
+// An operation with a validator in validators is answered with 304 before its
+// handler runs; the others are matched against a hash of the encoded body.
+func ConditionalGET(validators map[string]ValidatorFunc) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		operation, ok := conditionalOperations[operationID]
+		if !ok {
+			return f
+		}
+		validate := validators[operationID]
+
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+			if r.Method != http.MethodGet {
+				return f(ctx, w, r, request)
+			}
+
+			// Validators only exist for final representations, so a match skips the handler
+			var validatorETag string
+			if validate != nil {
+				validator, ok, err := validate(ctx, request)
+				if err != nil {
+					slog.WarnContext(ctx, "failed to compute response validator", "operation", operationID, "error", err)
+				}
+				if ok && err == nil {
+					strong := operation.isPinned(request)
+					validatorETag = computeETag([]byte(validator), strong)
+					if etagMatches(r.Header.Get("If-None-Match"), validatorETag) {
+						setCacheHeaders(w.Header(), validatorETag, cacheControl(operation, strong))
+						w.WriteHeader(http.StatusNotModified)
+						return nil, nil
+					}
+				}
+			}
+
+			response, err := f(ctx, w, r, request)
+			if err != nil {
+				return response, err
+			}
+
+			cacheable, ok := response.(CacheableResponse)
+			if !ok {
+				return response, nil
+			}
+			body, final, err := cacheable.CacheableBody()
+			if err != nil {
+				return response, nil
+			}
+
+			strong := final && operation.isPinned(request)
+			etag := validatorETag
+			if etag == "" || !final {
+				etag = computeETag(body, strong)
+			}
+
+			h := w.Header()
+			setCacheHeaders(h, etag, cacheControl(operation, strong))
+
+			if etagMatches(r.Header.Get("If-None-Match"), etag) {
+				w.WriteHeader(http.StatusNotModified)
+				return nil, nil
+			}
+
+			h.Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			if _, err := w.Write(body); err != nil {
+				return nil, err
+			}
+			return nil, nil
+		}
+	}
+}
+
+func cacheControl(operation conditionalOperation, strong bool) string {
+	if strong {
+		return operation.pinnedCacheControl
+	}
+	return latestCacheControl
+}
+
+func setCacheHeaders(h http.Header, etag, cacheControl string) {
+	h.Set("ETag", etag)
+	h.Set("Cache-Control", cacheControl)
+}
+
+func computeETag(body []byte, strong bool) string {
+	sum := sha256.Sum256(body)
+	tag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	if strong {
+		return tag
+	}
+	return "W/" + tag
+}
+
+// etagMatches applies the weak comparison RFC 9110 requires for If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type stubCacheableResponse struct {
+	body  string
+	final bool
+}
+
+func (r stubCacheableResponse) CacheableBody() ([]byte, bool, error) {
+	return []byte(r.body), r.final, nil
+}
+
+func serveConditional(t *testing.T, operationID string, request, response any, ifNoneMatch string) *httptest.ResponseRecorder {
+	t.Helper()
+	return serveValidated(t, nil, operationID, request, response, ifNoneMatch, nil)
+}
+
+// serveValidated counts the handler's calls in calls when it is set.
+func serveValidated(t *testing.T, validate ValidatorFunc, operationID string, request, response any, ifNoneMatch string, calls *int) *httptest.ResponseRecorder {
+	t.Helper()
+
+	handler := ConditionalGET(map[string]ValidatorFunc{operationID: validate})(func(context.Context, http.ResponseWriter, *http.Request, any) (any, error) {
+		if calls != nil {
+			*calls++
+		}
+		return response, nil
+	}, operationID)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if ifNoneMatch != "" {
+		r.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	w := httptest.NewRecorder()
+
+	result, err := handler(r.Context(), w, r, request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result != nil {
+		t.Fatalf("expected response to be written by middleware, got %T", result)
+	}
+	return w
+}
+
+func TestConditionalGET_PinnedResponseGetsStrongETag(t *testing.T) {
+	commit := "abc1234"
+	request := AnalyzeRepositoryRequestObject{Params: AnalyzeRepositoryParams{Commit: &commit}}
+	response := stubCacheableResponse{body: `{"status":"completed"}`, final: true}
+
+	w := serveConditional(t, "AnalyzeRepository", request, response, "")
+
+	if w.Code != http.StatusOK || w.Body.String() != response.body {
+		t.Fatalf("unexpected response %d %q", w.Code, w.Body.String())
+	}
+	etag := w.Header().Get("ETag")
+	if etag == "" || strings.HasPrefix(etag, "W/") {
+		t.Errorf("expected strong ETag, got %q", etag)
+	}
+	if got := w.Header().Get("Cache-Control"); got != pinnedCacheControl {
+		t.Errorf("expected Cache-Control %q, got %q", pinnedCacheControl, got)
+	}
+
+	w = serveConditional(t, "AnalyzeRepository", request, response, etag)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("expected empty 304, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestConditionalGET_LatestResponseGetsWeakETag(t *testing.T) {
+	response := stubCacheableResponse{body: `{"status":"completed"}`, final: true}
+
+	w := serveConditional(t, "AnalyzeRepository", AnalyzeRepositoryRequestObject{}, response, "")
+
+	etag := w.Header().Get("ETag")
+	if !strings.HasPrefix(etag, "W/") {
+		t.Errorf("expected weak ETag, got %q", etag)
+	}
+	if got := w.Header().Get("Cache-Control"); got != latestCacheControl {
+		t.Errorf("expected Cache-Control %q, got %q", latestCacheControl, got)
+	}
+
+	w = serveConditional(t, "AnalyzeRepository", AnalyzeRepositoryRequestObject{}, response, `"other", `+etag)
+	if w.Code != http.StatusNotModified {
+		t.Errorf("expected 304 for matching weak ETag in list, got %d", w.Code)
+	}
+}
+
+func TestConditionalGET_PinnedSpecDocumentIsRevalidated(t *testing.T) {
+	version := 2
+	request := GetSpecDocumentRequestObject{Params: GetSpecDocumentParams{Version: &version}}
+
+	w := serveConditional(t, "GetSpecDocument", request, stubCacheableResponse{body: `{"status":"completed"}`, final: true}, "")
+
+	if etag := w.Header().Get("ETag"); etag == "" || strings.HasPrefix(etag, "W/") {
+		t.Errorf("expected strong ETag, got %q", etag)
+	}
+	if got := w.Header().Get("Cache-Control"); got != latestCacheControl {
+		t.Errorf("expected Cache-Control %q, got %q", latestCacheControl, got)
+	}
+}
+
+func TestConditionalGET_UnfinishedPinnedResponseIsWeak(t *testing.T) {
+	version := 2
+	request := GetSpecDocumentRequestObject{Params: GetSpecDocumentParams{Version: &version}}
+
+	w := serveConditional(t, "GetSpecDocument", request, stubCacheableResponse{body: `{"status":"generating"}`}, "")
+
+	if etag := w.Header().Get("ETag"); !strings.HasPrefix(etag, "W/") {
+		t.Errorf("expected weak ETag while generating, got %q", etag)
+	}
+}
+
+func TestConditionalGET_ChangedBodyDoesNotMatch(t *testing.T) {
+	first := serveConditional(t, "AnalyzeRepository", AnalyzeRepositoryRequestObject{}, stubCacheableResponse{body: `{"v":1}`}, "")
+	w := serveConditional(t, "AnalyzeRepository", AnalyzeRepositoryRequestObject{}, stubCacheableResponse{body: `{"v":2}`}, first.Header().Get("ETag"))
+
+	if w.Code != http.StatusOK || w.Body.String() != `{"v":2}` {
+		t.Errorf("expected fresh 200, got %d %q", w.Code, w.Body.String())
+	}
+}
+
+func TestConditionalGET_SkipsOtherResponses(t *testing.T) {
+	notFound := AnalyzeRepository404ApplicationProblemPlusJSONResponse{NotFoundApplicationProblemPlusJSONResponse: NewNotFound("missing")}
+	handler := ConditionalGET(nil)(func(context.Context, http.ResponseWriter, *http.Request, any) (any, error) {
+		return notFound, nil
+	}, "AnalyzeRepository")
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	result, _ := handler(r.Context(), w, r, AnalyzeRepositoryRequestObject{})
+
+	if _, ok := result.(AnalyzeRepository404ApplicationProblemPlusJSONResponse); !ok {
+		t.Errorf("expected response to pass through, got %T", result)
+	}
+	if w.Header().Get("ETag") != "" {
+		t.Error("expected no ETag on error response")
+	}
+}
+
+func TestConditionalGET_ValidatorAnswersBeforeHandler(t *testing.T) {
+	commit := "abc1234"
+	request := AnalyzeRepositoryRequestObject{Params: AnalyzeRepositoryParams{Commit: &commit}}
+	response := stubCacheableResponse{body: `{"status":"completed"}`, final: true}
+	validate := func(context.Context, any) (string, bool, error) { return "analysis-1:1", true, nil }
+	calls := 0
+
+	w := serveValidated(t, validate, "AnalyzeRepository", request, response, "", &calls)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" || strings.HasPrefix(etag, "W/") {
+		t.Fatalf("expected 200 with strong ETag, got %d %q", w.Code, etag)
+	}
+
+	w = serveValidated(t, validate, "AnalyzeRepository", request, response, etag, &calls)
+	if w.Code != http.StatusNotModified || w.Body.Len() != 0 {
+		t.Errorf("expected empty 304, got %d %q", w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("Cache-Control"); got != pinnedCacheControl {
+		t.Errorf("expected Cache-Control %q, got %q", pinnedCacheControl, got)
+	}
+	if calls != 1 {
+		t.Errorf("expected the handler to run only for the first request, got %d calls", calls)
+	}
+}
+
+func TestConditionalGET_ChangedValidatorRunsHandler(t *testing.T) {
+	commit := "abc1234"
+	request := AnalyzeRepositoryRequestObject{Params: AnalyzeRepositoryParams{Commit: &commit}}
+	response := stubCacheableResponse{body: `{"status":"completed"}`, final: true}
+	calls := 0
+
+	first := serveValidated(t, func(context.Context, any) (string, bool, error) { return "analysis-1:1", true, nil },
+		"AnalyzeRepository", request, response, "", &calls)
+	w := serveValidated(t, func(context.Context, any) (string, bool, error) { return "analysis-2:2", true, nil },
+		"AnalyzeRepository", request, response, first.Header().Get("ETag"), &calls)
+
+	if w.Code != http.StatusOK || calls != 2 {
+		t.Errorf("expected fresh 200 from the handler, got %d after %d calls", w.Code, calls)
+	}
+}
+
+func TestConditionalGET_FallsBackToBodyHash(t *testing.T) {
+	commit := "abc1234"
+	request := AnalyzeRepositoryRequestObject{Params: AnalyzeRepositoryParams{Commit: &commit}}
+	response := stubCacheableResponse{body: `{"status":"completed"}`, final: true}
+	hashed := serveConditional(t, "AnalyzeRepository", request, response, "").Header().Get("ETag")
+
+	tests := []struct {
+		name     string
+		validate ValidatorFunc
+	}{
+		{name: "no validator for the request", validate: func(context.Context, any) (string, bool, error) { return "", false, nil }},
+		{name: "validator error", validate: func(context.Context, any) (string, bool, error) { return "", false, errors.New("db down") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			w := serveValidated(t, tt.validate, "AnalyzeRepository", request, response, hashed, &calls)
+
+			if w.Code != http.StatusNotModified || calls != 1 {
+				t.Errorf("expected 304 from the body hash after running the handler, got %d after %d calls", w.Code, calls)
+			}
+		})
+	}
+}
@@ -5,6 +5,21 @@ import (
 	"net/http"
 )
 
+type AdminHandlers interface {
+	GetBulkReanalysisRun(ctx context.Context, request GetBulkReanalysisRunRequestObject) (GetBulkReanalysisRunResponseObject, error)
+	ListBulkReanalysisRuns(ctx context.Context, request ListBulkReanalysisRunsRequestObject) (ListBulkReanalysisRunsResponseObject, error)
+	StartBulkReanalysis(ctx context.Context, request StartBulkReanalysisRequestObject) (StartBulkReanalysisResponseObject, error)
+}
+
+type AnalysisBatchHandlers interface {
+	GetAnalysisBatch(ctx context.Context, request GetAnalysisBatchRequestObject) (GetAnalysisBatchResponseObject, error)
+	StartAnalysisBatch(ctx context.Context, request StartAnalysisBatchRequestObject) (StartAnalysisBatchResponseObject, error)
+}
+
+type AnalysisIngestHandlers interface {
+	IngestAnalysis(ctx context.Context, request IngestAnalysisRequestObject) (IngestAnalysisResponseObject, error)
+}
+
 type AnalyzerHandlers interface {
 	AnalyzeRepository(ctx context.Context, request AnalyzeRepositoryRequestObject) (AnalyzeRepositoryResponseObject, error)
 	GetAnalysisHistory(ctx context.Context, request GetAnalysisHistoryRequestObject) (GetAnalysisHistoryResponseObject, error)
@@ -22,6 +37,9 @@ type AuthHandlers interface {
 	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
 	AuthMe(ctx context.Context, request AuthMeRequestObject) (AuthMeResponseObject, error)
 	AuthRefresh(ctx context.Context, request AuthRefreshRequestObject) (AuthRefreshResponseObject, error)
+	CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error)
+	ListPersonalAccessTokens(ctx context.Context, request ListPersonalAccessTokensRequestObject) (ListPersonalAccessTokensResponseObject, error)
+	RevokePersonalAccessToken(ctx context.Context, request RevokePersonalAccessTokenRequestObject) (RevokePersonalAccessTokenResponseObject, error)
 }
 
 type BookmarkHandlers interface {
@@ -58,14 +76,36 @@ type GitHubAppHandlers interface {
 }
 
 type SpecViewHandlers interface {
+	AddSpecComment(ctx context.Context, request AddSpecCommentRequestObject) (AddSpecCommentResponseObject, error)
+	CancelSpecGeneration(ctx context.Context, request CancelSpecGenerationRequestObject) (CancelSpecGenerationResponseObject, error)
+	CreateSpecCommentThread(ctx context.Context, request CreateSpecCommentThreadRequestObject) (CreateSpecCommentThreadResponseObject, error)
+	CreateSpecShareLink(ctx context.Context, request CreateSpecShareLinkRequestObject) (CreateSpecShareLinkResponseObject, error)
+	DeleteSpecComment(ctx context.Context, request DeleteSpecCommentRequestObject) (DeleteSpecCommentResponseObject, error)
+	EditSpecBehavior(ctx context.Context, request EditSpecBehaviorRequestObject) (EditSpecBehaviorResponseObject, error)
+	EditSpecFeature(ctx context.Context, request EditSpecFeatureRequestObject) (EditSpecFeatureResponseObject, error)
+	ExportSpecDocument(ctx context.Context, request ExportSpecDocumentRequestObject) (ExportSpecDocumentResponseObject, error)
+	ExportSpecDocumentByRepository(ctx context.Context, request ExportSpecDocumentByRepositoryRequestObject) (ExportSpecDocumentByRepositoryResponseObject, error)
+	GetSharedSpec(ctx context.Context, request GetSharedSpecRequestObject) (GetSharedSpecResponseObject, error)
 	GetSpecCacheAvailability(ctx context.Context, request GetSpecCacheAvailabilityRequestObject) (GetSpecCacheAvailabilityResponseObject, error)
 	GetSpecCachePrediction(ctx context.Context, request GetSpecCachePredictionRequestObject) (GetSpecCachePredictionResponseObject, error)
+	GetSpecComments(ctx context.Context, request GetSpecCommentsRequestObject) (GetSpecCommentsResponseObject, error)
+	GetSpecDiff(ctx context.Context, request GetSpecDiffRequestObject) (GetSpecDiffResponseObject, error)
+	GetSpecDiffByRepository(ctx context.Context, request GetSpecDiffByRepositoryRequestObject) (GetSpecDiffByRepositoryResponseObject, error)
 	GetSpecDocument(ctx context.Context, request GetSpecDocumentRequestObject) (GetSpecDocumentResponseObject, error)
 	GetSpecDocumentByRepository(ctx context.Context, request GetSpecDocumentByRepositoryRequestObject) (GetSpecDocumentByRepositoryResponseObject, error)
+	GetSpecEdits(ctx context.Context, request GetSpecEditsRequestObject) (GetSpecEditsResponseObject, error)
 	GetSpecGenerationStatus(ctx context.Context, request GetSpecGenerationStatusRequestObject) (GetSpecGenerationStatusResponseObject, error)
 	GetSpecVersions(ctx context.Context, request GetSpecVersionsRequestObject) (GetSpecVersionsResponseObject, error)
 	GetVersionHistoryByRepository(ctx context.Context, request GetVersionHistoryByRepositoryRequestObject) (GetVersionHistoryByRepositoryResponseObject, error)
+	ListSpecShareLinks(ctx context.Context, request ListSpecShareLinksRequestObject) (ListSpecShareLinksResponseObject, error)
+	ReopenSpecCommentThread(ctx context.Context, request ReopenSpecCommentThreadRequestObject) (ReopenSpecCommentThreadResponseObject, error)
 	RequestSpecGeneration(ctx context.Context, request RequestSpecGenerationRequestObject) (RequestSpecGenerationResponseObject, error)
+	ResolveSpecCommentThread(ctx context.Context, request ResolveSpecCommentThreadRequestObject) (ResolveSpecCommentThreadResponseObject, error)
+	RevertSpecBehaviorEdit(ctx context.Context, request RevertSpecBehaviorEditRequestObject) (RevertSpecBehaviorEditResponseObject, error)
+	RevertSpecFeatureEdit(ctx context.Context, request RevertSpecFeatureEditRequestObject) (RevertSpecFeatureEditResponseObject, error)
+	RevokeSpecShareLink(ctx context.Context, request RevokeSpecShareLinkRequestObject) (RevokeSpecShareLinkResponseObject, error)
+	SearchSpecs(ctx context.Context, request SearchSpecsRequestObject) (SearchSpecsResponseObject, error)
+	UpdateSpecComment(ctx context.Context, request UpdateSpecCommentRequestObject) (UpdateSpecCommentResponseObject, error)
 }
 
 type UsageHandlers interface {
@@ -77,17 +117,37 @@ type SubscriptionHandlers interface {
 	GetUserSubscription(ctx context.Context, request GetUserSubscriptionRequestObject) (GetUserSubscriptionResponseObject, error)
 }
 
+type OrgDashboardHandlers interface {
+	GetOrgDashboard(ctx context.Context, request GetOrgDashboardRequestObject) (GetOrgDashboardResponseObject, error)
+}
+
 type PricingHandlers interface {
 	GetPricing(ctx context.Context, request GetPricingRequestObject) (GetPricingResponseObject, error)
 }
 
+type WorkspaceHandlers interface {
+	AcceptWorkspaceInvitation(ctx context.Context, request AcceptWorkspaceInvitationRequestObject) (AcceptWorkspaceInvitationResponseObject, error)
+	CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error)
+	DeclineWorkspaceInvitation(ctx context.Context, request DeclineWorkspaceInvitationRequestObject) (DeclineWorkspaceInvitationResponseObject, error)
+	InviteWorkspaceMember(ctx context.Context, request InviteWorkspaceMemberRequestObject) (InviteWorkspaceMemberResponseObject, error)
+	ListWorkspaceInvitations(ctx context.Context, request ListWorkspaceInvitationsRequestObject) (ListWorkspaceInvitationsResponseObject, error)
+	ListWorkspaceMembers(ctx context.Context, request ListWorkspaceMembersRequestObject) (ListWorkspaceMembersResponseObject, error)
+	ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error)
+	RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error)
+	UpdateWorkspaceMemberRole(ctx context.Context, request UpdateWorkspaceMemberRoleRequestObject) (UpdateWorkspaceMemberRoleResponseObject, error)
+}
+
 type APIHandlers struct {
+	admin           AdminHandlers
 	analyzer        AnalyzerHandlers
+	analysisBatch   AnalysisBatchHandlers
 	analysisHistory AnalysisHistoryHandlers
+	analysisIngest  AnalysisIngestHandlers
 	auth            AuthHandlers
 	bookmark        BookmarkHandlers
 	github          GitHubHandlers
 	githubApp       GitHubAppHandlers
+	orgDashboard    OrgDashboardHandlers
 	pricing         PricingHandlers
 	repository      RepositoryHandlers
 	specView        SpecViewHandlers
@@ -95,17 +155,22 @@ type APIHandlers struct {
 	usage           UsageHandlers
 	userActiveTasks UserActiveTasksHandlers
 	webhook         WebhookHandlers
+	workspace       WorkspaceHandlers
 }
 
 var _ StrictServerInterface = (*APIHandlers)(nil)
 
 func NewAPIHandlers(
+	admin AdminHandlers,
 	analyzer AnalyzerHandlers,
+	analysisBatch AnalysisBatchHandlers,
 	analysisHistory AnalysisHistoryHandlers,
+	analysisIngest AnalysisIngestHandlers,
 	auth AuthHandlers,
 	bookmark BookmarkHandlers,
 	github GitHubHandlers,
 	githubApp GitHubAppHandlers,
+	orgDashboard OrgDashboardHandlers,
 	pricing PricingHandlers,
 	repository RepositoryHandlers,
 	specView SpecViewHandlers,
@@ -113,14 +178,19 @@ func NewAPIHandlers(
 	usage UsageHandlers,
 	userActiveTasks UserActiveTasksHandlers,
 	webhook WebhookHandlers,
+	workspace WorkspaceHandlers,
 ) *APIHandlers {
 	return &APIHandlers{
+		admin:           admin,
 		analyzer:        analyzer,
+		analysisBatch:   analysisBatch,
 		analysisHistory: analysisHistory,
+		analysisIngest:  analysisIngest,
 		auth:            auth,
 		bookmark:        bookmark,
 		github:          github,
 		githubApp:       githubApp,
+		orgDashboard:    orgDashboard,
 		pricing:         pricing,
 		repository:      repository,
 		specView:        specView,
@@ -128,6 +198,7 @@ func NewAPIHandlers(
 		usage:           usage,
 		userActiveTasks: userActiveTasks,
 		webhook:         webhook,
+		workspace:       workspace,
 	}
 }
 
@@ -143,6 +214,42 @@ func (h *APIHandlers) GetAnalysisStatus(ctx context.Context, request GetAnalysis
 	return h.analyzer.GetAnalysisStatus(ctx, request)
 }
 
+func (h *APIHandlers) GetAnalysisBatch(ctx context.Context, request GetAnalysisBatchRequestObject) (GetAnalysisBatchResponseObject, error) {
+	if h.analysisBatch == nil {
+		return GetAnalysisBatch500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Analysis batch feature not configured"),
+		}, nil
+	}
+	return h.analysisBatch.GetAnalysisBatch(ctx, request)
+}
+
+func (h *APIHandlers) StartAnalysisBatch(ctx context.Context, request StartAnalysisBatchRequestObject) (StartAnalysisBatchResponseObject, error) {
+	if h.analysisBatch == nil {
+		return StartAnalysisBatch500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Analysis batch feature not configured"),
+		}, nil
+	}
+	return h.analysisBatch.StartAnalysisBatch(ctx, request)
+}
+
+func (h *APIHandlers) IngestAnalysis(ctx context.Context, request IngestAnalysisRequestObject) (IngestAnalysisResponseObject, error) {
+	if h.analysisIngest == nil {
+		return IngestAnalysis500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Analysis ingestion feature not configured"),
+		}, nil
+	}
+	return h.analysisIngest.IngestAnalysis(ctx, request)
+}
+
+func (h *APIHandlers) GetOrgDashboard(ctx context.Context, request GetOrgDashboardRequestObject) (GetOrgDashboardResponseObject, error) {
+	if h.orgDashboard == nil {
+		return GetOrgDashboard500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Organization dashboard feature not configured"),
+		}, nil
+	}
+	return h.orgDashboard.GetOrgDashboard(ctx, request)
+}
+
 func (h *APIHandlers) AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error) {
 	return h.auth.AuthCallback(ctx, request)
 }
@@ -167,6 +274,18 @@ func (h *APIHandlers) AuthDevLogin(ctx context.Context, request AuthDevLoginRequ
 	return h.auth.AuthDevLogin(ctx, request)
 }
 
+func (h *APIHandlers) CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error) {
+	return h.auth.CreatePersonalAccessToken(ctx, request)
+}
+
+func (h *APIHandlers) ListPersonalAccessTokens(ctx context.Context, request ListPersonalAccessTokensRequestObject) (ListPersonalAccessTokensResponseObject, error) {
+	return h.auth.ListPersonalAccessTokens(ctx, request)
+}
+
+func (h *APIHandlers) RevokePersonalAccessToken(ctx context.Context, request RevokePersonalAccessTokenRequestObject) (RevokePersonalAccessTokenResponseObject, error) {
+	return h.auth.RevokePersonalAccessToken(ctx, request)
+}
+
 func (h *APIHandlers) AddBookmark(ctx context.Context, request AddBookmarkRequestObject) (AddBookmarkResponseObject, error) {
 	return h.bookmark.AddBookmark(ctx, request)
 }
@@ -346,6 +465,204 @@ func (h *APIHandlers) GetSpecDocumentByRepository(ctx context.Context, request G
 	return h.specView.GetSpecDocumentByRepository(ctx, request)
 }
 
+func (h *APIHandlers) ExportSpecDocument(ctx context.Context, request ExportSpecDocumentRequestObject) (ExportSpecDocumentResponseObject, error) {
+	if h.specView == nil {
+		return ExportSpecDocument500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.ExportSpecDocument(ctx, request)
+}
+
+func (h *APIHandlers) ExportSpecDocumentByRepository(ctx context.Context, request ExportSpecDocumentByRepositoryRequestObject) (ExportSpecDocumentByRepositoryResponseObject, error) {
+	if h.specView == nil {
+		return ExportSpecDocumentByRepository500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.ExportSpecDocumentByRepository(ctx, request)
+}
+
+func (h *APIHandlers) GetSpecDiff(ctx context.Context, request GetSpecDiffRequestObject) (GetSpecDiffResponseObject, error) {
+	if h.specView == nil {
+		return GetSpecDiff500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.GetSpecDiff(ctx, request)
+}
+
+func (h *APIHandlers) GetSpecDiffByRepository(ctx context.Context, request GetSpecDiffByRepositoryRequestObject) (GetSpecDiffByRepositoryResponseObject, error) {
+	if h.specView == nil {
+		return GetSpecDiffByRepository500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.GetSpecDiffByRepository(ctx, request)
+}
+
+func (h *APIHandlers) EditSpecBehavior(ctx context.Context, request EditSpecBehaviorRequestObject) (EditSpecBehaviorResponseObject, error) {
+	if h.specView == nil {
+		return EditSpecBehavior500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.EditSpecBehavior(ctx, request)
+}
+
+func (h *APIHandlers) EditSpecFeature(ctx context.Context, request EditSpecFeatureRequestObject) (EditSpecFeatureResponseObject, error) {
+	if h.specView == nil {
+		return EditSpecFeature500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.EditSpecFeature(ctx, request)
+}
+
+func (h *APIHandlers) GetSpecEdits(ctx context.Context, request GetSpecEditsRequestObject) (GetSpecEditsResponseObject, error) {
+	if h.specView == nil {
+		return GetSpecEdits500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.GetSpecEdits(ctx, request)
+}
+
+func (h *APIHandlers) RevertSpecBehaviorEdit(ctx context.Context, request RevertSpecBehaviorEditRequestObject) (RevertSpecBehaviorEditResponseObject, error) {
+	if h.specView == nil {
+		return RevertSpecBehaviorEdit500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.RevertSpecBehaviorEdit(ctx, request)
+}
+
+func (h *APIHandlers) RevertSpecFeatureEdit(ctx context.Context, request RevertSpecFeatureEditRequestObject) (RevertSpecFeatureEditResponseObject, error) {
+	if h.specView == nil {
+		return RevertSpecFeatureEdit500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.RevertSpecFeatureEdit(ctx, request)
+}
+
+func (h *APIHandlers) AddSpecComment(ctx context.Context, request AddSpecCommentRequestObject) (AddSpecCommentResponseObject, error) {
+	if h.specView == nil {
+		return AddSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.AddSpecComment(ctx, request)
+}
+
+func (h *APIHandlers) CancelSpecGeneration(ctx context.Context, request CancelSpecGenerationRequestObject) (CancelSpecGenerationResponseObject, error) {
+	if h.specView == nil {
+		return CancelSpecGeneration500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.CancelSpecGeneration(ctx, request)
+}
+
+func (h *APIHandlers) CreateSpecCommentThread(ctx context.Context, request CreateSpecCommentThreadRequestObject) (CreateSpecCommentThreadResponseObject, error) {
+	if h.specView == nil {
+		return CreateSpecCommentThread500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.CreateSpecCommentThread(ctx, request)
+}
+
+func (h *APIHandlers) DeleteSpecComment(ctx context.Context, request DeleteSpecCommentRequestObject) (DeleteSpecCommentResponseObject, error) {
+	if h.specView == nil {
+		return DeleteSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.DeleteSpecComment(ctx, request)
+}
+
+func (h *APIHandlers) GetSpecComments(ctx context.Context, request GetSpecCommentsRequestObject) (GetSpecCommentsResponseObject, error) {
+	if h.specView == nil {
+		return GetSpecComments500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.GetSpecComments(ctx, request)
+}
+
+func (h *APIHandlers) ReopenSpecCommentThread(ctx context.Context, request ReopenSpecCommentThreadRequestObject) (ReopenSpecCommentThreadResponseObject, error) {
+	if h.specView == nil {
+		return ReopenSpecCommentThread500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.ReopenSpecCommentThread(ctx, request)
+}
+
+func (h *APIHandlers) ResolveSpecCommentThread(ctx context.Context, request ResolveSpecCommentThreadRequestObject) (ResolveSpecCommentThreadResponseObject, error) {
+	if h.specView == nil {
+		return ResolveSpecCommentThread500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.ResolveSpecCommentThread(ctx, request)
+}
+
+func (h *APIHandlers) UpdateSpecComment(ctx context.Context, request UpdateSpecCommentRequestObject) (UpdateSpecCommentResponseObject, error) {
+	if h.specView == nil {
+		return UpdateSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.UpdateSpecComment(ctx, request)
+}
+
+func (h *APIHandlers) CreateSpecShareLink(ctx context.Context, request CreateSpecShareLinkRequestObject) (CreateSpecShareLinkResponseObject, error) {
+	if h.specView == nil {
+		return CreateSpecShareLink500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.CreateSpecShareLink(ctx, request)
+}
+
+func (h *APIHandlers) GetSharedSpec(ctx context.Context, request GetSharedSpecRequestObject) (GetSharedSpecResponseObject, error) {
+	if h.specView == nil {
+		return GetSharedSpec500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.GetSharedSpec(ctx, request)
+}
+
+func (h *APIHandlers) ListSpecShareLinks(ctx context.Context, request ListSpecShareLinksRequestObject) (ListSpecShareLinksResponseObject, error) {
+	if h.specView == nil {
+		return ListSpecShareLinks500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.ListSpecShareLinks(ctx, request)
+}
+
+func (h *APIHandlers) RevokeSpecShareLink(ctx context.Context, request RevokeSpecShareLinkRequestObject) (RevokeSpecShareLinkResponseObject, error) {
+	if h.specView == nil {
+		return RevokeSpecShareLink500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.RevokeSpecShareLink(ctx, request)
+}
+
+func (h *APIHandlers) SearchSpecs(ctx context.Context, request SearchSpecsRequestObject) (SearchSpecsResponseObject, error) {
+	if h.specView == nil {
+		return SearchSpecs500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Spec View feature not configured"),
+		}, nil
+	}
+	return h.specView.SearchSpecs(ctx, request)
+}
+
 func (h *APIHandlers) GetVersionHistoryByRepository(ctx context.Context, request GetVersionHistoryByRepositoryRequestObject) (GetVersionHistoryByRepositoryResponseObject, error) {
 	if h.specView == nil {
 		return GetVersionHistoryByRepository500ApplicationProblemPlusJSONResponse{
@@ -354,3 +671,111 @@ func (h *APIHandlers) GetVersionHistoryByRepository(ctx context.Context, request
 	}
 	return h.specView.GetVersionHistoryByRepository(ctx, request)
 }
+
+func (h *APIHandlers) GetBulkReanalysisRun(ctx context.Context, request GetBulkReanalysisRunRequestObject) (GetBulkReanalysisRunResponseObject, error) {
+	if h.admin == nil {
+		return GetBulkReanalysisRun500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Admin feature not configured"),
+		}, nil
+	}
+	return h.admin.GetBulkReanalysisRun(ctx, request)
+}
+
+func (h *APIHandlers) ListBulkReanalysisRuns(ctx context.Context, request ListBulkReanalysisRunsRequestObject) (ListBulkReanalysisRunsResponseObject, error) {
+	if h.admin == nil {
+		return ListBulkReanalysisRuns500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Admin feature not configured"),
+		}, nil
+	}
+	return h.admin.ListBulkReanalysisRuns(ctx, request)
+}
+
+func (h *APIHandlers) StartBulkReanalysis(ctx context.Context, request StartBulkReanalysisRequestObject) (StartBulkReanalysisResponseObject, error) {
+	if h.admin == nil {
+		return StartBulkReanalysis500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Admin feature not configured"),
+		}, nil
+	}
+	return h.admin.StartBulkReanalysis(ctx, request)
+}
+
+func (h *APIHandlers) AcceptWorkspaceInvitation(ctx context.Context, request AcceptWorkspaceInvitationRequestObject) (AcceptWorkspaceInvitationResponseObject, error) {
+	if h.workspace == nil {
+		return AcceptWorkspaceInvitation500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.AcceptWorkspaceInvitation(ctx, request)
+}
+
+func (h *APIHandlers) CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error) {
+	if h.workspace == nil {
+		return CreateWorkspace500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.CreateWorkspace(ctx, request)
+}
+
+func (h *APIHandlers) DeclineWorkspaceInvitation(ctx context.Context, request DeclineWorkspaceInvitationRequestObject) (DeclineWorkspaceInvitationResponseObject, error) {
+	if h.workspace == nil {
+		return DeclineWorkspaceInvitation500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.DeclineWorkspaceInvitation(ctx, request)
+}
+
+func (h *APIHandlers) InviteWorkspaceMember(ctx context.Context, request InviteWorkspaceMemberRequestObject) (InviteWorkspaceMemberResponseObject, error) {
+	if h.workspace == nil {
+		return InviteWorkspaceMember500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.InviteWorkspaceMember(ctx, request)
+}
+
+func (h *APIHandlers) ListWorkspaceInvitations(ctx context.Context, request ListWorkspaceInvitationsRequestObject) (ListWorkspaceInvitationsResponseObject, error) {
+	if h.workspace == nil {
+		return ListWorkspaceInvitations500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.ListWorkspaceInvitations(ctx, request)
+}
+
+func (h *APIHandlers) ListWorkspaceMembers(ctx context.Context, request ListWorkspaceMembersRequestObject) (ListWorkspaceMembersResponseObject, error) {
+	if h.workspace == nil {
+		return ListWorkspaceMembers500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.ListWorkspaceMembers(ctx, request)
+}
+
+func (h *APIHandlers) ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error) {
+	if h.workspace == nil {
+		return ListWorkspaces500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.ListWorkspaces(ctx, request)
+}
+
+func (h *APIHandlers) RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error) {
+	if h.workspace == nil {
+		return RemoveWorkspaceMember500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.RemoveWorkspaceMember(ctx, request)
+}
+
+func (h *APIHandlers) UpdateWorkspaceMemberRole(ctx context.Context, request UpdateWorkspaceMemberRoleRequestObject) (UpdateWorkspaceMemberRoleResponseObject, error) {
+	if h.workspace == nil {
+		return UpdateWorkspaceMemberRole500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: NewInternalError("Workspace feature not configured"),
+		}, nil
+	}
+	return h.workspace.UpdateWorkspaceMemberRole(ctx, request)
+}
@@ -49,3 +49,19 @@ func NewForbidden(detail string) ForbiddenApplicationProblemPlusJSONResponse {
 		Title:  "Forbidden",
 	}
 }
+
+func NewConflict(detail string) ConflictApplicationProblemPlusJSONResponse {
+	return ConflictApplicationProblemPlusJSONResponse{
+		Detail: detail,
+		Status: http.StatusConflict,
+		Title:  "Conflict",
+	}
+}
+
+func NewPayloadTooLarge(detail string) PayloadTooLargeApplicationProblemPlusJSONResponse {
+	return PayloadTooLargeApplicationProblemPlusJSONResponse{
+		Detail: detail,
+		Status: http.StatusRequestEntityTooLarge,
+		Title:  "Payload Too Large",
+	}
+}
@@ -1,8 +1,34 @@
 package api
 
+import "encoding/json"
+
 // MarshalJSON implements json.Marshaler for GetSpecDocumentByRepository200JSONResponse.
 // This is needed because the generated type alias doesn't inherit the MarshalJSON method
 // from RepoSpecDocumentResponse, causing the unexported union field to be ignored.
 func (r GetSpecDocumentByRepository200JSONResponse) MarshalJSON() ([]byte, error) {
 	return RepoSpecDocumentResponse(r).MarshalJSON()
 }
+
+// CacheableBody implements CacheableResponse. The trailing newline matches the
+// generated Visit method, which encodes with json.Encoder.
+func (r AnalyzeRepository200JSONResponse) CacheableBody() ([]byte, bool, error) {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return nil, false, err
+	}
+	return append(body, '\n'), true, nil
+}
+
+// CacheableBody implements CacheableResponse. Only completed documents are
+// final; the empty state changes as soon as a document is generated.
+func (r GetSpecDocumentByRepository200JSONResponse) CacheableBody() ([]byte, bool, error) {
+	body, err := r.MarshalJSON()
+	if err != nil {
+		return nil, false, err
+	}
+	status, err := RepoSpecDocumentResponse(r).Discriminator()
+	if err != nil {
+		return nil, false, err
+	}
+	return append(body, '\n'), status == "completed", nil
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -18,13 +19,14 @@ import (
 )
 
 const (
+	BearerAuthScopes = "bearerAuth.Scopes"
 	CookieAuthScopes = "cookieAuth.Scopes"
 )
 
 // Defines values for ActiveTaskStatus.
 const (
-	Analyzing ActiveTaskStatus = "analyzing"
-	Queued    ActiveTaskStatus = "queued"
+	ActiveTaskStatusAnalyzing ActiveTaskStatus = "analyzing"
+	ActiveTaskStatusQueued    ActiveTaskStatus = "queued"
 )
 
 // Defines values for ActiveTaskType.
@@ -32,6 +34,35 @@ const (
 	ActiveTaskTypeAnalysis ActiveTaskType = "analysis"
 )
 
+// Defines values for AnalysisBatchStatus.
+const (
+	AnalysisBatchStatusAnalyzing  AnalysisBatchStatus = "analyzing"
+	AnalysisBatchStatusCompleted  AnalysisBatchStatus = "completed"
+	AnalysisBatchStatusSubmitting AnalysisBatchStatus = "submitting"
+)
+
+// Defines values for AnalysisBatchItemStatus.
+const (
+	AnalysisBatchItemStatusAnalyzing AnalysisBatchItemStatus = "analyzing"
+	AnalysisBatchItemStatusCompleted AnalysisBatchItemStatus = "completed"
+	AnalysisBatchItemStatusFailed    AnalysisBatchItemStatus = "failed"
+	AnalysisBatchItemStatusPending   AnalysisBatchItemStatus = "pending"
+)
+
+// Defines values for BulkReanalysisRunStatus.
+const (
+	BulkReanalysisRunStatusCompleted BulkReanalysisRunStatus = "completed"
+	BulkReanalysisRunStatusFailed    BulkReanalysisRunStatus = "failed"
+	BulkReanalysisRunStatusPending   BulkReanalysisRunStatus = "pending"
+	BulkReanalysisRunStatusRunning   BulkReanalysisRunStatus = "running"
+)
+
+// Defines values for BulkReanalysisRunTrigger.
+const (
+	Auto   BulkReanalysisRunTrigger = "auto"
+	Manual BulkReanalysisRunTrigger = "manual"
+)
+
 // Defines values for GitHubAppInstallationAccountType.
 const (
 	GitHubAppInstallationAccountTypeOrganization GitHubAppInstallationAccountType = "organization"
@@ -73,9 +104,10 @@ const (
 
 // Defines values for SortByParam.
 const (
-	Name   SortByParam = "name"
-	Recent SortByParam = "recent"
-	Tests  SortByParam = "tests"
+	Name     SortByParam = "name"
+	Recent   SortByParam = "recent"
+	Tests    SortByParam = "tests"
+	Trending SortByParam = "trending"
 )
 
 // Defines values for SortOrderParam.
@@ -84,6 +116,29 @@ const (
 	Desc SortOrderParam = "desc"
 )
 
+// Defines values for SpecChangeType.
+const (
+	Added    SpecChangeType = "added"
+	Modified SpecChangeType = "modified"
+	Removed  SpecChangeType = "removed"
+	Renamed  SpecChangeType = "renamed"
+	Reworded SpecChangeType = "reworded"
+)
+
+// Defines values for SpecCommentTargetType.
+const (
+	SpecCommentTargetTypeBehavior SpecCommentTargetType = "behavior"
+	SpecCommentTargetTypeDomain   SpecCommentTargetType = "domain"
+	SpecCommentTargetTypeFeature  SpecCommentTargetType = "feature"
+)
+
+// Defines values for SpecExportFormat.
+const (
+	Gherkin  SpecExportFormat = "gherkin"
+	HTML     SpecExportFormat = "html"
+	Markdown SpecExportFormat = "markdown"
+)
+
 // Defines values for SpecGenerationMode.
 const (
 	Initial          SpecGenerationMode = "initial"
@@ -93,6 +148,7 @@ const (
 
 // Defines values for SpecGenerationStatusEnum.
 const (
+	SpecGenerationStatusEnumCancelled SpecGenerationStatusEnum = "cancelled"
 	SpecGenerationStatusEnumCompleted SpecGenerationStatusEnum = "completed"
 	SpecGenerationStatusEnumFailed    SpecGenerationStatusEnum = "failed"
 	SpecGenerationStatusEnumNotFound  SpecGenerationStatusEnum = "not_found"
@@ -128,6 +184,13 @@ const (
 	Vietnamese SpecLanguage = "Vietnamese"
 )
 
+// Defines values for SpecSearchResultKind.
+const (
+	SpecSearchResultKindBehavior SpecSearchResultKind = "behavior"
+	SpecSearchResultKindDomain   SpecSearchResultKind = "domain"
+	SpecSearchResultKindFeature  SpecSearchResultKind = "feature"
+)
+
 // Defines values for TestStatus.
 const (
 	Active  TestStatus = "active"
@@ -137,6 +200,14 @@ const (
 	Xfail   TestStatus = "xfail"
 )
 
+// Defines values for TokenScope.
+const (
+	AnalysisRead  TokenScope = "analysis:read"
+	AnalysisWrite TokenScope = "analysis:write"
+	SpecRead      TokenScope = "spec:read"
+	SpecWrite     TokenScope = "spec:write"
+)
+
 // Defines values for UpdateStatus.
 const (
 	NewCommits UpdateStatus = "new-commits"
@@ -163,6 +234,13 @@ const (
 	User         WebhookAccountType = "User"
 )
 
+// Defines values for WorkspaceRole.
+const (
+	WorkspaceRoleEditor WorkspaceRole = "editor"
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+	WorkspaceRoleViewer WorkspaceRole = "viewer"
+)
+
 // Defines values for GetUserAnalyzedRepositoriesParamsOwnership.
 const (
 	GetUserAnalyzedRepositoriesParamsOwnershipAll          GetUserAnalyzedRepositoriesParamsOwnership = "all"
@@ -230,6 +308,74 @@ type AiSpecSummary struct {
 	LatestGeneratedAt *time.Time `json:"latestGeneratedAt,omitempty"`
 }
 
+// AnalysisBatch defines model for AnalysisBatch.
+type AnalysisBatch struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ID Batch ID
+	ID    openapi_types.UUID  `json:"id"`
+	Items []AnalysisBatchItem `json:"items"`
+
+	// Org Organization the batch was created from
+	Org      *string               `json:"org,omitempty"`
+	Progress AnalysisBatchProgress `json:"progress"`
+
+	// SkippedCount Organization repositories excluded by the archived/fork filters
+	SkippedCount int `json:"skippedCount"`
+
+	// Status - submitting: Repositories are still being queued
+	// - analyzing: All repositories queued, some analyses still running
+	// - completed: Every repository finished (successfully or not)
+	Status AnalysisBatchStatus `json:"status"`
+
+	// SubmittedAt When every repository had been queued
+	SubmittedAt *time.Time `json:"submittedAt,omitempty"`
+
+	// TotalCount Repositories in the batch
+	TotalCount int `json:"totalCount"`
+}
+
+// AnalysisBatchStatus - submitting: Repositories are still being queued
+// - analyzing: All repositories queued, some analyses still running
+// - completed: Every repository finished (successfully or not)
+type AnalysisBatchStatus string
+
+// AnalysisBatchItem defines model for AnalysisBatchItem.
+type AnalysisBatchItem struct {
+	// CommitSHA Commit being analyzed, once queued
+	CommitSHA *string `json:"commitSha,omitempty"`
+
+	// Error Reason the repository could not be queued
+	Error  *string                 `json:"error,omitempty"`
+	Owner  string                  `json:"owner"`
+	Repo   string                  `json:"repo"`
+	Status AnalysisBatchItemStatus `json:"status"`
+}
+
+// AnalysisBatchItemStatus defines model for AnalysisBatchItem.Status.
+type AnalysisBatchItemStatus string
+
+// AnalysisBatchProgress defines model for AnalysisBatchProgress.
+type AnalysisBatchProgress struct {
+	// Analyzing Queued or running
+	Analyzing int `json:"analyzing"`
+
+	// Completed Analysis available (including reused cached results)
+	Completed int `json:"completed"`
+
+	// Failed Could not be queued or analysis failed
+	Failed int `json:"failed"`
+
+	// Pending Not yet queued
+	Pending int `json:"pending"`
+}
+
+// AnalysisBatchRepositoryRef defines model for AnalysisBatchRepositoryRef.
+type AnalysisBatchRepositoryRef struct {
+	Owner string `json:"owner"`
+	Repo  string `json:"repo"`
+}
+
 // AnalysisHistoryItem defines model for AnalysisHistoryItem.
 type AnalysisHistoryItem struct {
 	// BranchName Branch name at the time of analysis
@@ -365,6 +511,55 @@ type BookmarkedRepositoriesResponse struct {
 	Data []RepositoryCard `json:"data"`
 }
 
+// BulkReanalysisRun defines model for BulkReanalysisRun.
+type BulkReanalysisRun struct {
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+
+	// EnqueuedCount Analyses enqueued so far
+	EnqueuedCount int `json:"enqueuedCount"`
+
+	// ErrorMessage Failure reason when status is failed
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+
+	// FailedCount Codebases that could not be enqueued
+	FailedCount int `json:"failedCount"`
+
+	// ID Run ID
+	ID openapi_types.UUID `json:"id"`
+
+	// ParserVersion Parser version the corpus is being re-analyzed with
+	ParserVersion string `json:"parserVersion"`
+
+	// Progress Processed percentage of totalCount
+	Progress  float32    `json:"progress"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// Status Current run status
+	Status BulkReanalysisRunStatus `json:"status"`
+
+	// TotalCount Outdated codebases found when the run was created
+	TotalCount int `json:"totalCount"`
+
+	// Trigger How the run was started:
+	// - manual: Operator request
+	// - auto: Parser version change detected in system_config
+	Trigger BulkReanalysisRunTrigger `json:"trigger"`
+}
+
+// BulkReanalysisRunStatus Current run status
+type BulkReanalysisRunStatus string
+
+// BulkReanalysisRunTrigger How the run was started:
+// - manual: Operator request
+// - auto: Parser version change detected in system_config
+type BulkReanalysisRunTrigger string
+
+// BulkReanalysisRunsResponse defines model for BulkReanalysisRunsResponse.
+type BulkReanalysisRunsResponse struct {
+	Data []BulkReanalysisRun `json:"data"`
+}
+
 // CacheAvailabilityResponse defines model for CacheAvailabilityResponse.
 type CacheAvailabilityResponse struct {
 	// Languages Map of language to cache availability (hasPreviousSpec)
@@ -421,6 +616,58 @@ type CompletedResponse struct {
 	Status string         `json:"status"`
 }
 
+// CreatePersonalAccessTokenRequest defines model for CreatePersonalAccessTokenRequest.
+type CreatePersonalAccessTokenRequest struct {
+	// ExpiresInDays Days until the token expires. Omit for a token that never expires.
+	ExpiresInDays *int         `json:"expiresInDays,omitempty"`
+	Name          string       `json:"name"`
+	Scopes        []TokenScope `json:"scopes"`
+}
+
+// CreatePersonalAccessTokenResponse defines model for CreatePersonalAccessTokenResponse.
+type CreatePersonalAccessTokenResponse struct {
+	Data PersonalAccessToken `json:"data"`
+
+	// Token Token secret. Shown only once.
+	Token string `json:"token"`
+}
+
+// CreateSpecCommentThreadRequest defines model for CreateSpecCommentThreadRequest.
+type CreateSpecCommentThreadRequest struct {
+	Body string `json:"body"`
+
+	// TargetID ID of the domain, feature or behavior in the document
+	TargetID openapi_types.UUID `json:"targetId"`
+
+	// TargetType Spec hierarchy level a comment thread is attached to
+	TargetType SpecCommentTargetType `json:"targetType"`
+}
+
+// CreateSpecShareLinkRequest defines model for CreateSpecShareLinkRequest.
+type CreateSpecShareLinkRequest struct {
+	// DocumentID Spec document to share
+	DocumentID openapi_types.UUID `json:"documentId"`
+
+	// ExpiresInDays Days until the link expires. Omit for a link that never expires.
+	ExpiresInDays *int `json:"expiresInDays,omitempty"`
+
+	// Latest Follow the newest document for the document's repository and language instead of this version
+	Latest *bool `json:"latest,omitempty"`
+}
+
+// CreateSpecShareLinkResponse defines model for CreateSpecShareLinkResponse.
+type CreateSpecShareLinkResponse struct {
+	Data SpecShareLink `json:"data"`
+
+	// Token Share link token. Shown only once.
+	Token string `json:"token"`
+}
+
+// CreateWorkspaceRequest defines model for CreateWorkspaceRequest.
+type CreateWorkspaceRequest struct {
+	Name string `json:"name"`
+}
+
 // DevLoginRequest defines model for DevLoginRequest.
 type DevLoginRequest struct {
 	// UserID Optional user ID to login as (uses default test user if not provided)
@@ -436,6 +683,21 @@ type DevLoginResponse struct {
 	User    UserInfo `json:"user"`
 }
 
+// EditSpecBehaviorRequest At least one of description or hidden=true is required
+type EditSpecBehaviorRequest struct {
+	// Description Description replacing the generated one
+	Description *string `json:"description,omitempty"`
+
+	// Hidden Hide the behavior from spec documents
+	Hidden *bool `json:"hidden,omitempty"`
+}
+
+// EditSpecFeatureRequest defines model for EditSpecFeatureRequest.
+type EditSpecFeatureRequest struct {
+	// Name New feature name; the name of another feature in the same domain merges them
+	Name string `json:"name"`
+}
+
 // FailedResponse defines model for FailedResponse.
 type FailedResponse struct {
 	// Error Error message describing the failure
@@ -579,6 +841,85 @@ type GitHubWebhookPayload struct {
 	Sender              *WebhookSender       `json:"sender,omitempty"`
 }
 
+// IngestAnalysisRequest defines model for IngestAnalysisRequest.
+type IngestAnalysisRequest struct {
+	// Branch Branch the commit was analyzed on
+	Branch *string `json:"branch,omitempty"`
+
+	// CommitSHA Full commit SHA the inventory was produced from
+	CommitSHA string `json:"commitSha"`
+
+	// CommittedAt Commit timestamp, used to order analysis history
+	CommittedAt *time.Time       `json:"committedAt,omitempty"`
+	Files       []IngestTestFile `json:"files"`
+
+	// ParserVersion Version of the parser that produced the inventory
+	ParserVersion string `json:"parserVersion"`
+}
+
+// IngestAnalysisResponse defines model for IngestAnalysisResponse.
+type IngestAnalysisResponse struct {
+	AnalysisID    openapi_types.UUID `json:"analysisId"`
+	CommitSHA     string             `json:"commitSha"`
+	CompletedAt   time.Time          `json:"completedAt"`
+	ParserVersion string             `json:"parserVersion"`
+	TotalSuites   int                `json:"totalSuites"`
+	TotalTests    int                `json:"totalTests"`
+}
+
+// IngestTestCase defines model for IngestTestCase.
+type IngestTestCase struct {
+	Line *int `json:"line,omitempty"`
+
+	// Modifier Test modifier (e.g., only, skip)
+	Modifier *string `json:"modifier,omitempty"`
+	Name     string  `json:"name"`
+
+	// Status Test status indicator:
+	// - active: Normal test that will run
+	// - focused: Test marked to run exclusively (e.g., it.only)
+	// - skipped: Test marked to be skipped (e.g., it.skip)
+	// - todo: Placeholder test to be implemented
+	// - xfail: Expected to fail (pytest xfail)
+	Status TestStatus `json:"status"`
+	Tags   *[]string  `json:"tags,omitempty"`
+}
+
+// IngestTestFile defines model for IngestTestFile.
+type IngestTestFile struct {
+	// Framework Testing framework identifier
+	Framework Framework `json:"framework"`
+
+	// Path Path to the test file relative to repository root
+	Path   string             `json:"path"`
+	Suites *[]IngestTestSuite `json:"suites,omitempty"`
+
+	// Tests Tests declared outside any suite
+	Tests *[]IngestTestCase `json:"tests,omitempty"`
+}
+
+// IngestTestSuite defines model for IngestTestSuite.
+type IngestTestSuite struct {
+	Line *int   `json:"line,omitempty"`
+	Name string `json:"name"`
+
+	// Suites Nested suites
+	Suites *[]IngestTestSuite `json:"suites,omitempty"`
+	Tests  *[]IngestTestCase  `json:"tests,omitempty"`
+}
+
+// InviteWorkspaceMemberRequest defines model for InviteWorkspaceMemberRequest.
+type InviteWorkspaceMemberRequest struct {
+	// Role Member role in a workspace:
+	// - owner: manages members; the workspace's generations are charged to this user
+	// - editor: can generate spec documents for the workspace
+	// - viewer: can read the workspace's spec documents
+	Role WorkspaceRole `json:"role"`
+
+	// Username GitHub username of a user who has signed in at least once
+	Username string `json:"username"`
+}
+
 // LoginResponse defines model for LoginResponse.
 type LoginResponse struct {
 	// AuthURL GitHub OAuth authorization URL to redirect user
@@ -591,6 +932,44 @@ type LogoutResponse struct {
 	Success bool `json:"success"`
 }
 
+// OrgAttentionRepository defines model for OrgAttentionRepository.
+type OrgAttentionRepository struct {
+	AnalyzedAt   time.Time `json:"analyzedAt"`
+	FocusedCount int       `json:"focusedCount"`
+	Name         string    `json:"name"`
+	SkippedCount int       `json:"skippedCount"`
+	TotalTests   int       `json:"totalTests"`
+}
+
+// OrgDashboard defines model for OrgDashboard.
+type OrgDashboard struct {
+	// AttentionRepositories Repositories with the most skipped and focused tests (max 10)
+	AttentionRepositories []OrgAttentionRepository `json:"attentionRepositories"`
+
+	// ChangeWindowStart Start of the window test count changes are measured over (30 days)
+	ChangeWindowStart time.Time `json:"changeWindowStart"`
+	Org               string    `json:"org"`
+
+	// RepositoryCount Number of analyzed repositories owned by the organization
+	RepositoryCount int `json:"repositoryCount"`
+
+	// TestCountChanges Largest test count changes since changeWindowStart (max 10)
+	TestCountChanges []OrgTestCountChange `json:"testCountChanges"`
+	TestSummary      TestStatusSummary    `json:"testSummary"`
+
+	// TotalTests Total tests across the latest analysis of each repository
+	TotalTests int `json:"totalTests"`
+}
+
+// OrgTestCountChange defines model for OrgTestCountChange.
+type OrgTestCountChange struct {
+	// Change currentTests minus previousTests
+	Change        int    `json:"change"`
+	CurrentTests  int    `json:"currentTests"`
+	Name          string `json:"name"`
+	PreviousTests int    `json:"previousTests"`
+}
+
 // OrganizationAccessStatus Organization repository access status.
 // - accessible: GitHub App installed, can access organization repositories
 // - restricted: No GitHub App installation, cannot access organization repositories
@@ -616,6 +995,26 @@ type PaginatedRepositoriesResponse struct {
 	NextCursor *string `json:"nextCursor"`
 }
 
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ExpiresAt Absent for tokens that never expire
+	ExpiresAt  *time.Time         `json:"expiresAt,omitempty"`
+	ID         openapi_types.UUID `json:"id"`
+	LastUsedAt *time.Time         `json:"lastUsedAt,omitempty"`
+	Name       string             `json:"name"`
+	Scopes     []TokenScope       `json:"scopes"`
+
+	// TokenPrefix First characters of the token, to tell tokens apart
+	TokenPrefix string `json:"tokenPrefix"`
+}
+
+// PersonalAccessTokenListResponse defines model for PersonalAccessTokenListResponse.
+type PersonalAccessTokenListResponse struct {
+	Data []PersonalAccessToken `json:"data"`
+}
+
 // PlanInfo defines model for PlanInfo.
 type PlanInfo struct {
 	// AnalysisMonthlyLimit Monthly analysis limit (null for unlimited)
@@ -855,6 +1254,10 @@ type RequestSpecGenerationRequest struct {
 
 	// Language Target language for spec document generation (24 languages supported)
 	Language *SpecLanguage `json:"language,omitempty"`
+
+	// WorkspaceID Generate the document for this workspace instead of the user alone.
+	// Requires the editor or owner role; usage is charged to the workspace owner.
+	WorkspaceID *openapi_types.UUID `json:"workspaceId,omitempty"`
 }
 
 // RequestSpecGenerationResponse defines model for RequestSpecGenerationResponse.
@@ -870,20 +1273,37 @@ type RequestSpecGenerationResponse struct {
 	// - running: AI generation in progress
 	// - completed: Document successfully generated
 	// - failed: Generation failed
+	// - cancelled: Generation cancelled by the user
 	// - not_found: No generation request exists
 	Status SpecGenerationStatusEnum `json:"status"`
 }
 
+// SharedSpecDocumentResponse defines model for SharedSpecDocumentResponse.
+type SharedSpecDocumentResponse struct {
+	// Data Spec document with repository context (includes commit SHA)
+	Data RepoSpecDocument `json:"data"`
+
+	// ExpiresAt When the share link expires. Absent for links that never expire.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Owner Repository owner
+	Owner string `json:"owner"`
+
+	// Repo Repository name
+	Repo string `json:"repo"`
+}
+
 // SortByParam Field to sort repositories by:
 // - name: Repository name (alphabetical)
 // - recent: Analysis timestamp (most recent first)
 // - tests: Test count (highest first)
+// - trending: Unique daily viewers plus analysis activity over the last 7 UTC days (public repositories only)
 type SortByParam string
 
 // SortOrderParam Sort direction:
 // - asc: Ascending order
 // - desc: Descending order
-// Defaults depend on sortBy (desc for recent/tests, asc for name)
+// Defaults depend on sortBy (desc for recent/tests/trending, asc for name)
 type SortOrderParam string
 
 // SpecBehavior defines model for SpecBehavior.
@@ -891,6 +1311,9 @@ type SpecBehavior struct {
 	// ConvertedDescription AI-converted natural language description
 	ConvertedDescription string `json:"convertedDescription"`
 
+	// Edited Whether convertedDescription was written by the user instead of generated
+	Edited *bool `json:"edited,omitempty"`
+
 	// ID Behavior ID
 	ID openapi_types.UUID `json:"id"`
 
@@ -905,6 +1328,46 @@ type SpecBehavior struct {
 	SourceTestCaseID *openapi_types.UUID `json:"sourceTestCaseId,omitempty"`
 }
 
+// SpecBehaviorDiff defines model for SpecBehaviorDiff.
+type SpecBehaviorDiff struct {
+	// Change How an item changed between versions:
+	// - added / removed: Only present in the target / base version.
+	// - renamed: Name changed; the description may have changed too.
+	// - reworded: Only the description changed.
+	// - modified: Unchanged itself, but some children changed.
+	Change SpecChangeType `json:"change"`
+
+	// Description Converted description in the target version (base version for removed items)
+	Description string `json:"description"`
+
+	// OriginalName Source test name in the target version (base version for removed items)
+	OriginalName string `json:"originalName"`
+
+	// PreviousDescription Converted description in the base version. Set when reworded.
+	PreviousDescription *string `json:"previousDescription,omitempty"`
+
+	// PreviousOriginalName Source test name in the base version. Set when the test was renamed.
+	PreviousOriginalName *string                 `json:"previousOriginalName,omitempty"`
+	SourceInfo           *SpecBehaviorSourceInfo `json:"sourceInfo,omitempty"`
+}
+
+// SpecBehaviorEdit defines model for SpecBehaviorEdit.
+type SpecBehaviorEdit struct {
+	// Description Description replacing the generated one (omitted if only hidden)
+	Description *string `json:"description,omitempty"`
+
+	// FilePath Test file of the edited behavior (empty if unknown)
+	FilePath string `json:"filePath"`
+
+	// Hidden Whether the behavior is hidden from spec documents
+	Hidden bool               `json:"hidden"`
+	ID     openapi_types.UUID `json:"id"`
+
+	// TestName Original test case name of the edited behavior
+	TestName  string    `json:"testName"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // SpecBehaviorSourceInfo defines model for SpecBehaviorSourceInfo.
 type SpecBehaviorSourceInfo struct {
 	// FilePath Test file path
@@ -925,6 +1388,135 @@ type SpecBehaviorSourceInfo struct {
 	Status TestStatus `json:"status"`
 }
 
+// SpecChangeCounts defines model for SpecChangeCounts.
+type SpecChangeCounts struct {
+	Added    int `json:"added"`
+	Removed  int `json:"removed"`
+	Renamed  int `json:"renamed"`
+	Reworded int `json:"reworded"`
+}
+
+// SpecChangeType How an item changed between versions:
+// - added / removed: Only present in the target / base version.
+// - renamed: Name changed; the description may have changed too.
+// - reworded: Only the description changed.
+// - modified: Unchanged itself, but some children changed.
+type SpecChangeType string
+
+// SpecComment defines model for SpecComment.
+type SpecComment struct {
+	Author    SpecCommentUser `json:"author"`
+	Body      string          `json:"body"`
+	CreatedAt time.Time       `json:"createdAt"`
+
+	// Edited Whether the comment was edited after it was posted
+	Edited bool               `json:"edited"`
+	ID     openapi_types.UUID `json:"id"`
+
+	// Mentions Existing users mentioned with @username in the body
+	Mentions  []SpecCommentUser  `json:"mentions"`
+	ThreadID  openapi_types.UUID `json:"threadId"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// SpecCommentRequest defines model for SpecCommentRequest.
+type SpecCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// SpecCommentTargetType Spec hierarchy level a comment thread is attached to
+type SpecCommentTargetType string
+
+// SpecCommentThread defines model for SpecCommentThread.
+type SpecCommentThread struct {
+	// Comments Comments oldest first
+	Comments  []SpecComment `json:"comments"`
+	CreatedAt time.Time     `json:"createdAt"`
+
+	// DocumentID Document the thread was opened on (omitted once that version is deleted)
+	DocumentID *openapi_types.UUID `json:"documentId,omitempty"`
+
+	// DomainName Generated name of the domain the thread is attached to or within
+	DomainName string `json:"domainName"`
+
+	// FeatureName Generated name of the feature (omitted for domain threads)
+	FeatureName *string            `json:"featureName,omitempty"`
+	ID          openapi_types.UUID `json:"id"`
+	Resolved    bool               `json:"resolved"`
+	ResolvedAt  *time.Time         `json:"resolvedAt,omitempty"`
+	ResolvedBy  *SpecCommentUser   `json:"resolvedBy,omitempty"`
+
+	// TargetID ID of the domain, feature or behavior in the requested document
+	TargetID openapi_types.UUID `json:"targetId"`
+
+	// TargetType Spec hierarchy level a comment thread is attached to
+	TargetType SpecCommentTargetType `json:"targetType"`
+
+	// TestName Original test case name (behavior threads only)
+	TestName *string `json:"testName,omitempty"`
+}
+
+// SpecCommentThreadsResponse defines model for SpecCommentThreadsResponse.
+type SpecCommentThreadsResponse struct {
+	// Data Threads in the order they were opened
+	Data []SpecCommentThread `json:"data"`
+}
+
+// SpecCommentUser defines model for SpecCommentUser.
+type SpecCommentUser struct {
+	ID       openapi_types.UUID `json:"id"`
+	Username string             `json:"username"`
+}
+
+// SpecDiffResponse defines model for SpecDiffResponse.
+type SpecDiffResponse struct {
+	// Domains Changed domains. Unchanged domains are omitted.
+	Domains []SpecDomainDiff `json:"domains"`
+
+	// ExecutiveSummary A changed text value. Absent when unchanged.
+	ExecutiveSummary *SpecTextChange `json:"executiveSummary,omitempty"`
+
+	// From Spec document version being compared
+	From SpecDiffVersion `json:"from"`
+
+	// Summary Change counts per level. A renamed item with a changed description counts as both renamed and reworded.
+	Summary SpecDiffSummary `json:"summary"`
+
+	// To Spec document version being compared
+	To SpecDiffVersion `json:"to"`
+}
+
+// SpecDiffSummary Change counts per level. A renamed item with a changed description counts as both renamed and reworded.
+type SpecDiffSummary struct {
+	Behaviors SpecChangeCounts `json:"behaviors"`
+	Domains   SpecChangeCounts `json:"domains"`
+	Features  SpecChangeCounts `json:"features"`
+}
+
+// SpecDiffVersion Spec document version being compared
+type SpecDiffVersion struct {
+	// AnalysisID Analysis ID this version belongs to
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+
+	// CommitSHA Git commit SHA of the analysis. Only set for repository diffs.
+	CommitSHA *string `json:"commitSha,omitempty"`
+
+	// CreatedAt When this version was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// ID Spec document ID
+	ID openapi_types.UUID `json:"id"`
+
+	// Language Target language for spec document generation (24 languages supported)
+	Language SpecLanguage `json:"language"`
+
+	// ModelID AI model ID used for this version
+	ModelID *string `json:"modelId,omitempty"`
+
+	// Version Version number
+	Version int `json:"version"`
+}
+
 // SpecDocument defines model for SpecDocument.
 type SpecDocument struct {
 	// AnalysisID Associated analysis ID
@@ -994,6 +1586,43 @@ type SpecDomain struct {
 	SortOrder int `json:"sortOrder"`
 }
 
+// SpecDomainDiff defines model for SpecDomainDiff.
+type SpecDomainDiff struct {
+	// Change How an item changed between versions:
+	// - added / removed: Only present in the target / base version.
+	// - renamed: Name changed; the description may have changed too.
+	// - reworded: Only the description changed.
+	// - modified: Unchanged itself, but some children changed.
+	Change SpecChangeType `json:"change"`
+
+	// Description Domain description
+	Description *string `json:"description,omitempty"`
+
+	// Features Changed features. All features are listed for added and removed domains.
+	Features []SpecFeatureDiff `json:"features"`
+
+	// Name Domain name in the target version (base version for removed items)
+	Name string `json:"name"`
+
+	// PreviousDescription Domain description in the base version. Set when the description changed.
+	PreviousDescription *string `json:"previousDescription,omitempty"`
+
+	// PreviousName Domain name in the base version. Set when renamed.
+	PreviousName *string `json:"previousName,omitempty"`
+}
+
+// SpecEditsResponse defines model for SpecEditsResponse.
+type SpecEditsResponse struct {
+	Behaviors []SpecBehaviorEdit `json:"behaviors"`
+	Features  []SpecFeatureEdit  `json:"features"`
+}
+
+// SpecExportFormat File format for spec document exports:
+// - gherkin: Zip archive holding a zip per domain with a .feature file per feature; behaviors become scenarios.
+// - html: Self-contained page with embedded styles, table of contents and collapsible sections.
+// - markdown: CommonMark document.
+type SpecExportFormat string
+
 // SpecFeature defines model for SpecFeature.
 type SpecFeature struct {
 	// Behaviors Behaviors (converted test cases) within this feature
@@ -1002,6 +1631,9 @@ type SpecFeature struct {
 	// Description Feature description
 	Description *string `json:"description,omitempty"`
 
+	// Edited Whether the user renamed this feature or merged another feature into it
+	Edited *bool `json:"edited,omitempty"`
+
 	// ID Feature ID
 	ID openapi_types.UUID `json:"id"`
 
@@ -1012,6 +1644,45 @@ type SpecFeature struct {
 	SortOrder int `json:"sortOrder"`
 }
 
+// SpecFeatureDiff defines model for SpecFeatureDiff.
+type SpecFeatureDiff struct {
+	// Behaviors Changed behaviors. All behaviors are listed for added and removed features.
+	Behaviors []SpecBehaviorDiff `json:"behaviors"`
+
+	// Change How an item changed between versions:
+	// - added / removed: Only present in the target / base version.
+	// - renamed: Name changed; the description may have changed too.
+	// - reworded: Only the description changed.
+	// - modified: Unchanged itself, but some children changed.
+	Change SpecChangeType `json:"change"`
+
+	// Description Feature description
+	Description *string `json:"description,omitempty"`
+
+	// Name Feature name in the target version (base version for removed items)
+	Name string `json:"name"`
+
+	// PreviousDescription Feature description in the base version. Set when the description changed.
+	PreviousDescription *string `json:"previousDescription,omitempty"`
+
+	// PreviousName Feature name in the base version. Set when renamed.
+	PreviousName *string `json:"previousName,omitempty"`
+}
+
+// SpecFeatureEdit defines model for SpecFeatureEdit.
+type SpecFeatureEdit struct {
+	// DomainName Generated name of the domain containing the feature
+	DomainName string `json:"domainName"`
+
+	// FeatureName Generated feature name
+	FeatureName string             `json:"featureName"`
+	ID          openapi_types.UUID `json:"id"`
+
+	// Name Name shown instead of the generated one
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // SpecGenerationMode Controls generation behavior:
 // - initial: First-time generation. Rejects if document already exists.
 // - regenerate_cached: Regeneration reusing cached classifications for speed.
@@ -1029,6 +1700,7 @@ type SpecGenerationStatus struct {
 	// - running: AI generation in progress
 	// - completed: Document successfully generated
 	// - failed: Generation failed
+	// - cancelled: Generation cancelled by the user
 	// - not_found: No generation request exists
 	Status SpecGenerationStatusEnum `json:"status"`
 }
@@ -1038,6 +1710,7 @@ type SpecGenerationStatus struct {
 // - running: AI generation in progress
 // - completed: Document successfully generated
 // - failed: Generation failed
+// - cancelled: Generation cancelled by the user
 // - not_found: No generation request exists
 type SpecGenerationStatusEnum string
 
@@ -1059,13 +1732,130 @@ type SpecGenerationStatusResponse struct {
 	// - running: AI generation in progress
 	// - completed: Document successfully generated
 	// - failed: Generation failed
+	// - cancelled: Generation cancelled by the user
 	// - not_found: No generation request exists
 	Status SpecGenerationStatusEnum `json:"status"`
 }
 
-// SpecLanguage Target language for spec document generation (24 languages supported)
+// SpecHighlightSegment defines model for SpecHighlightSegment.
+type SpecHighlightSegment struct {
+	// Matched Whether the segment contains search terms
+	Matched bool `json:"matched"`
+
+	// Text Unescaped text of the segment
+	Text string `json:"text"`
+}
+
+// SpecLanguage Target language for spec document generation (24 languages supported)
 type SpecLanguage string
 
+// SpecSearchResponse defines model for SpecSearchResponse.
+type SpecSearchResponse struct {
+	// Data Results in the current page, most relevant first
+	Data []SpecSearchResult `json:"data"`
+
+	// HasNext Whether more pages are available
+	HasNext bool `json:"hasNext"`
+
+	// NextCursor Cursor for fetching the next page (null if no more pages)
+	NextCursor *string `json:"nextCursor"`
+}
+
+// SpecSearchResult defines model for SpecSearchResult.
+type SpecSearchResult struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	DocumentID openapi_types.UUID `json:"documentId"`
+	DomainID   openapi_types.UUID `json:"domainId"`
+	DomainName string             `json:"domainName"`
+
+	// FeatureID Containing feature (omitted for domain results)
+	FeatureID   *openapi_types.UUID `json:"featureId,omitempty"`
+	FeatureName *string             `json:"featureName,omitempty"`
+
+	// Highlights Excerpt of the matched text split into plain and matched segments
+	Highlights []SpecHighlightSegment `json:"highlights"`
+
+	// ID ID of the matched domain, feature, or behavior
+	ID openapi_types.UUID `json:"id"`
+
+	// Kind Spec hierarchy level the result matched at
+	Kind SpecSearchResultKind `json:"kind"`
+
+	// Language Target language for spec document generation (24 languages supported)
+	Language SpecLanguage `json:"language"`
+	Owner    string       `json:"owner"`
+
+	// Rank Relevance score; only meaningful relative to other results of the same search
+	Rank float32 `json:"rank"`
+	Repo string  `json:"repo"`
+
+	// Version Version of the spec document containing the match
+	Version int `json:"version"`
+}
+
+// SpecSearchResultKind Spec hierarchy level the result matched at
+type SpecSearchResultKind string
+
+// SpecShareLink defines model for SpecShareLink.
+type SpecShareLink struct {
+	// AccessCount Number of times the link was used
+	AccessCount int64     `json:"accessCount"`
+	CreatedAt   time.Time `json:"createdAt"`
+
+	// DocumentID Shared document version. Absent for links that follow the newest document.
+	DocumentID *openapi_types.UUID `json:"documentId,omitempty"`
+
+	// DocumentVersion Version number of the shared document
+	DocumentVersion *int `json:"documentVersion,omitempty"`
+
+	// ExpiresAt Absent for links that never expire
+	ExpiresAt *time.Time         `json:"expiresAt,omitempty"`
+	ID        openapi_types.UUID `json:"id"`
+
+	// Language Target language for spec document generation (24 languages supported)
+	Language       SpecLanguage `json:"language"`
+	LastAccessedAt *time.Time   `json:"lastAccessedAt,omitempty"`
+
+	// Latest Whether the link follows the newest document instead of a fixed version
+	Latest bool `json:"latest"`
+
+	// Owner Repository owner
+	Owner string `json:"owner"`
+
+	// Repo Repository name
+	Repo string `json:"repo"`
+
+	// TokenPrefix First characters of the token, to tell links apart
+	TokenPrefix string `json:"tokenPrefix"`
+}
+
+// SpecShareLinkListResponse defines model for SpecShareLinkListResponse.
+type SpecShareLinkListResponse struct {
+	Data []SpecShareLink `json:"data"`
+}
+
+// SpecTextChange A changed text value. Absent when unchanged.
+type SpecTextChange struct {
+	// From Text in the base version
+	From *string `json:"from,omitempty"`
+
+	// To Text in the target version
+	To *string `json:"to,omitempty"`
+}
+
+// StartAnalysisBatchRequest Provide exactly one of org or repositories.
+type StartAnalysisBatchRequest struct {
+	// ExcludeArchived Skip archived repositories (org batches only)
+	ExcludeArchived *bool `json:"excludeArchived,omitempty"`
+
+	// ExcludeForks Skip forked repositories (org batches only)
+	ExcludeForks *bool `json:"excludeForks,omitempty"`
+
+	// Org GitHub organization login whose repositories are analyzed
+	Org          *string                       `json:"org,omitempty"`
+	Repositories *[]AnalysisBatchRepositoryRef `json:"repositories,omitempty"`
+}
+
 // Summary defines model for Summary.
 type Summary struct {
 	// Active Number of active tests
@@ -1153,6 +1943,9 @@ type TestSuite struct {
 	Tests     []TestCase `json:"tests"`
 }
 
+// TokenScope Permission granted to a personal access token
+type TokenScope string
+
 // UpdateStatus Repository update status:
 // - up-to-date: Latest analysis is current with HEAD
 // - new-commits: New commits available since last analysis
@@ -1177,6 +1970,15 @@ type UpdateStatusResponse struct {
 	Status UpdateStatus `json:"status"`
 }
 
+// UpdateWorkspaceMemberRoleRequest defines model for UpdateWorkspaceMemberRoleRequest.
+type UpdateWorkspaceMemberRoleRequest struct {
+	// Role Member role in a workspace:
+	// - owner: manages members; the workspace's generations are charged to this user
+	// - editor: can generate spec documents for the workspace
+	// - viewer: can read the workspace's spec documents
+	Role WorkspaceRole `json:"role"`
+}
+
 // UsageEventType Type of usage event:
 // - specview: SpecView generation
 // - analysis: Repository analysis
@@ -1342,15 +2144,94 @@ type WebhookSender struct {
 	Login string `json:"login"`
 }
 
+// Workspace defines model for Workspace.
+type Workspace struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	ID        openapi_types.UUID `json:"id"`
+
+	// MemberCount Number of members including the owner
+	MemberCount int    `json:"memberCount"`
+	Name        string `json:"name"`
+
+	// Role Member role in a workspace:
+	// - owner: manages members; the workspace's generations are charged to this user
+	// - editor: can generate spec documents for the workspace
+	// - viewer: can read the workspace's spec documents
+	Role WorkspaceRole `json:"role"`
+}
+
+// WorkspaceInvitation defines model for WorkspaceInvitation.
+type WorkspaceInvitation struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	ID        openapi_types.UUID `json:"id"`
+
+	// InvitedBy GitHub username of the owner who sent the invitation
+	InvitedBy string `json:"invitedBy"`
+
+	// Role Member role in a workspace:
+	// - owner: manages members; the workspace's generations are charged to this user
+	// - editor: can generate spec documents for the workspace
+	// - viewer: can read the workspace's spec documents
+	Role WorkspaceRole `json:"role"`
+
+	// Username GitHub username of the invited user
+	Username      string             `json:"username"`
+	WorkspaceID   openapi_types.UUID `json:"workspaceId"`
+	WorkspaceName string             `json:"workspaceName"`
+}
+
+// WorkspaceInvitationListResponse defines model for WorkspaceInvitationListResponse.
+type WorkspaceInvitationListResponse struct {
+	Data []WorkspaceInvitation `json:"data"`
+}
+
+// WorkspaceListResponse defines model for WorkspaceListResponse.
+type WorkspaceListResponse struct {
+	Data []Workspace `json:"data"`
+}
+
+// WorkspaceMember defines model for WorkspaceMember.
+type WorkspaceMember struct {
+	AvatarURL *string   `json:"avatarUrl,omitempty"`
+	JoinedAt  time.Time `json:"joinedAt"`
+
+	// Role Member role in a workspace:
+	// - owner: manages members; the workspace's generations are charged to this user
+	// - editor: can generate spec documents for the workspace
+	// - viewer: can read the workspace's spec documents
+	Role   WorkspaceRole      `json:"role"`
+	UserID openapi_types.UUID `json:"userId"`
+
+	// Username GitHub username
+	Username string `json:"username"`
+}
+
+// WorkspaceMemberListResponse defines model for WorkspaceMemberListResponse.
+type WorkspaceMemberListResponse struct {
+	Data []WorkspaceMember `json:"data"`
+}
+
+// WorkspaceRole Member role in a workspace:
+// - owner: manages members; the workspace's generations are charged to this user
+// - editor: can generate spec documents for the workspace
+// - viewer: can read the workspace's spec documents
+type WorkspaceRole string
+
 // Owner defines model for Owner.
 type Owner = string
 
 // Repo defines model for Repo.
 type Repo = string
 
+// SpecWorkspaceID defines model for SpecWorkspaceId.
+type SpecWorkspaceID = openapi_types.UUID
+
 // BadRequest defines model for BadRequest.
 type BadRequest = ProblemDetail
 
+// Conflict defines model for Conflict.
+type Conflict = ProblemDetail
+
 // Forbidden defines model for Forbidden.
 type Forbidden = ProblemDetail
 
@@ -1360,12 +2241,21 @@ type InternalError = ProblemDetail
 // NotFound defines model for NotFound.
 type NotFound = ProblemDetail
 
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ProblemDetail
+
 // TooManyRequests defines model for TooManyRequests.
 type TooManyRequests = ProblemDetail
 
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = ProblemDetail
 
+// ListBulkReanalysisRunsParams defines parameters for ListBulkReanalysisRuns.
+type ListBulkReanalysisRunsParams struct {
+	// Limit Maximum number of runs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // AnalyzeRepositoryParams defines parameters for AnalyzeRepository.
 type AnalyzeRepositoryParams struct {
 	// Commit Specific commit SHA to retrieve analysis for.
@@ -1394,7 +2284,7 @@ type GetRecentRepositoriesParams struct {
 	// SortBy Field to sort by (default is recent)
 	SortBy *SortByParam `form:"sortBy,omitempty" json:"sortBy,omitempty"`
 
-	// SortOrder Sort direction (default depends on sortBy - desc for recent/tests, asc for name)
+	// SortOrder Sort direction (default depends on sortBy - desc for recent/tests/trending, asc for name)
 	SortOrder *SortOrderParam `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
 
 	// View Filter repositories by analyzer (who analyzed the repository)
@@ -1404,6 +2294,72 @@ type GetRecentRepositoriesParams struct {
 	Ownership *OwnershipFilterParam `form:"ownership,omitempty" json:"ownership,omitempty"`
 }
 
+// RevertSpecBehaviorEditParams defines parameters for RevertSpecBehaviorEdit.
+type RevertSpecBehaviorEditParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// EditSpecBehaviorParams defines parameters for EditSpecBehavior.
+type EditSpecBehaviorParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// AddSpecCommentParams defines parameters for AddSpecComment.
+type AddSpecCommentParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// ReopenSpecCommentThreadParams defines parameters for ReopenSpecCommentThread.
+type ReopenSpecCommentThreadParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// ResolveSpecCommentThreadParams defines parameters for ResolveSpecCommentThread.
+type ResolveSpecCommentThreadParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// DeleteSpecCommentParams defines parameters for DeleteSpecComment.
+type DeleteSpecCommentParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// UpdateSpecCommentParams defines parameters for UpdateSpecComment.
+type UpdateSpecCommentParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// GetSpecCommentsParams defines parameters for GetSpecComments.
+type GetSpecCommentsParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// CreateSpecCommentThreadParams defines parameters for CreateSpecCommentThread.
+type CreateSpecCommentThreadParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// RevertSpecFeatureEditParams defines parameters for RevertSpecFeatureEdit.
+type RevertSpecFeatureEditParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// EditSpecFeatureParams defines parameters for EditSpecFeature.
+type EditSpecFeatureParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
 // GetSpecDocumentByRepositoryParams defines parameters for GetSpecDocumentByRepository.
 type GetSpecDocumentByRepositoryParams struct {
 	// Language Filter by language. If not specified, returns the most recent document.
@@ -1416,14 +2372,86 @@ type GetSpecDocumentByRepositoryParams struct {
 	DocumentID *openapi_types.UUID `form:"documentId,omitempty" json:"documentId,omitempty"`
 }
 
+// GetSpecDiffByRepositoryParams defines parameters for GetSpecDiffByRepository.
+type GetSpecDiffByRepositoryParams struct {
+	// From Base spec document ID
+	From openapi_types.UUID `form:"from" json:"from"`
+
+	// To Target spec document ID
+	To openapi_types.UUID `form:"to" json:"to"`
+}
+
+// ExportSpecDocumentByRepositoryParams defines parameters for ExportSpecDocumentByRepository.
+type ExportSpecDocumentByRepositoryParams struct {
+	// Language Filter by language. If not specified, exports the most recent document.
+	Language *SpecLanguage `form:"language,omitempty" json:"language,omitempty"`
+
+	// Version Specific version number to export. If not specified, exports the latest version.
+	Version *int `form:"version,omitempty" json:"version,omitempty"`
+
+	// DocumentID Specific document ID (UUID) to export. Takes precedence over version parameter.
+	DocumentID *openapi_types.UUID `form:"documentId,omitempty" json:"documentId,omitempty"`
+
+	// Format Output format of the export.
+	Format *SpecExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// SourceLinks Link each behavior to its test source on GitHub at the analyzed commit.
+	SourceLinks *bool `form:"sourceLinks,omitempty" json:"sourceLinks,omitempty"`
+}
+
 // GetVersionHistoryByRepositoryParams defines parameters for GetVersionHistoryByRepository.
 type GetVersionHistoryByRepositoryParams struct {
 	// Language Language to get version history for
 	Language SpecLanguage `form:"language" json:"language"`
 }
 
+// SearchSpecsParams defines parameters for SearchSpecs.
+type SearchSpecsParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
+	// Q Search query. Supports quoted phrases, OR, and -exclusion.
+	Q string `form:"q" json:"q"`
+
+	// Owner Only search repositories of this owner
+	Owner *string `form:"owner,omitempty" json:"owner,omitempty"`
+
+	// Repo Only search repositories with this name
+	Repo *string `form:"repo,omitempty" json:"repo,omitempty"`
+
+	// Language Only search documents in this language
+	Language *SpecLanguage `form:"language,omitempty" json:"language,omitempty"`
+
+	// Cursor Pagination cursor for next page (opaque string from previous response)
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Maximum number of results to return per page
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSpecShareLinksParams defines parameters for ListSpecShareLinks.
+type ListSpecShareLinksParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// CreateSpecShareLinkParams defines parameters for CreateSpecShareLink.
+type CreateSpecShareLinkParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
+// RevokeSpecShareLinkParams defines parameters for RevokeSpecShareLink.
+type RevokeSpecShareLinkParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+}
+
 // GetSpecGenerationStatusParams defines parameters for GetSpecGenerationStatus.
 type GetSpecGenerationStatusParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
 	// Language Language to check status for (e.g., English, Korean)
 	Language *string `form:"language,omitempty" json:"language,omitempty"`
 }
@@ -1435,6 +2463,9 @@ type GetSpecDocumentParams struct {
 
 	// Version Specific version number to retrieve. Requires language parameter. If not specified, returns the latest version.
 	Version *int `form:"version,omitempty" json:"version,omitempty"`
+
+	// WorkspaceID Return the workspace's shared document instead of the user's own. Requires workspace membership.
+	WorkspaceID *openapi_types.UUID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
 }
 
 // GetSpecCachePredictionParams defines parameters for GetSpecCachePrediction.
@@ -1443,8 +2474,62 @@ type GetSpecCachePredictionParams struct {
 	Language SpecLanguage `form:"language" json:"language"`
 }
 
+// CancelSpecGenerationParams defines parameters for CancelSpecGeneration.
+type CancelSpecGenerationParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
+	// Language Language of the generation to cancel. Defaults to English.
+	Language *string `form:"language,omitempty" json:"language,omitempty"`
+}
+
+// GetSpecDiffParams defines parameters for GetSpecDiff.
+type GetSpecDiffParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
+	// Language Language of the compared versions
+	Language SpecLanguage `form:"language" json:"language"`
+
+	// From Base version number
+	From int `form:"from" json:"from"`
+
+	// To Target version number
+	To int `form:"to" json:"to"`
+}
+
+// GetSpecEditsParams defines parameters for GetSpecEdits.
+type GetSpecEditsParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
+	// Language Language the edits apply to
+	Language SpecLanguage `form:"language" json:"language"`
+}
+
+// ExportSpecDocumentParams defines parameters for ExportSpecDocument.
+type ExportSpecDocumentParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
+	// Language Filter by language. If not specified, exports the most recent document.
+	Language *SpecLanguage `form:"language,omitempty" json:"language,omitempty"`
+
+	// Version Specific version number to export. Requires language parameter. If not specified, exports the latest version.
+	Version *int `form:"version,omitempty" json:"version,omitempty"`
+
+	// Format Output format of the export.
+	Format *SpecExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// SourceLinks Link each behavior to its test source on GitHub at the analyzed commit.
+	SourceLinks *bool `form:"sourceLinks,omitempty" json:"sourceLinks,omitempty"`
+}
+
 // GetSpecVersionsParams defines parameters for GetSpecVersions.
 type GetSpecVersionsParams struct {
+	// WorkspaceID Act on the workspace's shared document instead of the user's own. Requires workspace membership; changes require the editor or owner role.
+	WorkspaceID *SpecWorkspaceID `form:"workspaceId,omitempty" json:"workspaceId,omitempty"`
+
 	// Language Language to get version history for
 	Language SpecLanguage `form:"language" json:"language"`
 }
@@ -1497,21 +2582,57 @@ type HandleGitHubAppWebhookParams struct {
 	XGitHubDelivery openapi_types.UUID `json:"X-GitHub-Delivery"`
 }
 
+// StartAnalysisBatchJSONRequestBody defines body for StartAnalysisBatch for application/json ContentType.
+type StartAnalysisBatchJSONRequestBody = StartAnalysisBatchRequest
+
+// IngestAnalysisJSONRequestBody defines body for IngestAnalysis for application/json ContentType.
+type IngestAnalysisJSONRequestBody = IngestAnalysisRequest
+
 // AuthDevLoginJSONRequestBody defines body for AuthDevLogin for application/json ContentType.
 type AuthDevLoginJSONRequestBody = DevLoginRequest
 
+// EditSpecBehaviorJSONRequestBody defines body for EditSpecBehavior for application/json ContentType.
+type EditSpecBehaviorJSONRequestBody = EditSpecBehaviorRequest
+
+// AddSpecCommentJSONRequestBody defines body for AddSpecComment for application/json ContentType.
+type AddSpecCommentJSONRequestBody = SpecCommentRequest
+
+// UpdateSpecCommentJSONRequestBody defines body for UpdateSpecComment for application/json ContentType.
+type UpdateSpecCommentJSONRequestBody = SpecCommentRequest
+
+// CreateSpecCommentThreadJSONRequestBody defines body for CreateSpecCommentThread for application/json ContentType.
+type CreateSpecCommentThreadJSONRequestBody = CreateSpecCommentThreadRequest
+
+// EditSpecFeatureJSONRequestBody defines body for EditSpecFeature for application/json ContentType.
+type EditSpecFeatureJSONRequestBody = EditSpecFeatureRequest
+
 // RequestSpecGenerationJSONRequestBody defines body for RequestSpecGeneration for application/json ContentType.
 type RequestSpecGenerationJSONRequestBody = RequestSpecGenerationRequest
 
+// CreateSpecShareLinkJSONRequestBody defines body for CreateSpecShareLink for application/json ContentType.
+type CreateSpecShareLinkJSONRequestBody = CreateSpecShareLinkRequest
+
 // CheckQuotaJSONRequestBody defines body for CheckQuota for application/json ContentType.
 type CheckQuotaJSONRequestBody = CheckQuotaRequest
 
+// CreatePersonalAccessTokenJSONRequestBody defines body for CreatePersonalAccessToken for application/json ContentType.
+type CreatePersonalAccessTokenJSONRequestBody = CreatePersonalAccessTokenRequest
+
 // AddUserAnalyzedRepositoryJSONRequestBody defines body for AddUserAnalyzedRepository for application/json ContentType.
 type AddUserAnalyzedRepositoryJSONRequestBody = AddAnalyzedRepositoryRequest
 
 // HandleGitHubAppWebhookJSONRequestBody defines body for HandleGitHubAppWebhook for application/json ContentType.
 type HandleGitHubAppWebhookJSONRequestBody = GitHubWebhookPayload
 
+// CreateWorkspaceJSONRequestBody defines body for CreateWorkspace for application/json ContentType.
+type CreateWorkspaceJSONRequestBody = CreateWorkspaceRequest
+
+// InviteWorkspaceMemberJSONRequestBody defines body for InviteWorkspaceMember for application/json ContentType.
+type InviteWorkspaceMemberJSONRequestBody = InviteWorkspaceMemberRequest
+
+// UpdateWorkspaceMemberRoleJSONRequestBody defines body for UpdateWorkspaceMemberRole for application/json ContentType.
+type UpdateWorkspaceMemberRoleJSONRequestBody = UpdateWorkspaceMemberRoleRequest
+
 // AsCompletedResponse returns the union data inside the AnalysisResponse as a CompletedResponse
 func (t AnalysisResponse) AsCompletedResponse() (CompletedResponse, error) {
 	var body CompletedResponse
@@ -1841,12 +2962,30 @@ func (t *SpecDocumentResponse) UnmarshalJSON(b []byte) error {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List bulk re-analysis runs
+	// (GET /api/admin/bulk-reanalysis)
+	ListBulkReanalysisRuns(w http.ResponseWriter, r *http.Request, params ListBulkReanalysisRunsParams)
+	// Start bulk re-analysis for outdated parser versions
+	// (POST /api/admin/bulk-reanalysis)
+	StartBulkReanalysis(w http.ResponseWriter, r *http.Request)
+	// Get bulk re-analysis progress
+	// (GET /api/admin/bulk-reanalysis/{runId})
+	GetBulkReanalysisRun(w http.ResponseWriter, r *http.Request, runID openapi_types.UUID)
+	// Analyze many repositories at once
+	// (POST /api/analysis-batches)
+	StartAnalysisBatch(w http.ResponseWriter, r *http.Request)
+	// Get analysis batch progress
+	// (GET /api/analysis-batches/{batchId})
+	GetAnalysisBatch(w http.ResponseWriter, r *http.Request, batchID openapi_types.UUID)
 	// Analyze repository test specifications
 	// (GET /api/analyze/{owner}/{repo})
 	AnalyzeRepository(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo, params AnalyzeRepositoryParams)
 	// Get analysis history for a repository
 	// (GET /api/analyze/{owner}/{repo}/history)
 	GetAnalysisHistory(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo)
+	// Upload a parsed test inventory from CI
+	// (POST /api/analyze/{owner}/{repo}/ingest)
+	IngestAnalysis(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo)
 	// Get analysis status
 	// (GET /api/analyze/{owner}/{repo}/status)
 	GetAnalysisStatus(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo)
@@ -1868,6 +3007,9 @@ type ServerInterface interface {
 	// Refresh authentication tokens
 	// (POST /api/auth/refresh)
 	AuthRefresh(w http.ResponseWriter, r *http.Request)
+	// Get organization test dashboard
+	// (GET /api/organizations/{org}/dashboard)
+	GetOrgDashboard(w http.ResponseWriter, r *http.Request, org string)
 	// Get subscription plan pricing
 	// (GET /api/pricing)
 	GetPricing(w http.ResponseWriter, r *http.Request)
@@ -1889,15 +3031,69 @@ type ServerInterface interface {
 	// Check repository update status
 	// (GET /api/repositories/{owner}/{repo}/update-status)
 	GetUpdateStatus(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo)
+	// Revert a spec behavior edit
+	// (DELETE /api/spec-view/behaviors/{behaviorId}/edit)
+	RevertSpecBehaviorEdit(w http.ResponseWriter, r *http.Request, behaviorID openapi_types.UUID, params RevertSpecBehaviorEditParams)
+	// Edit a spec behavior
+	// (PUT /api/spec-view/behaviors/{behaviorId}/edit)
+	EditSpecBehavior(w http.ResponseWriter, r *http.Request, behaviorID openapi_types.UUID, params EditSpecBehaviorParams)
+	// Reply to a comment thread
+	// (POST /api/spec-view/comment-threads/{threadId}/comments)
+	AddSpecComment(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params AddSpecCommentParams)
+	// Reopen a resolved comment thread
+	// (DELETE /api/spec-view/comment-threads/{threadId}/resolution)
+	ReopenSpecCommentThread(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params ReopenSpecCommentThreadParams)
+	// Resolve a comment thread
+	// (PUT /api/spec-view/comment-threads/{threadId}/resolution)
+	ResolveSpecCommentThread(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params ResolveSpecCommentThreadParams)
+	// Delete a comment
+	// (DELETE /api/spec-view/comments/{commentId})
+	DeleteSpecComment(w http.ResponseWriter, r *http.Request, commentID openapi_types.UUID, params DeleteSpecCommentParams)
+	// Edit a comment
+	// (PUT /api/spec-view/comments/{commentId})
+	UpdateSpecComment(w http.ResponseWriter, r *http.Request, commentID openapi_types.UUID, params UpdateSpecCommentParams)
+	// List comment threads of a spec document
+	// (GET /api/spec-view/documents/{documentId}/comments)
+	GetSpecComments(w http.ResponseWriter, r *http.Request, documentID openapi_types.UUID, params GetSpecCommentsParams)
+	// Open a comment thread
+	// (POST /api/spec-view/documents/{documentId}/comments)
+	CreateSpecCommentThread(w http.ResponseWriter, r *http.Request, documentID openapi_types.UUID, params CreateSpecCommentThreadParams)
+	// Revert a spec feature rename
+	// (DELETE /api/spec-view/features/{featureId}/edit)
+	RevertSpecFeatureEdit(w http.ResponseWriter, r *http.Request, featureID openapi_types.UUID, params RevertSpecFeatureEditParams)
+	// Rename or merge a spec feature
+	// (PUT /api/spec-view/features/{featureId}/edit)
+	EditSpecFeature(w http.ResponseWriter, r *http.Request, featureID openapi_types.UUID, params EditSpecFeatureParams)
 	// Request spec document generation
 	// (POST /api/spec-view/generate)
 	RequestSpecGeneration(w http.ResponseWriter, r *http.Request)
 	// Get latest spec document for a repository
 	// (GET /api/spec-view/repository/{owner}/{repo})
 	GetSpecDocumentByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetSpecDocumentByRepositoryParams)
+	// Compare two spec documents of a repository
+	// (GET /api/spec-view/repository/{owner}/{repo}/diff)
+	GetSpecDiffByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetSpecDiffByRepositoryParams)
+	// Export latest spec document for a repository
+	// (GET /api/spec-view/repository/{owner}/{repo}/export)
+	ExportSpecDocumentByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params ExportSpecDocumentByRepositoryParams)
 	// Get version history for a repository across all analyses
 	// (GET /api/spec-view/repository/{owner}/{repo}/versions)
 	GetVersionHistoryByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetVersionHistoryByRepositoryParams)
+	// Search the user's spec documents
+	// (GET /api/spec-view/search)
+	SearchSpecs(w http.ResponseWriter, r *http.Request, params SearchSpecsParams)
+	// List spec share links
+	// (GET /api/spec-view/share-links)
+	ListSpecShareLinks(w http.ResponseWriter, r *http.Request, params ListSpecShareLinksParams)
+	// Create a spec share link
+	// (POST /api/spec-view/share-links)
+	CreateSpecShareLink(w http.ResponseWriter, r *http.Request, params CreateSpecShareLinkParams)
+	// Revoke a spec share link
+	// (DELETE /api/spec-view/share-links/{shareLinkId})
+	RevokeSpecShareLink(w http.ResponseWriter, r *http.Request, shareLinkID openapi_types.UUID, params RevokeSpecShareLinkParams)
+	// Get a shared spec document
+	// (GET /api/spec-view/shared/{token})
+	GetSharedSpec(w http.ResponseWriter, r *http.Request, token string)
 	// Get spec generation status
 	// (GET /api/spec-view/status/{analysisId})
 	GetSpecGenerationStatus(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecGenerationStatusParams)
@@ -1910,6 +3106,18 @@ type ServerInterface interface {
 	// Get cache prediction statistics for a language
 	// (GET /api/spec-view/{analysisId}/cache-prediction)
 	GetSpecCachePrediction(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecCachePredictionParams)
+	// Cancel a pending or running spec generation
+	// (POST /api/spec-view/{analysisId}/cancel)
+	CancelSpecGeneration(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params CancelSpecGenerationParams)
+	// Compare two versions of a spec document
+	// (GET /api/spec-view/{analysisId}/diff)
+	GetSpecDiff(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecDiffParams)
+	// List manual spec edits
+	// (GET /api/spec-view/{analysisId}/edits)
+	GetSpecEdits(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecEditsParams)
+	// Export specification document for analysis
+	// (GET /api/spec-view/{analysisId}/export)
+	ExportSpecDocument(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params ExportSpecDocumentParams)
 	// Get version history for a specific language
 	// (GET /api/spec-view/{analysisId}/versions)
 	GetSpecVersions(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecVersionsParams)
@@ -1919,6 +3127,15 @@ type ServerInterface interface {
 	// Get current usage status
 	// (GET /api/usage/current)
 	GetCurrentUsage(w http.ResponseWriter, r *http.Request)
+	// List personal access tokens
+	// (GET /api/user/access-tokens)
+	ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request)
+	// Create a personal access token
+	// (POST /api/user/access-tokens)
+	CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request)
+	// Revoke a personal access token
+	// (DELETE /api/user/access-tokens/{tokenId})
+	RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request, tokenID openapi_types.UUID)
 	// Get user's active background tasks
 	// (GET /api/user/active-tasks)
 	GetUserActiveTasks(w http.ResponseWriter, r *http.Request)
@@ -1949,15 +3166,72 @@ type ServerInterface interface {
 	// Get user's subscription details
 	// (GET /api/user/subscription)
 	GetUserSubscription(w http.ResponseWriter, r *http.Request)
+	// List pending workspace invitations
+	// (GET /api/user/workspace-invitations)
+	ListWorkspaceInvitations(w http.ResponseWriter, r *http.Request)
+	// Decline a workspace invitation
+	// (DELETE /api/user/workspace-invitations/{invitationId})
+	DeclineWorkspaceInvitation(w http.ResponseWriter, r *http.Request, invitationID openapi_types.UUID)
+	// Accept a workspace invitation
+	// (POST /api/user/workspace-invitations/{invitationId}/accept)
+	AcceptWorkspaceInvitation(w http.ResponseWriter, r *http.Request, invitationID openapi_types.UUID)
 	// Handle GitHub App webhook events
 	// (POST /api/webhooks/github-app)
 	HandleGitHubAppWebhook(w http.ResponseWriter, r *http.Request, params HandleGitHubAppWebhookParams)
+	// List workspaces
+	// (GET /api/workspaces)
+	ListWorkspaces(w http.ResponseWriter, r *http.Request)
+	// Create a workspace
+	// (POST /api/workspaces)
+	CreateWorkspace(w http.ResponseWriter, r *http.Request)
+	// Invite a user to a workspace
+	// (POST /api/workspaces/{workspaceId}/invitations)
+	InviteWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID)
+	// List workspace members
+	// (GET /api/workspaces/{workspaceId}/members)
+	ListWorkspaceMembers(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID)
+	// Remove a workspace member
+	// (DELETE /api/workspaces/{workspaceId}/members/{userId})
+	RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID, userID openapi_types.UUID)
+	// Change a workspace member's role
+	// (PATCH /api/workspaces/{workspaceId}/members/{userId})
+	UpdateWorkspaceMemberRole(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID, userID openapi_types.UUID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List bulk re-analysis runs
+// (GET /api/admin/bulk-reanalysis)
+func (_ Unimplemented) ListBulkReanalysisRuns(w http.ResponseWriter, r *http.Request, params ListBulkReanalysisRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start bulk re-analysis for outdated parser versions
+// (POST /api/admin/bulk-reanalysis)
+func (_ Unimplemented) StartBulkReanalysis(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get bulk re-analysis progress
+// (GET /api/admin/bulk-reanalysis/{runId})
+func (_ Unimplemented) GetBulkReanalysisRun(w http.ResponseWriter, r *http.Request, runID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Analyze many repositories at once
+// (POST /api/analysis-batches)
+func (_ Unimplemented) StartAnalysisBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get analysis batch progress
+// (GET /api/analysis-batches/{batchId})
+func (_ Unimplemented) GetAnalysisBatch(w http.ResponseWriter, r *http.Request, batchID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Analyze repository test specifications
 // (GET /api/analyze/{owner}/{repo})
 func (_ Unimplemented) AnalyzeRepository(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo, params AnalyzeRepositoryParams) {
@@ -1970,6 +3244,12 @@ func (_ Unimplemented) GetAnalysisHistory(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Upload a parsed test inventory from CI
+// (POST /api/analyze/{owner}/{repo}/ingest)
+func (_ Unimplemented) IngestAnalysis(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get analysis status
 // (GET /api/analyze/{owner}/{repo}/status)
 func (_ Unimplemented) GetAnalysisStatus(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
@@ -2012,6 +3292,12 @@ func (_ Unimplemented) AuthRefresh(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get organization test dashboard
+// (GET /api/organizations/{org}/dashboard)
+func (_ Unimplemented) GetOrgDashboard(w http.ResponseWriter, r *http.Request, org string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get subscription plan pricing
 // (GET /api/pricing)
 func (_ Unimplemented) GetPricing(w http.ResponseWriter, r *http.Request) {
@@ -2054,6 +3340,72 @@ func (_ Unimplemented) GetUpdateStatus(w http.ResponseWriter, r *http.Request, o
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Revert a spec behavior edit
+// (DELETE /api/spec-view/behaviors/{behaviorId}/edit)
+func (_ Unimplemented) RevertSpecBehaviorEdit(w http.ResponseWriter, r *http.Request, behaviorID openapi_types.UUID, params RevertSpecBehaviorEditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Edit a spec behavior
+// (PUT /api/spec-view/behaviors/{behaviorId}/edit)
+func (_ Unimplemented) EditSpecBehavior(w http.ResponseWriter, r *http.Request, behaviorID openapi_types.UUID, params EditSpecBehaviorParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reply to a comment thread
+// (POST /api/spec-view/comment-threads/{threadId}/comments)
+func (_ Unimplemented) AddSpecComment(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params AddSpecCommentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reopen a resolved comment thread
+// (DELETE /api/spec-view/comment-threads/{threadId}/resolution)
+func (_ Unimplemented) ReopenSpecCommentThread(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params ReopenSpecCommentThreadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Resolve a comment thread
+// (PUT /api/spec-view/comment-threads/{threadId}/resolution)
+func (_ Unimplemented) ResolveSpecCommentThread(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params ResolveSpecCommentThreadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a comment
+// (DELETE /api/spec-view/comments/{commentId})
+func (_ Unimplemented) DeleteSpecComment(w http.ResponseWriter, r *http.Request, commentID openapi_types.UUID, params DeleteSpecCommentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Edit a comment
+// (PUT /api/spec-view/comments/{commentId})
+func (_ Unimplemented) UpdateSpecComment(w http.ResponseWriter, r *http.Request, commentID openapi_types.UUID, params UpdateSpecCommentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List comment threads of a spec document
+// (GET /api/spec-view/documents/{documentId}/comments)
+func (_ Unimplemented) GetSpecComments(w http.ResponseWriter, r *http.Request, documentID openapi_types.UUID, params GetSpecCommentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Open a comment thread
+// (POST /api/spec-view/documents/{documentId}/comments)
+func (_ Unimplemented) CreateSpecCommentThread(w http.ResponseWriter, r *http.Request, documentID openapi_types.UUID, params CreateSpecCommentThreadParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revert a spec feature rename
+// (DELETE /api/spec-view/features/{featureId}/edit)
+func (_ Unimplemented) RevertSpecFeatureEdit(w http.ResponseWriter, r *http.Request, featureID openapi_types.UUID, params RevertSpecFeatureEditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Rename or merge a spec feature
+// (PUT /api/spec-view/features/{featureId}/edit)
+func (_ Unimplemented) EditSpecFeature(w http.ResponseWriter, r *http.Request, featureID openapi_types.UUID, params EditSpecFeatureParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Request spec document generation
 // (POST /api/spec-view/generate)
 func (_ Unimplemented) RequestSpecGeneration(w http.ResponseWriter, r *http.Request) {
@@ -2066,12 +3418,54 @@ func (_ Unimplemented) GetSpecDocumentByRepository(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Compare two spec documents of a repository
+// (GET /api/spec-view/repository/{owner}/{repo}/diff)
+func (_ Unimplemented) GetSpecDiffByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetSpecDiffByRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export latest spec document for a repository
+// (GET /api/spec-view/repository/{owner}/{repo}/export)
+func (_ Unimplemented) ExportSpecDocumentByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params ExportSpecDocumentByRepositoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get version history for a repository across all analyses
 // (GET /api/spec-view/repository/{owner}/{repo}/versions)
 func (_ Unimplemented) GetVersionHistoryByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetVersionHistoryByRepositoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Search the user's spec documents
+// (GET /api/spec-view/search)
+func (_ Unimplemented) SearchSpecs(w http.ResponseWriter, r *http.Request, params SearchSpecsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List spec share links
+// (GET /api/spec-view/share-links)
+func (_ Unimplemented) ListSpecShareLinks(w http.ResponseWriter, r *http.Request, params ListSpecShareLinksParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a spec share link
+// (POST /api/spec-view/share-links)
+func (_ Unimplemented) CreateSpecShareLink(w http.ResponseWriter, r *http.Request, params CreateSpecShareLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a spec share link
+// (DELETE /api/spec-view/share-links/{shareLinkId})
+func (_ Unimplemented) RevokeSpecShareLink(w http.ResponseWriter, r *http.Request, shareLinkID openapi_types.UUID, params RevokeSpecShareLinkParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a shared spec document
+// (GET /api/spec-view/shared/{token})
+func (_ Unimplemented) GetSharedSpec(w http.ResponseWriter, r *http.Request, token string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get spec generation status
 // (GET /api/spec-view/status/{analysisId})
 func (_ Unimplemented) GetSpecGenerationStatus(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecGenerationStatusParams) {
@@ -2096,6 +3490,30 @@ func (_ Unimplemented) GetSpecCachePrediction(w http.ResponseWriter, r *http.Req
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Cancel a pending or running spec generation
+// (POST /api/spec-view/{analysisId}/cancel)
+func (_ Unimplemented) CancelSpecGeneration(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params CancelSpecGenerationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Compare two versions of a spec document
+// (GET /api/spec-view/{analysisId}/diff)
+func (_ Unimplemented) GetSpecDiff(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecDiffParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List manual spec edits
+// (GET /api/spec-view/{analysisId}/edits)
+func (_ Unimplemented) GetSpecEdits(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecEditsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export specification document for analysis
+// (GET /api/spec-view/{analysisId}/export)
+func (_ Unimplemented) ExportSpecDocument(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params ExportSpecDocumentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get version history for a specific language
 // (GET /api/spec-view/{analysisId}/versions)
 func (_ Unimplemented) GetSpecVersions(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecVersionsParams) {
@@ -2114,6 +3532,24 @@ func (_ Unimplemented) GetCurrentUsage(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List personal access tokens
+// (GET /api/user/access-tokens)
+func (_ Unimplemented) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a personal access token
+// (POST /api/user/access-tokens)
+func (_ Unimplemented) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a personal access token
+// (DELETE /api/user/access-tokens/{tokenId})
+func (_ Unimplemented) RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request, tokenID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get user's active background tasks
 // (GET /api/user/active-tasks)
 func (_ Unimplemented) GetUserActiveTasks(w http.ResponseWriter, r *http.Request) {
@@ -2174,12 +3610,66 @@ func (_ Unimplemented) GetUserSubscription(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List pending workspace invitations
+// (GET /api/user/workspace-invitations)
+func (_ Unimplemented) ListWorkspaceInvitations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Decline a workspace invitation
+// (DELETE /api/user/workspace-invitations/{invitationId})
+func (_ Unimplemented) DeclineWorkspaceInvitation(w http.ResponseWriter, r *http.Request, invitationID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Accept a workspace invitation
+// (POST /api/user/workspace-invitations/{invitationId}/accept)
+func (_ Unimplemented) AcceptWorkspaceInvitation(w http.ResponseWriter, r *http.Request, invitationID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Handle GitHub App webhook events
 // (POST /api/webhooks/github-app)
 func (_ Unimplemented) HandleGitHubAppWebhook(w http.ResponseWriter, r *http.Request, params HandleGitHubAppWebhookParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List workspaces
+// (GET /api/workspaces)
+func (_ Unimplemented) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a workspace
+// (POST /api/workspaces)
+func (_ Unimplemented) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Invite a user to a workspace
+// (POST /api/workspaces/{workspaceId}/invitations)
+func (_ Unimplemented) InviteWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List workspace members
+// (GET /api/workspaces/{workspaceId}/members)
+func (_ Unimplemented) ListWorkspaceMembers(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a workspace member
+// (DELETE /api/workspaces/{workspaceId}/members/{userId})
+func (_ Unimplemented) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID, userID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Change a workspace member's role
+// (PATCH /api/workspaces/{workspaceId}/members/{userId})
+func (_ Unimplemented) UpdateWorkspaceMemberRole(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID, userID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -2189,6 +3679,141 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListBulkReanalysisRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListBulkReanalysisRuns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBulkReanalysisRunsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBulkReanalysisRuns(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartBulkReanalysis operation middleware
+func (siw *ServerInterfaceWrapper) StartBulkReanalysis(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartBulkReanalysis(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBulkReanalysisRun operation middleware
+func (siw *ServerInterfaceWrapper) GetBulkReanalysisRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "runId" -------------
+	var runID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", chi.URLParam(r, "runId"), &runID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBulkReanalysisRun(w, r, runID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartAnalysisBatch operation middleware
+func (siw *ServerInterfaceWrapper) StartAnalysisBatch(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartAnalysisBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAnalysisBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalysisBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "batchId" -------------
+	var batchID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "batchId", chi.URLParam(r, "batchId"), &batchID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "batchId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAnalysisBatch(w, r, batchID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // AnalyzeRepository operation middleware
 func (siw *ServerInterfaceWrapper) AnalyzeRepository(w http.ResponseWriter, r *http.Request) {
 
@@ -2268,6 +3893,48 @@ func (siw *ServerInterfaceWrapper) GetAnalysisHistory(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
+// IngestAnalysis operation middleware
+func (siw *ServerInterfaceWrapper) IngestAnalysis(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner Owner
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo Repo
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", chi.URLParam(r, "repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestAnalysis(w, r, owner, repo)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetAnalysisStatus operation middleware
 func (siw *ServerInterfaceWrapper) GetAnalysisStatus(w http.ResponseWriter, r *http.Request) {
 
@@ -2433,6 +4100,37 @@ func (siw *ServerInterfaceWrapper) AuthRefresh(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// GetOrgDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgDashboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", chi.URLParam(r, "org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "org", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrgDashboard(w, r, org)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetPricing operation middleware
 func (siw *ServerInterfaceWrapper) GetPricing(w http.ResponseWriter, r *http.Request) {
 
@@ -2700,8 +4398,19 @@ func (siw *ServerInterfaceWrapper) GetUpdateStatus(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// RequestSpecGeneration operation middleware
-func (siw *ServerInterfaceWrapper) RequestSpecGeneration(w http.ResponseWriter, r *http.Request) {
+// RevertSpecBehaviorEdit operation middleware
+func (siw *ServerInterfaceWrapper) RevertSpecBehaviorEdit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "behaviorId" -------------
+	var behaviorID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "behaviorId", chi.URLParam(r, "behaviorId"), &behaviorID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "behaviorId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2709,8 +4418,19 @@ func (siw *ServerInterfaceWrapper) RequestSpecGeneration(w http.ResponseWriter,
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevertSpecBehaviorEditParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RequestSpecGeneration(w, r)
+		siw.Handler.RevertSpecBehaviorEdit(w, r, behaviorID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2720,26 +4440,17 @@ func (siw *ServerInterfaceWrapper) RequestSpecGeneration(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpecDocumentByRepository operation middleware
-func (siw *ServerInterfaceWrapper) GetSpecDocumentByRepository(w http.ResponseWriter, r *http.Request) {
+// EditSpecBehavior operation middleware
+func (siw *ServerInterfaceWrapper) EditSpecBehavior(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "owner" -------------
-	var owner string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "repo" -------------
-	var repo string
+	// ------------- Path parameter "behaviorId" -------------
+	var behaviorID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "repo", chi.URLParam(r, "repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "behaviorId", chi.URLParam(r, "behaviorId"), &behaviorID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "behaviorId", Err: err})
 		return
 	}
 
@@ -2750,34 +4461,60 @@ func (siw *ServerInterfaceWrapper) GetSpecDocumentByRepository(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSpecDocumentByRepositoryParams
+	var params EditSpecBehaviorParams
 
-	// ------------- Optional query parameter "language" -------------
+	// ------------- Optional query parameter "workspaceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "version" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditSpecBehavior(w, r, behaviorID, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "documentId" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "documentId", r.URL.Query(), &params.DocumentID)
+// AddSpecComment operation middleware
+func (siw *ServerInterfaceWrapper) AddSpecComment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "threadId" -------------
+	var threadID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "threadId", chi.URLParam(r, "threadId"), &threadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "threadId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddSpecCommentParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpecDocumentByRepository(w, r, owner, repo, params)
+		siw.Handler.AddSpecComment(w, r, threadID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2787,26 +4524,17 @@ func (siw *ServerInterfaceWrapper) GetSpecDocumentByRepository(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetVersionHistoryByRepository operation middleware
-func (siw *ServerInterfaceWrapper) GetVersionHistoryByRepository(w http.ResponseWriter, r *http.Request) {
+// ReopenSpecCommentThread operation middleware
+func (siw *ServerInterfaceWrapper) ReopenSpecCommentThread(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "owner" -------------
-	var owner string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "repo" -------------
-	var repo string
+	// ------------- Path parameter "threadId" -------------
+	var threadID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "repo", chi.URLParam(r, "repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "threadId", chi.URLParam(r, "threadId"), &threadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "threadId", Err: err})
 		return
 	}
 
@@ -2817,25 +4545,18 @@ func (siw *ServerInterfaceWrapper) GetVersionHistoryByRepository(w http.Response
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetVersionHistoryByRepositoryParams
-
-	// ------------- Required query parameter "language" -------------
-
-	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
+	var params ReopenSpecCommentThreadParams
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
-		return
-	}
+	// ------------- Optional query parameter "workspaceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVersionHistoryByRepository(w, r, owner, repo, params)
+		siw.Handler.ReopenSpecCommentThread(w, r, threadID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2845,17 +4566,17 @@ func (siw *ServerInterfaceWrapper) GetVersionHistoryByRepository(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpecGenerationStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetSpecGenerationStatus(w http.ResponseWriter, r *http.Request) {
+// ResolveSpecCommentThread operation middleware
+func (siw *ServerInterfaceWrapper) ResolveSpecCommentThread(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "analysisId" -------------
-	var analysisID openapi_types.UUID
+	// ------------- Path parameter "threadId" -------------
+	var threadID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "threadId", chi.URLParam(r, "threadId"), &threadID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "threadId", Err: err})
 		return
 	}
 
@@ -2866,18 +4587,18 @@ func (siw *ServerInterfaceWrapper) GetSpecGenerationStatus(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSpecGenerationStatusParams
+	var params ResolveSpecCommentThreadParams
 
-	// ------------- Optional query parameter "language" -------------
+	// ------------- Optional query parameter "workspaceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpecGenerationStatus(w, r, analysisID, params)
+		siw.Handler.ResolveSpecCommentThread(w, r, threadID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2887,17 +4608,17 @@ func (siw *ServerInterfaceWrapper) GetSpecGenerationStatus(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpecDocument operation middleware
-func (siw *ServerInterfaceWrapper) GetSpecDocument(w http.ResponseWriter, r *http.Request) {
+// DeleteSpecComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSpecComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "analysisId" -------------
-	var analysisID openapi_types.UUID
+	// ------------- Path parameter "commentId" -------------
+	var commentID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
 		return
 	}
 
@@ -2908,26 +4629,18 @@ func (siw *ServerInterfaceWrapper) GetSpecDocument(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSpecDocumentParams
-
-	// ------------- Optional query parameter "language" -------------
+	var params DeleteSpecCommentParams
 
-	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "version" -------------
+	// ------------- Optional query parameter "workspaceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpecDocument(w, r, analysisID, params)
+		siw.Handler.DeleteSpecComment(w, r, commentID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2937,17 +4650,17 @@ func (siw *ServerInterfaceWrapper) GetSpecDocument(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpecCacheAvailability operation middleware
-func (siw *ServerInterfaceWrapper) GetSpecCacheAvailability(w http.ResponseWriter, r *http.Request) {
+// UpdateSpecComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSpecComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "analysisId" -------------
-	var analysisID openapi_types.UUID
+	// ------------- Path parameter "commentId" -------------
+	var commentID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
 		return
 	}
 
@@ -2957,8 +4670,19 @@ func (siw *ServerInterfaceWrapper) GetSpecCacheAvailability(w http.ResponseWrite
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSpecCommentParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpecCacheAvailability(w, r, analysisID)
+		siw.Handler.UpdateSpecComment(w, r, commentID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2968,17 +4692,17 @@ func (siw *ServerInterfaceWrapper) GetSpecCacheAvailability(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpecCachePrediction operation middleware
-func (siw *ServerInterfaceWrapper) GetSpecCachePrediction(w http.ResponseWriter, r *http.Request) {
+// GetSpecComments operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecComments(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "analysisId" -------------
-	var analysisID openapi_types.UUID
+	// ------------- Path parameter "documentId" -------------
+	var documentID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", chi.URLParam(r, "documentId"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
@@ -2989,25 +4713,18 @@ func (siw *ServerInterfaceWrapper) GetSpecCachePrediction(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSpecCachePredictionParams
+	var params GetSpecCommentsParams
 
-	// ------------- Required query parameter "language" -------------
-
-	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
-		return
-	}
+	// ------------- Optional query parameter "workspaceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpecCachePrediction(w, r, analysisID, params)
+		siw.Handler.GetSpecComments(w, r, documentID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3017,17 +4734,17 @@ func (siw *ServerInterfaceWrapper) GetSpecCachePrediction(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetSpecVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetSpecVersions(w http.ResponseWriter, r *http.Request) {
+// CreateSpecCommentThread operation middleware
+func (siw *ServerInterfaceWrapper) CreateSpecCommentThread(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "analysisId" -------------
-	var analysisID openapi_types.UUID
+	// ------------- Path parameter "documentId" -------------
+	var documentID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "documentId", chi.URLParam(r, "documentId"), &documentID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
@@ -3038,25 +4755,18 @@ func (siw *ServerInterfaceWrapper) GetSpecVersions(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSpecVersionsParams
-
-	// ------------- Required query parameter "language" -------------
-
-	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
+	var params CreateSpecCommentThreadParams
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
-		return
-	}
+	// ------------- Optional query parameter "workspaceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSpecVersions(w, r, analysisID, params)
+		siw.Handler.CreateSpecCommentThread(w, r, documentID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3066,8 +4776,19 @@ func (siw *ServerInterfaceWrapper) GetSpecVersions(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// CheckQuota operation middleware
-func (siw *ServerInterfaceWrapper) CheckQuota(w http.ResponseWriter, r *http.Request) {
+// RevertSpecFeatureEdit operation middleware
+func (siw *ServerInterfaceWrapper) RevertSpecFeatureEdit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "featureId" -------------
+	var featureID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "featureId", chi.URLParam(r, "featureId"), &featureID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "featureId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3075,8 +4796,19 @@ func (siw *ServerInterfaceWrapper) CheckQuota(w http.ResponseWriter, r *http.Req
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevertSpecFeatureEditParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CheckQuota(w, r)
+		siw.Handler.RevertSpecFeatureEdit(w, r, featureID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3086,8 +4818,19 @@ func (siw *ServerInterfaceWrapper) CheckQuota(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetCurrentUsage operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUsage(w http.ResponseWriter, r *http.Request) {
+// EditSpecFeature operation middleware
+func (siw *ServerInterfaceWrapper) EditSpecFeature(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "featureId" -------------
+	var featureID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "featureId", chi.URLParam(r, "featureId"), &featureID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "featureId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3095,8 +4838,19 @@ func (siw *ServerInterfaceWrapper) GetCurrentUsage(w http.ResponseWriter, r *htt
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditSpecFeatureParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCurrentUsage(w, r)
+		siw.Handler.EditSpecFeature(w, r, featureID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3106,8 +4860,8 @@ func (siw *ServerInterfaceWrapper) GetCurrentUsage(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserActiveTasks operation middleware
-func (siw *ServerInterfaceWrapper) GetUserActiveTasks(w http.ResponseWriter, r *http.Request) {
+// RequestSpecGeneration operation middleware
+func (siw *ServerInterfaceWrapper) RequestSpecGeneration(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3116,7 +4870,7 @@ func (siw *ServerInterfaceWrapper) GetUserActiveTasks(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserActiveTasks(w, r)
+		siw.Handler.RequestSpecGeneration(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3126,11 +4880,29 @@ func (siw *ServerInterfaceWrapper) GetUserActiveTasks(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserAnalyzedRepositories operation middleware
-func (siw *ServerInterfaceWrapper) GetUserAnalyzedRepositories(w http.ResponseWriter, r *http.Request) {
+// GetSpecDocumentByRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecDocumentByRepository(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", chi.URLParam(r, "repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
@@ -3138,34 +4910,34 @@ func (siw *ServerInterfaceWrapper) GetUserAnalyzedRepositories(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserAnalyzedRepositoriesParams
+	var params GetSpecDocumentByRepositoryParams
 
-	// ------------- Optional query parameter "cursor" -------------
+	// ------------- Optional query parameter "language" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "version" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "ownership" -------------
+	// ------------- Optional query parameter "documentId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "ownership", r.URL.Query(), &params.Ownership)
+	err = runtime.BindQueryParameter("form", true, false, "documentId", r.URL.Query(), &params.DocumentID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownership", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserAnalyzedRepositories(w, r, params)
+		siw.Handler.GetSpecDocumentByRepository(w, r, owner, repo, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3175,28 +4947,28 @@ func (siw *ServerInterfaceWrapper) GetUserAnalyzedRepositories(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// AddUserAnalyzedRepository operation middleware
-func (siw *ServerInterfaceWrapper) AddUserAnalyzedRepository(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// GetSpecDiffByRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecDiffByRepository(w http.ResponseWriter, r *http.Request) {
 
-	r = r.WithContext(ctx)
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddUserAnalyzedRepository(w, r)
-	}))
+	// ------------- Path parameter "owner" -------------
+	var owner string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "repo" -------------
+	var repo string
 
-// GetUserBookmarks operation middleware
-func (siw *ServerInterfaceWrapper) GetUserBookmarks(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", chi.URLParam(r, "repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3204,28 +4976,41 @@ func (siw *ServerInterfaceWrapper) GetUserBookmarks(w http.ResponseWriter, r *ht
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserBookmarks(w, r)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpecDiffByRepositoryParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// ------------- Required query parameter "from" -------------
 
-	handler.ServeHTTP(w, r)
-}
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
 
-// GetGitHubAppInstallURL operation middleware
-func (siw *ServerInterfaceWrapper) GetGitHubAppInstallURL(w http.ResponseWriter, r *http.Request) {
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
 
-	ctx := r.Context()
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	// ------------- Required query parameter "to" -------------
 
-	r = r.WithContext(ctx)
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGitHubAppInstallURL(w, r)
+		siw.Handler.GetSpecDiffByRepository(w, r, owner, repo, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3235,8 +5020,28 @@ func (siw *ServerInterfaceWrapper) GetGitHubAppInstallURL(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserGitHubAppInstallations operation middleware
-func (siw *ServerInterfaceWrapper) GetUserGitHubAppInstallations(w http.ResponseWriter, r *http.Request) {
+// ExportSpecDocumentByRepository operation middleware
+func (siw *ServerInterfaceWrapper) ExportSpecDocumentByRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", chi.URLParam(r, "repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -3244,8 +5049,51 @@ func (siw *ServerInterfaceWrapper) GetUserGitHubAppInstallations(w http.Response
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportSpecDocumentByRepositoryParams
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "documentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "documentId", r.URL.Query(), &params.DocumentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "documentId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sourceLinks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sourceLinks", r.URL.Query(), &params.SourceLinks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceLinks", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserGitHubAppInstallations(w, r)
+		siw.Handler.ExportSpecDocumentByRepository(w, r, owner, repo, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3255,11 +5103,29 @@ func (siw *ServerInterfaceWrapper) GetUserGitHubAppInstallations(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserGitHubOrganizations operation middleware
-func (siw *ServerInterfaceWrapper) GetUserGitHubOrganizations(w http.ResponseWriter, r *http.Request) {
+// GetVersionHistoryByRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetVersionHistoryByRepository(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "owner" -------------
+	var owner string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "owner", chi.URLParam(r, "owner"), &owner, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "repo" -------------
+	var repo string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "repo", chi.URLParam(r, "repo"), &repo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
@@ -3267,18 +5133,25 @@ func (siw *ServerInterfaceWrapper) GetUserGitHubOrganizations(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserGitHubOrganizationsParams
+	var params GetVersionHistoryByRepositoryParams
 
-	// ------------- Optional query parameter "refresh" -------------
+	// ------------- Required query parameter "language" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "refresh", r.URL.Query(), &params.Refresh)
+	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserGitHubOrganizations(w, r, params)
+		siw.Handler.GetVersionHistoryByRepository(w, r, owner, repo, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3288,39 +5161,85 @@ func (siw *ServerInterfaceWrapper) GetUserGitHubOrganizations(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetOrganizationRepositories operation middleware
-func (siw *ServerInterfaceWrapper) GetOrganizationRepositories(w http.ResponseWriter, r *http.Request) {
+// SearchSpecs operation middleware
+func (siw *ServerInterfaceWrapper) SearchSpecs(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "org" -------------
-	var org string
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "org", chi.URLParam(r, "org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchSpecsParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "org", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Required query parameter "q" -------------
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
 
-	r = r.WithContext(ctx)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetOrganizationRepositoriesParams
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
 
-	// ------------- Optional query parameter "refresh" -------------
+	// ------------- Optional query parameter "owner" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "refresh", r.URL.Query(), &params.Refresh)
+	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repo", r.URL.Query(), &params.Repo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repo", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetOrganizationRepositories(w, r, org, params)
+		siw.Handler.SearchSpecs(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3330,8 +5249,8 @@ func (siw *ServerInterfaceWrapper) GetOrganizationRepositories(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserGitHubRepositories operation middleware
-func (siw *ServerInterfaceWrapper) GetUserGitHubRepositories(w http.ResponseWriter, r *http.Request) {
+// ListSpecShareLinks operation middleware
+func (siw *ServerInterfaceWrapper) ListSpecShareLinks(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3342,18 +5261,18 @@ func (siw *ServerInterfaceWrapper) GetUserGitHubRepositories(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserGitHubRepositoriesParams
+	var params ListSpecShareLinksParams
 
-	// ------------- Optional query parameter "refresh" -------------
+	// ------------- Optional query parameter "workspaceId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "refresh", r.URL.Query(), &params.Refresh)
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserGitHubRepositories(w, r, params)
+		siw.Handler.ListSpecShareLinks(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3363,8 +5282,10 @@ func (siw *ServerInterfaceWrapper) GetUserGitHubRepositories(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserSubscription operation middleware
-func (siw *ServerInterfaceWrapper) GetUserSubscription(w http.ResponseWriter, r *http.Request) {
+// CreateSpecShareLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateSpecShareLink(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -3372,8 +5293,19 @@ func (siw *ServerInterfaceWrapper) GetUserSubscription(w http.ResponseWriter, r
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSpecShareLinkParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserSubscription(w, r)
+		siw.Handler.CreateSpecShareLink(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3383,61 +5315,1111 @@ func (siw *ServerInterfaceWrapper) GetUserSubscription(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// HandleGitHubAppWebhook operation middleware
-func (siw *ServerInterfaceWrapper) HandleGitHubAppWebhook(w http.ResponseWriter, r *http.Request) {
+// RevokeSpecShareLink operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSpecShareLink(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HandleGitHubAppWebhookParams
+	// ------------- Path parameter "shareLinkId" -------------
+	var shareLinkID openapi_types.UUID
 
-	headers := r.Header
+	err = runtime.BindStyledParameterWithOptions("simple", "shareLinkId", chi.URLParam(r, "shareLinkId"), &shareLinkID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "shareLinkId", Err: err})
+		return
+	}
 
-	// ------------- Required header parameter "X-GitHub-Event" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
-		var XGitHubEvent string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-GitHub-Event", Count: n})
-			return
-		}
+	ctx := r.Context()
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Event", Err: err})
-			return
-		}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-		params.XGitHubEvent = XGitHubEvent
+	r = r.WithContext(ctx)
 
-	} else {
-		err := fmt.Errorf("Header parameter X-GitHub-Event is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-GitHub-Event", Err: err})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeSpecShareLinkParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
 		return
 	}
 
-	// ------------- Required header parameter "X-Hub-Signature-256" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
-		var XHubSignature256 string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Hub-Signature-256", Count: n})
-			return
-		}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeSpecShareLink(w, r, shareLinkID, params)
+	}))
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Hub-Signature-256", Err: err})
-			return
-		}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-		params.XHubSignature256 = XHubSignature256
+	handler.ServeHTTP(w, r)
+}
 
-	} else {
-		err := fmt.Errorf("Header parameter X-Hub-Signature-256 is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Hub-Signature-256", Err: err})
-		return
-	}
+// GetSharedSpec operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedSpec(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedSpec(w, r, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpecGenerationStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecGenerationStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpecGenerationStatusParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpecGenerationStatus(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpecDocument operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpecDocumentParams
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpecDocument(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpecCacheAvailability operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecCacheAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpecCacheAvailability(w, r, analysisID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpecCachePrediction operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecCachePrediction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpecCachePredictionParams
+
+	// ------------- Required query parameter "language" -------------
+
+	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpecCachePrediction(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelSpecGeneration operation middleware
+func (siw *ServerInterfaceWrapper) CancelSpecGeneration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelSpecGenerationParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelSpecGeneration(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpecDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecDiff(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpecDiffParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "language" -------------
+
+	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := r.URL.Query().Get("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := r.URL.Query().Get("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpecDiff(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpecEdits operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecEdits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpecEditsParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "language" -------------
+
+	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpecEdits(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportSpecDocument operation middleware
+func (siw *ServerInterfaceWrapper) ExportSpecDocument(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportSpecDocumentParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sourceLinks" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sourceLinks", r.URL.Query(), &params.SourceLinks)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceLinks", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportSpecDocument(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSpecVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetSpecVersions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "analysisId" -------------
+	var analysisID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "analysisId", chi.URLParam(r, "analysisId"), &analysisID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "analysisId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSpecVersionsParams
+
+	// ------------- Optional query parameter "workspaceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "workspaceId", r.URL.Query(), &params.WorkspaceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "language" -------------
+
+	if paramValue := r.URL.Query().Get("language"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "language"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSpecVersions(w, r, analysisID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckQuota operation middleware
+func (siw *ServerInterfaceWrapper) CheckQuota(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckQuota(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUsage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUsage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPersonalAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPersonalAccessTokens(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePersonalAccessToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokePersonalAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", chi.URLParam(r, "tokenId"), &tokenID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokePersonalAccessToken(w, r, tokenID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserActiveTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetUserActiveTasks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserActiveTasks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserAnalyzedRepositories operation middleware
+func (siw *ServerInterfaceWrapper) GetUserAnalyzedRepositories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserAnalyzedRepositoriesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ownership" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ownership", r.URL.Query(), &params.Ownership)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ownership", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserAnalyzedRepositories(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddUserAnalyzedRepository operation middleware
+func (siw *ServerInterfaceWrapper) AddUserAnalyzedRepository(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddUserAnalyzedRepository(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserBookmarks operation middleware
+func (siw *ServerInterfaceWrapper) GetUserBookmarks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserBookmarks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetGitHubAppInstallURL operation middleware
+func (siw *ServerInterfaceWrapper) GetGitHubAppInstallURL(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGitHubAppInstallURL(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserGitHubAppInstallations operation middleware
+func (siw *ServerInterfaceWrapper) GetUserGitHubAppInstallations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserGitHubAppInstallations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserGitHubOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) GetUserGitHubOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserGitHubOrganizationsParams
+
+	// ------------- Optional query parameter "refresh" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refresh", r.URL.Query(), &params.Refresh)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserGitHubOrganizations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationRepositories operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationRepositories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "org" -------------
+	var org string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "org", chi.URLParam(r, "org"), &org, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "org", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationRepositoriesParams
+
+	// ------------- Optional query parameter "refresh" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refresh", r.URL.Query(), &params.Refresh)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationRepositories(w, r, org, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserGitHubRepositories operation middleware
+func (siw *ServerInterfaceWrapper) GetUserGitHubRepositories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserGitHubRepositoriesParams
+
+	// ------------- Optional query parameter "refresh" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refresh", r.URL.Query(), &params.Refresh)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "refresh", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserGitHubRepositories(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSubscription(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserSubscription(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkspaceInvitations operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaceInvitations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaceInvitations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeclineWorkspaceInvitation operation middleware
+func (siw *ServerInterfaceWrapper) DeclineWorkspaceInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", chi.URLParam(r, "invitationId"), &invitationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeclineWorkspaceInvitation(w, r, invitationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptWorkspaceInvitation operation middleware
+func (siw *ServerInterfaceWrapper) AcceptWorkspaceInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", chi.URLParam(r, "invitationId"), &invitationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptWorkspaceInvitation(w, r, invitationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// HandleGitHubAppWebhook operation middleware
+func (siw *ServerInterfaceWrapper) HandleGitHubAppWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HandleGitHubAppWebhookParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-GitHub-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
+		var XGitHubEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-GitHub-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Event", Err: err})
+			return
+		}
+
+		params.XGitHubEvent = XGitHubEvent
+
+	} else {
+		err := fmt.Errorf("Header parameter X-GitHub-Event is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-GitHub-Event", Err: err})
+		return
+	}
+
+	// ------------- Required header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Hub-Signature-256", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Hub-Signature-256", Err: err})
+			return
+		}
+
+		params.XHubSignature256 = XHubSignature256
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Hub-Signature-256 is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Hub-Signature-256", Err: err})
+		return
+	}
 
 	// ------------- Required header parameter "X-GitHub-Delivery" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Delivery")]; found {
@@ -3448,2401 +6430,6338 @@ func (siw *ServerInterfaceWrapper) HandleGitHubAppWebhook(w http.ResponseWriter,
 			return
 		}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Delivery", valueList[0], &XGitHubDelivery, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Delivery", Err: err})
-			return
-		}
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Delivery", valueList[0], &XGitHubDelivery, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Delivery", Err: err})
+			return
+		}
+
+		params.XGitHubDelivery = XGitHubDelivery
+
+	} else {
+		err := fmt.Errorf("Header parameter X-GitHub-Delivery is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-GitHub-Delivery", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleGitHubAppWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkspaces operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaces(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWorkspace(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InviteWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) InviteWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", chi.URLParam(r, "workspaceId"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InviteWorkspaceMember(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWorkspaceMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", chi.URLParam(r, "workspaceId"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWorkspaceMembers(w, r, workspaceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", chi.URLParam(r, "workspaceId"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveWorkspaceMember(w, r, workspaceID, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWorkspaceMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkspaceMemberRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", chi.URLParam(r, "workspaceId"), &workspaceID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "workspaceId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWorkspaceMemberRole(w, r, workspaceID, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/admin/bulk-reanalysis", wrapper.ListBulkReanalysisRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/admin/bulk-reanalysis", wrapper.StartBulkReanalysis)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/admin/bulk-reanalysis/{runId}", wrapper.GetBulkReanalysisRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/analysis-batches", wrapper.StartAnalysisBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/analysis-batches/{batchId}", wrapper.GetAnalysisBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/analyze/{owner}/{repo}", wrapper.AnalyzeRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/analyze/{owner}/{repo}/history", wrapper.GetAnalysisHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/analyze/{owner}/{repo}/ingest", wrapper.IngestAnalysis)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/analyze/{owner}/{repo}/status", wrapper.GetAnalysisStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/callback", wrapper.AuthCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/dev-login", wrapper.AuthDevLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/login", wrapper.AuthLogin)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/logout", wrapper.AuthLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/auth/me", wrapper.AuthMe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/auth/refresh", wrapper.AuthRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/organizations/{org}/dashboard", wrapper.GetOrgDashboard)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/pricing", wrapper.GetPricing)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/repositories/recent", wrapper.GetRecentRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/repositories/stats", wrapper.GetRepositoryStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/repositories/{owner}/{repo}/bookmark", wrapper.RemoveBookmark)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/repositories/{owner}/{repo}/bookmark", wrapper.AddBookmark)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/repositories/{owner}/{repo}/reanalyze", wrapper.ReanalyzeRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/repositories/{owner}/{repo}/update-status", wrapper.GetUpdateStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/spec-view/behaviors/{behaviorId}/edit", wrapper.RevertSpecBehaviorEdit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/spec-view/behaviors/{behaviorId}/edit", wrapper.EditSpecBehavior)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/spec-view/comment-threads/{threadId}/comments", wrapper.AddSpecComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/spec-view/comment-threads/{threadId}/resolution", wrapper.ReopenSpecCommentThread)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/spec-view/comment-threads/{threadId}/resolution", wrapper.ResolveSpecCommentThread)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/spec-view/comments/{commentId}", wrapper.DeleteSpecComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/spec-view/comments/{commentId}", wrapper.UpdateSpecComment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/documents/{documentId}/comments", wrapper.GetSpecComments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/spec-view/documents/{documentId}/comments", wrapper.CreateSpecCommentThread)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/spec-view/features/{featureId}/edit", wrapper.RevertSpecFeatureEdit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/spec-view/features/{featureId}/edit", wrapper.EditSpecFeature)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/spec-view/generate", wrapper.RequestSpecGeneration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/repository/{owner}/{repo}", wrapper.GetSpecDocumentByRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/repository/{owner}/{repo}/diff", wrapper.GetSpecDiffByRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/repository/{owner}/{repo}/export", wrapper.ExportSpecDocumentByRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/repository/{owner}/{repo}/versions", wrapper.GetVersionHistoryByRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/search", wrapper.SearchSpecs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/share-links", wrapper.ListSpecShareLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/spec-view/share-links", wrapper.CreateSpecShareLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/spec-view/share-links/{shareLinkId}", wrapper.RevokeSpecShareLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/shared/{token}", wrapper.GetSharedSpec)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/status/{analysisId}", wrapper.GetSpecGenerationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/{analysisId}", wrapper.GetSpecDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/cache-availability", wrapper.GetSpecCacheAvailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/cache-prediction", wrapper.GetSpecCachePrediction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/spec-view/{analysisId}/cancel", wrapper.CancelSpecGeneration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/diff", wrapper.GetSpecDiff)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/edits", wrapper.GetSpecEdits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/export", wrapper.ExportSpecDocument)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/versions", wrapper.GetSpecVersions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/usage/check-quota", wrapper.CheckQuota)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/usage/current", wrapper.GetCurrentUsage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/access-tokens", wrapper.ListPersonalAccessTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/user/access-tokens", wrapper.CreatePersonalAccessToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/user/access-tokens/{tokenId}", wrapper.RevokePersonalAccessToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/active-tasks", wrapper.GetUserActiveTasks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/analyzed-repositories", wrapper.GetUserAnalyzedRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/user/analyzed-repositories", wrapper.AddUserAnalyzedRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/bookmarks", wrapper.GetUserBookmarks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/github-app/install-url", wrapper.GetGitHubAppInstallURL)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/github-app/installations", wrapper.GetUserGitHubAppInstallations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/github/organizations", wrapper.GetUserGitHubOrganizations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/github/organizations/{org}/repositories", wrapper.GetOrganizationRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/github/repositories", wrapper.GetUserGitHubRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/subscription", wrapper.GetUserSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/user/workspace-invitations", wrapper.ListWorkspaceInvitations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/user/workspace-invitations/{invitationId}", wrapper.DeclineWorkspaceInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/user/workspace-invitations/{invitationId}/accept", wrapper.AcceptWorkspaceInvitation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/webhooks/github-app", wrapper.HandleGitHubAppWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workspaces", wrapper.ListWorkspaces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workspaces", wrapper.CreateWorkspace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/workspaces/{workspaceId}/invitations", wrapper.InviteWorkspaceMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/workspaces/{workspaceId}/members", wrapper.ListWorkspaceMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/workspaces/{workspaceId}/members/{userId}", wrapper.RemoveWorkspaceMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/workspaces/{workspaceId}/members/{userId}", wrapper.UpdateWorkspaceMemberRole)
+	})
+
+	return r
+}
+
+type BadRequestApplicationProblemPlusJSONResponse ProblemDetail
+
+type ConflictApplicationProblemPlusJSONResponse ProblemDetail
+
+type ForbiddenApplicationProblemPlusJSONResponse ProblemDetail
+
+type InternalErrorApplicationProblemPlusJSONResponse ProblemDetail
+
+type NotFoundApplicationProblemPlusJSONResponse ProblemDetail
+
+type PayloadTooLargeApplicationProblemPlusJSONResponse ProblemDetail
+
+type TooManyRequestsApplicationProblemPlusJSONResponse ProblemDetail
+
+type UnauthorizedApplicationProblemPlusJSONResponse ProblemDetail
+
+type ListBulkReanalysisRunsRequestObject struct {
+	Params ListBulkReanalysisRunsParams
+}
+
+type ListBulkReanalysisRunsResponseObject interface {
+	VisitListBulkReanalysisRunsResponse(w http.ResponseWriter) error
+}
+
+type ListBulkReanalysisRuns200JSONResponse BulkReanalysisRunsResponse
+
+func (response ListBulkReanalysisRuns200JSONResponse) VisitListBulkReanalysisRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBulkReanalysisRuns401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListBulkReanalysisRuns401ApplicationProblemPlusJSONResponse) VisitListBulkReanalysisRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBulkReanalysisRuns403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListBulkReanalysisRuns403ApplicationProblemPlusJSONResponse) VisitListBulkReanalysisRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBulkReanalysisRuns500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListBulkReanalysisRuns500ApplicationProblemPlusJSONResponse) VisitListBulkReanalysisRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartBulkReanalysisRequestObject struct {
+}
+
+type StartBulkReanalysisResponseObject interface {
+	VisitStartBulkReanalysisResponse(w http.ResponseWriter) error
+}
+
+type StartBulkReanalysis202JSONResponse BulkReanalysisRun
+
+func (response StartBulkReanalysis202JSONResponse) VisitStartBulkReanalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartBulkReanalysis401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response StartBulkReanalysis401ApplicationProblemPlusJSONResponse) VisitStartBulkReanalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartBulkReanalysis403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response StartBulkReanalysis403ApplicationProblemPlusJSONResponse) VisitStartBulkReanalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartBulkReanalysis409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response StartBulkReanalysis409ApplicationProblemPlusJSONResponse) VisitStartBulkReanalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartBulkReanalysis500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response StartBulkReanalysis500ApplicationProblemPlusJSONResponse) VisitStartBulkReanalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkReanalysisRunRequestObject struct {
+	RunID openapi_types.UUID `json:"runId"`
+}
+
+type GetBulkReanalysisRunResponseObject interface {
+	VisitGetBulkReanalysisRunResponse(w http.ResponseWriter) error
+}
+
+type GetBulkReanalysisRun200JSONResponse BulkReanalysisRun
+
+func (response GetBulkReanalysisRun200JSONResponse) VisitGetBulkReanalysisRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkReanalysisRun401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetBulkReanalysisRun401ApplicationProblemPlusJSONResponse) VisitGetBulkReanalysisRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkReanalysisRun403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetBulkReanalysisRun403ApplicationProblemPlusJSONResponse) VisitGetBulkReanalysisRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkReanalysisRun404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetBulkReanalysisRun404ApplicationProblemPlusJSONResponse) VisitGetBulkReanalysisRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBulkReanalysisRun500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetBulkReanalysisRun500ApplicationProblemPlusJSONResponse) VisitGetBulkReanalysisRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartAnalysisBatchRequestObject struct {
+	Body *StartAnalysisBatchJSONRequestBody
+}
+
+type StartAnalysisBatchResponseObject interface {
+	VisitStartAnalysisBatchResponse(w http.ResponseWriter) error
+}
+
+type StartAnalysisBatch202JSONResponse AnalysisBatch
+
+func (response StartAnalysisBatch202JSONResponse) VisitStartAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartAnalysisBatch400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response StartAnalysisBatch400ApplicationProblemPlusJSONResponse) VisitStartAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartAnalysisBatch401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response StartAnalysisBatch401ApplicationProblemPlusJSONResponse) VisitStartAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartAnalysisBatch403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response StartAnalysisBatch403ApplicationProblemPlusJSONResponse) VisitStartAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartAnalysisBatch404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response StartAnalysisBatch404ApplicationProblemPlusJSONResponse) VisitStartAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartAnalysisBatch429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response StartAnalysisBatch429ApplicationProblemPlusJSONResponse) VisitStartAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartAnalysisBatch500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response StartAnalysisBatch500ApplicationProblemPlusJSONResponse) VisitStartAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisBatchRequestObject struct {
+	BatchID openapi_types.UUID `json:"batchId"`
+}
+
+type GetAnalysisBatchResponseObject interface {
+	VisitGetAnalysisBatchResponse(w http.ResponseWriter) error
+}
+
+type GetAnalysisBatch200JSONResponse AnalysisBatch
+
+func (response GetAnalysisBatch200JSONResponse) VisitGetAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisBatch401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisBatch401ApplicationProblemPlusJSONResponse) VisitGetAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisBatch404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisBatch404ApplicationProblemPlusJSONResponse) VisitGetAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisBatch500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisBatch500ApplicationProblemPlusJSONResponse) VisitGetAnalysisBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeRepositoryRequestObject struct {
+	Owner  Owner `json:"owner"`
+	Repo   Repo  `json:"repo"`
+	Params AnalyzeRepositoryParams
+}
+
+type AnalyzeRepositoryResponseObject interface {
+	VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error
+}
+
+type AnalyzeRepository200JSONResponse CompletedResponse
+
+func (response AnalyzeRepository200JSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeRepository202JSONResponse struct {
+	union json.RawMessage
+}
+
+func (response AnalyzeRepository202JSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.union)
+}
+
+type AnalyzeRepository400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response AnalyzeRepository400ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeRepository404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response AnalyzeRepository404ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeRepository429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response AnalyzeRepository429ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AnalyzeRepository500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AnalyzeRepository500ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisHistoryRequestObject struct {
+	Owner Owner `json:"owner"`
+	Repo  Repo  `json:"repo"`
+}
+
+type GetAnalysisHistoryResponseObject interface {
+	VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetAnalysisHistory200JSONResponse AnalysisHistoryResponse
+
+func (response GetAnalysisHistory200JSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisHistory400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisHistory400ApplicationProblemPlusJSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisHistory404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisHistory404ApplicationProblemPlusJSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisHistory500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisHistory500ApplicationProblemPlusJSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAnalysisRequestObject struct {
+	Owner Owner `json:"owner"`
+	Repo  Repo  `json:"repo"`
+	Body  *IngestAnalysisJSONRequestBody
+}
+
+type IngestAnalysisResponseObject interface {
+	VisitIngestAnalysisResponse(w http.ResponseWriter) error
+}
+
+type IngestAnalysis201JSONResponse IngestAnalysisResponse
+
+func (response IngestAnalysis201JSONResponse) VisitIngestAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAnalysis400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response IngestAnalysis400ApplicationProblemPlusJSONResponse) VisitIngestAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAnalysis401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response IngestAnalysis401ApplicationProblemPlusJSONResponse) VisitIngestAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAnalysis403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response IngestAnalysis403ApplicationProblemPlusJSONResponse) VisitIngestAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAnalysis409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response IngestAnalysis409ApplicationProblemPlusJSONResponse) VisitIngestAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAnalysis413ApplicationProblemPlusJSONResponse struct {
+	PayloadTooLargeApplicationProblemPlusJSONResponse
+}
+
+func (response IngestAnalysis413ApplicationProblemPlusJSONResponse) VisitIngestAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAnalysis500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response IngestAnalysis500ApplicationProblemPlusJSONResponse) VisitIngestAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisStatusRequestObject struct {
+	Owner Owner `json:"owner"`
+	Repo  Repo  `json:"repo"`
+}
+
+type GetAnalysisStatusResponseObject interface {
+	VisitGetAnalysisStatusResponse(w http.ResponseWriter) error
+}
+
+type GetAnalysisStatus200JSONResponse AnalysisResponse
+
+func (response GetAnalysisStatus200JSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisStatus400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisStatus400ApplicationProblemPlusJSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisStatus404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisStatus404ApplicationProblemPlusJSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAnalysisStatus500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetAnalysisStatus500ApplicationProblemPlusJSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallbackRequestObject struct {
+	Params AuthCallbackParams
+}
+
+type AuthCallbackResponseObject interface {
+	VisitAuthCallbackResponse(w http.ResponseWriter) error
+}
+
+type AuthCallback302ResponseHeaders struct {
+	Location  string
+	SetCookie string
+}
+
+type AuthCallback302Response struct {
+	Headers AuthCallback302ResponseHeaders
+}
+
+func (response AuthCallback302Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(302)
+	return nil
+}
+
+type AuthCallback400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response AuthCallback400ApplicationProblemPlusJSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallback500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AuthCallback500ApplicationProblemPlusJSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthDevLoginRequestObject struct {
+	Body *AuthDevLoginJSONRequestBody
+}
+
+type AuthDevLoginResponseObject interface {
+	VisitAuthDevLoginResponse(w http.ResponseWriter) error
+}
+
+type AuthDevLogin200ResponseHeaders struct {
+	SetCookie string
+}
+
+type AuthDevLogin200JSONResponse struct {
+	Body    DevLoginResponse
+	Headers AuthDevLogin200ResponseHeaders
+}
+
+func (response AuthDevLogin200JSONResponse) VisitAuthDevLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthDevLogin403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response AuthDevLogin403ApplicationProblemPlusJSONResponse) VisitAuthDevLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthDevLogin500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AuthDevLogin500ApplicationProblemPlusJSONResponse) VisitAuthDevLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthLoginRequestObject struct {
+}
+
+type AuthLoginResponseObject interface {
+	VisitAuthLoginResponse(w http.ResponseWriter) error
+}
+
+type AuthLogin200JSONResponse LoginResponse
+
+func (response AuthLogin200JSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthLogin500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AuthLogin500ApplicationProblemPlusJSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthLogoutRequestObject struct {
+}
+
+type AuthLogoutResponseObject interface {
+	VisitAuthLogoutResponse(w http.ResponseWriter) error
+}
+
+type AuthLogout200JSONResponse LogoutResponse
+
+func (response AuthLogout200JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthLogout500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AuthLogout500ApplicationProblemPlusJSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthMeRequestObject struct {
+}
+
+type AuthMeResponseObject interface {
+	VisitAuthMeResponse(w http.ResponseWriter) error
+}
+
+type AuthMe200JSONResponse UserInfo
+
+func (response AuthMe200JSONResponse) VisitAuthMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthMe401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response AuthMe401ApplicationProblemPlusJSONResponse) VisitAuthMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthMe500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AuthMe500ApplicationProblemPlusJSONResponse) VisitAuthMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthRefreshRequestObject struct {
+}
+
+type AuthRefreshResponseObject interface {
+	VisitAuthRefreshResponse(w http.ResponseWriter) error
+}
+
+type AuthRefresh200ResponseHeaders struct {
+	SetCookie string
+}
+
+type AuthRefresh200JSONResponse struct {
+	Body    RefreshResponse
+	Headers AuthRefresh200ResponseHeaders
+}
+
+func (response AuthRefresh200JSONResponse) VisitAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthRefresh401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response AuthRefresh401ApplicationProblemPlusJSONResponse) VisitAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthRefresh500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AuthRefresh500ApplicationProblemPlusJSONResponse) VisitAuthRefreshResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrgDashboardRequestObject struct {
+	Org string `json:"org"`
+}
+
+type GetOrgDashboardResponseObject interface {
+	VisitGetOrgDashboardResponse(w http.ResponseWriter) error
+}
+
+type GetOrgDashboard200JSONResponse OrgDashboard
+
+func (response GetOrgDashboard200JSONResponse) VisitGetOrgDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrgDashboard400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetOrgDashboard400ApplicationProblemPlusJSONResponse) VisitGetOrgDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrgDashboard401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetOrgDashboard401ApplicationProblemPlusJSONResponse) VisitGetOrgDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrgDashboard403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetOrgDashboard403ApplicationProblemPlusJSONResponse) VisitGetOrgDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrgDashboard500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetOrgDashboard500ApplicationProblemPlusJSONResponse) VisitGetOrgDashboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPricingRequestObject struct {
+}
+
+type GetPricingResponseObject interface {
+	VisitGetPricingResponse(w http.ResponseWriter) error
+}
+
+type GetPricing200JSONResponse PricingResponse
+
+func (response GetPricing200JSONResponse) VisitGetPricingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPricing500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPricing500ApplicationProblemPlusJSONResponse) VisitGetPricingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecentRepositoriesRequestObject struct {
+	Params GetRecentRepositoriesParams
+}
+
+type GetRecentRepositoriesResponseObject interface {
+	VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error
+}
+
+type GetRecentRepositories200JSONResponse PaginatedRepositoriesResponse
+
+func (response GetRecentRepositories200JSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecentRepositories400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetRecentRepositories400ApplicationProblemPlusJSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecentRepositories401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetRecentRepositories401ApplicationProblemPlusJSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecentRepositories500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRecentRepositories500ApplicationProblemPlusJSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryStatsRequestObject struct {
+}
+
+type GetRepositoryStatsResponseObject interface {
+	VisitGetRepositoryStatsResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryStats200JSONResponse RepositoryStatsResponse
+
+func (response GetRepositoryStats200JSONResponse) VisitGetRepositoryStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryStats401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetRepositoryStats401ApplicationProblemPlusJSONResponse) VisitGetRepositoryStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryStats500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetRepositoryStats500ApplicationProblemPlusJSONResponse) VisitGetRepositoryStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveBookmarkRequestObject struct {
+	Owner Owner `json:"owner"`
+	Repo  Repo  `json:"repo"`
+}
+
+type RemoveBookmarkResponseObject interface {
+	VisitRemoveBookmarkResponse(w http.ResponseWriter) error
+}
+
+type RemoveBookmark200JSONResponse BookmarkResponse
+
+func (response RemoveBookmark200JSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveBookmark400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RemoveBookmark400ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveBookmark401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RemoveBookmark401ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveBookmark404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RemoveBookmark404ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveBookmark500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RemoveBookmark500ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBookmarkRequestObject struct {
+	Owner Owner `json:"owner"`
+	Repo  Repo  `json:"repo"`
+}
+
+type AddBookmarkResponseObject interface {
+	VisitAddBookmarkResponse(w http.ResponseWriter) error
+}
+
+type AddBookmark200JSONResponse BookmarkResponse
+
+func (response AddBookmark200JSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBookmark400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response AddBookmark400ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBookmark401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response AddBookmark401ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBookmark404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response AddBookmark404ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddBookmark500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AddBookmark500ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReanalyzeRepositoryRequestObject struct {
+	Owner Owner `json:"owner"`
+	Repo  Repo  `json:"repo"`
+}
+
+type ReanalyzeRepositoryResponseObject interface {
+	VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error
+}
+
+type ReanalyzeRepository202JSONResponse struct {
+	union json.RawMessage
+}
+
+func (response ReanalyzeRepository202JSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response.union)
+}
+
+type ReanalyzeRepository400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ReanalyzeRepository400ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReanalyzeRepository401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ReanalyzeRepository401ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReanalyzeRepository404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ReanalyzeRepository404ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReanalyzeRepository500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReanalyzeRepository500ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpdateStatusRequestObject struct {
+	Owner Owner `json:"owner"`
+	Repo  Repo  `json:"repo"`
+}
+
+type GetUpdateStatusResponseObject interface {
+	VisitGetUpdateStatusResponse(w http.ResponseWriter) error
+}
+
+type GetUpdateStatus200JSONResponse UpdateStatusResponse
+
+func (response GetUpdateStatus200JSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpdateStatus400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUpdateStatus400ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpdateStatus401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetUpdateStatus401ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpdateStatus404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetUpdateStatus404ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUpdateStatus500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUpdateStatus500ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecBehaviorEditRequestObject struct {
+	BehaviorID openapi_types.UUID `json:"behaviorId"`
+	Params     RevertSpecBehaviorEditParams
+}
+
+type RevertSpecBehaviorEditResponseObject interface {
+	VisitRevertSpecBehaviorEditResponse(w http.ResponseWriter) error
+}
+
+type RevertSpecBehaviorEdit204Response struct {
+}
+
+func (response RevertSpecBehaviorEdit204Response) VisitRevertSpecBehaviorEditResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevertSpecBehaviorEdit401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecBehaviorEdit401ApplicationProblemPlusJSONResponse) VisitRevertSpecBehaviorEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecBehaviorEdit403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecBehaviorEdit403ApplicationProblemPlusJSONResponse) VisitRevertSpecBehaviorEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecBehaviorEdit404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecBehaviorEdit404ApplicationProblemPlusJSONResponse) VisitRevertSpecBehaviorEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecBehaviorEdit500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecBehaviorEdit500ApplicationProblemPlusJSONResponse) VisitRevertSpecBehaviorEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecBehaviorRequestObject struct {
+	BehaviorID openapi_types.UUID `json:"behaviorId"`
+	Params     EditSpecBehaviorParams
+	Body       *EditSpecBehaviorJSONRequestBody
+}
+
+type EditSpecBehaviorResponseObject interface {
+	VisitEditSpecBehaviorResponse(w http.ResponseWriter) error
+}
+
+type EditSpecBehavior200JSONResponse SpecBehaviorEdit
+
+func (response EditSpecBehavior200JSONResponse) VisitEditSpecBehaviorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecBehavior400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecBehavior400ApplicationProblemPlusJSONResponse) VisitEditSpecBehaviorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecBehavior401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecBehavior401ApplicationProblemPlusJSONResponse) VisitEditSpecBehaviorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecBehavior403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecBehavior403ApplicationProblemPlusJSONResponse) VisitEditSpecBehaviorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecBehavior404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecBehavior404ApplicationProblemPlusJSONResponse) VisitEditSpecBehaviorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecBehavior500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecBehavior500ApplicationProblemPlusJSONResponse) VisitEditSpecBehaviorResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSpecCommentRequestObject struct {
+	ThreadID openapi_types.UUID `json:"threadId"`
+	Params   AddSpecCommentParams
+	Body     *AddSpecCommentJSONRequestBody
+}
+
+type AddSpecCommentResponseObject interface {
+	VisitAddSpecCommentResponse(w http.ResponseWriter) error
+}
+
+type AddSpecComment201JSONResponse SpecComment
+
+func (response AddSpecComment201JSONResponse) VisitAddSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSpecComment400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response AddSpecComment400ApplicationProblemPlusJSONResponse) VisitAddSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSpecComment401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response AddSpecComment401ApplicationProblemPlusJSONResponse) VisitAddSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSpecComment403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response AddSpecComment403ApplicationProblemPlusJSONResponse) VisitAddSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSpecComment404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response AddSpecComment404ApplicationProblemPlusJSONResponse) VisitAddSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSpecComment500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response AddSpecComment500ApplicationProblemPlusJSONResponse) VisitAddSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenSpecCommentThreadRequestObject struct {
+	ThreadID openapi_types.UUID `json:"threadId"`
+	Params   ReopenSpecCommentThreadParams
+}
+
+type ReopenSpecCommentThreadResponseObject interface {
+	VisitReopenSpecCommentThreadResponse(w http.ResponseWriter) error
+}
+
+type ReopenSpecCommentThread204Response struct {
+}
+
+func (response ReopenSpecCommentThread204Response) VisitReopenSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReopenSpecCommentThread401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ReopenSpecCommentThread401ApplicationProblemPlusJSONResponse) VisitReopenSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenSpecCommentThread403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ReopenSpecCommentThread403ApplicationProblemPlusJSONResponse) VisitReopenSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenSpecCommentThread404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ReopenSpecCommentThread404ApplicationProblemPlusJSONResponse) VisitReopenSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReopenSpecCommentThread500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ReopenSpecCommentThread500ApplicationProblemPlusJSONResponse) VisitReopenSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveSpecCommentThreadRequestObject struct {
+	ThreadID openapi_types.UUID `json:"threadId"`
+	Params   ResolveSpecCommentThreadParams
+}
+
+type ResolveSpecCommentThreadResponseObject interface {
+	VisitResolveSpecCommentThreadResponse(w http.ResponseWriter) error
+}
+
+type ResolveSpecCommentThread204Response struct {
+}
+
+func (response ResolveSpecCommentThread204Response) VisitResolveSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ResolveSpecCommentThread401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ResolveSpecCommentThread401ApplicationProblemPlusJSONResponse) VisitResolveSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveSpecCommentThread403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ResolveSpecCommentThread403ApplicationProblemPlusJSONResponse) VisitResolveSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveSpecCommentThread404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ResolveSpecCommentThread404ApplicationProblemPlusJSONResponse) VisitResolveSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResolveSpecCommentThread500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ResolveSpecCommentThread500ApplicationProblemPlusJSONResponse) VisitResolveSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpecCommentRequestObject struct {
+	CommentID openapi_types.UUID `json:"commentId"`
+	Params    DeleteSpecCommentParams
+}
+
+type DeleteSpecCommentResponseObject interface {
+	VisitDeleteSpecCommentResponse(w http.ResponseWriter) error
+}
+
+type DeleteSpecComment204Response struct {
+}
+
+func (response DeleteSpecComment204Response) VisitDeleteSpecCommentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSpecComment401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpecComment401ApplicationProblemPlusJSONResponse) VisitDeleteSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpecComment403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpecComment403ApplicationProblemPlusJSONResponse) VisitDeleteSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpecComment404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpecComment404ApplicationProblemPlusJSONResponse) VisitDeleteSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSpecComment500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteSpecComment500ApplicationProblemPlusJSONResponse) VisitDeleteSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSpecCommentRequestObject struct {
+	CommentID openapi_types.UUID `json:"commentId"`
+	Params    UpdateSpecCommentParams
+	Body      *UpdateSpecCommentJSONRequestBody
+}
+
+type UpdateSpecCommentResponseObject interface {
+	VisitUpdateSpecCommentResponse(w http.ResponseWriter) error
+}
+
+type UpdateSpecComment200JSONResponse SpecComment
+
+func (response UpdateSpecComment200JSONResponse) VisitUpdateSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSpecComment400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateSpecComment400ApplicationProblemPlusJSONResponse) VisitUpdateSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSpecComment401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateSpecComment401ApplicationProblemPlusJSONResponse) VisitUpdateSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSpecComment403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateSpecComment403ApplicationProblemPlusJSONResponse) VisitUpdateSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSpecComment404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateSpecComment404ApplicationProblemPlusJSONResponse) VisitUpdateSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSpecComment500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response UpdateSpecComment500ApplicationProblemPlusJSONResponse) VisitUpdateSpecCommentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecCommentsRequestObject struct {
+	DocumentID openapi_types.UUID `json:"documentId"`
+	Params     GetSpecCommentsParams
+}
+
+type GetSpecCommentsResponseObject interface {
+	VisitGetSpecCommentsResponse(w http.ResponseWriter) error
+}
+
+type GetSpecComments200JSONResponse SpecCommentThreadsResponse
+
+func (response GetSpecComments200JSONResponse) VisitGetSpecCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecComments400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecComments400ApplicationProblemPlusJSONResponse) VisitGetSpecCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecComments401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecComments401ApplicationProblemPlusJSONResponse) VisitGetSpecCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecComments403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecComments403ApplicationProblemPlusJSONResponse) VisitGetSpecCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecComments404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecComments404ApplicationProblemPlusJSONResponse) VisitGetSpecCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecComments500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecComments500ApplicationProblemPlusJSONResponse) VisitGetSpecCommentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecCommentThreadRequestObject struct {
+	DocumentID openapi_types.UUID `json:"documentId"`
+	Params     CreateSpecCommentThreadParams
+	Body       *CreateSpecCommentThreadJSONRequestBody
+}
+
+type CreateSpecCommentThreadResponseObject interface {
+	VisitCreateSpecCommentThreadResponse(w http.ResponseWriter) error
+}
+
+type CreateSpecCommentThread201JSONResponse SpecCommentThread
+
+func (response CreateSpecCommentThread201JSONResponse) VisitCreateSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecCommentThread400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecCommentThread400ApplicationProblemPlusJSONResponse) VisitCreateSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecCommentThread401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecCommentThread401ApplicationProblemPlusJSONResponse) VisitCreateSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecCommentThread403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecCommentThread403ApplicationProblemPlusJSONResponse) VisitCreateSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecCommentThread404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecCommentThread404ApplicationProblemPlusJSONResponse) VisitCreateSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecCommentThread500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecCommentThread500ApplicationProblemPlusJSONResponse) VisitCreateSpecCommentThreadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecFeatureEditRequestObject struct {
+	FeatureID openapi_types.UUID `json:"featureId"`
+	Params    RevertSpecFeatureEditParams
+}
+
+type RevertSpecFeatureEditResponseObject interface {
+	VisitRevertSpecFeatureEditResponse(w http.ResponseWriter) error
+}
+
+type RevertSpecFeatureEdit204Response struct {
+}
+
+func (response RevertSpecFeatureEdit204Response) VisitRevertSpecFeatureEditResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevertSpecFeatureEdit401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecFeatureEdit401ApplicationProblemPlusJSONResponse) VisitRevertSpecFeatureEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecFeatureEdit403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecFeatureEdit403ApplicationProblemPlusJSONResponse) VisitRevertSpecFeatureEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecFeatureEdit404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecFeatureEdit404ApplicationProblemPlusJSONResponse) VisitRevertSpecFeatureEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevertSpecFeatureEdit500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RevertSpecFeatureEdit500ApplicationProblemPlusJSONResponse) VisitRevertSpecFeatureEditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecFeatureRequestObject struct {
+	FeatureID openapi_types.UUID `json:"featureId"`
+	Params    EditSpecFeatureParams
+	Body      *EditSpecFeatureJSONRequestBody
+}
+
+type EditSpecFeatureResponseObject interface {
+	VisitEditSpecFeatureResponse(w http.ResponseWriter) error
+}
+
+type EditSpecFeature200JSONResponse SpecFeatureEdit
+
+func (response EditSpecFeature200JSONResponse) VisitEditSpecFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecFeature400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecFeature400ApplicationProblemPlusJSONResponse) VisitEditSpecFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecFeature401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecFeature401ApplicationProblemPlusJSONResponse) VisitEditSpecFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecFeature403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecFeature403ApplicationProblemPlusJSONResponse) VisitEditSpecFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecFeature404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecFeature404ApplicationProblemPlusJSONResponse) VisitEditSpecFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EditSpecFeature500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response EditSpecFeature500ApplicationProblemPlusJSONResponse) VisitEditSpecFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGenerationRequestObject struct {
+	Body *RequestSpecGenerationJSONRequestBody
+}
+
+type RequestSpecGenerationResponseObject interface {
+	VisitRequestSpecGenerationResponse(w http.ResponseWriter) error
+}
+
+type RequestSpecGeneration202JSONResponse RequestSpecGenerationResponse
+
+func (response RequestSpecGeneration202JSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGeneration400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response RequestSpecGeneration400ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGeneration401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RequestSpecGeneration401ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGeneration403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RequestSpecGeneration403ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGeneration404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RequestSpecGeneration404ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGeneration409ApplicationProblemPlusJSONResponse ProblemDetail
+
+func (response RequestSpecGeneration409ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGeneration429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
+}
+
+func (response RequestSpecGeneration429ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestSpecGeneration500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RequestSpecGeneration500ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocumentByRepositoryRequestObject struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Params GetSpecDocumentByRepositoryParams
+}
+
+type GetSpecDocumentByRepositoryResponseObject interface {
+	VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error
+}
+
+type GetSpecDocumentByRepository200JSONResponse RepoSpecDocumentResponse
+
+func (response GetSpecDocumentByRepository200JSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocumentByRepository400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDocumentByRepository400ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocumentByRepository401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDocumentByRepository401ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocumentByRepository403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDocumentByRepository403ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocumentByRepository404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDocumentByRepository404ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocumentByRepository500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDocumentByRepository500ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDiffByRepositoryRequestObject struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Params GetSpecDiffByRepositoryParams
+}
+
+type GetSpecDiffByRepositoryResponseObject interface {
+	VisitGetSpecDiffByRepositoryResponse(w http.ResponseWriter) error
+}
+
+type GetSpecDiffByRepository200JSONResponse SpecDiffResponse
+
+func (response GetSpecDiffByRepository200JSONResponse) VisitGetSpecDiffByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDiffByRepository400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDiffByRepository400ApplicationProblemPlusJSONResponse) VisitGetSpecDiffByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDiffByRepository401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDiffByRepository401ApplicationProblemPlusJSONResponse) VisitGetSpecDiffByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDiffByRepository403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDiffByRepository403ApplicationProblemPlusJSONResponse) VisitGetSpecDiffByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDiffByRepository404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDiffByRepository404ApplicationProblemPlusJSONResponse) VisitGetSpecDiffByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDiffByRepository500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecDiffByRepository500ApplicationProblemPlusJSONResponse) VisitGetSpecDiffByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSpecDocumentByRepositoryRequestObject struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Params ExportSpecDocumentByRepositoryParams
+}
+
+type ExportSpecDocumentByRepositoryResponseObject interface {
+	VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error
+}
+
+type ExportSpecDocumentByRepository200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportSpecDocumentByRepository200ApplicationZipResponse struct {
+	Body          io.Reader
+	Headers       ExportSpecDocumentByRepository200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSpecDocumentByRepository200ApplicationZipResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSpecDocumentByRepository200TextHTMLResponse struct {
+	Body          io.Reader
+	Headers       ExportSpecDocumentByRepository200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSpecDocumentByRepository200TextHTMLResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSpecDocumentByRepository200TextMarkdownResponse struct {
+	Body          io.Reader
+	Headers       ExportSpecDocumentByRepository200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSpecDocumentByRepository200TextMarkdownResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/markdown")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSpecDocumentByRepository400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response ExportSpecDocumentByRepository400ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSpecDocumentByRepository401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ExportSpecDocumentByRepository401ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSpecDocumentByRepository403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ExportSpecDocumentByRepository403ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSpecDocumentByRepository404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response ExportSpecDocumentByRepository404ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSpecDocumentByRepository500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ExportSpecDocumentByRepository500ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionHistoryByRepositoryRequestObject struct {
+	Owner  string `json:"owner"`
+	Repo   string `json:"repo"`
+	Params GetVersionHistoryByRepositoryParams
+}
+
+type GetVersionHistoryByRepositoryResponseObject interface {
+	VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error
+}
+
+type GetVersionHistoryByRepository200JSONResponse RepoVersionHistoryResponse
+
+func (response GetVersionHistoryByRepository200JSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionHistoryByRepository400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetVersionHistoryByRepository400ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionHistoryByRepository401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetVersionHistoryByRepository401ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionHistoryByRepository403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetVersionHistoryByRepository403ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionHistoryByRepository404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetVersionHistoryByRepository404ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVersionHistoryByRepository500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetVersionHistoryByRepository500ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSpecsRequestObject struct {
+	Params SearchSpecsParams
+}
+
+type SearchSpecsResponseObject interface {
+	VisitSearchSpecsResponse(w http.ResponseWriter) error
+}
+
+type SearchSpecs200JSONResponse SpecSearchResponse
+
+func (response SearchSpecs200JSONResponse) VisitSearchSpecsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSpecs400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response SearchSpecs400ApplicationProblemPlusJSONResponse) VisitSearchSpecsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSpecs401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response SearchSpecs401ApplicationProblemPlusJSONResponse) VisitSearchSpecsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSpecs403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response SearchSpecs403ApplicationProblemPlusJSONResponse) VisitSearchSpecsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSpecs500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response SearchSpecs500ApplicationProblemPlusJSONResponse) VisitSearchSpecsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSpecShareLinksRequestObject struct {
+	Params ListSpecShareLinksParams
+}
+
+type ListSpecShareLinksResponseObject interface {
+	VisitListSpecShareLinksResponse(w http.ResponseWriter) error
+}
+
+type ListSpecShareLinks200JSONResponse SpecShareLinkListResponse
+
+func (response ListSpecShareLinks200JSONResponse) VisitListSpecShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSpecShareLinks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response ListSpecShareLinks401ApplicationProblemPlusJSONResponse) VisitListSpecShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSpecShareLinks403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response ListSpecShareLinks403ApplicationProblemPlusJSONResponse) VisitListSpecShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSpecShareLinks500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response ListSpecShareLinks500ApplicationProblemPlusJSONResponse) VisitListSpecShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecShareLinkRequestObject struct {
+	Params CreateSpecShareLinkParams
+	Body   *CreateSpecShareLinkJSONRequestBody
+}
+
+type CreateSpecShareLinkResponseObject interface {
+	VisitCreateSpecShareLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateSpecShareLink201JSONResponse CreateSpecShareLinkResponse
+
+func (response CreateSpecShareLink201JSONResponse) VisitCreateSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecShareLink400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecShareLink400ApplicationProblemPlusJSONResponse) VisitCreateSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecShareLink401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecShareLink401ApplicationProblemPlusJSONResponse) VisitCreateSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecShareLink403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecShareLink403ApplicationProblemPlusJSONResponse) VisitCreateSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecShareLink404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecShareLink404ApplicationProblemPlusJSONResponse) VisitCreateSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSpecShareLink500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CreateSpecShareLink500ApplicationProblemPlusJSONResponse) VisitCreateSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSpecShareLinkRequestObject struct {
+	ShareLinkID openapi_types.UUID `json:"shareLinkId"`
+	Params      RevokeSpecShareLinkParams
+}
+
+type RevokeSpecShareLinkResponseObject interface {
+	VisitRevokeSpecShareLinkResponse(w http.ResponseWriter) error
+}
+
+type RevokeSpecShareLink204Response struct {
+}
+
+func (response RevokeSpecShareLink204Response) VisitRevokeSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeSpecShareLink401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeSpecShareLink401ApplicationProblemPlusJSONResponse) VisitRevokeSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSpecShareLink403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeSpecShareLink403ApplicationProblemPlusJSONResponse) VisitRevokeSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSpecShareLink404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeSpecShareLink404ApplicationProblemPlusJSONResponse) VisitRevokeSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSpecShareLink500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response RevokeSpecShareLink500ApplicationProblemPlusJSONResponse) VisitRevokeSpecShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedSpecRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetSharedSpecResponseObject interface {
+	VisitGetSharedSpecResponse(w http.ResponseWriter) error
+}
+
+type GetSharedSpec200JSONResponse SharedSpecDocumentResponse
+
+func (response GetSharedSpec200JSONResponse) VisitGetSharedSpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedSpec404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSharedSpec404ApplicationProblemPlusJSONResponse) VisitGetSharedSpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedSpec500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSharedSpec500ApplicationProblemPlusJSONResponse) VisitGetSharedSpecResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecGenerationStatusRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     GetSpecGenerationStatusParams
+}
+
+type GetSpecGenerationStatusResponseObject interface {
+	VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error
+}
+
+type GetSpecGenerationStatus200JSONResponse SpecGenerationStatusResponse
+
+func (response GetSpecGenerationStatus200JSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecGenerationStatus400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecGenerationStatus400ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecGenerationStatus401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecGenerationStatus401ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecGenerationStatus403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecGenerationStatus403ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecGenerationStatus404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecGenerationStatus404ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecGenerationStatus500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecGenerationStatus500ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocumentRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     GetSpecDocumentParams
+}
+
+type GetSpecDocumentResponseObject interface {
+	VisitGetSpecDocumentResponse(w http.ResponseWriter) error
+}
+
+type GetSpecDocument200JSONResponse SpecDocumentResponse
+
+func (response GetSpecDocument200JSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDocument400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
 
-		params.XGitHubDelivery = XGitHubDelivery
+func (response GetSpecDocument400ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-	} else {
-		err := fmt.Errorf("Header parameter X-GitHub-Delivery is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-GitHub-Delivery", Err: err})
-		return
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HandleGitHubAppWebhook(w, r, params)
-	}))
+type GetSpecDocument401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+func (response GetSpecDocument401ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-	handler.ServeHTTP(w, r)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+type GetSpecDocument403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+func (response GetSpecDocument403ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+type GetSpecDocument404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+func (response GetSpecDocument404ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+type GetSpecDocument500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+func (response GetSpecDocument500ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RequiredParamError struct {
-	ParamName string
+type GetSpecCacheAvailabilityRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+type GetSpecCacheAvailabilityResponseObject interface {
+	VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+type GetSpecCacheAvailability200JSONResponse CacheAvailabilityResponse
+
+func (response GetSpecCacheAvailability200JSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+type GetSpecCacheAvailability401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+func (response GetSpecCacheAvailability401ApplicationProblemPlusJSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+type GetSpecCacheAvailability404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+func (response GetSpecCacheAvailability404ApplicationProblemPlusJSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+type GetSpecCacheAvailability500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+func (response GetSpecCacheAvailability500ApplicationProblemPlusJSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecCachePredictionRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     GetSpecCachePredictionParams
+}
+
+type GetSpecCachePredictionResponseObject interface {
+	VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error
+}
+
+type GetSpecCachePrediction200JSONResponse CachePredictionResponse
+
+func (response GetSpecCachePrediction200JSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecCachePrediction400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecCachePrediction400ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecCachePrediction401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecCachePrediction401ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecCachePrediction404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecCachePrediction404ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecCachePrediction500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecCachePrediction500ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSpecGenerationRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     CancelSpecGenerationParams
+}
+
+type CancelSpecGenerationResponseObject interface {
+	VisitCancelSpecGenerationResponse(w http.ResponseWriter) error
+}
+
+type CancelSpecGeneration200JSONResponse SpecGenerationStatusResponse
+
+func (response CancelSpecGeneration200JSONResponse) VisitCancelSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSpecGeneration400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response CancelSpecGeneration400ApplicationProblemPlusJSONResponse) VisitCancelSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSpecGeneration401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response CancelSpecGeneration401ApplicationProblemPlusJSONResponse) VisitCancelSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSpecGeneration403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response CancelSpecGeneration403ApplicationProblemPlusJSONResponse) VisitCancelSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSpecGeneration404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+func (response CancelSpecGeneration404ApplicationProblemPlusJSONResponse) VisitCancelSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSpecGeneration409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CancelSpecGeneration409ApplicationProblemPlusJSONResponse) VisitCancelSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSpecGeneration500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response CancelSpecGeneration500ApplicationProblemPlusJSONResponse) VisitCancelSpecGenerationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecDiffRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     GetSpecDiffParams
+}
+
+type GetSpecDiffResponseObject interface {
+	VisitGetSpecDiffResponse(w http.ResponseWriter) error
+}
+
+type GetSpecDiff200JSONResponse SpecDiffResponse
+
+func (response GetSpecDiff200JSONResponse) VisitGetSpecDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+type GetSpecDiff400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+func (response GetSpecDiff400ApplicationProblemPlusJSONResponse) VisitGetSpecDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+	return json.NewEncoder(w).Encode(response)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type GetSpecDiff401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+func (response GetSpecDiff401ApplicationProblemPlusJSONResponse) VisitGetSpecDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/analyze/{owner}/{repo}", wrapper.AnalyzeRepository)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/analyze/{owner}/{repo}/history", wrapper.GetAnalysisHistory)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/analyze/{owner}/{repo}/status", wrapper.GetAnalysisStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/auth/callback", wrapper.AuthCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth/dev-login", wrapper.AuthDevLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/auth/login", wrapper.AuthLogin)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth/logout", wrapper.AuthLogout)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/auth/me", wrapper.AuthMe)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/auth/refresh", wrapper.AuthRefresh)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/pricing", wrapper.GetPricing)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/repositories/recent", wrapper.GetRecentRepositories)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/repositories/stats", wrapper.GetRepositoryStats)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/repositories/{owner}/{repo}/bookmark", wrapper.RemoveBookmark)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/repositories/{owner}/{repo}/bookmark", wrapper.AddBookmark)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/repositories/{owner}/{repo}/reanalyze", wrapper.ReanalyzeRepository)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/repositories/{owner}/{repo}/update-status", wrapper.GetUpdateStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/spec-view/generate", wrapper.RequestSpecGeneration)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spec-view/repository/{owner}/{repo}", wrapper.GetSpecDocumentByRepository)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spec-view/repository/{owner}/{repo}/versions", wrapper.GetVersionHistoryByRepository)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spec-view/status/{analysisId}", wrapper.GetSpecGenerationStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spec-view/{analysisId}", wrapper.GetSpecDocument)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/cache-availability", wrapper.GetSpecCacheAvailability)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/cache-prediction", wrapper.GetSpecCachePrediction)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/spec-view/{analysisId}/versions", wrapper.GetSpecVersions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/usage/check-quota", wrapper.CheckQuota)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/usage/current", wrapper.GetCurrentUsage)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/active-tasks", wrapper.GetUserActiveTasks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/analyzed-repositories", wrapper.GetUserAnalyzedRepositories)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/user/analyzed-repositories", wrapper.AddUserAnalyzedRepository)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/bookmarks", wrapper.GetUserBookmarks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/github-app/install-url", wrapper.GetGitHubAppInstallURL)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/github-app/installations", wrapper.GetUserGitHubAppInstallations)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/github/organizations", wrapper.GetUserGitHubOrganizations)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/github/organizations/{org}/repositories", wrapper.GetOrganizationRepositories)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/github/repositories", wrapper.GetUserGitHubRepositories)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/user/subscription", wrapper.GetUserSubscription)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/webhooks/github-app", wrapper.HandleGitHubAppWebhook)
-	})
+type GetSpecDiff403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
 
-	return r
+func (response GetSpecDiff403ApplicationProblemPlusJSONResponse) VisitGetSpecDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type BadRequestApplicationProblemPlusJSONResponse ProblemDetail
+type GetSpecDiff404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
 
-type ForbiddenApplicationProblemPlusJSONResponse ProblemDetail
+func (response GetSpecDiff404ApplicationProblemPlusJSONResponse) VisitGetSpecDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
-type InternalErrorApplicationProblemPlusJSONResponse ProblemDetail
+	return json.NewEncoder(w).Encode(response)
+}
 
-type NotFoundApplicationProblemPlusJSONResponse ProblemDetail
+type GetSpecDiff500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
 
-type TooManyRequestsApplicationProblemPlusJSONResponse ProblemDetail
+func (response GetSpecDiff500ApplicationProblemPlusJSONResponse) VisitGetSpecDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
 
-type UnauthorizedApplicationProblemPlusJSONResponse ProblemDetail
+	return json.NewEncoder(w).Encode(response)
+}
 
-type AnalyzeRepositoryRequestObject struct {
-	Owner  Owner `json:"owner"`
-	Repo   Repo  `json:"repo"`
-	Params AnalyzeRepositoryParams
+type GetSpecEditsRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     GetSpecEditsParams
 }
 
-type AnalyzeRepositoryResponseObject interface {
-	VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error
+type GetSpecEditsResponseObject interface {
+	VisitGetSpecEditsResponse(w http.ResponseWriter) error
 }
 
-type AnalyzeRepository200JSONResponse CompletedResponse
+type GetSpecEdits200JSONResponse SpecEditsResponse
 
-func (response AnalyzeRepository200JSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetSpecEdits200JSONResponse) VisitGetSpecEditsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AnalyzeRepository202JSONResponse struct {
-	union json.RawMessage
+type GetSpecEdits400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response AnalyzeRepository202JSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+func (response GetSpecEdits400ApplicationProblemPlusJSONResponse) VisitGetSpecEditsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-	return json.NewEncoder(w).Encode(response.union)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AnalyzeRepository400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type GetSpecEdits401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response AnalyzeRepository400ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetSpecEdits401ApplicationProblemPlusJSONResponse) VisitGetSpecEditsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AnalyzeRepository404ApplicationProblemPlusJSONResponse struct {
+type GetSpecEdits403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetSpecEdits403ApplicationProblemPlusJSONResponse) VisitGetSpecEditsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSpecEdits404ApplicationProblemPlusJSONResponse struct {
 	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response AnalyzeRepository404ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetSpecEdits404ApplicationProblemPlusJSONResponse) VisitGetSpecEditsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AnalyzeRepository429ApplicationProblemPlusJSONResponse struct {
-	TooManyRequestsApplicationProblemPlusJSONResponse
+type GetSpecEdits500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AnalyzeRepository429ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetSpecEdits500ApplicationProblemPlusJSONResponse) VisitGetSpecEditsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(429)
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSpecDocumentRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     ExportSpecDocumentParams
+}
+
+type ExportSpecDocumentResponseObject interface {
+	VisitExportSpecDocumentResponse(w http.ResponseWriter) error
+}
+
+type ExportSpecDocument200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportSpecDocument200ApplicationZipResponse struct {
+	Body          io.Reader
+	Headers       ExportSpecDocument200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSpecDocument200ApplicationZipResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSpecDocument200TextHTMLResponse struct {
+	Body          io.Reader
+	Headers       ExportSpecDocument200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSpecDocument200TextHTMLResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSpecDocument200TextMarkdownResponse struct {
+	Body          io.Reader
+	Headers       ExportSpecDocument200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSpecDocument200TextMarkdownResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/markdown")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type AnalyzeRepository500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+type ExportSpecDocument400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response AnalyzeRepository500ApplicationProblemPlusJSONResponse) VisitAnalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response ExportSpecDocument400ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisHistoryRequestObject struct {
-	Owner Owner `json:"owner"`
-	Repo  Repo  `json:"repo"`
-}
-
-type GetAnalysisHistoryResponseObject interface {
-	VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error
+type ExportSpecDocument401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-type GetAnalysisHistory200JSONResponse AnalysisHistoryResponse
-
-func (response GetAnalysisHistory200JSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response ExportSpecDocument401ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisHistory400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type ExportSpecDocument403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response GetAnalysisHistory400ApplicationProblemPlusJSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
+func (response ExportSpecDocument403ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisHistory404ApplicationProblemPlusJSONResponse struct {
+type ExportSpecDocument404ApplicationProblemPlusJSONResponse struct {
 	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response GetAnalysisHistory404ApplicationProblemPlusJSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
+func (response ExportSpecDocument404ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisHistory500ApplicationProblemPlusJSONResponse struct {
+type ExportSpecDocument500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetAnalysisHistory500ApplicationProblemPlusJSONResponse) VisitGetAnalysisHistoryResponse(w http.ResponseWriter) error {
+func (response ExportSpecDocument500ApplicationProblemPlusJSONResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisStatusRequestObject struct {
-	Owner Owner `json:"owner"`
-	Repo  Repo  `json:"repo"`
+type GetSpecVersionsRequestObject struct {
+	AnalysisID openapi_types.UUID `json:"analysisId"`
+	Params     GetSpecVersionsParams
 }
 
-type GetAnalysisStatusResponseObject interface {
-	VisitGetAnalysisStatusResponse(w http.ResponseWriter) error
+type GetSpecVersionsResponseObject interface {
+	VisitGetSpecVersionsResponse(w http.ResponseWriter) error
 }
 
-type GetAnalysisStatus200JSONResponse AnalysisResponse
+type GetSpecVersions200JSONResponse VersionHistoryResponse
 
-func (response GetAnalysisStatus200JSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+func (response GetSpecVersions200JSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisStatus400ApplicationProblemPlusJSONResponse struct {
+type GetSpecVersions400ApplicationProblemPlusJSONResponse struct {
 	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response GetAnalysisStatus400ApplicationProblemPlusJSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+func (response GetSpecVersions400ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisStatus404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+type GetSpecVersions401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetAnalysisStatus404ApplicationProblemPlusJSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+func (response GetSpecVersions401ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAnalysisStatus500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+type GetSpecVersions403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response GetAnalysisStatus500ApplicationProblemPlusJSONResponse) VisitGetAnalysisStatusResponse(w http.ResponseWriter) error {
+func (response GetSpecVersions403ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthCallbackRequestObject struct {
-	Params AuthCallbackParams
-}
-
-type AuthCallbackResponseObject interface {
-	VisitAuthCallbackResponse(w http.ResponseWriter) error
-}
-
-type AuthCallback302ResponseHeaders struct {
-	Location  string
-	SetCookie string
+type GetSpecVersions404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-type AuthCallback302Response struct {
-	Headers AuthCallback302ResponseHeaders
-}
+func (response GetSpecVersions404ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
-func (response AuthCallback302Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(302)
-	return nil
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthCallback400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type GetSpecVersions500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AuthCallback400ApplicationProblemPlusJSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+func (response GetSpecVersions500ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthCallback500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+type CheckQuotaRequestObject struct {
+	Body *CheckQuotaJSONRequestBody
 }
 
-func (response AuthCallback500ApplicationProblemPlusJSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+type CheckQuotaResponseObject interface {
+	VisitCheckQuotaResponse(w http.ResponseWriter) error
+}
+
+type CheckQuota200JSONResponse CheckQuotaResponse
+
+func (response CheckQuota200JSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthDevLoginRequestObject struct {
-	Body *AuthDevLoginJSONRequestBody
+type CheckQuota400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-type AuthDevLoginResponseObject interface {
-	VisitAuthDevLoginResponse(w http.ResponseWriter) error
-}
+func (response CheckQuota400ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-type AuthDevLogin200ResponseHeaders struct {
-	SetCookie string
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthDevLogin200JSONResponse struct {
-	Body    DevLoginResponse
-	Headers AuthDevLogin200ResponseHeaders
+type CheckQuota401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response AuthDevLogin200JSONResponse) VisitAuthDevLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+func (response CheckQuota401ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthDevLogin403ApplicationProblemPlusJSONResponse struct {
-	ForbiddenApplicationProblemPlusJSONResponse
+type CheckQuota404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response AuthDevLogin403ApplicationProblemPlusJSONResponse) VisitAuthDevLoginResponse(w http.ResponseWriter) error {
+func (response CheckQuota404ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthDevLogin500ApplicationProblemPlusJSONResponse struct {
+type CheckQuota500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AuthDevLogin500ApplicationProblemPlusJSONResponse) VisitAuthDevLoginResponse(w http.ResponseWriter) error {
+func (response CheckQuota500ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthLoginRequestObject struct {
+type GetCurrentUsageRequestObject struct {
 }
 
-type AuthLoginResponseObject interface {
-	VisitAuthLoginResponse(w http.ResponseWriter) error
+type GetCurrentUsageResponseObject interface {
+	VisitGetCurrentUsageResponse(w http.ResponseWriter) error
 }
 
-type AuthLogin200JSONResponse LoginResponse
+type GetCurrentUsage200JSONResponse UsageStatusResponse
 
-func (response AuthLogin200JSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
+func (response GetCurrentUsage200JSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthLogin500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+type GetCurrentUsage401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response AuthLogin500ApplicationProblemPlusJSONResponse) VisitAuthLoginResponse(w http.ResponseWriter) error {
+func (response GetCurrentUsage401ApplicationProblemPlusJSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthLogoutRequestObject struct {
-}
-
-type AuthLogoutResponseObject interface {
-	VisitAuthLogoutResponse(w http.ResponseWriter) error
+type GetCurrentUsage404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-type AuthLogout200JSONResponse LogoutResponse
-
-func (response AuthLogout200JSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response GetCurrentUsage404ApplicationProblemPlusJSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthLogout500ApplicationProblemPlusJSONResponse struct {
+type GetCurrentUsage500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AuthLogout500ApplicationProblemPlusJSONResponse) VisitAuthLogoutResponse(w http.ResponseWriter) error {
+func (response GetCurrentUsage500ApplicationProblemPlusJSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthMeRequestObject struct {
+type ListPersonalAccessTokensRequestObject struct {
 }
 
-type AuthMeResponseObject interface {
-	VisitAuthMeResponse(w http.ResponseWriter) error
+type ListPersonalAccessTokensResponseObject interface {
+	VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error
 }
 
-type AuthMe200JSONResponse UserInfo
+type ListPersonalAccessTokens200JSONResponse PersonalAccessTokenListResponse
 
-func (response AuthMe200JSONResponse) VisitAuthMeResponse(w http.ResponseWriter) error {
+func (response ListPersonalAccessTokens200JSONResponse) VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthMe401ApplicationProblemPlusJSONResponse struct {
+type ListPersonalAccessTokens401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response AuthMe401ApplicationProblemPlusJSONResponse) VisitAuthMeResponse(w http.ResponseWriter) error {
+func (response ListPersonalAccessTokens401ApplicationProblemPlusJSONResponse) VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthMe500ApplicationProblemPlusJSONResponse struct {
+type ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AuthMe500ApplicationProblemPlusJSONResponse) VisitAuthMeResponse(w http.ResponseWriter) error {
+func (response ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse) VisitListPersonalAccessTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthRefreshRequestObject struct {
+type CreatePersonalAccessTokenRequestObject struct {
+	Body *CreatePersonalAccessTokenJSONRequestBody
 }
 
-type AuthRefreshResponseObject interface {
-	VisitAuthRefreshResponse(w http.ResponseWriter) error
+type CreatePersonalAccessTokenResponseObject interface {
+	VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error
 }
 
-type AuthRefresh200ResponseHeaders struct {
-	SetCookie string
+type CreatePersonalAccessToken201JSONResponse CreatePersonalAccessTokenResponse
+
+func (response CreatePersonalAccessToken201JSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthRefresh200JSONResponse struct {
-	Body    RefreshResponse
-	Headers AuthRefresh200ResponseHeaders
+type CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response AuthRefresh200JSONResponse) VisitAuthRefreshResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+func (response CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthRefresh401ApplicationProblemPlusJSONResponse struct {
+type CreatePersonalAccessToken401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response AuthRefresh401ApplicationProblemPlusJSONResponse) VisitAuthRefreshResponse(w http.ResponseWriter) error {
+func (response CreatePersonalAccessToken401ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AuthRefresh500ApplicationProblemPlusJSONResponse struct {
+type CreatePersonalAccessToken409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
+}
+
+func (response CreatePersonalAccessToken409ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AuthRefresh500ApplicationProblemPlusJSONResponse) VisitAuthRefreshResponse(w http.ResponseWriter) error {
+func (response CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse) VisitCreatePersonalAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPricingRequestObject struct {
+type RevokePersonalAccessTokenRequestObject struct {
+	TokenID openapi_types.UUID `json:"tokenId"`
 }
 
-type GetPricingResponseObject interface {
-	VisitGetPricingResponse(w http.ResponseWriter) error
+type RevokePersonalAccessTokenResponseObject interface {
+	VisitRevokePersonalAccessTokenResponse(w http.ResponseWriter) error
 }
 
-type GetPricing200JSONResponse PricingResponse
+type RevokePersonalAccessToken204Response struct {
+}
+
+func (response RevokePersonalAccessToken204Response) VisitRevokePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokePersonalAccessToken401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response RevokePersonalAccessToken401ApplicationProblemPlusJSONResponse) VisitRevokePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokePersonalAccessToken404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
+}
 
-func (response GetPricing200JSONResponse) VisitGetPricingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response RevokePersonalAccessToken404ApplicationProblemPlusJSONResponse) VisitRevokePersonalAccessTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPricing500ApplicationProblemPlusJSONResponse struct {
+type RevokePersonalAccessToken500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetPricing500ApplicationProblemPlusJSONResponse) VisitGetPricingResponse(w http.ResponseWriter) error {
+func (response RevokePersonalAccessToken500ApplicationProblemPlusJSONResponse) VisitRevokePersonalAccessTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecentRepositoriesRequestObject struct {
-	Params GetRecentRepositoriesParams
+type GetUserActiveTasksRequestObject struct {
 }
 
-type GetRecentRepositoriesResponseObject interface {
-	VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error
+type GetUserActiveTasksResponseObject interface {
+	VisitGetUserActiveTasksResponse(w http.ResponseWriter) error
 }
 
-type GetRecentRepositories200JSONResponse PaginatedRepositoriesResponse
+type GetUserActiveTasks200JSONResponse UserActiveTasksResponse
 
-func (response GetRecentRepositories200JSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
+func (response GetUserActiveTasks200JSONResponse) VisitGetUserActiveTasksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecentRepositories400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
-}
-
-func (response GetRecentRepositories400ApplicationProblemPlusJSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetRecentRepositories401ApplicationProblemPlusJSONResponse struct {
+type GetUserActiveTasks401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetRecentRepositories401ApplicationProblemPlusJSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
+func (response GetUserActiveTasks401ApplicationProblemPlusJSONResponse) VisitGetUserActiveTasksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRecentRepositories500ApplicationProblemPlusJSONResponse struct {
+type GetUserActiveTasks500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetRecentRepositories500ApplicationProblemPlusJSONResponse) VisitGetRecentRepositoriesResponse(w http.ResponseWriter) error {
+func (response GetUserActiveTasks500ApplicationProblemPlusJSONResponse) VisitGetUserActiveTasksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRepositoryStatsRequestObject struct {
+type GetUserAnalyzedRepositoriesRequestObject struct {
+	Params GetUserAnalyzedRepositoriesParams
 }
 
-type GetRepositoryStatsResponseObject interface {
-	VisitGetRepositoryStatsResponse(w http.ResponseWriter) error
+type GetUserAnalyzedRepositoriesResponseObject interface {
+	VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error
 }
 
-type GetRepositoryStats200JSONResponse RepositoryStatsResponse
+type GetUserAnalyzedRepositories200JSONResponse UserAnalyzedRepositoriesResponse
 
-func (response GetRepositoryStats200JSONResponse) VisitGetRepositoryStatsResponse(w http.ResponseWriter) error {
+func (response GetUserAnalyzedRepositories200JSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRepositoryStats401ApplicationProblemPlusJSONResponse struct {
+type GetUserAnalyzedRepositories400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
+}
+
+func (response GetUserAnalyzedRepositories400ApplicationProblemPlusJSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserAnalyzedRepositories401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetRepositoryStats401ApplicationProblemPlusJSONResponse) VisitGetRepositoryStatsResponse(w http.ResponseWriter) error {
+func (response GetUserAnalyzedRepositories401ApplicationProblemPlusJSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRepositoryStats500ApplicationProblemPlusJSONResponse struct {
+type GetUserAnalyzedRepositories500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetRepositoryStats500ApplicationProblemPlusJSONResponse) VisitGetRepositoryStatsResponse(w http.ResponseWriter) error {
+func (response GetUserAnalyzedRepositories500ApplicationProblemPlusJSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveBookmarkRequestObject struct {
-	Owner Owner `json:"owner"`
-	Repo  Repo  `json:"repo"`
+type AddUserAnalyzedRepositoryRequestObject struct {
+	Body *AddUserAnalyzedRepositoryJSONRequestBody
 }
 
-type RemoveBookmarkResponseObject interface {
-	VisitRemoveBookmarkResponse(w http.ResponseWriter) error
+type AddUserAnalyzedRepositoryResponseObject interface {
+	VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error
 }
 
-type RemoveBookmark200JSONResponse BookmarkResponse
+type AddUserAnalyzedRepository200JSONResponse AddAnalyzedRepositoryResponse
 
-func (response RemoveBookmark200JSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+func (response AddUserAnalyzedRepository200JSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveBookmark400ApplicationProblemPlusJSONResponse struct {
+type AddUserAnalyzedRepository400ApplicationProblemPlusJSONResponse struct {
 	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response RemoveBookmark400ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+func (response AddUserAnalyzedRepository400ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveBookmark401ApplicationProblemPlusJSONResponse struct {
+type AddUserAnalyzedRepository401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response RemoveBookmark401ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+func (response AddUserAnalyzedRepository401ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveBookmark404ApplicationProblemPlusJSONResponse struct {
+type AddUserAnalyzedRepository404ApplicationProblemPlusJSONResponse struct {
 	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response RemoveBookmark404ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+func (response AddUserAnalyzedRepository404ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RemoveBookmark500ApplicationProblemPlusJSONResponse struct {
+type AddUserAnalyzedRepository500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response RemoveBookmark500ApplicationProblemPlusJSONResponse) VisitRemoveBookmarkResponse(w http.ResponseWriter) error {
+func (response AddUserAnalyzedRepository500ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBookmarkRequestObject struct {
-	Owner Owner `json:"owner"`
-	Repo  Repo  `json:"repo"`
+type GetUserBookmarksRequestObject struct {
 }
 
-type AddBookmarkResponseObject interface {
-	VisitAddBookmarkResponse(w http.ResponseWriter) error
+type GetUserBookmarksResponseObject interface {
+	VisitGetUserBookmarksResponse(w http.ResponseWriter) error
 }
 
-type AddBookmark200JSONResponse BookmarkResponse
+type GetUserBookmarks200JSONResponse BookmarkedRepositoriesResponse
 
-func (response AddBookmark200JSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+func (response GetUserBookmarks200JSONResponse) VisitGetUserBookmarksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBookmark400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type GetUserBookmarks401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response AddBookmark400ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+func (response GetUserBookmarks401ApplicationProblemPlusJSONResponse) VisitGetUserBookmarksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBookmark401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type GetUserBookmarks500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AddBookmark401ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+func (response GetUserBookmarks500ApplicationProblemPlusJSONResponse) VisitGetUserBookmarksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBookmark404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+type GetGitHubAppInstallURLRequestObject struct {
 }
 
-func (response AddBookmark404ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+type GetGitHubAppInstallURLResponseObject interface {
+	VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error
+}
+
+type GetGitHubAppInstallURL200JSONResponse GitHubAppInstallURLResponse
+
+func (response GetGitHubAppInstallURL200JSONResponse) VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGitHubAppInstallURL401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetGitHubAppInstallURL401ApplicationProblemPlusJSONResponse) VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type AddBookmark500ApplicationProblemPlusJSONResponse struct {
+type GetGitHubAppInstallURL500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response AddBookmark500ApplicationProblemPlusJSONResponse) VisitAddBookmarkResponse(w http.ResponseWriter) error {
+func (response GetGitHubAppInstallURL500ApplicationProblemPlusJSONResponse) VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReanalyzeRepositoryRequestObject struct {
-	Owner Owner `json:"owner"`
-	Repo  Repo  `json:"repo"`
+type GetUserGitHubAppInstallationsRequestObject struct {
 }
 
-type ReanalyzeRepositoryResponseObject interface {
-	VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error
+type GetUserGitHubAppInstallationsResponseObject interface {
+	VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error
 }
 
-type ReanalyzeRepository202JSONResponse struct {
-	union json.RawMessage
-}
+type GetUserGitHubAppInstallations200JSONResponse GitHubAppInstallationsResponse
 
-func (response ReanalyzeRepository202JSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubAppInstallations200JSONResponse) VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response.union)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ReanalyzeRepository400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type GetUserGitHubAppInstallations401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response ReanalyzeRepository400ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubAppInstallations401ApplicationProblemPlusJSONResponse) VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReanalyzeRepository401ApplicationProblemPlusJSONResponse struct {
+type GetUserGitHubAppInstallations500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetUserGitHubAppInstallations500ApplicationProblemPlusJSONResponse) VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserGitHubOrganizationsRequestObject struct {
+	Params GetUserGitHubOrganizationsParams
+}
+
+type GetUserGitHubOrganizationsResponseObject interface {
+	VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error
+}
+
+type GetUserGitHubOrganizations200JSONResponse GitHubOrganizationsResponse
+
+func (response GetUserGitHubOrganizations200JSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserGitHubOrganizations401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response ReanalyzeRepository401ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubOrganizations401ApplicationProblemPlusJSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReanalyzeRepository404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+type GetUserGitHubOrganizations429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
 }
 
-func (response ReanalyzeRepository404ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubOrganizations429ApplicationProblemPlusJSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ReanalyzeRepository500ApplicationProblemPlusJSONResponse struct {
+type GetUserGitHubOrganizations500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response ReanalyzeRepository500ApplicationProblemPlusJSONResponse) VisitReanalyzeRepositoryResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubOrganizations500ApplicationProblemPlusJSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUpdateStatusRequestObject struct {
-	Owner Owner `json:"owner"`
-	Repo  Repo  `json:"repo"`
+type GetOrganizationRepositoriesRequestObject struct {
+	Org    string `json:"org"`
+	Params GetOrganizationRepositoriesParams
 }
 
-type GetUpdateStatusResponseObject interface {
-	VisitGetUpdateStatusResponse(w http.ResponseWriter) error
+type GetOrganizationRepositoriesResponseObject interface {
+	VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error
 }
 
-type GetUpdateStatus200JSONResponse UpdateStatusResponse
+type GetOrganizationRepositories200JSONResponse GitHubRepositoriesResponse
 
-func (response GetUpdateStatus200JSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+func (response GetOrganizationRepositories200JSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUpdateStatus400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type GetOrganizationRepositories401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetUpdateStatus400ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+func (response GetOrganizationRepositories401ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUpdateStatus401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type GetOrganizationRepositories404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response GetUpdateStatus401ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+func (response GetOrganizationRepositories404ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUpdateStatus404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+type GetOrganizationRepositories429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
 }
 
-func (response GetUpdateStatus404ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+func (response GetOrganizationRepositories429ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUpdateStatus500ApplicationProblemPlusJSONResponse struct {
+type GetOrganizationRepositories500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetUpdateStatus500ApplicationProblemPlusJSONResponse) VisitGetUpdateStatusResponse(w http.ResponseWriter) error {
+func (response GetOrganizationRepositories500ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGenerationRequestObject struct {
-	Body *RequestSpecGenerationJSONRequestBody
+type GetUserGitHubRepositoriesRequestObject struct {
+	Params GetUserGitHubRepositoriesParams
 }
 
-type RequestSpecGenerationResponseObject interface {
-	VisitRequestSpecGenerationResponse(w http.ResponseWriter) error
+type GetUserGitHubRepositoriesResponseObject interface {
+	VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error
 }
 
-type RequestSpecGeneration202JSONResponse RequestSpecGenerationResponse
+type GetUserGitHubRepositories200JSONResponse GitHubRepositoriesResponse
 
-func (response RequestSpecGeneration202JSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubRepositories200JSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGeneration400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type GetUserGitHubRepositories401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response RequestSpecGeneration400ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubRepositories401ApplicationProblemPlusJSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGeneration401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type GetUserGitHubRepositories429ApplicationProblemPlusJSONResponse struct {
+	TooManyRequestsApplicationProblemPlusJSONResponse
 }
 
-func (response RequestSpecGeneration401ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubRepositories429ApplicationProblemPlusJSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGeneration403ApplicationProblemPlusJSONResponse struct {
-	ForbiddenApplicationProblemPlusJSONResponse
+type GetUserGitHubRepositories500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response RequestSpecGeneration403ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+func (response GetUserGitHubRepositories500ApplicationProblemPlusJSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGeneration404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+type GetUserSubscriptionRequestObject struct {
 }
 
-func (response RequestSpecGeneration404ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+type GetUserSubscriptionResponseObject interface {
+	VisitGetUserSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type GetUserSubscription200JSONResponse UserSubscriptionResponse
+
+func (response GetUserSubscription200JSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGeneration409ApplicationProblemPlusJSONResponse ProblemDetail
+type GetUserSubscription401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
 
-func (response RequestSpecGeneration409ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+func (response GetUserSubscription401ApplicationProblemPlusJSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGeneration429ApplicationProblemPlusJSONResponse struct {
-	TooManyRequestsApplicationProblemPlusJSONResponse
+type GetUserSubscription404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response RequestSpecGeneration429ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+func (response GetUserSubscription404ApplicationProblemPlusJSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(429)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RequestSpecGeneration500ApplicationProblemPlusJSONResponse struct {
+type GetUserSubscription500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response RequestSpecGeneration500ApplicationProblemPlusJSONResponse) VisitRequestSpecGenerationResponse(w http.ResponseWriter) error {
+func (response GetUserSubscription500ApplicationProblemPlusJSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocumentByRepositoryRequestObject struct {
-	Owner  string `json:"owner"`
-	Repo   string `json:"repo"`
-	Params GetSpecDocumentByRepositoryParams
+type ListWorkspaceInvitationsRequestObject struct {
 }
 
-type GetSpecDocumentByRepositoryResponseObject interface {
-	VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error
+type ListWorkspaceInvitationsResponseObject interface {
+	VisitListWorkspaceInvitationsResponse(w http.ResponseWriter) error
 }
 
-type GetSpecDocumentByRepository200JSONResponse RepoSpecDocumentResponse
+type ListWorkspaceInvitations200JSONResponse WorkspaceInvitationListResponse
 
-func (response GetSpecDocumentByRepository200JSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+func (response ListWorkspaceInvitations200JSONResponse) VisitListWorkspaceInvitationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocumentByRepository400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type ListWorkspaceInvitations401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecDocumentByRepository400ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+func (response ListWorkspaceInvitations401ApplicationProblemPlusJSONResponse) VisitListWorkspaceInvitationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocumentByRepository401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type ListWorkspaceInvitations500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecDocumentByRepository401ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+func (response ListWorkspaceInvitations500ApplicationProblemPlusJSONResponse) VisitListWorkspaceInvitationsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocumentByRepository403ApplicationProblemPlusJSONResponse struct {
-	ForbiddenApplicationProblemPlusJSONResponse
+type DeclineWorkspaceInvitationRequestObject struct {
+	InvitationID openapi_types.UUID `json:"invitationId"`
 }
 
-func (response GetSpecDocumentByRepository403ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+type DeclineWorkspaceInvitationResponseObject interface {
+	VisitDeclineWorkspaceInvitationResponse(w http.ResponseWriter) error
+}
+
+type DeclineWorkspaceInvitation204Response struct {
+}
+
+func (response DeclineWorkspaceInvitation204Response) VisitDeclineWorkspaceInvitationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeclineWorkspaceInvitation401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeclineWorkspaceInvitation401ApplicationProblemPlusJSONResponse) VisitDeclineWorkspaceInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocumentByRepository404ApplicationProblemPlusJSONResponse struct {
+type DeclineWorkspaceInvitation404ApplicationProblemPlusJSONResponse struct {
 	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecDocumentByRepository404ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+func (response DeclineWorkspaceInvitation404ApplicationProblemPlusJSONResponse) VisitDeclineWorkspaceInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocumentByRepository500ApplicationProblemPlusJSONResponse struct {
+type DeclineWorkspaceInvitation500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecDocumentByRepository500ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+func (response DeclineWorkspaceInvitation500ApplicationProblemPlusJSONResponse) VisitDeclineWorkspaceInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionHistoryByRepositoryRequestObject struct {
-	Owner  string `json:"owner"`
-	Repo   string `json:"repo"`
-	Params GetVersionHistoryByRepositoryParams
+type AcceptWorkspaceInvitationRequestObject struct {
+	InvitationID openapi_types.UUID `json:"invitationId"`
 }
 
-type GetVersionHistoryByRepositoryResponseObject interface {
-	VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error
+type AcceptWorkspaceInvitationResponseObject interface {
+	VisitAcceptWorkspaceInvitationResponse(w http.ResponseWriter) error
 }
 
-type GetVersionHistoryByRepository200JSONResponse RepoVersionHistoryResponse
+type AcceptWorkspaceInvitation200JSONResponse Workspace
 
-func (response GetVersionHistoryByRepository200JSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+func (response AcceptWorkspaceInvitation200JSONResponse) VisitAcceptWorkspaceInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionHistoryByRepository400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
-}
-
-func (response GetVersionHistoryByRepository400ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetVersionHistoryByRepository401ApplicationProblemPlusJSONResponse struct {
+type AcceptWorkspaceInvitation401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetVersionHistoryByRepository401ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+func (response AcceptWorkspaceInvitation401ApplicationProblemPlusJSONResponse) VisitAcceptWorkspaceInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionHistoryByRepository403ApplicationProblemPlusJSONResponse struct {
-	ForbiddenApplicationProblemPlusJSONResponse
-}
-
-func (response GetVersionHistoryByRepository403ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetVersionHistoryByRepository404ApplicationProblemPlusJSONResponse struct {
+type AcceptWorkspaceInvitation404ApplicationProblemPlusJSONResponse struct {
 	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response GetVersionHistoryByRepository404ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+func (response AcceptWorkspaceInvitation404ApplicationProblemPlusJSONResponse) VisitAcceptWorkspaceInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetVersionHistoryByRepository500ApplicationProblemPlusJSONResponse struct {
+type AcceptWorkspaceInvitation500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetVersionHistoryByRepository500ApplicationProblemPlusJSONResponse) VisitGetVersionHistoryByRepositoryResponse(w http.ResponseWriter) error {
+func (response AcceptWorkspaceInvitation500ApplicationProblemPlusJSONResponse) VisitAcceptWorkspaceInvitationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecGenerationStatusRequestObject struct {
-	AnalysisID openapi_types.UUID `json:"analysisId"`
-	Params     GetSpecGenerationStatusParams
+type HandleGitHubAppWebhookRequestObject struct {
+	Params HandleGitHubAppWebhookParams
+	Body   *HandleGitHubAppWebhookJSONRequestBody
 }
 
-type GetSpecGenerationStatusResponseObject interface {
-	VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error
+type HandleGitHubAppWebhookResponseObject interface {
+	VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error
 }
 
-type GetSpecGenerationStatus200JSONResponse SpecGenerationStatusResponse
+type HandleGitHubAppWebhook200JSONResponse WebhookResponse
 
-func (response GetSpecGenerationStatus200JSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+func (response HandleGitHubAppWebhook200JSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecGenerationStatus400ApplicationProblemPlusJSONResponse struct {
+type HandleGitHubAppWebhook400ApplicationProblemPlusJSONResponse struct {
 	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecGenerationStatus400ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+func (response HandleGitHubAppWebhook400ApplicationProblemPlusJSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecGenerationStatus401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
-}
+type HandleGitHubAppWebhook401ApplicationProblemPlusJSONResponse ProblemDetail
 
-func (response GetSpecGenerationStatus401ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+func (response HandleGitHubAppWebhook401ApplicationProblemPlusJSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecGenerationStatus403ApplicationProblemPlusJSONResponse struct {
-	ForbiddenApplicationProblemPlusJSONResponse
-}
-
-func (response GetSpecGenerationStatus403ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetSpecGenerationStatus404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
-}
-
-func (response GetSpecGenerationStatus404ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetSpecGenerationStatus500ApplicationProblemPlusJSONResponse struct {
+type HandleGitHubAppWebhook500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecGenerationStatus500ApplicationProblemPlusJSONResponse) VisitGetSpecGenerationStatusResponse(w http.ResponseWriter) error {
+func (response HandleGitHubAppWebhook500ApplicationProblemPlusJSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocumentRequestObject struct {
-	AnalysisID openapi_types.UUID `json:"analysisId"`
-	Params     GetSpecDocumentParams
+type ListWorkspacesRequestObject struct {
 }
 
-type GetSpecDocumentResponseObject interface {
-	VisitGetSpecDocumentResponse(w http.ResponseWriter) error
+type ListWorkspacesResponseObject interface {
+	VisitListWorkspacesResponse(w http.ResponseWriter) error
 }
 
-type GetSpecDocument200JSONResponse SpecDocumentResponse
+type ListWorkspaces200JSONResponse WorkspaceListResponse
 
-func (response GetSpecDocument200JSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+func (response ListWorkspaces200JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocument401ApplicationProblemPlusJSONResponse struct {
+type ListWorkspaces401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecDocument401ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+func (response ListWorkspaces401ApplicationProblemPlusJSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecDocument403ApplicationProblemPlusJSONResponse struct {
-	ForbiddenApplicationProblemPlusJSONResponse
-}
-
-func (response GetSpecDocument403ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetSpecDocument404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
-}
-
-func (response GetSpecDocument404ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetSpecDocument500ApplicationProblemPlusJSONResponse struct {
+type ListWorkspaces500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecDocument500ApplicationProblemPlusJSONResponse) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
+func (response ListWorkspaces500ApplicationProblemPlusJSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCacheAvailabilityRequestObject struct {
-	AnalysisID openapi_types.UUID `json:"analysisId"`
+type CreateWorkspaceRequestObject struct {
+	Body *CreateWorkspaceJSONRequestBody
 }
 
-type GetSpecCacheAvailabilityResponseObject interface {
-	VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error
+type CreateWorkspaceResponseObject interface {
+	VisitCreateWorkspaceResponse(w http.ResponseWriter) error
 }
 
-type GetSpecCacheAvailability200JSONResponse CacheAvailabilityResponse
+type CreateWorkspace201JSONResponse Workspace
 
-func (response GetSpecCacheAvailability200JSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+func (response CreateWorkspace201JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCacheAvailability401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type CreateWorkspace400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecCacheAvailability401ApplicationProblemPlusJSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+func (response CreateWorkspace400ApplicationProblemPlusJSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
-
-type GetSpecCacheAvailability404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+
+type CreateWorkspace401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecCacheAvailability404ApplicationProblemPlusJSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+func (response CreateWorkspace401ApplicationProblemPlusJSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCacheAvailability500ApplicationProblemPlusJSONResponse struct {
+type CreateWorkspace500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecCacheAvailability500ApplicationProblemPlusJSONResponse) VisitGetSpecCacheAvailabilityResponse(w http.ResponseWriter) error {
+func (response CreateWorkspace500ApplicationProblemPlusJSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCachePredictionRequestObject struct {
-	AnalysisID openapi_types.UUID `json:"analysisId"`
-	Params     GetSpecCachePredictionParams
+type InviteWorkspaceMemberRequestObject struct {
+	WorkspaceID openapi_types.UUID `json:"workspaceId"`
+	Body        *InviteWorkspaceMemberJSONRequestBody
 }
 
-type GetSpecCachePredictionResponseObject interface {
-	VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error
+type InviteWorkspaceMemberResponseObject interface {
+	VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error
 }
 
-type GetSpecCachePrediction200JSONResponse CachePredictionResponse
+type InviteWorkspaceMember201JSONResponse WorkspaceInvitation
 
-func (response GetSpecCachePrediction200JSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+func (response InviteWorkspaceMember201JSONResponse) VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCachePrediction400ApplicationProblemPlusJSONResponse struct {
+type InviteWorkspaceMember400ApplicationProblemPlusJSONResponse struct {
 	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecCachePrediction400ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+func (response InviteWorkspaceMember400ApplicationProblemPlusJSONResponse) VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCachePrediction401ApplicationProblemPlusJSONResponse struct {
+type InviteWorkspaceMember401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecCachePrediction401ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+func (response InviteWorkspaceMember401ApplicationProblemPlusJSONResponse) VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCachePrediction404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+type InviteWorkspaceMember403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecCachePrediction404ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+func (response InviteWorkspaceMember403ApplicationProblemPlusJSONResponse) VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecCachePrediction500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+type InviteWorkspaceMember404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecCachePrediction500ApplicationProblemPlusJSONResponse) VisitGetSpecCachePredictionResponse(w http.ResponseWriter) error {
+func (response InviteWorkspaceMember404ApplicationProblemPlusJSONResponse) VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecVersionsRequestObject struct {
-	AnalysisID openapi_types.UUID `json:"analysisId"`
-	Params     GetSpecVersionsParams
-}
-
-type GetSpecVersionsResponseObject interface {
-	VisitGetSpecVersionsResponse(w http.ResponseWriter) error
+type InviteWorkspaceMember409ApplicationProblemPlusJSONResponse struct {
+	ConflictApplicationProblemPlusJSONResponse
 }
 
-type GetSpecVersions200JSONResponse VersionHistoryResponse
-
-func (response GetSpecVersions200JSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response InviteWorkspaceMember409ApplicationProblemPlusJSONResponse) VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecVersions400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type InviteWorkspaceMember500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecVersions400ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
+func (response InviteWorkspaceMember500ApplicationProblemPlusJSONResponse) VisitInviteWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecVersions401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type ListWorkspaceMembersRequestObject struct {
+	WorkspaceID openapi_types.UUID `json:"workspaceId"`
 }
 
-func (response GetSpecVersions401ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+type ListWorkspaceMembersResponseObject interface {
+	VisitListWorkspaceMembersResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaceMembers200JSONResponse WorkspaceMemberListResponse
+
+func (response ListWorkspaceMembers200JSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecVersions403ApplicationProblemPlusJSONResponse struct {
-	ForbiddenApplicationProblemPlusJSONResponse
+type ListWorkspaceMembers401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecVersions403ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
+func (response ListWorkspaceMembers401ApplicationProblemPlusJSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecVersions404ApplicationProblemPlusJSONResponse struct {
+type ListWorkspaceMembers404ApplicationProblemPlusJSONResponse struct {
 	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecVersions404ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
+func (response ListWorkspaceMembers404ApplicationProblemPlusJSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSpecVersions500ApplicationProblemPlusJSONResponse struct {
+type ListWorkspaceMembers500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetSpecVersions500ApplicationProblemPlusJSONResponse) VisitGetSpecVersionsResponse(w http.ResponseWriter) error {
+func (response ListWorkspaceMembers500ApplicationProblemPlusJSONResponse) VisitListWorkspaceMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckQuotaRequestObject struct {
-	Body *CheckQuotaJSONRequestBody
+type RemoveWorkspaceMemberRequestObject struct {
+	WorkspaceID openapi_types.UUID `json:"workspaceId"`
+	UserID      openapi_types.UUID `json:"userId"`
 }
 
-type CheckQuotaResponseObject interface {
-	VisitCheckQuotaResponse(w http.ResponseWriter) error
+type RemoveWorkspaceMemberResponseObject interface {
+	VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error
 }
 
-type CheckQuota200JSONResponse CheckQuotaResponse
-
-func (response CheckQuota200JSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type RemoveWorkspaceMember204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response RemoveWorkspaceMember204Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type CheckQuota400ApplicationProblemPlusJSONResponse struct {
+type RemoveWorkspaceMember400ApplicationProblemPlusJSONResponse struct {
 	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response CheckQuota400ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
+func (response RemoveWorkspaceMember400ApplicationProblemPlusJSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckQuota401ApplicationProblemPlusJSONResponse struct {
+type RemoveWorkspaceMember401ApplicationProblemPlusJSONResponse struct {
 	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response CheckQuota401ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
+func (response RemoveWorkspaceMember401ApplicationProblemPlusJSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckQuota404ApplicationProblemPlusJSONResponse struct {
+type RemoveWorkspaceMember403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response RemoveWorkspaceMember403ApplicationProblemPlusJSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkspaceMember404ApplicationProblemPlusJSONResponse struct {
 	NotFoundApplicationProblemPlusJSONResponse
 }
 
-func (response CheckQuota404ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
+func (response RemoveWorkspaceMember404ApplicationProblemPlusJSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CheckQuota500ApplicationProblemPlusJSONResponse struct {
+type RemoveWorkspaceMember500ApplicationProblemPlusJSONResponse struct {
 	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response CheckQuota500ApplicationProblemPlusJSONResponse) VisitCheckQuotaResponse(w http.ResponseWriter) error {
+func (response RemoveWorkspaceMember500ApplicationProblemPlusJSONResponse) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCurrentUsageRequestObject struct {
+type UpdateWorkspaceMemberRoleRequestObject struct {
+	WorkspaceID openapi_types.UUID `json:"workspaceId"`
+	UserID      openapi_types.UUID `json:"userId"`
+	Body        *UpdateWorkspaceMemberRoleJSONRequestBody
 }
 
-type GetCurrentUsageResponseObject interface {
-	VisitGetCurrentUsageResponse(w http.ResponseWriter) error
+type UpdateWorkspaceMemberRoleResponseObject interface {
+	VisitUpdateWorkspaceMemberRoleResponse(w http.ResponseWriter) error
 }
 
-type GetCurrentUsage200JSONResponse UsageStatusResponse
+type UpdateWorkspaceMemberRole200JSONResponse WorkspaceMember
 
-func (response GetCurrentUsage200JSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
+func (response UpdateWorkspaceMemberRole200JSONResponse) VisitUpdateWorkspaceMemberRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCurrentUsage401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type UpdateWorkspaceMemberRole400ApplicationProblemPlusJSONResponse struct {
+	BadRequestApplicationProblemPlusJSONResponse
 }
 
-func (response GetCurrentUsage401ApplicationProblemPlusJSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
+func (response UpdateWorkspaceMemberRole400ApplicationProblemPlusJSONResponse) VisitUpdateWorkspaceMemberRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCurrentUsage404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
+type UpdateWorkspaceMemberRole401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
 }
 
-func (response GetCurrentUsage404ApplicationProblemPlusJSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
+func (response UpdateWorkspaceMemberRole401ApplicationProblemPlusJSONResponse) VisitUpdateWorkspaceMemberRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCurrentUsage500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+type UpdateWorkspaceMemberRole403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
 }
 
-func (response GetCurrentUsage500ApplicationProblemPlusJSONResponse) VisitGetCurrentUsageResponse(w http.ResponseWriter) error {
+func (response UpdateWorkspaceMemberRole403ApplicationProblemPlusJSONResponse) VisitUpdateWorkspaceMemberRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserActiveTasksRequestObject struct {
-}
-
-type GetUserActiveTasksResponseObject interface {
-	VisitGetUserActiveTasksResponse(w http.ResponseWriter) error
+type UpdateWorkspaceMemberRole404ApplicationProblemPlusJSONResponse struct {
+	NotFoundApplicationProblemPlusJSONResponse
 }
 
-type GetUserActiveTasks200JSONResponse UserActiveTasksResponse
-
-func (response GetUserActiveTasks200JSONResponse) VisitGetUserActiveTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response UpdateWorkspaceMemberRole404ApplicationProblemPlusJSONResponse) VisitUpdateWorkspaceMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserActiveTasks401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+type UpdateWorkspaceMemberRole500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
 }
 
-func (response GetUserActiveTasks401ApplicationProblemPlusJSONResponse) VisitGetUserActiveTasksResponse(w http.ResponseWriter) error {
+func (response UpdateWorkspaceMemberRole500ApplicationProblemPlusJSONResponse) VisitUpdateWorkspaceMemberRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserActiveTasks500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List bulk re-analysis runs
+	// (GET /api/admin/bulk-reanalysis)
+	ListBulkReanalysisRuns(ctx context.Context, request ListBulkReanalysisRunsRequestObject) (ListBulkReanalysisRunsResponseObject, error)
+	// Start bulk re-analysis for outdated parser versions
+	// (POST /api/admin/bulk-reanalysis)
+	StartBulkReanalysis(ctx context.Context, request StartBulkReanalysisRequestObject) (StartBulkReanalysisResponseObject, error)
+	// Get bulk re-analysis progress
+	// (GET /api/admin/bulk-reanalysis/{runId})
+	GetBulkReanalysisRun(ctx context.Context, request GetBulkReanalysisRunRequestObject) (GetBulkReanalysisRunResponseObject, error)
+	// Analyze many repositories at once
+	// (POST /api/analysis-batches)
+	StartAnalysisBatch(ctx context.Context, request StartAnalysisBatchRequestObject) (StartAnalysisBatchResponseObject, error)
+	// Get analysis batch progress
+	// (GET /api/analysis-batches/{batchId})
+	GetAnalysisBatch(ctx context.Context, request GetAnalysisBatchRequestObject) (GetAnalysisBatchResponseObject, error)
+	// Analyze repository test specifications
+	// (GET /api/analyze/{owner}/{repo})
+	AnalyzeRepository(ctx context.Context, request AnalyzeRepositoryRequestObject) (AnalyzeRepositoryResponseObject, error)
+	// Get analysis history for a repository
+	// (GET /api/analyze/{owner}/{repo}/history)
+	GetAnalysisHistory(ctx context.Context, request GetAnalysisHistoryRequestObject) (GetAnalysisHistoryResponseObject, error)
+	// Upload a parsed test inventory from CI
+	// (POST /api/analyze/{owner}/{repo}/ingest)
+	IngestAnalysis(ctx context.Context, request IngestAnalysisRequestObject) (IngestAnalysisResponseObject, error)
+	// Get analysis status
+	// (GET /api/analyze/{owner}/{repo}/status)
+	GetAnalysisStatus(ctx context.Context, request GetAnalysisStatusRequestObject) (GetAnalysisStatusResponseObject, error)
+	// GitHub OAuth callback
+	// (GET /api/auth/callback)
+	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
+	// Development-only test login
+	// (POST /api/auth/dev-login)
+	AuthDevLogin(ctx context.Context, request AuthDevLoginRequestObject) (AuthDevLoginResponseObject, error)
+	// Initiate GitHub OAuth login
+	// (GET /api/auth/login)
+	AuthLogin(ctx context.Context, request AuthLoginRequestObject) (AuthLoginResponseObject, error)
+	// Logout and clear authentication
+	// (POST /api/auth/logout)
+	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
+	// Get current user info
+	// (GET /api/auth/me)
+	AuthMe(ctx context.Context, request AuthMeRequestObject) (AuthMeResponseObject, error)
+	// Refresh authentication tokens
+	// (POST /api/auth/refresh)
+	AuthRefresh(ctx context.Context, request AuthRefreshRequestObject) (AuthRefreshResponseObject, error)
+	// Get organization test dashboard
+	// (GET /api/organizations/{org}/dashboard)
+	GetOrgDashboard(ctx context.Context, request GetOrgDashboardRequestObject) (GetOrgDashboardResponseObject, error)
+	// Get subscription plan pricing
+	// (GET /api/pricing)
+	GetPricing(ctx context.Context, request GetPricingRequestObject) (GetPricingResponseObject, error)
+	// Get recently analyzed repositories
+	// (GET /api/repositories/recent)
+	GetRecentRepositories(ctx context.Context, request GetRecentRepositoriesRequestObject) (GetRecentRepositoriesResponseObject, error)
+	// Get repository statistics
+	// (GET /api/repositories/stats)
+	GetRepositoryStats(ctx context.Context, request GetRepositoryStatsRequestObject) (GetRepositoryStatsResponseObject, error)
+	// Remove bookmark
+	// (DELETE /api/repositories/{owner}/{repo}/bookmark)
+	RemoveBookmark(ctx context.Context, request RemoveBookmarkRequestObject) (RemoveBookmarkResponseObject, error)
+	// Bookmark a repository
+	// (POST /api/repositories/{owner}/{repo}/bookmark)
+	AddBookmark(ctx context.Context, request AddBookmarkRequestObject) (AddBookmarkResponseObject, error)
+	// Trigger repository re-analysis
+	// (POST /api/repositories/{owner}/{repo}/reanalyze)
+	ReanalyzeRepository(ctx context.Context, request ReanalyzeRepositoryRequestObject) (ReanalyzeRepositoryResponseObject, error)
+	// Check repository update status
+	// (GET /api/repositories/{owner}/{repo}/update-status)
+	GetUpdateStatus(ctx context.Context, request GetUpdateStatusRequestObject) (GetUpdateStatusResponseObject, error)
+	// Revert a spec behavior edit
+	// (DELETE /api/spec-view/behaviors/{behaviorId}/edit)
+	RevertSpecBehaviorEdit(ctx context.Context, request RevertSpecBehaviorEditRequestObject) (RevertSpecBehaviorEditResponseObject, error)
+	// Edit a spec behavior
+	// (PUT /api/spec-view/behaviors/{behaviorId}/edit)
+	EditSpecBehavior(ctx context.Context, request EditSpecBehaviorRequestObject) (EditSpecBehaviorResponseObject, error)
+	// Reply to a comment thread
+	// (POST /api/spec-view/comment-threads/{threadId}/comments)
+	AddSpecComment(ctx context.Context, request AddSpecCommentRequestObject) (AddSpecCommentResponseObject, error)
+	// Reopen a resolved comment thread
+	// (DELETE /api/spec-view/comment-threads/{threadId}/resolution)
+	ReopenSpecCommentThread(ctx context.Context, request ReopenSpecCommentThreadRequestObject) (ReopenSpecCommentThreadResponseObject, error)
+	// Resolve a comment thread
+	// (PUT /api/spec-view/comment-threads/{threadId}/resolution)
+	ResolveSpecCommentThread(ctx context.Context, request ResolveSpecCommentThreadRequestObject) (ResolveSpecCommentThreadResponseObject, error)
+	// Delete a comment
+	// (DELETE /api/spec-view/comments/{commentId})
+	DeleteSpecComment(ctx context.Context, request DeleteSpecCommentRequestObject) (DeleteSpecCommentResponseObject, error)
+	// Edit a comment
+	// (PUT /api/spec-view/comments/{commentId})
+	UpdateSpecComment(ctx context.Context, request UpdateSpecCommentRequestObject) (UpdateSpecCommentResponseObject, error)
+	// List comment threads of a spec document
+	// (GET /api/spec-view/documents/{documentId}/comments)
+	GetSpecComments(ctx context.Context, request GetSpecCommentsRequestObject) (GetSpecCommentsResponseObject, error)
+	// Open a comment thread
+	// (POST /api/spec-view/documents/{documentId}/comments)
+	CreateSpecCommentThread(ctx context.Context, request CreateSpecCommentThreadRequestObject) (CreateSpecCommentThreadResponseObject, error)
+	// Revert a spec feature rename
+	// (DELETE /api/spec-view/features/{featureId}/edit)
+	RevertSpecFeatureEdit(ctx context.Context, request RevertSpecFeatureEditRequestObject) (RevertSpecFeatureEditResponseObject, error)
+	// Rename or merge a spec feature
+	// (PUT /api/spec-view/features/{featureId}/edit)
+	EditSpecFeature(ctx context.Context, request EditSpecFeatureRequestObject) (EditSpecFeatureResponseObject, error)
+	// Request spec document generation
+	// (POST /api/spec-view/generate)
+	RequestSpecGeneration(ctx context.Context, request RequestSpecGenerationRequestObject) (RequestSpecGenerationResponseObject, error)
+	// Get latest spec document for a repository
+	// (GET /api/spec-view/repository/{owner}/{repo})
+	GetSpecDocumentByRepository(ctx context.Context, request GetSpecDocumentByRepositoryRequestObject) (GetSpecDocumentByRepositoryResponseObject, error)
+	// Compare two spec documents of a repository
+	// (GET /api/spec-view/repository/{owner}/{repo}/diff)
+	GetSpecDiffByRepository(ctx context.Context, request GetSpecDiffByRepositoryRequestObject) (GetSpecDiffByRepositoryResponseObject, error)
+	// Export latest spec document for a repository
+	// (GET /api/spec-view/repository/{owner}/{repo}/export)
+	ExportSpecDocumentByRepository(ctx context.Context, request ExportSpecDocumentByRepositoryRequestObject) (ExportSpecDocumentByRepositoryResponseObject, error)
+	// Get version history for a repository across all analyses
+	// (GET /api/spec-view/repository/{owner}/{repo}/versions)
+	GetVersionHistoryByRepository(ctx context.Context, request GetVersionHistoryByRepositoryRequestObject) (GetVersionHistoryByRepositoryResponseObject, error)
+	// Search the user's spec documents
+	// (GET /api/spec-view/search)
+	SearchSpecs(ctx context.Context, request SearchSpecsRequestObject) (SearchSpecsResponseObject, error)
+	// List spec share links
+	// (GET /api/spec-view/share-links)
+	ListSpecShareLinks(ctx context.Context, request ListSpecShareLinksRequestObject) (ListSpecShareLinksResponseObject, error)
+	// Create a spec share link
+	// (POST /api/spec-view/share-links)
+	CreateSpecShareLink(ctx context.Context, request CreateSpecShareLinkRequestObject) (CreateSpecShareLinkResponseObject, error)
+	// Revoke a spec share link
+	// (DELETE /api/spec-view/share-links/{shareLinkId})
+	RevokeSpecShareLink(ctx context.Context, request RevokeSpecShareLinkRequestObject) (RevokeSpecShareLinkResponseObject, error)
+	// Get a shared spec document
+	// (GET /api/spec-view/shared/{token})
+	GetSharedSpec(ctx context.Context, request GetSharedSpecRequestObject) (GetSharedSpecResponseObject, error)
+	// Get spec generation status
+	// (GET /api/spec-view/status/{analysisId})
+	GetSpecGenerationStatus(ctx context.Context, request GetSpecGenerationStatusRequestObject) (GetSpecGenerationStatusResponseObject, error)
+	// Get specification document for analysis
+	// (GET /api/spec-view/{analysisId})
+	GetSpecDocument(ctx context.Context, request GetSpecDocumentRequestObject) (GetSpecDocumentResponseObject, error)
+	// Get cache availability for all languages
+	// (GET /api/spec-view/{analysisId}/cache-availability)
+	GetSpecCacheAvailability(ctx context.Context, request GetSpecCacheAvailabilityRequestObject) (GetSpecCacheAvailabilityResponseObject, error)
+	// Get cache prediction statistics for a language
+	// (GET /api/spec-view/{analysisId}/cache-prediction)
+	GetSpecCachePrediction(ctx context.Context, request GetSpecCachePredictionRequestObject) (GetSpecCachePredictionResponseObject, error)
+	// Cancel a pending or running spec generation
+	// (POST /api/spec-view/{analysisId}/cancel)
+	CancelSpecGeneration(ctx context.Context, request CancelSpecGenerationRequestObject) (CancelSpecGenerationResponseObject, error)
+	// Compare two versions of a spec document
+	// (GET /api/spec-view/{analysisId}/diff)
+	GetSpecDiff(ctx context.Context, request GetSpecDiffRequestObject) (GetSpecDiffResponseObject, error)
+	// List manual spec edits
+	// (GET /api/spec-view/{analysisId}/edits)
+	GetSpecEdits(ctx context.Context, request GetSpecEditsRequestObject) (GetSpecEditsResponseObject, error)
+	// Export specification document for analysis
+	// (GET /api/spec-view/{analysisId}/export)
+	ExportSpecDocument(ctx context.Context, request ExportSpecDocumentRequestObject) (ExportSpecDocumentResponseObject, error)
+	// Get version history for a specific language
+	// (GET /api/spec-view/{analysisId}/versions)
+	GetSpecVersions(ctx context.Context, request GetSpecVersionsRequestObject) (GetSpecVersionsResponseObject, error)
+	// Check usage quota before operation
+	// (POST /api/usage/check-quota)
+	CheckQuota(ctx context.Context, request CheckQuotaRequestObject) (CheckQuotaResponseObject, error)
+	// Get current usage status
+	// (GET /api/usage/current)
+	GetCurrentUsage(ctx context.Context, request GetCurrentUsageRequestObject) (GetCurrentUsageResponseObject, error)
+	// List personal access tokens
+	// (GET /api/user/access-tokens)
+	ListPersonalAccessTokens(ctx context.Context, request ListPersonalAccessTokensRequestObject) (ListPersonalAccessTokensResponseObject, error)
+	// Create a personal access token
+	// (POST /api/user/access-tokens)
+	CreatePersonalAccessToken(ctx context.Context, request CreatePersonalAccessTokenRequestObject) (CreatePersonalAccessTokenResponseObject, error)
+	// Revoke a personal access token
+	// (DELETE /api/user/access-tokens/{tokenId})
+	RevokePersonalAccessToken(ctx context.Context, request RevokePersonalAccessTokenRequestObject) (RevokePersonalAccessTokenResponseObject, error)
+	// Get user's active background tasks
+	// (GET /api/user/active-tasks)
+	GetUserActiveTasks(ctx context.Context, request GetUserActiveTasksRequestObject) (GetUserActiveTasksResponseObject, error)
+	// Get user's analyzed repositories
+	// (GET /api/user/analyzed-repositories)
+	GetUserAnalyzedRepositories(ctx context.Context, request GetUserAnalyzedRepositoriesRequestObject) (GetUserAnalyzedRepositoriesResponseObject, error)
+	// Add repository to user's analysis history
+	// (POST /api/user/analyzed-repositories)
+	AddUserAnalyzedRepository(ctx context.Context, request AddUserAnalyzedRepositoryRequestObject) (AddUserAnalyzedRepositoryResponseObject, error)
+	// Get user's bookmarked repositories
+	// (GET /api/user/bookmarks)
+	GetUserBookmarks(ctx context.Context, request GetUserBookmarksRequestObject) (GetUserBookmarksResponseObject, error)
+	// Get GitHub App installation URL
+	// (GET /api/user/github-app/install-url)
+	GetGitHubAppInstallURL(ctx context.Context, request GetGitHubAppInstallURLRequestObject) (GetGitHubAppInstallURLResponseObject, error)
+	// Get user's GitHub App installations
+	// (GET /api/user/github-app/installations)
+	GetUserGitHubAppInstallations(ctx context.Context, request GetUserGitHubAppInstallationsRequestObject) (GetUserGitHubAppInstallationsResponseObject, error)
+	// Get user's GitHub organizations
+	// (GET /api/user/github/organizations)
+	GetUserGitHubOrganizations(ctx context.Context, request GetUserGitHubOrganizationsRequestObject) (GetUserGitHubOrganizationsResponseObject, error)
+	// Get organization's repositories
+	// (GET /api/user/github/organizations/{org}/repositories)
+	GetOrganizationRepositories(ctx context.Context, request GetOrganizationRepositoriesRequestObject) (GetOrganizationRepositoriesResponseObject, error)
+	// Get user's GitHub repositories
+	// (GET /api/user/github/repositories)
+	GetUserGitHubRepositories(ctx context.Context, request GetUserGitHubRepositoriesRequestObject) (GetUserGitHubRepositoriesResponseObject, error)
+	// Get user's subscription details
+	// (GET /api/user/subscription)
+	GetUserSubscription(ctx context.Context, request GetUserSubscriptionRequestObject) (GetUserSubscriptionResponseObject, error)
+	// List pending workspace invitations
+	// (GET /api/user/workspace-invitations)
+	ListWorkspaceInvitations(ctx context.Context, request ListWorkspaceInvitationsRequestObject) (ListWorkspaceInvitationsResponseObject, error)
+	// Decline a workspace invitation
+	// (DELETE /api/user/workspace-invitations/{invitationId})
+	DeclineWorkspaceInvitation(ctx context.Context, request DeclineWorkspaceInvitationRequestObject) (DeclineWorkspaceInvitationResponseObject, error)
+	// Accept a workspace invitation
+	// (POST /api/user/workspace-invitations/{invitationId}/accept)
+	AcceptWorkspaceInvitation(ctx context.Context, request AcceptWorkspaceInvitationRequestObject) (AcceptWorkspaceInvitationResponseObject, error)
+	// Handle GitHub App webhook events
+	// (POST /api/webhooks/github-app)
+	HandleGitHubAppWebhook(ctx context.Context, request HandleGitHubAppWebhookRequestObject) (HandleGitHubAppWebhookResponseObject, error)
+	// List workspaces
+	// (GET /api/workspaces)
+	ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error)
+	// Create a workspace
+	// (POST /api/workspaces)
+	CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error)
+	// Invite a user to a workspace
+	// (POST /api/workspaces/{workspaceId}/invitations)
+	InviteWorkspaceMember(ctx context.Context, request InviteWorkspaceMemberRequestObject) (InviteWorkspaceMemberResponseObject, error)
+	// List workspace members
+	// (GET /api/workspaces/{workspaceId}/members)
+	ListWorkspaceMembers(ctx context.Context, request ListWorkspaceMembersRequestObject) (ListWorkspaceMembersResponseObject, error)
+	// Remove a workspace member
+	// (DELETE /api/workspaces/{workspaceId}/members/{userId})
+	RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error)
+	// Change a workspace member's role
+	// (PATCH /api/workspaces/{workspaceId}/members/{userId})
+	UpdateWorkspaceMemberRole(ctx context.Context, request UpdateWorkspaceMemberRoleRequestObject) (UpdateWorkspaceMemberRoleResponseObject, error)
 }
 
-func (response GetUserActiveTasks500ApplicationProblemPlusJSONResponse) VisitGetUserActiveTasksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-type GetUserAnalyzedRepositoriesRequestObject struct {
-	Params GetUserAnalyzedRepositoriesParams
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type GetUserAnalyzedRepositoriesResponseObject interface {
-	VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-type GetUserAnalyzedRepositories200JSONResponse UserAnalyzedRepositoriesResponse
-
-func (response GetUserAnalyzedRepositories200JSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type GetUserAnalyzedRepositories400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-func (response GetUserAnalyzedRepositories400ApplicationProblemPlusJSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+// ListBulkReanalysisRuns operation middleware
+func (sh *strictHandler) ListBulkReanalysisRuns(w http.ResponseWriter, r *http.Request, params ListBulkReanalysisRunsParams) {
+	var request ListBulkReanalysisRunsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetUserAnalyzedRepositories401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBulkReanalysisRuns(ctx, request.(ListBulkReanalysisRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBulkReanalysisRuns")
+	}
 
-func (response GetUserAnalyzedRepositories401ApplicationProblemPlusJSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListBulkReanalysisRunsResponseObject); ok {
+		if err := validResponse.VisitListBulkReanalysisRunsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserAnalyzedRepositories500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
-}
+// StartBulkReanalysis operation middleware
+func (sh *strictHandler) StartBulkReanalysis(w http.ResponseWriter, r *http.Request) {
+	var request StartBulkReanalysisRequestObject
 
-func (response GetUserAnalyzedRepositories500ApplicationProblemPlusJSONResponse) VisitGetUserAnalyzedRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartBulkReanalysis(ctx, request.(StartBulkReanalysisRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartBulkReanalysis")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type AddUserAnalyzedRepositoryRequestObject struct {
-	Body *AddUserAnalyzedRepositoryJSONRequestBody
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartBulkReanalysisResponseObject); ok {
+		if err := validResponse.VisitStartBulkReanalysisResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddUserAnalyzedRepositoryResponseObject interface {
-	VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error
-}
+// GetBulkReanalysisRun operation middleware
+func (sh *strictHandler) GetBulkReanalysisRun(w http.ResponseWriter, r *http.Request, runID openapi_types.UUID) {
+	var request GetBulkReanalysisRunRequestObject
 
-type AddUserAnalyzedRepository200JSONResponse AddAnalyzedRepositoryResponse
+	request.RunID = runID
 
-func (response AddUserAnalyzedRepository200JSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBulkReanalysisRun(ctx, request.(GetBulkReanalysisRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBulkReanalysisRun")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type AddUserAnalyzedRepository400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBulkReanalysisRunResponseObject); ok {
+		if err := validResponse.VisitGetBulkReanalysisRunResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response AddUserAnalyzedRepository400ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+// StartAnalysisBatch operation middleware
+func (sh *strictHandler) StartAnalysisBatch(w http.ResponseWriter, r *http.Request) {
+	var request StartAnalysisBatchRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body StartAnalysisBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type AddUserAnalyzedRepository401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StartAnalysisBatch(ctx, request.(StartAnalysisBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartAnalysisBatch")
+	}
 
-func (response AddUserAnalyzedRepository401ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StartAnalysisBatchResponseObject); ok {
+		if err := validResponse.VisitStartAnalysisBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type AddUserAnalyzedRepository404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
-}
+// GetAnalysisBatch operation middleware
+func (sh *strictHandler) GetAnalysisBatch(w http.ResponseWriter, r *http.Request, batchID openapi_types.UUID) {
+	var request GetAnalysisBatchRequestObject
 
-func (response AddUserAnalyzedRepository404ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	request.BatchID = batchID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalysisBatch(ctx, request.(GetAnalysisBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalysisBatch")
+	}
 
-type AddUserAnalyzedRepository500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalysisBatchResponseObject); ok {
+		if err := validResponse.VisitGetAnalysisBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response AddUserAnalyzedRepository500ApplicationProblemPlusJSONResponse) VisitAddUserAnalyzedRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+// AnalyzeRepository operation middleware
+func (sh *strictHandler) AnalyzeRepository(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo, params AnalyzeRepositoryParams) {
+	var request AnalyzeRepositoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Owner = owner
+	request.Repo = repo
+	request.Params = params
 
-type GetUserBookmarksRequestObject struct {
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AnalyzeRepository(ctx, request.(AnalyzeRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AnalyzeRepository")
+	}
 
-type GetUserBookmarksResponseObject interface {
-	VisitGetUserBookmarksResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AnalyzeRepositoryResponseObject); ok {
+		if err := validResponse.VisitAnalyzeRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserBookmarks200JSONResponse BookmarkedRepositoriesResponse
+// GetAnalysisHistory operation middleware
+func (sh *strictHandler) GetAnalysisHistory(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	var request GetAnalysisHistoryRequestObject
 
-func (response GetUserBookmarks200JSONResponse) VisitGetUserBookmarksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Owner = owner
+	request.Repo = repo
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalysisHistory(ctx, request.(GetAnalysisHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalysisHistory")
+	}
 
-type GetUserBookmarks401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalysisHistoryResponseObject); ok {
+		if err := validResponse.VisitGetAnalysisHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUserBookmarks401ApplicationProblemPlusJSONResponse) VisitGetUserBookmarksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+// IngestAnalysis operation middleware
+func (sh *strictHandler) IngestAnalysis(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	var request IngestAnalysisRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Owner = owner
+	request.Repo = repo
 
-type GetUserBookmarks500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
-}
+	var body IngestAnalysisJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response GetUserBookmarks500ApplicationProblemPlusJSONResponse) VisitGetUserBookmarksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IngestAnalysis(ctx, request.(IngestAnalysisRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IngestAnalysis")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetGitHubAppInstallURLRequestObject struct {
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IngestAnalysisResponseObject); ok {
+		if err := validResponse.VisitIngestAnalysisResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetGitHubAppInstallURLResponseObject interface {
-	VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error
-}
+// GetAnalysisStatus operation middleware
+func (sh *strictHandler) GetAnalysisStatus(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	var request GetAnalysisStatusRequestObject
 
-type GetGitHubAppInstallURL200JSONResponse GitHubAppInstallURLResponse
+	request.Owner = owner
+	request.Repo = repo
 
-func (response GetGitHubAppInstallURL200JSONResponse) VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAnalysisStatus(ctx, request.(GetAnalysisStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAnalysisStatus")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetGitHubAppInstallURL401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAnalysisStatusResponseObject); ok {
+		if err := validResponse.VisitGetAnalysisStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetGitHubAppInstallURL401ApplicationProblemPlusJSONResponse) VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+// AuthCallback operation middleware
+func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
+	var request AuthCallbackRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetGitHubAppInstallURL500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthCallback")
+	}
 
-func (response GetGitHubAppInstallURL500ApplicationProblemPlusJSONResponse) VisitGetGitHubAppInstallURLResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
+		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserGitHubAppInstallationsRequestObject struct {
-}
+// AuthDevLogin operation middleware
+func (sh *strictHandler) AuthDevLogin(w http.ResponseWriter, r *http.Request) {
+	var request AuthDevLoginRequestObject
 
-type GetUserGitHubAppInstallationsResponseObject interface {
-	VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error
-}
+	var body AuthDevLoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetUserGitHubAppInstallations200JSONResponse GitHubAppInstallationsResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthDevLogin(ctx, request.(AuthDevLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthDevLogin")
+	}
 
-func (response GetUserGitHubAppInstallations200JSONResponse) VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthDevLoginResponseObject); ok {
+		if err := validResponse.VisitAuthDevLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserGitHubAppInstallations401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
-}
+// AuthLogin operation middleware
+func (sh *strictHandler) AuthLogin(w http.ResponseWriter, r *http.Request) {
+	var request AuthLoginRequestObject
 
-func (response GetUserGitHubAppInstallations401ApplicationProblemPlusJSONResponse) VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthLogin(ctx, request.(AuthLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthLogin")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUserGitHubAppInstallations500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthLoginResponseObject); ok {
+		if err := validResponse.VisitAuthLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUserGitHubAppInstallations500ApplicationProblemPlusJSONResponse) VisitGetUserGitHubAppInstallationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+// AuthLogout operation middleware
+func (sh *strictHandler) AuthLogout(w http.ResponseWriter, r *http.Request) {
+	var request AuthLogoutRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthLogout(ctx, request.(AuthLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthLogout")
+	}
 
-type GetUserGitHubOrganizationsRequestObject struct {
-	Params GetUserGitHubOrganizationsParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUserGitHubOrganizationsResponseObject interface {
-	VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthLogoutResponseObject); ok {
+		if err := validResponse.VisitAuthLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserGitHubOrganizations200JSONResponse GitHubOrganizationsResponse
+// AuthMe operation middleware
+func (sh *strictHandler) AuthMe(w http.ResponseWriter, r *http.Request) {
+	var request AuthMeRequestObject
 
-func (response GetUserGitHubOrganizations200JSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthMe(ctx, request.(AuthMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthMe")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUserGitHubOrganizations401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthMeResponseObject); ok {
+		if err := validResponse.VisitAuthMeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUserGitHubOrganizations401ApplicationProblemPlusJSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// AuthRefresh operation middleware
+func (sh *strictHandler) AuthRefresh(w http.ResponseWriter, r *http.Request) {
+	var request AuthRefreshRequestObject
 
-type GetUserGitHubOrganizations429ApplicationProblemPlusJSONResponse struct {
-	TooManyRequestsApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthRefresh(ctx, request.(AuthRefreshRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthRefresh")
+	}
 
-func (response GetUserGitHubOrganizations429ApplicationProblemPlusJSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(429)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthRefreshResponseObject); ok {
+		if err := validResponse.VisitAuthRefreshResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserGitHubOrganizations500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
-}
+// GetOrgDashboard operation middleware
+func (sh *strictHandler) GetOrgDashboard(w http.ResponseWriter, r *http.Request, org string) {
+	var request GetOrgDashboardRequestObject
 
-func (response GetUserGitHubOrganizations500ApplicationProblemPlusJSONResponse) VisitGetUserGitHubOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	request.Org = org
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrgDashboard(ctx, request.(GetOrgDashboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrgDashboard")
+	}
 
-type GetOrganizationRepositoriesRequestObject struct {
-	Org    string `json:"org"`
-	Params GetOrganizationRepositoriesParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetOrganizationRepositoriesResponseObject interface {
-	VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrgDashboardResponseObject); ok {
+		if err := validResponse.VisitGetOrgDashboardResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetOrganizationRepositories200JSONResponse GitHubRepositoriesResponse
+// GetPricing operation middleware
+func (sh *strictHandler) GetPricing(w http.ResponseWriter, r *http.Request) {
+	var request GetPricingRequestObject
 
-func (response GetOrganizationRepositories200JSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPricing(ctx, request.(GetPricingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPricing")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetOrganizationRepositories401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPricingResponseObject); ok {
+		if err := validResponse.VisitGetPricingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetOrganizationRepositories401ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+// GetRecentRepositories operation middleware
+func (sh *strictHandler) GetRecentRepositories(w http.ResponseWriter, r *http.Request, params GetRecentRepositoriesParams) {
+	var request GetRecentRepositoriesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetOrganizationRepositories404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecentRepositories(ctx, request.(GetRecentRepositoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecentRepositories")
+	}
 
-func (response GetOrganizationRepositories404ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRecentRepositoriesResponseObject); ok {
+		if err := validResponse.VisitGetRecentRepositoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetOrganizationRepositories429ApplicationProblemPlusJSONResponse struct {
-	TooManyRequestsApplicationProblemPlusJSONResponse
-}
+// GetRepositoryStats operation middleware
+func (sh *strictHandler) GetRepositoryStats(w http.ResponseWriter, r *http.Request) {
+	var request GetRepositoryStatsRequestObject
 
-func (response GetOrganizationRepositories429ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(429)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryStats(ctx, request.(GetRepositoryStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryStats")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetOrganizationRepositories500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRepositoryStatsResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryStatsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetOrganizationRepositories500ApplicationProblemPlusJSONResponse) VisitGetOrganizationRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+// RemoveBookmark operation middleware
+func (sh *strictHandler) RemoveBookmark(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	var request RemoveBookmarkRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Owner = owner
+	request.Repo = repo
 
-type GetUserGitHubRepositoriesRequestObject struct {
-	Params GetUserGitHubRepositoriesParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveBookmark(ctx, request.(RemoveBookmarkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveBookmark")
+	}
 
-type GetUserGitHubRepositoriesResponseObject interface {
-	VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveBookmarkResponseObject); ok {
+		if err := validResponse.VisitRemoveBookmarkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserGitHubRepositories200JSONResponse GitHubRepositoriesResponse
+// AddBookmark operation middleware
+func (sh *strictHandler) AddBookmark(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	var request AddBookmarkRequestObject
 
-func (response GetUserGitHubRepositories200JSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Owner = owner
+	request.Repo = repo
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddBookmark(ctx, request.(AddBookmarkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddBookmark")
+	}
 
-type GetUserGitHubRepositories401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddBookmarkResponseObject); ok {
+		if err := validResponse.VisitAddBookmarkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetUserGitHubRepositories401ApplicationProblemPlusJSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+// ReanalyzeRepository operation middleware
+func (sh *strictHandler) ReanalyzeRepository(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	var request ReanalyzeRepositoryRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Owner = owner
+	request.Repo = repo
 
-type GetUserGitHubRepositories429ApplicationProblemPlusJSONResponse struct {
-	TooManyRequestsApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReanalyzeRepository(ctx, request.(ReanalyzeRepositoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReanalyzeRepository")
+	}
 
-func (response GetUserGitHubRepositories429ApplicationProblemPlusJSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(429)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReanalyzeRepositoryResponseObject); ok {
+		if err := validResponse.VisitReanalyzeRepositoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserGitHubRepositories500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
-}
+// GetUpdateStatus operation middleware
+func (sh *strictHandler) GetUpdateStatus(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
+	var request GetUpdateStatusRequestObject
 
-func (response GetUserGitHubRepositories500ApplicationProblemPlusJSONResponse) VisitGetUserGitHubRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	request.Owner = owner
+	request.Repo = repo
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUpdateStatus(ctx, request.(GetUpdateStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUpdateStatus")
+	}
 
-type GetUserSubscriptionRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUserSubscriptionResponseObject interface {
-	VisitGetUserSubscriptionResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUpdateStatusResponseObject); ok {
+		if err := validResponse.VisitGetUpdateStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserSubscription200JSONResponse UserSubscriptionResponse
-
-func (response GetUserSubscription200JSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RevertSpecBehaviorEdit operation middleware
+func (sh *strictHandler) RevertSpecBehaviorEdit(w http.ResponseWriter, r *http.Request, behaviorID openapi_types.UUID, params RevertSpecBehaviorEditParams) {
+	var request RevertSpecBehaviorEditRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.BehaviorID = behaviorID
+	request.Params = params
 
-type GetUserSubscription401ApplicationProblemPlusJSONResponse struct {
-	UnauthorizedApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevertSpecBehaviorEdit(ctx, request.(RevertSpecBehaviorEditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevertSpecBehaviorEdit")
+	}
 
-func (response GetUserSubscription401ApplicationProblemPlusJSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevertSpecBehaviorEditResponseObject); ok {
+		if err := validResponse.VisitRevertSpecBehaviorEditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUserSubscription404ApplicationProblemPlusJSONResponse struct {
-	NotFoundApplicationProblemPlusJSONResponse
-}
+// EditSpecBehavior operation middleware
+func (sh *strictHandler) EditSpecBehavior(w http.ResponseWriter, r *http.Request, behaviorID openapi_types.UUID, params EditSpecBehaviorParams) {
+	var request EditSpecBehaviorRequestObject
 
-func (response GetUserSubscription404ApplicationProblemPlusJSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(404)
+	request.BehaviorID = behaviorID
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body EditSpecBehaviorJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetUserSubscription500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EditSpecBehavior(ctx, request.(EditSpecBehaviorRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EditSpecBehavior")
+	}
 
-func (response GetUserSubscription500ApplicationProblemPlusJSONResponse) VisitGetUserSubscriptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EditSpecBehaviorResponseObject); ok {
+		if err := validResponse.VisitEditSpecBehaviorResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type HandleGitHubAppWebhookRequestObject struct {
-	Params HandleGitHubAppWebhookParams
-	Body   *HandleGitHubAppWebhookJSONRequestBody
-}
+// AddSpecComment operation middleware
+func (sh *strictHandler) AddSpecComment(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params AddSpecCommentParams) {
+	var request AddSpecCommentRequestObject
 
-type HandleGitHubAppWebhookResponseObject interface {
-	VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error
-}
+	request.ThreadID = threadID
+	request.Params = params
 
-type HandleGitHubAppWebhook200JSONResponse WebhookResponse
+	var body AddSpecCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response HandleGitHubAppWebhook200JSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AddSpecComment(ctx, request.(AddSpecCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddSpecComment")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type HandleGitHubAppWebhook400ApplicationProblemPlusJSONResponse struct {
-	BadRequestApplicationProblemPlusJSONResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AddSpecCommentResponseObject); ok {
+		if err := validResponse.VisitAddSpecCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response HandleGitHubAppWebhook400ApplicationProblemPlusJSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+// ReopenSpecCommentThread operation middleware
+func (sh *strictHandler) ReopenSpecCommentThread(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params ReopenSpecCommentThreadParams) {
+	var request ReopenSpecCommentThreadRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.ThreadID = threadID
+	request.Params = params
 
-type HandleGitHubAppWebhook401ApplicationProblemPlusJSONResponse ProblemDetail
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReopenSpecCommentThread(ctx, request.(ReopenSpecCommentThreadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReopenSpecCommentThread")
+	}
 
-func (response HandleGitHubAppWebhook401ApplicationProblemPlusJSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReopenSpecCommentThreadResponseObject); ok {
+		if err := validResponse.VisitReopenSpecCommentThreadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type HandleGitHubAppWebhook500ApplicationProblemPlusJSONResponse struct {
-	InternalErrorApplicationProblemPlusJSONResponse
-}
+// ResolveSpecCommentThread operation middleware
+func (sh *strictHandler) ResolveSpecCommentThread(w http.ResponseWriter, r *http.Request, threadID openapi_types.UUID, params ResolveSpecCommentThreadParams) {
+	var request ResolveSpecCommentThreadRequestObject
 
-func (response HandleGitHubAppWebhook500ApplicationProblemPlusJSONResponse) VisitHandleGitHubAppWebhookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	request.ThreadID = threadID
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveSpecCommentThread(ctx, request.(ResolveSpecCommentThreadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveSpecCommentThread")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Analyze repository test specifications
-	// (GET /api/analyze/{owner}/{repo})
-	AnalyzeRepository(ctx context.Context, request AnalyzeRepositoryRequestObject) (AnalyzeRepositoryResponseObject, error)
-	// Get analysis history for a repository
-	// (GET /api/analyze/{owner}/{repo}/history)
-	GetAnalysisHistory(ctx context.Context, request GetAnalysisHistoryRequestObject) (GetAnalysisHistoryResponseObject, error)
-	// Get analysis status
-	// (GET /api/analyze/{owner}/{repo}/status)
-	GetAnalysisStatus(ctx context.Context, request GetAnalysisStatusRequestObject) (GetAnalysisStatusResponseObject, error)
-	// GitHub OAuth callback
-	// (GET /api/auth/callback)
-	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
-	// Development-only test login
-	// (POST /api/auth/dev-login)
-	AuthDevLogin(ctx context.Context, request AuthDevLoginRequestObject) (AuthDevLoginResponseObject, error)
-	// Initiate GitHub OAuth login
-	// (GET /api/auth/login)
-	AuthLogin(ctx context.Context, request AuthLoginRequestObject) (AuthLoginResponseObject, error)
-	// Logout and clear authentication
-	// (POST /api/auth/logout)
-	AuthLogout(ctx context.Context, request AuthLogoutRequestObject) (AuthLogoutResponseObject, error)
-	// Get current user info
-	// (GET /api/auth/me)
-	AuthMe(ctx context.Context, request AuthMeRequestObject) (AuthMeResponseObject, error)
-	// Refresh authentication tokens
-	// (POST /api/auth/refresh)
-	AuthRefresh(ctx context.Context, request AuthRefreshRequestObject) (AuthRefreshResponseObject, error)
-	// Get subscription plan pricing
-	// (GET /api/pricing)
-	GetPricing(ctx context.Context, request GetPricingRequestObject) (GetPricingResponseObject, error)
-	// Get recently analyzed repositories
-	// (GET /api/repositories/recent)
-	GetRecentRepositories(ctx context.Context, request GetRecentRepositoriesRequestObject) (GetRecentRepositoriesResponseObject, error)
-	// Get repository statistics
-	// (GET /api/repositories/stats)
-	GetRepositoryStats(ctx context.Context, request GetRepositoryStatsRequestObject) (GetRepositoryStatsResponseObject, error)
-	// Remove bookmark
-	// (DELETE /api/repositories/{owner}/{repo}/bookmark)
-	RemoveBookmark(ctx context.Context, request RemoveBookmarkRequestObject) (RemoveBookmarkResponseObject, error)
-	// Bookmark a repository
-	// (POST /api/repositories/{owner}/{repo}/bookmark)
-	AddBookmark(ctx context.Context, request AddBookmarkRequestObject) (AddBookmarkResponseObject, error)
-	// Trigger repository re-analysis
-	// (POST /api/repositories/{owner}/{repo}/reanalyze)
-	ReanalyzeRepository(ctx context.Context, request ReanalyzeRepositoryRequestObject) (ReanalyzeRepositoryResponseObject, error)
-	// Check repository update status
-	// (GET /api/repositories/{owner}/{repo}/update-status)
-	GetUpdateStatus(ctx context.Context, request GetUpdateStatusRequestObject) (GetUpdateStatusResponseObject, error)
-	// Request spec document generation
-	// (POST /api/spec-view/generate)
-	RequestSpecGeneration(ctx context.Context, request RequestSpecGenerationRequestObject) (RequestSpecGenerationResponseObject, error)
-	// Get latest spec document for a repository
-	// (GET /api/spec-view/repository/{owner}/{repo})
-	GetSpecDocumentByRepository(ctx context.Context, request GetSpecDocumentByRepositoryRequestObject) (GetSpecDocumentByRepositoryResponseObject, error)
-	// Get version history for a repository across all analyses
-	// (GET /api/spec-view/repository/{owner}/{repo}/versions)
-	GetVersionHistoryByRepository(ctx context.Context, request GetVersionHistoryByRepositoryRequestObject) (GetVersionHistoryByRepositoryResponseObject, error)
-	// Get spec generation status
-	// (GET /api/spec-view/status/{analysisId})
-	GetSpecGenerationStatus(ctx context.Context, request GetSpecGenerationStatusRequestObject) (GetSpecGenerationStatusResponseObject, error)
-	// Get specification document for analysis
-	// (GET /api/spec-view/{analysisId})
-	GetSpecDocument(ctx context.Context, request GetSpecDocumentRequestObject) (GetSpecDocumentResponseObject, error)
-	// Get cache availability for all languages
-	// (GET /api/spec-view/{analysisId}/cache-availability)
-	GetSpecCacheAvailability(ctx context.Context, request GetSpecCacheAvailabilityRequestObject) (GetSpecCacheAvailabilityResponseObject, error)
-	// Get cache prediction statistics for a language
-	// (GET /api/spec-view/{analysisId}/cache-prediction)
-	GetSpecCachePrediction(ctx context.Context, request GetSpecCachePredictionRequestObject) (GetSpecCachePredictionResponseObject, error)
-	// Get version history for a specific language
-	// (GET /api/spec-view/{analysisId}/versions)
-	GetSpecVersions(ctx context.Context, request GetSpecVersionsRequestObject) (GetSpecVersionsResponseObject, error)
-	// Check usage quota before operation
-	// (POST /api/usage/check-quota)
-	CheckQuota(ctx context.Context, request CheckQuotaRequestObject) (CheckQuotaResponseObject, error)
-	// Get current usage status
-	// (GET /api/usage/current)
-	GetCurrentUsage(ctx context.Context, request GetCurrentUsageRequestObject) (GetCurrentUsageResponseObject, error)
-	// Get user's active background tasks
-	// (GET /api/user/active-tasks)
-	GetUserActiveTasks(ctx context.Context, request GetUserActiveTasksRequestObject) (GetUserActiveTasksResponseObject, error)
-	// Get user's analyzed repositories
-	// (GET /api/user/analyzed-repositories)
-	GetUserAnalyzedRepositories(ctx context.Context, request GetUserAnalyzedRepositoriesRequestObject) (GetUserAnalyzedRepositoriesResponseObject, error)
-	// Add repository to user's analysis history
-	// (POST /api/user/analyzed-repositories)
-	AddUserAnalyzedRepository(ctx context.Context, request AddUserAnalyzedRepositoryRequestObject) (AddUserAnalyzedRepositoryResponseObject, error)
-	// Get user's bookmarked repositories
-	// (GET /api/user/bookmarks)
-	GetUserBookmarks(ctx context.Context, request GetUserBookmarksRequestObject) (GetUserBookmarksResponseObject, error)
-	// Get GitHub App installation URL
-	// (GET /api/user/github-app/install-url)
-	GetGitHubAppInstallURL(ctx context.Context, request GetGitHubAppInstallURLRequestObject) (GetGitHubAppInstallURLResponseObject, error)
-	// Get user's GitHub App installations
-	// (GET /api/user/github-app/installations)
-	GetUserGitHubAppInstallations(ctx context.Context, request GetUserGitHubAppInstallationsRequestObject) (GetUserGitHubAppInstallationsResponseObject, error)
-	// Get user's GitHub organizations
-	// (GET /api/user/github/organizations)
-	GetUserGitHubOrganizations(ctx context.Context, request GetUserGitHubOrganizationsRequestObject) (GetUserGitHubOrganizationsResponseObject, error)
-	// Get organization's repositories
-	// (GET /api/user/github/organizations/{org}/repositories)
-	GetOrganizationRepositories(ctx context.Context, request GetOrganizationRepositoriesRequestObject) (GetOrganizationRepositoriesResponseObject, error)
-	// Get user's GitHub repositories
-	// (GET /api/user/github/repositories)
-	GetUserGitHubRepositories(ctx context.Context, request GetUserGitHubRepositoriesRequestObject) (GetUserGitHubRepositoriesResponseObject, error)
-	// Get user's subscription details
-	// (GET /api/user/subscription)
-	GetUserSubscription(ctx context.Context, request GetUserSubscriptionRequestObject) (GetUserSubscriptionResponseObject, error)
-	// Handle GitHub App webhook events
-	// (POST /api/webhooks/github-app)
-	HandleGitHubAppWebhook(ctx context.Context, request HandleGitHubAppWebhookRequestObject) (HandleGitHubAppWebhookResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolveSpecCommentThreadResponseObject); ok {
+		if err := validResponse.VisitResolveSpecCommentThreadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// DeleteSpecComment operation middleware
+func (sh *strictHandler) DeleteSpecComment(w http.ResponseWriter, r *http.Request, commentID openapi_types.UUID, params DeleteSpecCommentParams) {
+	var request DeleteSpecCommentRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.CommentID = commentID
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSpecComment(ctx, request.(DeleteSpecCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSpecComment")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSpecCommentResponseObject); ok {
+		if err := validResponse.VisitDeleteSpecCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// AnalyzeRepository operation middleware
-func (sh *strictHandler) AnalyzeRepository(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo, params AnalyzeRepositoryParams) {
-	var request AnalyzeRepositoryRequestObject
+// UpdateSpecComment operation middleware
+func (sh *strictHandler) UpdateSpecComment(w http.ResponseWriter, r *http.Request, commentID openapi_types.UUID, params UpdateSpecCommentParams) {
+	var request UpdateSpecCommentRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
+	request.CommentID = commentID
 	request.Params = params
 
+	var body UpdateSpecCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AnalyzeRepository(ctx, request.(AnalyzeRepositoryRequestObject))
+		return sh.ssi.UpdateSpecComment(ctx, request.(UpdateSpecCommentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AnalyzeRepository")
+		handler = middleware(handler, "UpdateSpecComment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AnalyzeRepositoryResponseObject); ok {
-		if err := validResponse.VisitAnalyzeRepositoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSpecCommentResponseObject); ok {
+		if err := validResponse.VisitUpdateSpecCommentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5850,26 +12769,26 @@ func (sh *strictHandler) AnalyzeRepository(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetAnalysisHistory operation middleware
-func (sh *strictHandler) GetAnalysisHistory(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
-	var request GetAnalysisHistoryRequestObject
-
-	request.Owner = owner
-	request.Repo = repo
+// GetSpecComments operation middleware
+func (sh *strictHandler) GetSpecComments(w http.ResponseWriter, r *http.Request, documentID openapi_types.UUID, params GetSpecCommentsParams) {
+	var request GetSpecCommentsRequestObject
+
+	request.DocumentID = documentID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalysisHistory(ctx, request.(GetAnalysisHistoryRequestObject))
+		return sh.ssi.GetSpecComments(ctx, request.(GetSpecCommentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalysisHistory")
+		handler = middleware(handler, "GetSpecComments")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAnalysisHistoryResponseObject); ok {
-		if err := validResponse.VisitGetAnalysisHistoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecCommentsResponseObject); ok {
+		if err := validResponse.VisitGetSpecCommentsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5877,26 +12796,33 @@ func (sh *strictHandler) GetAnalysisHistory(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetAnalysisStatus operation middleware
-func (sh *strictHandler) GetAnalysisStatus(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
-	var request GetAnalysisStatusRequestObject
+// CreateSpecCommentThread operation middleware
+func (sh *strictHandler) CreateSpecCommentThread(w http.ResponseWriter, r *http.Request, documentID openapi_types.UUID, params CreateSpecCommentThreadParams) {
+	var request CreateSpecCommentThreadRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
+	request.DocumentID = documentID
+	request.Params = params
+
+	var body CreateSpecCommentThreadJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAnalysisStatus(ctx, request.(GetAnalysisStatusRequestObject))
+		return sh.ssi.CreateSpecCommentThread(ctx, request.(CreateSpecCommentThreadRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAnalysisStatus")
+		handler = middleware(handler, "CreateSpecCommentThread")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAnalysisStatusResponseObject); ok {
-		if err := validResponse.VisitGetAnalysisStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSpecCommentThreadResponseObject); ok {
+		if err := validResponse.VisitCreateSpecCommentThreadResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5904,25 +12830,26 @@ func (sh *strictHandler) GetAnalysisStatus(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// AuthCallback operation middleware
-func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
-	var request AuthCallbackRequestObject
+// RevertSpecFeatureEdit operation middleware
+func (sh *strictHandler) RevertSpecFeatureEdit(w http.ResponseWriter, r *http.Request, featureID openapi_types.UUID, params RevertSpecFeatureEditParams) {
+	var request RevertSpecFeatureEditRequestObject
 
+	request.FeatureID = featureID
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+		return sh.ssi.RevertSpecFeatureEdit(ctx, request.(RevertSpecFeatureEditRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthCallback")
+		handler = middleware(handler, "RevertSpecFeatureEdit")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
-		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevertSpecFeatureEditResponseObject); ok {
+		if err := validResponse.VisitRevertSpecFeatureEditResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5930,11 +12857,14 @@ func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// AuthDevLogin operation middleware
-func (sh *strictHandler) AuthDevLogin(w http.ResponseWriter, r *http.Request) {
-	var request AuthDevLoginRequestObject
+// EditSpecFeature operation middleware
+func (sh *strictHandler) EditSpecFeature(w http.ResponseWriter, r *http.Request, featureID openapi_types.UUID, params EditSpecFeatureParams) {
+	var request EditSpecFeatureRequestObject
 
-	var body AuthDevLoginJSONRequestBody
+	request.FeatureID = featureID
+	request.Params = params
+
+	var body EditSpecFeatureJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5942,18 +12872,18 @@ func (sh *strictHandler) AuthDevLogin(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthDevLogin(ctx, request.(AuthDevLoginRequestObject))
+		return sh.ssi.EditSpecFeature(ctx, request.(EditSpecFeatureRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthDevLogin")
+		handler = middleware(handler, "EditSpecFeature")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthDevLoginResponseObject); ok {
-		if err := validResponse.VisitAuthDevLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(EditSpecFeatureResponseObject); ok {
+		if err := validResponse.VisitEditSpecFeatureResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5961,23 +12891,30 @@ func (sh *strictHandler) AuthDevLogin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthLogin operation middleware
-func (sh *strictHandler) AuthLogin(w http.ResponseWriter, r *http.Request) {
-	var request AuthLoginRequestObject
+// RequestSpecGeneration operation middleware
+func (sh *strictHandler) RequestSpecGeneration(w http.ResponseWriter, r *http.Request) {
+	var request RequestSpecGenerationRequestObject
+
+	var body RequestSpecGenerationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthLogin(ctx, request.(AuthLoginRequestObject))
+		return sh.ssi.RequestSpecGeneration(ctx, request.(RequestSpecGenerationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthLogin")
+		handler = middleware(handler, "RequestSpecGeneration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthLoginResponseObject); ok {
-		if err := validResponse.VisitAuthLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(RequestSpecGenerationResponseObject); ok {
+		if err := validResponse.VisitRequestSpecGenerationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5985,23 +12922,27 @@ func (sh *strictHandler) AuthLogin(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthLogout operation middleware
-func (sh *strictHandler) AuthLogout(w http.ResponseWriter, r *http.Request) {
-	var request AuthLogoutRequestObject
+// GetSpecDocumentByRepository operation middleware
+func (sh *strictHandler) GetSpecDocumentByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetSpecDocumentByRepositoryParams) {
+	var request GetSpecDocumentByRepositoryRequestObject
+
+	request.Owner = owner
+	request.Repo = repo
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthLogout(ctx, request.(AuthLogoutRequestObject))
+		return sh.ssi.GetSpecDocumentByRepository(ctx, request.(GetSpecDocumentByRepositoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthLogout")
+		handler = middleware(handler, "GetSpecDocumentByRepository")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthLogoutResponseObject); ok {
-		if err := validResponse.VisitAuthLogoutResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecDocumentByRepositoryResponseObject); ok {
+		if err := validResponse.VisitGetSpecDocumentByRepositoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6009,23 +12950,27 @@ func (sh *strictHandler) AuthLogout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthMe operation middleware
-func (sh *strictHandler) AuthMe(w http.ResponseWriter, r *http.Request) {
-	var request AuthMeRequestObject
+// GetSpecDiffByRepository operation middleware
+func (sh *strictHandler) GetSpecDiffByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetSpecDiffByRepositoryParams) {
+	var request GetSpecDiffByRepositoryRequestObject
+
+	request.Owner = owner
+	request.Repo = repo
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthMe(ctx, request.(AuthMeRequestObject))
+		return sh.ssi.GetSpecDiffByRepository(ctx, request.(GetSpecDiffByRepositoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthMe")
+		handler = middleware(handler, "GetSpecDiffByRepository")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthMeResponseObject); ok {
-		if err := validResponse.VisitAuthMeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecDiffByRepositoryResponseObject); ok {
+		if err := validResponse.VisitGetSpecDiffByRepositoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6033,23 +12978,27 @@ func (sh *strictHandler) AuthMe(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthRefresh operation middleware
-func (sh *strictHandler) AuthRefresh(w http.ResponseWriter, r *http.Request) {
-	var request AuthRefreshRequestObject
+// ExportSpecDocumentByRepository operation middleware
+func (sh *strictHandler) ExportSpecDocumentByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params ExportSpecDocumentByRepositoryParams) {
+	var request ExportSpecDocumentByRepositoryRequestObject
+
+	request.Owner = owner
+	request.Repo = repo
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthRefresh(ctx, request.(AuthRefreshRequestObject))
+		return sh.ssi.ExportSpecDocumentByRepository(ctx, request.(ExportSpecDocumentByRepositoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthRefresh")
+		handler = middleware(handler, "ExportSpecDocumentByRepository")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthRefreshResponseObject); ok {
-		if err := validResponse.VisitAuthRefreshResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExportSpecDocumentByRepositoryResponseObject); ok {
+		if err := validResponse.VisitExportSpecDocumentByRepositoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6057,23 +13006,27 @@ func (sh *strictHandler) AuthRefresh(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetPricing operation middleware
-func (sh *strictHandler) GetPricing(w http.ResponseWriter, r *http.Request) {
-	var request GetPricingRequestObject
+// GetVersionHistoryByRepository operation middleware
+func (sh *strictHandler) GetVersionHistoryByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetVersionHistoryByRepositoryParams) {
+	var request GetVersionHistoryByRepositoryRequestObject
+
+	request.Owner = owner
+	request.Repo = repo
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPricing(ctx, request.(GetPricingRequestObject))
+		return sh.ssi.GetVersionHistoryByRepository(ctx, request.(GetVersionHistoryByRepositoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPricing")
+		handler = middleware(handler, "GetVersionHistoryByRepository")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPricingResponseObject); ok {
-		if err := validResponse.VisitGetPricingResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVersionHistoryByRepositoryResponseObject); ok {
+		if err := validResponse.VisitGetVersionHistoryByRepositoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6081,25 +13034,25 @@ func (sh *strictHandler) GetPricing(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetRecentRepositories operation middleware
-func (sh *strictHandler) GetRecentRepositories(w http.ResponseWriter, r *http.Request, params GetRecentRepositoriesParams) {
-	var request GetRecentRepositoriesRequestObject
+// SearchSpecs operation middleware
+func (sh *strictHandler) SearchSpecs(w http.ResponseWriter, r *http.Request, params SearchSpecsParams) {
+	var request SearchSpecsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRecentRepositories(ctx, request.(GetRecentRepositoriesRequestObject))
+		return sh.ssi.SearchSpecs(ctx, request.(SearchSpecsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRecentRepositories")
+		handler = middleware(handler, "SearchSpecs")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRecentRepositoriesResponseObject); ok {
-		if err := validResponse.VisitGetRecentRepositoriesResponse(w); err != nil {
+	} else if validResponse, ok := response.(SearchSpecsResponseObject); ok {
+		if err := validResponse.VisitSearchSpecsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6107,23 +13060,25 @@ func (sh *strictHandler) GetRecentRepositories(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetRepositoryStats operation middleware
-func (sh *strictHandler) GetRepositoryStats(w http.ResponseWriter, r *http.Request) {
-	var request GetRepositoryStatsRequestObject
+// ListSpecShareLinks operation middleware
+func (sh *strictHandler) ListSpecShareLinks(w http.ResponseWriter, r *http.Request, params ListSpecShareLinksParams) {
+	var request ListSpecShareLinksRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRepositoryStats(ctx, request.(GetRepositoryStatsRequestObject))
+		return sh.ssi.ListSpecShareLinks(ctx, request.(ListSpecShareLinksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRepositoryStats")
+		handler = middleware(handler, "ListSpecShareLinks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRepositoryStatsResponseObject); ok {
-		if err := validResponse.VisitGetRepositoryStatsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSpecShareLinksResponseObject); ok {
+		if err := validResponse.VisitListSpecShareLinksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6131,26 +13086,32 @@ func (sh *strictHandler) GetRepositoryStats(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// RemoveBookmark operation middleware
-func (sh *strictHandler) RemoveBookmark(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
-	var request RemoveBookmarkRequestObject
+// CreateSpecShareLink operation middleware
+func (sh *strictHandler) CreateSpecShareLink(w http.ResponseWriter, r *http.Request, params CreateSpecShareLinkParams) {
+	var request CreateSpecShareLinkRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
+	request.Params = params
+
+	var body CreateSpecShareLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveBookmark(ctx, request.(RemoveBookmarkRequestObject))
+		return sh.ssi.CreateSpecShareLink(ctx, request.(CreateSpecShareLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveBookmark")
+		handler = middleware(handler, "CreateSpecShareLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RemoveBookmarkResponseObject); ok {
-		if err := validResponse.VisitRemoveBookmarkResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSpecShareLinkResponseObject); ok {
+		if err := validResponse.VisitCreateSpecShareLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6158,26 +13119,26 @@ func (sh *strictHandler) RemoveBookmark(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// AddBookmark operation middleware
-func (sh *strictHandler) AddBookmark(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
-	var request AddBookmarkRequestObject
+// RevokeSpecShareLink operation middleware
+func (sh *strictHandler) RevokeSpecShareLink(w http.ResponseWriter, r *http.Request, shareLinkID openapi_types.UUID, params RevokeSpecShareLinkParams) {
+	var request RevokeSpecShareLinkRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
+	request.ShareLinkID = shareLinkID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddBookmark(ctx, request.(AddBookmarkRequestObject))
+		return sh.ssi.RevokeSpecShareLink(ctx, request.(RevokeSpecShareLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddBookmark")
+		handler = middleware(handler, "RevokeSpecShareLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddBookmarkResponseObject); ok {
-		if err := validResponse.VisitAddBookmarkResponse(w); err != nil {
+	} else if validResponse, ok := response.(RevokeSpecShareLinkResponseObject); ok {
+		if err := validResponse.VisitRevokeSpecShareLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6185,26 +13146,25 @@ func (sh *strictHandler) AddBookmark(w http.ResponseWriter, r *http.Request, own
 	}
 }
 
-// ReanalyzeRepository operation middleware
-func (sh *strictHandler) ReanalyzeRepository(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
-	var request ReanalyzeRepositoryRequestObject
+// GetSharedSpec operation middleware
+func (sh *strictHandler) GetSharedSpec(w http.ResponseWriter, r *http.Request, token string) {
+	var request GetSharedSpecRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
+	request.Token = token
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ReanalyzeRepository(ctx, request.(ReanalyzeRepositoryRequestObject))
+		return sh.ssi.GetSharedSpec(ctx, request.(GetSharedSpecRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReanalyzeRepository")
+		handler = middleware(handler, "GetSharedSpec")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ReanalyzeRepositoryResponseObject); ok {
-		if err := validResponse.VisitReanalyzeRepositoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSharedSpecResponseObject); ok {
+		if err := validResponse.VisitGetSharedSpecResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6212,26 +13172,26 @@ func (sh *strictHandler) ReanalyzeRepository(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetUpdateStatus operation middleware
-func (sh *strictHandler) GetUpdateStatus(w http.ResponseWriter, r *http.Request, owner Owner, repo Repo) {
-	var request GetUpdateStatusRequestObject
+// GetSpecGenerationStatus operation middleware
+func (sh *strictHandler) GetSpecGenerationStatus(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecGenerationStatusParams) {
+	var request GetSpecGenerationStatusRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
+	request.AnalysisID = analysisID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUpdateStatus(ctx, request.(GetUpdateStatusRequestObject))
+		return sh.ssi.GetSpecGenerationStatus(ctx, request.(GetSpecGenerationStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUpdateStatus")
+		handler = middleware(handler, "GetSpecGenerationStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUpdateStatusResponseObject); ok {
-		if err := validResponse.VisitGetUpdateStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecGenerationStatusResponseObject); ok {
+		if err := validResponse.VisitGetSpecGenerationStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6239,30 +13199,26 @@ func (sh *strictHandler) GetUpdateStatus(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// RequestSpecGeneration operation middleware
-func (sh *strictHandler) RequestSpecGeneration(w http.ResponseWriter, r *http.Request) {
-	var request RequestSpecGenerationRequestObject
+// GetSpecDocument operation middleware
+func (sh *strictHandler) GetSpecDocument(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecDocumentParams) {
+	var request GetSpecDocumentRequestObject
 
-	var body RequestSpecGenerationJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.AnalysisID = analysisID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RequestSpecGeneration(ctx, request.(RequestSpecGenerationRequestObject))
+		return sh.ssi.GetSpecDocument(ctx, request.(GetSpecDocumentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RequestSpecGeneration")
+		handler = middleware(handler, "GetSpecDocument")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RequestSpecGenerationResponseObject); ok {
-		if err := validResponse.VisitRequestSpecGenerationResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecDocumentResponseObject); ok {
+		if err := validResponse.VisitGetSpecDocumentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6270,27 +13226,25 @@ func (sh *strictHandler) RequestSpecGeneration(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetSpecDocumentByRepository operation middleware
-func (sh *strictHandler) GetSpecDocumentByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetSpecDocumentByRepositoryParams) {
-	var request GetSpecDocumentByRepositoryRequestObject
+// GetSpecCacheAvailability operation middleware
+func (sh *strictHandler) GetSpecCacheAvailability(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID) {
+	var request GetSpecCacheAvailabilityRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
-	request.Params = params
+	request.AnalysisID = analysisID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSpecDocumentByRepository(ctx, request.(GetSpecDocumentByRepositoryRequestObject))
+		return sh.ssi.GetSpecCacheAvailability(ctx, request.(GetSpecCacheAvailabilityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSpecDocumentByRepository")
+		handler = middleware(handler, "GetSpecCacheAvailability")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSpecDocumentByRepositoryResponseObject); ok {
-		if err := validResponse.VisitGetSpecDocumentByRepositoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecCacheAvailabilityResponseObject); ok {
+		if err := validResponse.VisitGetSpecCacheAvailabilityResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6298,27 +13252,26 @@ func (sh *strictHandler) GetSpecDocumentByRepository(w http.ResponseWriter, r *h
 	}
 }
 
-// GetVersionHistoryByRepository operation middleware
-func (sh *strictHandler) GetVersionHistoryByRepository(w http.ResponseWriter, r *http.Request, owner string, repo string, params GetVersionHistoryByRepositoryParams) {
-	var request GetVersionHistoryByRepositoryRequestObject
+// GetSpecCachePrediction operation middleware
+func (sh *strictHandler) GetSpecCachePrediction(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecCachePredictionParams) {
+	var request GetSpecCachePredictionRequestObject
 
-	request.Owner = owner
-	request.Repo = repo
+	request.AnalysisID = analysisID
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetVersionHistoryByRepository(ctx, request.(GetVersionHistoryByRepositoryRequestObject))
+		return sh.ssi.GetSpecCachePrediction(ctx, request.(GetSpecCachePredictionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetVersionHistoryByRepository")
+		handler = middleware(handler, "GetSpecCachePrediction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetVersionHistoryByRepositoryResponseObject); ok {
-		if err := validResponse.VisitGetVersionHistoryByRepositoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecCachePredictionResponseObject); ok {
+		if err := validResponse.VisitGetSpecCachePredictionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6326,53 +13279,53 @@ func (sh *strictHandler) GetVersionHistoryByRepository(w http.ResponseWriter, r
 	}
 }
 
-// GetSpecGenerationStatus operation middleware
-func (sh *strictHandler) GetSpecGenerationStatus(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecGenerationStatusParams) {
-	var request GetSpecGenerationStatusRequestObject
+// CancelSpecGeneration operation middleware
+func (sh *strictHandler) CancelSpecGeneration(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params CancelSpecGenerationParams) {
+	var request CancelSpecGenerationRequestObject
 
 	request.AnalysisID = analysisID
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSpecGenerationStatus(ctx, request.(GetSpecGenerationStatusRequestObject))
+		return sh.ssi.CancelSpecGeneration(ctx, request.(CancelSpecGenerationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSpecGenerationStatus")
+		handler = middleware(handler, "CancelSpecGeneration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSpecGenerationStatusResponseObject); ok {
-		if err := validResponse.VisitGetSpecGenerationStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(CancelSpecGenerationResponseObject); ok {
+		if err := validResponse.VisitCancelSpecGenerationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
-}
-
-// GetSpecDocument operation middleware
-func (sh *strictHandler) GetSpecDocument(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecDocumentParams) {
-	var request GetSpecDocumentRequestObject
+}
+
+// GetSpecDiff operation middleware
+func (sh *strictHandler) GetSpecDiff(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecDiffParams) {
+	var request GetSpecDiffRequestObject
 
 	request.AnalysisID = analysisID
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSpecDocument(ctx, request.(GetSpecDocumentRequestObject))
+		return sh.ssi.GetSpecDiff(ctx, request.(GetSpecDiffRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSpecDocument")
+		handler = middleware(handler, "GetSpecDiff")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSpecDocumentResponseObject); ok {
-		if err := validResponse.VisitGetSpecDocumentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecDiffResponseObject); ok {
+		if err := validResponse.VisitGetSpecDiffResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6380,25 +13333,26 @@ func (sh *strictHandler) GetSpecDocument(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetSpecCacheAvailability operation middleware
-func (sh *strictHandler) GetSpecCacheAvailability(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID) {
-	var request GetSpecCacheAvailabilityRequestObject
+// GetSpecEdits operation middleware
+func (sh *strictHandler) GetSpecEdits(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecEditsParams) {
+	var request GetSpecEditsRequestObject
 
 	request.AnalysisID = analysisID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSpecCacheAvailability(ctx, request.(GetSpecCacheAvailabilityRequestObject))
+		return sh.ssi.GetSpecEdits(ctx, request.(GetSpecEditsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSpecCacheAvailability")
+		handler = middleware(handler, "GetSpecEdits")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSpecCacheAvailabilityResponseObject); ok {
-		if err := validResponse.VisitGetSpecCacheAvailabilityResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSpecEditsResponseObject); ok {
+		if err := validResponse.VisitGetSpecEditsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6406,26 +13360,26 @@ func (sh *strictHandler) GetSpecCacheAvailability(w http.ResponseWriter, r *http
 	}
 }
 
-// GetSpecCachePrediction operation middleware
-func (sh *strictHandler) GetSpecCachePrediction(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params GetSpecCachePredictionParams) {
-	var request GetSpecCachePredictionRequestObject
+// ExportSpecDocument operation middleware
+func (sh *strictHandler) ExportSpecDocument(w http.ResponseWriter, r *http.Request, analysisID openapi_types.UUID, params ExportSpecDocumentParams) {
+	var request ExportSpecDocumentRequestObject
 
 	request.AnalysisID = analysisID
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSpecCachePrediction(ctx, request.(GetSpecCachePredictionRequestObject))
+		return sh.ssi.ExportSpecDocument(ctx, request.(ExportSpecDocumentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSpecCachePrediction")
+		handler = middleware(handler, "ExportSpecDocument")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSpecCachePredictionResponseObject); ok {
-		if err := validResponse.VisitGetSpecCachePredictionResponse(w); err != nil {
+	} else if validResponse, ok := response.(ExportSpecDocumentResponseObject); ok {
+		if err := validResponse.VisitExportSpecDocumentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6515,6 +13469,87 @@ func (sh *strictHandler) GetCurrentUsage(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// ListPersonalAccessTokens operation middleware
+func (sh *strictHandler) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	var request ListPersonalAccessTokensRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPersonalAccessTokens(ctx, request.(ListPersonalAccessTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPersonalAccessTokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPersonalAccessTokensResponseObject); ok {
+		if err := validResponse.VisitListPersonalAccessTokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePersonalAccessToken operation middleware
+func (sh *strictHandler) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	var request CreatePersonalAccessTokenRequestObject
+
+	var body CreatePersonalAccessTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePersonalAccessToken(ctx, request.(CreatePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePersonalAccessToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitCreatePersonalAccessTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokePersonalAccessToken operation middleware
+func (sh *strictHandler) RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request, tokenID openapi_types.UUID) {
+	var request RevokePersonalAccessTokenRequestObject
+
+	request.TokenID = tokenID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokePersonalAccessToken(ctx, request.(RevokePersonalAccessTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokePersonalAccessToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokePersonalAccessTokenResponseObject); ok {
+		if err := validResponse.VisitRevokePersonalAccessTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetUserActiveTasks operation middleware
 func (sh *strictHandler) GetUserActiveTasks(w http.ResponseWriter, r *http.Request) {
 	var request GetUserActiveTasksRequestObject
@@ -6771,6 +13806,82 @@ func (sh *strictHandler) GetUserSubscription(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// ListWorkspaceInvitations operation middleware
+func (sh *strictHandler) ListWorkspaceInvitations(w http.ResponseWriter, r *http.Request) {
+	var request ListWorkspaceInvitationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkspaceInvitations(ctx, request.(ListWorkspaceInvitationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkspaceInvitations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkspaceInvitationsResponseObject); ok {
+		if err := validResponse.VisitListWorkspaceInvitationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeclineWorkspaceInvitation operation middleware
+func (sh *strictHandler) DeclineWorkspaceInvitation(w http.ResponseWriter, r *http.Request, invitationID openapi_types.UUID) {
+	var request DeclineWorkspaceInvitationRequestObject
+
+	request.InvitationID = invitationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeclineWorkspaceInvitation(ctx, request.(DeclineWorkspaceInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeclineWorkspaceInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeclineWorkspaceInvitationResponseObject); ok {
+		if err := validResponse.VisitDeclineWorkspaceInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AcceptWorkspaceInvitation operation middleware
+func (sh *strictHandler) AcceptWorkspaceInvitation(w http.ResponseWriter, r *http.Request, invitationID openapi_types.UUID) {
+	var request AcceptWorkspaceInvitationRequestObject
+
+	request.InvitationID = invitationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptWorkspaceInvitation(ctx, request.(AcceptWorkspaceInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptWorkspaceInvitation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptWorkspaceInvitationResponseObject); ok {
+		if err := validResponse.VisitAcceptWorkspaceInvitationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // HandleGitHubAppWebhook operation middleware
 func (sh *strictHandler) HandleGitHubAppWebhook(w http.ResponseWriter, r *http.Request, params HandleGitHubAppWebhookParams) {
 	var request HandleGitHubAppWebhookRequestObject
@@ -6803,3 +13914,178 @@ func (sh *strictHandler) HandleGitHubAppWebhook(w http.ResponseWriter, r *http.R
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// ListWorkspaces operation middleware
+func (sh *strictHandler) ListWorkspaces(w http.ResponseWriter, r *http.Request) {
+	var request ListWorkspacesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkspaces(ctx, request.(ListWorkspacesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkspaces")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkspacesResponseObject); ok {
+		if err := validResponse.VisitListWorkspacesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateWorkspace operation middleware
+func (sh *strictHandler) CreateWorkspace(w http.ResponseWriter, r *http.Request) {
+	var request CreateWorkspaceRequestObject
+
+	var body CreateWorkspaceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWorkspace(ctx, request.(CreateWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWorkspace")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateWorkspaceResponseObject); ok {
+		if err := validResponse.VisitCreateWorkspaceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InviteWorkspaceMember operation middleware
+func (sh *strictHandler) InviteWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID) {
+	var request InviteWorkspaceMemberRequestObject
+
+	request.WorkspaceID = workspaceID
+
+	var body InviteWorkspaceMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InviteWorkspaceMember(ctx, request.(InviteWorkspaceMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InviteWorkspaceMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InviteWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitInviteWorkspaceMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWorkspaceMembers operation middleware
+func (sh *strictHandler) ListWorkspaceMembers(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID) {
+	var request ListWorkspaceMembersRequestObject
+
+	request.WorkspaceID = workspaceID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWorkspaceMembers(ctx, request.(ListWorkspaceMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWorkspaceMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWorkspaceMembersResponseObject); ok {
+		if err := validResponse.VisitListWorkspaceMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveWorkspaceMember operation middleware
+func (sh *strictHandler) RemoveWorkspaceMember(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID, userID openapi_types.UUID) {
+	var request RemoveWorkspaceMemberRequestObject
+
+	request.WorkspaceID = workspaceID
+	request.UserID = userID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveWorkspaceMember(ctx, request.(RemoveWorkspaceMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveWorkspaceMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveWorkspaceMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateWorkspaceMemberRole operation middleware
+func (sh *strictHandler) UpdateWorkspaceMemberRole(w http.ResponseWriter, r *http.Request, workspaceID openapi_types.UUID, userID openapi_types.UUID) {
+	var request UpdateWorkspaceMemberRoleRequestObject
+
+	request.WorkspaceID = workspaceID
+	request.UserID = userID
+
+	var body UpdateWorkspaceMemberRoleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateWorkspaceMemberRole(ctx, request.(UpdateWorkspaceMemberRoleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateWorkspaceMemberRole")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateWorkspaceMemberRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateWorkspaceMemberRoleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
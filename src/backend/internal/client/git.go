@@ -7,8 +7,14 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/specvital/web/src/backend/common/metrics"
+	"github.com/specvital/web/src/backend/common/tracing"
 )
 
 type GitClient interface {
@@ -38,7 +44,18 @@ func (c *gitClient) GetLatestCommitSHAWithToken(ctx context.Context, owner, repo
 	return c.runLsRemote(ctx, repoURL, owner, repo)
 }
 
-func (c *gitClient) runLsRemote(ctx context.Context, repoURL, owner, repo string) (string, error) {
+func (c *gitClient) runLsRemote(ctx context.Context, repoURL, owner, repo string) (sha string, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "git ls-remote", trace.WithAttributes(
+		attribute.String("repo.owner", owner),
+		attribute.String("repo.name", repo),
+	))
+	start := time.Now()
+	reason := metrics.GitErrorOther
+	defer func() {
+		metrics.ObserveGitLsRemote(time.Since(start), reason, err)
+		tracing.End(span, err)
+	}()
+
 	cmd := exec.CommandContext(ctx, "git", "ls-remote", repoURL, "HEAD")
 	cmd.Env = append(os.Environ(),
 		"GIT_TERMINAL_PROMPT=0",
@@ -48,13 +65,18 @@ func (c *gitClient) runLsRemote(ctx context.Context, repoURL, owner, repo string
 	)
 	output, err := cmd.Output()
 	if err != nil {
+		if ctx.Err() != nil {
+			reason = metrics.GitErrorTimeout
+		}
 		var exitErr *exec.ExitError
 		if errors.As(err, &exitErr) {
 			stderr := string(exitErr.Stderr)
 			if strings.Contains(stderr, "not found") || strings.Contains(stderr, "Repository not found") {
+				reason = metrics.GitErrorNotFound
 				return "", errors.Wrap(ErrRepoNotFound, fmt.Sprintf("%s/%s", owner, repo))
 			}
 			if strings.Contains(stderr, "could not read Username") || strings.Contains(stderr, "Authentication failed") {
+				reason = metrics.GitErrorForbidden
 				return "", errors.Wrap(ErrForbidden, fmt.Sprintf("%s/%s", owner, repo))
 			}
 		}
@@ -0,0 +1,267 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/specvital/web/src/backend/common/metrics"
+)
+
+// CachingGitClient caches latest-commit lookups for a short TTL and coalesces
+// concurrent lookups of the same repository into a single git ls-remote.
+//
+// Token lookups are cached per token so a commit resolved with one user's
+// credentials is never served to a caller without them.
+//
+// Invalidation only reaches the process that received the push webhook;
+// other replicas serve the previous commit until their entry expires, so the
+// TTL must stay short.
+type CachingGitClient struct {
+	entries map[string]*repoCommitEntry
+	// epochs records the epoch of each repository's last invalidation. A
+	// lookup that started in an earlier epoch must not store its result,
+	// which may predate the push. Repositories without an entry are in
+	// epochFloor.
+	epoch       uint64
+	epochFloor  uint64
+	epochs      map[string]uint64
+	group       singleflight.Group
+	inner       GitClient
+	mu          sync.Mutex
+	now         func() time.Time
+	stats       gitCacheCounters
+	stopCleanup chan struct{}
+	ttl         time.Duration
+}
+
+// GitCacheStats is a snapshot of cache activity since startup.
+type GitCacheStats struct {
+	Coalesced     int64
+	Entries       int
+	Hits          int64
+	Invalidations int64
+	Misses        int64
+}
+
+type gitCacheCounters struct {
+	coalesced     atomic.Int64
+	hits          atomic.Int64
+	invalidations atomic.Int64
+	misses        atomic.Int64
+}
+
+// repoCommitEntry holds cached SHAs for one repository, keyed by credential.
+// The empty key is the anonymous lookup.
+type repoCommitEntry struct {
+	shas map[string]cachedCommit
+}
+
+type cachedCommit struct {
+	expiresAt time.Time
+	sha       string
+}
+
+var _ GitClient = (*CachingGitClient)(nil)
+
+// NewCachingGitClient wraps inner with a commit SHA cache. Expired entries are
+// dropped and stats logged every statsInterval.
+func NewCachingGitClient(inner GitClient, ttl, statsInterval time.Duration) *CachingGitClient {
+	c := &CachingGitClient{
+		entries:     make(map[string]*repoCommitEntry),
+		epochs:      make(map[string]uint64),
+		inner:       inner,
+		now:         time.Now,
+		stopCleanup: make(chan struct{}),
+		ttl:         ttl,
+	}
+
+	go c.maintain(statsInterval)
+
+	return c
+}
+
+func (c *CachingGitClient) GetLatestCommitSHA(ctx context.Context, owner, repo string) (string, error) {
+	return c.lookup(ctx, owner, repo, "", func(ctx context.Context) (string, error) {
+		return c.inner.GetLatestCommitSHA(ctx, owner, repo)
+	})
+}
+
+func (c *CachingGitClient) GetLatestCommitSHAWithToken(ctx context.Context, owner, repo, token string) (string, error) {
+	return c.lookup(ctx, owner, repo, hashToken(token), func(ctx context.Context) (string, error) {
+		return c.inner.GetLatestCommitSHAWithToken(ctx, owner, repo, token)
+	})
+}
+
+// InvalidateRepository drops every cached SHA for owner/repo, e.g. after a
+// push, including lookups still in flight.
+func (c *CachingGitClient) InvalidateRepository(owner, repo string) {
+	key := repoCacheKey(owner, repo)
+
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.epoch++
+	c.epochs[key] = c.epoch
+	c.mu.Unlock()
+
+	c.stats.invalidations.Add(1)
+	metrics.RecordCommitCacheInvalidation()
+}
+
+func (c *CachingGitClient) Stats() GitCacheStats {
+	c.mu.Lock()
+	entries := len(c.entries)
+	c.mu.Unlock()
+
+	return GitCacheStats{
+		Coalesced:     c.stats.coalesced.Load(),
+		Entries:       entries,
+		Hits:          c.stats.hits.Load(),
+		Invalidations: c.stats.invalidations.Load(),
+		Misses:        c.stats.misses.Load(),
+	}
+}
+
+func (c *CachingGitClient) lookup(ctx context.Context, owner, repo, credential string, fetch func(context.Context) (string, error)) (string, error) {
+	key := repoCacheKey(owner, repo)
+
+	sha, epoch, ok := c.get(key, credential)
+	if ok {
+		c.stats.hits.Add(1)
+		metrics.RecordCommitCacheLookup(metrics.CommitCacheHit)
+		return sha, nil
+	}
+	c.stats.misses.Add(1)
+
+	// The shared call must not be cancelled when the first caller goes away.
+	// Keying it by epoch keeps callers after an invalidation from joining a
+	// lookup that started before it.
+	result, err, shared := c.group.Do(key+"#"+credential+"#"+strconv.FormatUint(epoch, 10), func() (any, error) {
+		sha, err := fetch(context.WithoutCancel(ctx))
+		if err != nil {
+			return "", err
+		}
+		c.set(key, credential, sha, epoch)
+		return sha, nil
+	})
+	if shared {
+		c.stats.coalesced.Add(1)
+		metrics.RecordCommitCacheLookup(metrics.CommitCacheCoalesced)
+	} else {
+		metrics.RecordCommitCacheLookup(metrics.CommitCacheMiss)
+	}
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+// get also returns the repository's current epoch for a lookup on a miss.
+func (c *CachingGitClient) get(key, credential string) (sha string, epoch uint64, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	epoch = c.epochOf(key)
+	entry, ok := c.entries[key]
+	if !ok {
+		return "", epoch, false
+	}
+	commit, ok := entry.shas[credential]
+	if !ok || !c.now().Before(commit.expiresAt) {
+		return "", epoch, false
+	}
+	return commit.sha, epoch, true
+}
+
+// set skips storing sha if the repository was invalidated since epoch.
+func (c *CachingGitClient) set(key, credential, sha string, epoch uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.epochOf(key) != epoch {
+		return
+	}
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &repoCommitEntry{shas: make(map[string]cachedCommit)}
+		c.entries[key] = entry
+	}
+	entry.shas[credential] = cachedCommit{expiresAt: c.now().Add(c.ttl), sha: sha}
+}
+
+func (c *CachingGitClient) maintain(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.removeExpired()
+			stats := c.Stats()
+			slog.Info("commit sha cache stats",
+				"hits", stats.Hits,
+				"misses", stats.Misses,
+				"coalesced", stats.Coalesced,
+				"invalidations", stats.Invalidations,
+				"entries", stats.Entries,
+			)
+		case <-c.stopCleanup:
+			return
+		}
+	}
+}
+
+// epochOf must be called with mu held.
+func (c *CachingGitClient) epochOf(key string) uint64 {
+	if epoch, ok := c.epochs[key]; ok {
+		return epoch
+	}
+	return c.epochFloor
+}
+
+func (c *CachingGitClient) removeExpired() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Moving every repository to the latest epoch bounds the map. A lookup
+	// in flight then stores its result only if nothing was invalidated
+	// since it started, since every invalidation raises the floor.
+	c.epochFloor = c.epoch
+	clear(c.epochs)
+
+	now := c.now()
+	for key, entry := range c.entries {
+		for credential, commit := range entry.shas {
+			if !now.Before(commit.expiresAt) {
+				delete(entry.shas, credential)
+			}
+		}
+		if len(entry.shas) == 0 {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Close stops the maintenance goroutine. Implements io.Closer.
+func (c *CachingGitClient) Close() error {
+	close(c.stopCleanup)
+	return nil
+}
+
+func repoCacheKey(owner, repo string) string {
+	return strings.ToLower(owner) + "/" + strings.ToLower(repo)
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,195 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type countingGitClient struct {
+	calls   atomic.Int64
+	err     error
+	release chan struct{}
+	sha     string
+}
+
+func (m *countingGitClient) GetLatestCommitSHA(_ context.Context, _, _ string) (string, error) {
+	m.calls.Add(1)
+	if m.release != nil {
+		<-m.release
+	}
+	return m.sha, m.err
+}
+
+func (m *countingGitClient) GetLatestCommitSHAWithToken(_ context.Context, _, _, token string) (string, error) {
+	m.calls.Add(1)
+	return m.sha + "-" + token, m.err
+}
+
+func newTestCachingGitClient(t *testing.T, inner GitClient, now *time.Time) *CachingGitClient {
+	t.Helper()
+	c := NewCachingGitClient(inner, time.Minute, time.Hour)
+	t.Cleanup(func() { _ = c.Close() })
+	c.now = func() time.Time { return *now }
+	return c
+}
+
+func TestCachingGitClient_CachesWithinTTL(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	inner := &countingGitClient{sha: "abc123"}
+	c := newTestCachingGitClient(t, inner, &now)
+	ctx := context.Background()
+
+	for range 3 {
+		sha, err := c.GetLatestCommitSHA(ctx, "Owner", "Repo")
+		if err != nil || sha != "abc123" {
+			t.Fatalf("unexpected result %q, %v", sha, err)
+		}
+	}
+	if inner.calls.Load() != 1 {
+		t.Errorf("expected 1 lookup within TTL, got %d", inner.calls.Load())
+	}
+
+	now = now.Add(time.Minute)
+	_, _ = c.GetLatestCommitSHA(ctx, "owner", "repo")
+	if inner.calls.Load() != 2 {
+		t.Errorf("expected a new lookup after TTL, got %d", inner.calls.Load())
+	}
+
+	stats := c.Stats()
+	if stats.Hits != 2 || stats.Misses != 2 {
+		t.Errorf("expected 2 hits and 2 misses, got %+v", stats)
+	}
+}
+
+func TestCachingGitClient_SeparatesCredentials(t *testing.T) {
+	now := time.Now()
+	inner := &countingGitClient{sha: "abc123"}
+	c := newTestCachingGitClient(t, inner, &now)
+	ctx := context.Background()
+
+	if sha, _ := c.GetLatestCommitSHAWithToken(ctx, "owner", "private", "token-a"); sha != "abc123-token-a" {
+		t.Fatalf("unexpected sha %q", sha)
+	}
+	if sha, _ := c.GetLatestCommitSHAWithToken(ctx, "owner", "private", "token-b"); sha != "abc123-token-b" {
+		t.Errorf("expected token-b lookup not to reuse token-a result, got %q", sha)
+	}
+	if sha, _ := c.GetLatestCommitSHA(ctx, "owner", "private"); sha != "abc123" {
+		t.Errorf("expected anonymous lookup not to reuse token result, got %q", sha)
+	}
+	if inner.calls.Load() != 3 {
+		t.Errorf("expected 3 lookups, got %d", inner.calls.Load())
+	}
+}
+
+func TestCachingGitClient_DoesNotCacheErrors(t *testing.T) {
+	now := time.Now()
+	inner := &countingGitClient{err: ErrRepoNotFound}
+	c := newTestCachingGitClient(t, inner, &now)
+	ctx := context.Background()
+
+	for range 2 {
+		if _, err := c.GetLatestCommitSHA(ctx, "owner", "missing"); !errors.Is(err, ErrRepoNotFound) {
+			t.Fatalf("expected ErrRepoNotFound, got %v", err)
+		}
+	}
+	if inner.calls.Load() != 2 {
+		t.Errorf("expected errors not to be cached, got %d lookups", inner.calls.Load())
+	}
+}
+
+func TestCachingGitClient_InvalidateRepository(t *testing.T) {
+	now := time.Now()
+	inner := &countingGitClient{sha: "abc123"}
+	c := newTestCachingGitClient(t, inner, &now)
+	ctx := context.Background()
+
+	_, _ = c.GetLatestCommitSHA(ctx, "owner", "repo")
+	_, _ = c.GetLatestCommitSHAWithToken(ctx, "owner", "repo", "token")
+	c.InvalidateRepository("OWNER", "REPO")
+	_, _ = c.GetLatestCommitSHA(ctx, "owner", "repo")
+	_, _ = c.GetLatestCommitSHAWithToken(ctx, "owner", "repo", "token")
+
+	if inner.calls.Load() != 4 {
+		t.Errorf("expected invalidation to drop all credentials, got %d lookups", inner.calls.Load())
+	}
+	if c.Stats().Invalidations != 1 {
+		t.Errorf("expected 1 invalidation, got %d", c.Stats().Invalidations)
+	}
+}
+
+func TestCachingGitClient_InvalidateDuringLookup(t *testing.T) {
+	now := time.Now()
+	inner := &countingGitClient{release: make(chan struct{}), sha: "old"}
+	c := newTestCachingGitClient(t, inner, &now)
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = c.GetLatestCommitSHA(ctx, "owner", "repo")
+	}()
+	for inner.calls.Load() < 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The push lands while ls-remote is still returning the previous commit.
+	c.InvalidateRepository("owner", "repo")
+	close(inner.release)
+	<-done
+
+	if entries := c.Stats().Entries; entries != 0 {
+		t.Errorf("expected the in-flight result not to be cached, got %d entries", entries)
+	}
+	inner.sha = "new"
+	if sha, _ := c.GetLatestCommitSHA(ctx, "owner", "repo"); sha != "new" {
+		t.Errorf("expected a fresh lookup after invalidation, got %q", sha)
+	}
+}
+
+func TestCachingGitClient_CoalescesConcurrentLookups(t *testing.T) {
+	now := time.Now()
+	inner := &countingGitClient{release: make(chan struct{}), sha: "abc123"}
+	c := newTestCachingGitClient(t, inner, &now)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if sha, err := c.GetLatestCommitSHA(ctx, "owner", "repo"); err != nil || sha != "abc123" {
+				t.Errorf("unexpected result %q, %v", sha, err)
+			}
+		}()
+	}
+
+	// Let every goroutine reach the in-flight call before releasing it.
+	for c.Stats().Misses < 5 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+
+	if inner.calls.Load() != 1 {
+		t.Errorf("expected 1 coalesced lookup, got %d", inner.calls.Load())
+	}
+}
+
+func TestCachingGitClient_RemoveExpired(t *testing.T) {
+	now := time.Now()
+	c := newTestCachingGitClient(t, &countingGitClient{sha: "abc123"}, &now)
+
+	_, _ = c.GetLatestCommitSHA(context.Background(), "owner", "repo")
+	now = now.Add(2 * time.Minute)
+	c.removeExpired()
+
+	if entries := c.Stats().Entries; entries != 0 {
+		t.Errorf("expected expired entries to be removed, got %d", entries)
+	}
+}
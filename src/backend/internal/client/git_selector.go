@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/cockroachdb/errors"
+)
+
+var ErrNoInstallation = errors.New("no github app installation for owner")
+
+// InstallationTokenSource provides GitHub App installation tokens by
+// repository owner. It returns ErrNoInstallation when the owner has not
+// installed the app.
+type InstallationTokenSource interface {
+	InstallationToken(ctx context.Context, owner string) (string, error)
+}
+
+// SelectingGitClient resolves commits with the best credential available:
+// the owner's GitHub App installation, then the caller's token, then an
+// anonymous git ls-remote.
+//
+// Installation tokens can see private repositories the caller cannot, so a
+// private result from the installation is only trusted for visibility; the
+// commit itself is resolved with the caller's own credentials.
+type SelectingGitClient struct {
+	api           *GitHubAPIGitClient
+	git           GitClient
+	installations InstallationTokenSource
+}
+
+var _ GitClient = (*SelectingGitClient)(nil)
+
+func NewSelectingGitClient(git GitClient, api *GitHubAPIGitClient, installations InstallationTokenSource) *SelectingGitClient {
+	return &SelectingGitClient{
+		api:           api,
+		git:           git,
+		installations: installations,
+	}
+}
+
+func (c *SelectingGitClient) GetLatestCommitSHA(ctx context.Context, owner, repo string) (string, error) {
+	if head := c.headViaInstallation(ctx, owner, repo); head != nil {
+		if head.Private {
+			return "", errors.Wrap(ErrRepoNotFound, fmt.Sprintf("%s/%s", owner, repo))
+		}
+		return head.CommitSHA, nil
+	}
+
+	return c.git.GetLatestCommitSHA(ctx, owner, repo)
+}
+
+func (c *SelectingGitClient) GetLatestCommitSHAWithToken(ctx context.Context, owner, repo, token string) (string, error) {
+	head := c.headViaInstallation(ctx, owner, repo)
+	if head != nil && !head.Private {
+		return head.CommitSHA, nil
+	}
+
+	sha, err := c.api.GetLatestCommitSHAWithToken(ctx, owner, repo, token)
+	// A repository the installation reports private is never reachable anonymously
+	if err == nil || head != nil || ctx.Err() != nil {
+		return sha, err
+	}
+	if !errors.Is(err, ErrRepoNotFound) {
+		slog.WarnContext(ctx, "failed to resolve commit with user token", "owner", owner, "repo", repo, "error", err)
+	}
+
+	// The token may be expired, rate limited or missing a scope; public
+	// repositories still resolve anonymously. Report the token's error
+	// otherwise, since it explains more than an anonymous not found.
+	if sha, gitErr := c.git.GetLatestCommitSHA(ctx, owner, repo); gitErr == nil {
+		return sha, nil
+	}
+	return "", err
+}
+
+// headViaInstallation returns nil when no installation covers the repository
+// or the lookup fails, so callers fall through to the next strategy.
+func (c *SelectingGitClient) headViaInstallation(ctx context.Context, owner, repo string) *RepositoryHead {
+	if c.installations == nil {
+		return nil
+	}
+
+	token, err := c.installations.InstallationToken(ctx, owner)
+	if err != nil {
+		if !errors.Is(err, ErrNoInstallation) {
+			slog.WarnContext(ctx, "failed to get installation token", "owner", owner, "error", err)
+		}
+		return nil
+	}
+
+	head, err := c.api.GetRepositoryHead(ctx, owner, repo, token)
+	if err != nil {
+		if !errors.Is(err, ErrRepoNotFound) {
+			slog.WarnContext(ctx, "failed to resolve commit via installation", "owner", owner, "repo", repo, "error", err)
+		}
+		return nil
+	}
+	return head
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+type fakeInstallationTokenSource struct {
+	err    error
+	tokens map[string]string
+}
+
+func (s *fakeInstallationTokenSource) InstallationToken(_ context.Context, owner string) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	token, ok := s.tokens[owner]
+	if !ok {
+		return "", ErrNoInstallation
+	}
+	return token, nil
+}
+
+func newTestSelectingGitClient(t *testing.T, installations InstallationTokenSource) (*SelectingGitClient, *countingGitClient) {
+	t.Helper()
+	api, _ := newTestGitHubAPI(t, map[string]fakeGitHubRepo{
+		"installed/public":  {branch: "main", sha: "api-public"},
+		"installed/private": {branch: "main", private: true, sha: "api-private", token: "installation-token"},
+		"installed/user":    {branch: "main", private: true, sha: "api-installed-user", token: "user-token"},
+		"user/private":      {branch: "main", private: true, sha: "api-user-private", token: "user-token"},
+	})
+	git := &countingGitClient{sha: "git"}
+	return NewSelectingGitClient(git, api, installations), git
+}
+
+func TestSelectingGitClient_PrefersInstallation(t *testing.T) {
+	c, git := newTestSelectingGitClient(t, &fakeInstallationTokenSource{
+		tokens: map[string]string{"installed": "installation-token"},
+	})
+	ctx := context.Background()
+
+	if sha, err := c.GetLatestCommitSHA(ctx, "installed", "public"); err != nil || sha != "api-public" {
+		t.Errorf("expected installation lookup, got %q, %v", sha, err)
+	}
+	if sha, err := c.GetLatestCommitSHAWithToken(ctx, "installed", "public", "user-token"); err != nil || sha != "api-public" {
+		t.Errorf("expected installation lookup, got %q, %v", sha, err)
+	}
+	if git.calls.Load() != 0 {
+		t.Errorf("expected no git fallback, got %d calls", git.calls.Load())
+	}
+}
+
+func TestSelectingGitClient_HidesPrivateInstallationRepos(t *testing.T) {
+	c, git := newTestSelectingGitClient(t, &fakeInstallationTokenSource{
+		tokens: map[string]string{"installed": "installation-token"},
+	})
+	ctx := context.Background()
+
+	if _, err := c.GetLatestCommitSHA(ctx, "installed", "private"); !errors.Is(err, ErrRepoNotFound) {
+		t.Errorf("expected anonymous caller to get ErrRepoNotFound, got %v", err)
+	}
+	if _, err := c.GetLatestCommitSHAWithToken(ctx, "installed", "private", "user-token"); !errors.Is(err, ErrRepoNotFound) {
+		t.Errorf("expected caller without access to get ErrRepoNotFound, got %v", err)
+	}
+	if git.calls.Load() != 0 {
+		t.Errorf("expected no git fallback, got %d calls", git.calls.Load())
+	}
+}
+
+func TestSelectingGitClient_FallsBackWithoutInstallation(t *testing.T) {
+	c, git := newTestSelectingGitClient(t, &fakeInstallationTokenSource{})
+	ctx := context.Background()
+
+	if sha, err := c.GetLatestCommitSHAWithToken(ctx, "user", "private", "user-token"); err != nil || sha != "api-user-private" {
+		t.Errorf("expected user token lookup, got %q, %v", sha, err)
+	}
+	if sha, err := c.GetLatestCommitSHA(ctx, "other", "repo"); err != nil || sha != "git" {
+		t.Errorf("expected anonymous git lookup, got %q, %v", sha, err)
+	}
+	if git.calls.Load() != 1 {
+		t.Errorf("expected 1 git call, got %d", git.calls.Load())
+	}
+}
+
+func TestSelectingGitClient_FallsBackOnInstallationError(t *testing.T) {
+	c, git := newTestSelectingGitClient(t, &fakeInstallationTokenSource{err: errors.New("token service down")})
+
+	if sha, err := c.GetLatestCommitSHA(context.Background(), "installed", "public"); err != nil || sha != "git" {
+		t.Errorf("expected git fallback, got %q, %v", sha, err)
+	}
+	if git.calls.Load() != 1 {
+		t.Errorf("expected 1 git call, got %d", git.calls.Load())
+	}
+}
+
+func TestSelectingGitClient_FallsBackToUserTokenWhenInstallationCannotSee(t *testing.T) {
+	c, git := newTestSelectingGitClient(t, &fakeInstallationTokenSource{
+		tokens: map[string]string{"installed": "installation-token"},
+	})
+
+	sha, err := c.GetLatestCommitSHAWithToken(context.Background(), "installed", "user", "user-token")
+	if err != nil || sha != "api-installed-user" {
+		t.Errorf("expected user token lookup, got %q, %v", sha, err)
+	}
+	if git.calls.Load() != 0 {
+		t.Errorf("expected no git fallback, got %d calls", git.calls.Load())
+	}
+}
+
+func TestSelectingGitClient_FallsBackToAnonymousGitWhenUserTokenFails(t *testing.T) {
+	c, git := newTestSelectingGitClient(t, &fakeInstallationTokenSource{})
+
+	sha, err := c.GetLatestCommitSHAWithToken(context.Background(), "other", "public", "expired-token")
+	if err != nil || sha != "git" {
+		t.Errorf("expected anonymous git lookup, got %q, %v", sha, err)
+	}
+	if git.calls.Load() != 1 {
+		t.Errorf("expected 1 git call, got %d", git.calls.Load())
+	}
+}
+
+func TestSelectingGitClient_ReportsUserTokenErrorWhenAllFail(t *testing.T) {
+	c, git := newTestSelectingGitClient(t, &fakeInstallationTokenSource{})
+	git.err = errors.Wrap(ErrInvalidResponse, "git ls-remote failed")
+
+	_, err := c.GetLatestCommitSHAWithToken(context.Background(), "other", "private", "expired-token")
+	if !errors.Is(err, ErrRepoNotFound) {
+		t.Errorf("expected the user token error, got %v", err)
+	}
+	if git.calls.Load() != 1 {
+		t.Errorf("expected 1 git call, got %d", git.calls.Load())
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/cockroachdb/errors"
 	gh "github.com/google/go-github/v75/github"
 	"golang.org/x/oauth2"
+
+	"github.com/specvital/web/src/backend/common/tracing"
 )
 
 var (
@@ -28,6 +30,7 @@ func (e *RateLimitError) Error() string {
 
 type GitHubRepository struct {
 	Archived      bool
+	CanPush       bool
 	DefaultBranch string
 	Description   string
 	Disabled      bool
@@ -54,6 +57,7 @@ type GitHubOrganization struct {
 
 type GitHubClient interface {
 	GetOrganization(ctx context.Context, org string) (*GitHubOrganization, error)
+	GetRepository(ctx context.Context, owner, repo string) (*GitHubRepository, error)
 	ListOrgRepositories(ctx context.Context, org string, maxResults int) ([]GitHubRepository, error)
 	ListUserOrganizations(ctx context.Context) ([]GitHubOrganization, error)
 	ListUserRepositories(ctx context.Context, maxResults int) ([]GitHubRepository, error)
@@ -64,7 +68,8 @@ type GitHubClientFactory func(token string) GitHubClient
 func NewGitHubClientFactory() GitHubClientFactory {
 	return func(token string) GitHubClient {
 		ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-		tc := oauth2.NewClient(context.Background(), ts)
+		base := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: tracing.Transport(nil)})
+		tc := oauth2.NewClient(base, ts)
 		return &gitHubClient{client: gh.NewClient(tc)}
 	}
 }
@@ -163,6 +168,18 @@ func (c *gitHubClient) GetOrganization(ctx context.Context, org string) (*GitHub
 	return &result, nil
 }
 
+// GetRepository returns the repository as seen by the token's user; CanPush is
+// only reported for a user token.
+func (c *gitHubClient) GetRepository(ctx context.Context, owner, repo string) (*GitHubRepository, error) {
+	ghRepo, _, err := c.client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, handleGitHubError(err)
+	}
+
+	result := mapRepository(ghRepo)
+	return &result, nil
+}
+
 func handleGitHubError(err error) error {
 	var rateLimitErr *gh.RateLimitError
 	if errors.As(err, &rateLimitErr) {
@@ -199,8 +216,10 @@ func mapRepository(repo *gh.Repository) GitHubRepository {
 		owner = repo.Owner.GetLogin()
 	}
 
+	permissions := repo.GetPermissions()
 	r := GitHubRepository{
 		Archived:  repo.GetArchived(),
+		CanPush:   permissions["push"] || permissions["admin"],
 		Disabled:  repo.GetDisabled(),
 		Fork:      repo.GetFork(),
 		FullName:  repo.GetFullName(),
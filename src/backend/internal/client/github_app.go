@@ -9,6 +9,7 @@ import (
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	gh "github.com/google/go-github/v75/github"
 
+	"github.com/specvital/web/src/backend/common/tracing"
 	"github.com/specvital/web/src/backend/modules/github-app/domain"
 	"github.com/specvital/web/src/backend/modules/github-app/domain/port"
 )
@@ -35,7 +36,7 @@ func NewGitHubAppClient(cfg GitHubAppConfig) (*GitHubAppClient, error) {
 		return nil, domain.ErrInvalidPrivateKey
 	}
 
-	appTransport, err := ghinstallation.NewAppsTransport(http.DefaultTransport, cfg.AppID, cfg.PrivateKey)
+	appTransport, err := ghinstallation.NewAppsTransport(tracing.Transport(http.DefaultTransport), cfg.AppID, cfg.PrivateKey)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPrivateKey, err)
 	}
@@ -73,6 +74,14 @@ func (c *GitHubAppClient) GetInstallationURL() string {
 	return fmt.Sprintf("https://github.com/settings/apps/%d/installations", c.appID)
 }
 
+func (c *GitHubAppClient) VerifyCredentials(ctx context.Context) error {
+	client := gh.NewClient(&http.Client{Transport: c.appTransport})
+	if _, _, err := client.Apps.Get(ctx, ""); err != nil {
+		return fmt.Errorf("get authenticated app: %w", err)
+	}
+	return nil
+}
+
 func (c *GitHubAppClient) NewInstallationClient(installationID int64) *gh.Client {
 	itr := ghinstallation.NewFromAppsTransport(c.appTransport, installationID)
 	return gh.NewClient(&http.Client{Transport: itr})
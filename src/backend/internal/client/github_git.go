@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/cockroachdb/errors"
+	gh "github.com/google/go-github/v75/github"
+
+	"github.com/specvital/web/src/backend/common/tracing"
+)
+
+// RepositoryHead is the tip of a repository's default branch.
+type RepositoryHead struct {
+	CommitSHA     string
+	DefaultBranch string
+	Private       bool
+}
+
+// GitHubAPIGitClient resolves latest commits through the GitHub REST API
+// instead of spawning git. Errors use the same sentinels as the git client.
+type GitHubAPIGitClient struct {
+	baseURL *url.URL
+}
+
+var _ GitClient = (*GitHubAPIGitClient)(nil)
+
+func NewGitHubAPIGitClient() *GitHubAPIGitClient {
+	return &GitHubAPIGitClient{}
+}
+
+func (c *GitHubAPIGitClient) GetLatestCommitSHA(ctx context.Context, owner, repo string) (string, error) {
+	return c.GetLatestCommitSHAWithToken(ctx, owner, repo, "")
+}
+
+func (c *GitHubAPIGitClient) GetLatestCommitSHAWithToken(ctx context.Context, owner, repo, token string) (string, error) {
+	head, err := c.GetRepositoryHead(ctx, owner, repo, token)
+	if err != nil {
+		return "", err
+	}
+	return head.CommitSHA, nil
+}
+
+// GetRepositoryHead returns the default branch tip along with repository
+// visibility. An empty token makes an unauthenticated request.
+func (c *GitHubAPIGitClient) GetRepositoryHead(ctx context.Context, owner, repo, token string) (*RepositoryHead, error) {
+	client := c.newClient(token)
+
+	repository, _, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, mapCommitLookupError(err, owner, repo)
+	}
+
+	branch := repository.GetDefaultBranch()
+	sha, _, err := client.Repositories.GetCommitSHA1(ctx, owner, repo, branch, "")
+	if err != nil {
+		return nil, mapCommitLookupError(err, owner, repo)
+	}
+	if sha == "" {
+		return nil, errors.Wrap(ErrInvalidResponse, "no commit SHA in response")
+	}
+
+	return &RepositoryHead{
+		CommitSHA:     sha,
+		DefaultBranch: branch,
+		Private:       repository.GetPrivate(),
+	}, nil
+}
+
+func (c *GitHubAPIGitClient) newClient(token string) *gh.Client {
+	client := gh.NewClient(&http.Client{Transport: tracing.Transport(nil)})
+	if token != "" {
+		client = client.WithAuthToken(token)
+	}
+	if c.baseURL != nil {
+		client.BaseURL = c.baseURL
+	}
+	return client
+}
+
+func mapCommitLookupError(err error, owner, repo string) error {
+	var ghErr *gh.ErrorResponse
+	if errors.As(err, &ghErr) && ghErr.Response != nil {
+		switch ghErr.Response.StatusCode {
+		case http.StatusNotFound:
+			return errors.Wrap(ErrRepoNotFound, fmt.Sprintf("%s/%s", owner, repo))
+		case http.StatusUnauthorized:
+			return errors.Wrap(ErrForbidden, fmt.Sprintf("%s/%s", owner, repo))
+		}
+	}
+	return handleGitHubError(err)
+}
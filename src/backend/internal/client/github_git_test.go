@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+type fakeGitHubRepo struct {
+	branch  string
+	private bool
+	sha     string
+	token   string
+}
+
+func newTestGitHubAPI(t *testing.T, repos map[string]fakeGitHubRepo) (*GitHubAPIGitClient, *atomic.Int64) {
+	t.Helper()
+	var requests atomic.Int64
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/{owner}/{repo}", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		repo, ok := lookupFakeRepo(r, repos)
+		if !ok {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"default_branch":"` + repo.branch + `","private":` + boolJSON(repo.private) + `}`))
+	})
+	mux.HandleFunc("GET /repos/{owner}/{repo}/commits/{ref}", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		repo, ok := lookupFakeRepo(r, repos)
+		if !ok || r.PathValue("ref") != repo.branch {
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(repo.sha))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL + "/")
+	return &GitHubAPIGitClient{baseURL: baseURL}, &requests
+}
+
+// lookupFakeRepo hides private repositories from callers without the
+// matching token, the way GitHub answers with 404.
+func lookupFakeRepo(r *http.Request, repos map[string]fakeGitHubRepo) (fakeGitHubRepo, bool) {
+	repo, ok := repos[r.PathValue("owner")+"/"+r.PathValue("repo")]
+	if !ok {
+		return fakeGitHubRepo{}, false
+	}
+	if repo.private && r.Header.Get("Authorization") != "Bearer "+repo.token {
+		return fakeGitHubRepo{}, false
+	}
+	return repo, true
+}
+
+func boolJSON(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+func TestGitHubAPIGitClient_GetRepositoryHead(t *testing.T) {
+	c, _ := newTestGitHubAPI(t, map[string]fakeGitHubRepo{
+		"owner/public":  {branch: "main", sha: "abc123"},
+		"owner/private": {branch: "develop", private: true, sha: "def456", token: "secret"},
+	})
+	ctx := context.Background()
+
+	head, err := c.GetRepositoryHead(ctx, "owner", "public", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if head.CommitSHA != "abc123" || head.DefaultBranch != "main" || head.Private {
+		t.Errorf("unexpected head %+v", head)
+	}
+
+	head, err = c.GetRepositoryHead(ctx, "owner", "private", "secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if head.CommitSHA != "def456" || head.DefaultBranch != "develop" || !head.Private {
+		t.Errorf("unexpected head %+v", head)
+	}
+}
+
+func TestGitHubAPIGitClient_NotFound(t *testing.T) {
+	c, _ := newTestGitHubAPI(t, map[string]fakeGitHubRepo{
+		"owner/private": {branch: "main", private: true, sha: "abc123", token: "secret"},
+	})
+
+	_, err := c.GetLatestCommitSHAWithToken(context.Background(), "owner", "private", "other")
+	if !errors.Is(err, ErrRepoNotFound) {
+		t.Errorf("expected ErrRepoNotFound, got %v", err)
+	}
+}
@@ -19,6 +19,7 @@ SELECT EXISTS(
     WHERE c.host = $1 AND c.owner = $2 AND c.name = $3
       AND a.commit_sha = $4
       AND a.status = 'completed'
+      AND a.source = 'worker'
 ) AS exists
 `
 
@@ -196,7 +197,7 @@ WHERE c.host = $1 AND c.owner = $2 AND c.name = $3
   AND c.is_stale = false
   AND starts_with(a.commit_sha, $4::text)
   AND a.status = 'completed'
-ORDER BY COALESCE(a.committed_at, a.created_at) DESC
+ORDER BY a.source = 'ingest', COALESCE(a.committed_at, a.created_at) DESC
 LIMIT 1
 `
 
@@ -220,6 +221,7 @@ type GetCompletedAnalysisByCommitSHARow struct {
 	Repo          string             `json:"repo"`
 }
 
+// Analyses produced by the worker take precedence over ingested ones.
 func (q *Queries) GetCompletedAnalysisByCommitSHA(ctx context.Context, arg GetCompletedAnalysisByCommitSHAParams) (GetCompletedAnalysisByCommitSHARow, error) {
 	row := q.db.QueryRow(ctx, getCompletedAnalysisByCommitSHA,
 		arg.Host,
@@ -259,7 +261,7 @@ FROM analyses a
 JOIN codebases c ON c.id = a.codebase_id
 WHERE c.host = $1 AND c.owner = $2 AND c.name = $3
   AND a.status = 'completed'
-ORDER BY COALESCE(a.committed_at, a.created_at) DESC
+ORDER BY a.source = 'ingest', COALESCE(a.committed_at, a.created_at) DESC
 LIMIT 1
 `
 
@@ -282,6 +284,7 @@ type GetLatestCompletedAnalysisRow struct {
 	Repo          string             `json:"repo"`
 }
 
+// Analyses produced by the worker take precedence over ingested ones.
 func (q *Queries) GetLatestCompletedAnalysis(ctx context.Context, arg GetLatestCompletedAnalysisParams) (GetLatestCompletedAnalysisRow, error) {
 	row := q.db.QueryRow(ctx, getLatestCompletedAnalysis, arg.Host, arg.Owner, arg.Name)
 	var i GetLatestCompletedAnalysisRow
@@ -300,6 +303,116 @@ func (q *Queries) GetLatestCompletedAnalysis(ctx context.Context, arg GetLatestC
 	return i, err
 }
 
+const getOrgRepositoryTestSummaries = `-- name: GetOrgRepositoryTestSummaries :many
+SELECT
+    c.id AS codebase_id,
+    c.name,
+    a.id AS analysis_id,
+    a.completed_at AS analyzed_at,
+    a.total_tests,
+    a.active_count,
+    a.focused_count,
+    a.skipped_count,
+    a.todo_count,
+    a.xfail_count,
+    b.total_tests AS baseline_total_tests
+FROM codebases c
+JOIN LATERAL (
+    SELECT
+        an.id,
+        an.completed_at,
+        an.total_tests,
+        COALESCE(tc_summary.active_count, 0)::int AS active_count,
+        COALESCE(tc_summary.focused_count, 0)::int AS focused_count,
+        COALESCE(tc_summary.skipped_count, 0)::int AS skipped_count,
+        COALESCE(tc_summary.todo_count, 0)::int AS todo_count,
+        COALESCE(tc_summary.xfail_count, 0)::int AS xfail_count
+    FROM analyses an
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*) FILTER (WHERE tc.status = 'active') AS active_count,
+            COUNT(*) FILTER (WHERE tc.status = 'focused') AS focused_count,
+            COUNT(*) FILTER (WHERE tc.status = 'skipped') AS skipped_count,
+            COUNT(*) FILTER (WHERE tc.status = 'todo') AS todo_count,
+            COUNT(*) FILTER (WHERE tc.status = 'xfail') AS xfail_count
+        FROM test_cases tc
+        JOIN test_suites ts ON ts.id = tc.suite_id
+        JOIN test_files tf ON ts.file_id = tf.id
+        WHERE tf.analysis_id = an.id
+    ) tc_summary ON true
+    WHERE an.codebase_id = c.id AND an.status = 'completed'
+    ORDER BY an.source = 'ingest', an.created_at DESC
+    LIMIT 1
+) a ON true
+LEFT JOIN LATERAL (
+    SELECT an.total_tests
+    FROM analyses an
+    WHERE an.codebase_id = c.id AND an.status = 'completed'
+    ORDER BY
+        an.created_at <= $1::timestamptz DESC,
+        CASE WHEN an.created_at <= $1::timestamptz THEN an.created_at END DESC,
+        an.created_at ASC
+    LIMIT 1
+) b ON true
+WHERE c.host = 'github.com'
+  AND c.owner = $2
+  AND c.is_stale = false
+ORDER BY c.name
+`
+
+type GetOrgRepositoryTestSummariesParams struct {
+	Since pgtype.Timestamptz `json:"since"`
+	Owner string             `json:"owner"`
+}
+
+type GetOrgRepositoryTestSummariesRow struct {
+	CodebaseID         pgtype.UUID        `json:"codebase_id"`
+	Name               string             `json:"name"`
+	AnalysisID         pgtype.UUID        `json:"analysis_id"`
+	AnalyzedAt         pgtype.Timestamptz `json:"analyzed_at"`
+	TotalTests         int32              `json:"total_tests"`
+	ActiveCount        int32              `json:"active_count"`
+	FocusedCount       int32              `json:"focused_count"`
+	SkippedCount       int32              `json:"skipped_count"`
+	TodoCount          int32              `json:"todo_count"`
+	XfailCount         int32              `json:"xfail_count"`
+	BaselineTotalTests pgtype.Int4        `json:"baseline_total_tests"`
+}
+
+// Baseline is the latest completed analysis at or before since, falling back to
+// the oldest one for repositories first analyzed inside the window.
+func (q *Queries) GetOrgRepositoryTestSummaries(ctx context.Context, arg GetOrgRepositoryTestSummariesParams) ([]GetOrgRepositoryTestSummariesRow, error) {
+	rows, err := q.db.Query(ctx, getOrgRepositoryTestSummaries, arg.Since, arg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOrgRepositoryTestSummariesRow
+	for rows.Next() {
+		var i GetOrgRepositoryTestSummariesRow
+		if err := rows.Scan(
+			&i.CodebaseID,
+			&i.Name,
+			&i.AnalysisID,
+			&i.AnalyzedAt,
+			&i.TotalTests,
+			&i.ActiveCount,
+			&i.FocusedCount,
+			&i.SkippedCount,
+			&i.TodoCount,
+			&i.XfailCount,
+			&i.BaselineTotalTests,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPaginatedRepositoriesByName = `-- name: GetPaginatedRepositoriesByName :many
 WITH user_context AS (
     SELECT username FROM users WHERE id = $1::uuid
@@ -353,7 +466,7 @@ JOIN LATERAL (
         WHERE tf.analysis_id = an.id
     ) tc_summary ON true
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -514,7 +627,7 @@ JOIN LATERAL (
         WHERE tf.analysis_id = an.id
     ) tc_summary ON true
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -675,7 +788,7 @@ JOIN LATERAL (
         WHERE tf.analysis_id = an.id
     ) tc_summary ON true
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -783,6 +896,191 @@ func (q *Queries) GetPaginatedRepositoriesByTests(ctx context.Context, arg GetPa
 	return items, nil
 }
 
+const getPaginatedRepositoriesByTrending = `-- name: GetPaginatedRepositoriesByTrending :many
+WITH user_context AS (
+    SELECT username FROM users WHERE id = $1::uuid
+),
+user_orgs AS (
+    SELECT go.login
+    FROM user_github_org_memberships ugom
+    JOIN github_organizations go ON go.id = ugom.org_id
+    WHERE ugom.user_id = $1::uuid
+)
+SELECT
+    c.id AS codebase_id,
+    c.owner,
+    c.name,
+    a.id AS analysis_id,
+    a.commit_sha,
+    a.completed_at AS analyzed_at,
+    a.total_tests,
+    a.active_count,
+    a.focused_count,
+    a.skipped_count,
+    a.todo_count,
+    a.xfail_count,
+    EXISTS(
+        SELECT 1 FROM user_analysis_history uah
+        WHERE uah.analysis_id = a.id AND uah.user_id = $1::uuid
+    ) AS is_analyzed_by_me,
+    t.trending_score
+FROM codebases c
+JOIN LATERAL (
+    SELECT
+        an.id,
+        an.commit_sha,
+        an.completed_at,
+        an.total_tests,
+        COALESCE(tc_summary.active_count, 0)::int AS active_count,
+        COALESCE(tc_summary.focused_count, 0)::int AS focused_count,
+        COALESCE(tc_summary.skipped_count, 0)::int AS skipped_count,
+        COALESCE(tc_summary.todo_count, 0)::int AS todo_count,
+        COALESCE(tc_summary.xfail_count, 0)::int AS xfail_count
+    FROM analyses an
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*) FILTER (WHERE tc.status = 'active') AS active_count,
+            COUNT(*) FILTER (WHERE tc.status = 'focused') AS focused_count,
+            COUNT(*) FILTER (WHERE tc.status = 'skipped') AS skipped_count,
+            COUNT(*) FILTER (WHERE tc.status = 'todo') AS todo_count,
+            COUNT(*) FILTER (WHERE tc.status = 'xfail') AS xfail_count
+        FROM test_cases tc
+        JOIN test_suites ts ON ts.id = tc.suite_id
+        JOIN test_files tf ON ts.file_id = tf.id
+        WHERE tf.analysis_id = an.id
+    ) tc_summary ON true
+    WHERE an.codebase_id = c.id AND an.status = 'completed'
+    ORDER BY an.source = 'ingest', an.created_at DESC
+    LIMIT 1
+) a ON true
+JOIN LATERAL (
+    SELECT (
+        COALESCE((
+            SELECT SUM(v.view_count)
+            FROM codebase_daily_views v
+            WHERE v.codebase_id = c.id AND v.view_date >= (now() AT TIME ZONE 'UTC')::date - $2::int
+        ), 0)
+        + 5 * (
+            SELECT COUNT(*)
+            FROM analyses an
+            WHERE an.codebase_id = c.id
+              AND an.status = 'completed'
+              AND an.completed_at >= ((now() AT TIME ZONE 'UTC')::date - $2::int)::timestamp AT TIME ZONE 'UTC'
+        )
+    )::int AS trending_score
+) t ON true
+WHERE c.is_stale = false
+  AND c.is_private = false
+  AND (
+    $3::text = 'all'
+    OR ($3::text = 'my' AND EXISTS(
+        SELECT 1 FROM user_analysis_history uah
+        WHERE uah.analysis_id = a.id AND uah.user_id = $1::uuid
+    ))
+    OR ($3::text = 'community'
+        AND c.is_private = false
+        AND NOT EXISTS(
+            SELECT 1 FROM user_analysis_history uah
+            WHERE uah.analysis_id = a.id AND uah.user_id = $1::uuid
+        ))
+  )
+  AND (
+    $4::text = 'all'
+    OR ($4::text = 'mine' AND c.owner = (SELECT username FROM user_context))
+    OR ($4::text = 'organization' AND c.owner IN (SELECT login FROM user_orgs))
+    OR ($4::text = 'others'
+        AND c.owner != (SELECT username FROM user_context)
+        AND c.owner NOT IN (SELECT login FROM user_orgs))
+  )
+  AND (
+    $5::uuid IS NULL
+    OR (
+      ($6::text = 'desc' AND (t.trending_score, c.id) < ($7::int, $5::uuid))
+      OR ($6::text = 'asc' AND (t.trending_score, c.id) > ($7::int, $5::uuid))
+    )
+  )
+ORDER BY
+  CASE WHEN $6::text = 'desc' THEN t.trending_score END DESC,
+  CASE WHEN $6::text = 'asc' THEN t.trending_score END ASC,
+  CASE WHEN $6::text = 'desc' THEN c.id END DESC,
+  CASE WHEN $6::text = 'asc' THEN c.id END ASC
+LIMIT $8
+`
+
+type GetPaginatedRepositoriesByTrendingParams struct {
+	UserID              pgtype.UUID `json:"user_id"`
+	TrendingDays        int32       `json:"trending_days"`
+	ViewFilter          string      `json:"view_filter"`
+	OwnershipFilter     string      `json:"ownership_filter"`
+	CursorID            pgtype.UUID `json:"cursor_id"`
+	SortOrder           string      `json:"sort_order"`
+	CursorTrendingScore int32       `json:"cursor_trending_score"`
+	PageLimit           int32       `json:"page_limit"`
+}
+
+type GetPaginatedRepositoriesByTrendingRow struct {
+	CodebaseID     pgtype.UUID        `json:"codebase_id"`
+	Owner          string             `json:"owner"`
+	Name           string             `json:"name"`
+	AnalysisID     pgtype.UUID        `json:"analysis_id"`
+	CommitSha      string             `json:"commit_sha"`
+	AnalyzedAt     pgtype.Timestamptz `json:"analyzed_at"`
+	TotalTests     int32              `json:"total_tests"`
+	ActiveCount    int32              `json:"active_count"`
+	FocusedCount   int32              `json:"focused_count"`
+	SkippedCount   int32              `json:"skipped_count"`
+	TodoCount      int32              `json:"todo_count"`
+	XfailCount     int32              `json:"xfail_count"`
+	IsAnalyzedByMe bool               `json:"is_analyzed_by_me"`
+	TrendingScore  int32              `json:"trending_score"`
+}
+
+// Public repositories only: trending ranks by views plus completed analyses in
+// the last trending_days UTC days, weighting an analysis like five views.
+func (q *Queries) GetPaginatedRepositoriesByTrending(ctx context.Context, arg GetPaginatedRepositoriesByTrendingParams) ([]GetPaginatedRepositoriesByTrendingRow, error) {
+	rows, err := q.db.Query(ctx, getPaginatedRepositoriesByTrending,
+		arg.UserID,
+		arg.TrendingDays,
+		arg.ViewFilter,
+		arg.OwnershipFilter,
+		arg.CursorID,
+		arg.SortOrder,
+		arg.CursorTrendingScore,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPaginatedRepositoriesByTrendingRow
+	for rows.Next() {
+		var i GetPaginatedRepositoriesByTrendingRow
+		if err := rows.Scan(
+			&i.CodebaseID,
+			&i.Owner,
+			&i.Name,
+			&i.AnalysisID,
+			&i.CommitSha,
+			&i.AnalyzedAt,
+			&i.TotalTests,
+			&i.ActiveCount,
+			&i.FocusedCount,
+			&i.SkippedCount,
+			&i.TodoCount,
+			&i.XfailCount,
+			&i.IsAnalyzedByMe,
+			&i.TrendingScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPreviousAnalysis = `-- name: GetPreviousAnalysis :one
 SELECT
     id,
@@ -830,7 +1128,7 @@ JOIN LATERAL (
     SELECT an.id, an.total_tests
     FROM analyses an
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -958,20 +1256,47 @@ func (q *Queries) MarkAnalysisFailed(ctx context.Context, arg MarkAnalysisFailed
 	return err
 }
 
-const updateCodebaseLastViewed = `-- name: UpdateCodebaseLastViewed :exec
-UPDATE codebases
-SET last_viewed_at = now()
-WHERE host = $1 AND owner = $2 AND name = $3
+const recordCodebaseView = `-- name: RecordCodebaseView :exec
+WITH viewed AS (
+    UPDATE codebases
+    SET last_viewed_at = now()
+    WHERE host = $1 AND owner = $2 AND name = $3 AND is_stale = false
+    RETURNING id
+),
+stale_viewers AS (
+    DELETE FROM codebase_daily_viewers d
+    USING viewed
+    WHERE d.codebase_id = viewed.id AND d.view_date < (now() AT TIME ZONE 'UTC')::date
+),
+first_view AS (
+    INSERT INTO codebase_daily_viewers (codebase_id, view_date, viewer)
+    SELECT id, (now() AT TIME ZONE 'UTC')::date, $4::text FROM viewed
+    ON CONFLICT DO NOTHING
+    RETURNING codebase_id, view_date
+)
+INSERT INTO codebase_daily_views (codebase_id, view_date, view_count)
+SELECT codebase_id, view_date, 1 FROM first_view
+ON CONFLICT (codebase_id, view_date) DO UPDATE SET
+    view_count = codebase_daily_views.view_count + 1
 `
 
-type UpdateCodebaseLastViewedParams struct {
-	Host  string `json:"host"`
-	Owner string `json:"owner"`
-	Name  string `json:"name"`
+type RecordCodebaseViewParams struct {
+	Host   string `json:"host"`
+	Owner  string `json:"owner"`
+	Name   string `json:"name"`
+	Viewer string `json:"viewer"`
 }
 
-func (q *Queries) UpdateCodebaseLastViewed(ctx context.Context, arg UpdateCodebaseLastViewedParams) error {
-	_, err := q.db.Exec(ctx, updateCodebaseLastViewed, arg.Host, arg.Owner, arg.Name)
+// Touches last_viewed_at and counts the view in the per-day aggregate used for
+// trending, once per viewer per UTC day. Viewer markers from earlier days are
+// dropped whenever the codebase is viewed again.
+func (q *Queries) RecordCodebaseView(ctx context.Context, arg RecordCodebaseViewParams) error {
+	_, err := q.db.Exec(ctx, recordCodebaseView,
+		arg.Host,
+		arg.Owner,
+		arg.Name,
+		arg.Viewer,
+	)
 	return err
 }
 
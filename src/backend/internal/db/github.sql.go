@@ -276,6 +276,39 @@ func (q *Queries) HasUserGitHubRepositories(ctx context.Context, userID pgtype.U
 	return has_repos, err
 }
 
+const hasUserOrgAccess = `-- name: HasUserOrgAccess :one
+SELECT (
+    EXISTS(
+        SELECT 1
+        FROM user_github_org_memberships m
+        JOIN github_organizations go ON go.id = m.org_id
+        WHERE m.user_id = $1 AND go.login = $2
+    )
+    OR EXISTS(
+        SELECT 1
+        FROM github_app_installations i
+        WHERE i.installer_user_id = $1
+          AND i.account_login = $2
+          AND i.account_type = 'organization'
+          AND i.suspended_at IS NULL
+    )
+)::boolean AS has_access
+`
+
+type HasUserOrgAccessParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	Login  string      `json:"login"`
+}
+
+// A user can view organization data when they are a synced member or installed
+// the GitHub App on the organization themselves.
+func (q *Queries) HasUserOrgAccess(ctx context.Context, arg HasUserOrgAccessParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasUserOrgAccess, arg.UserID, arg.Login)
+	var has_access bool
+	err := row.Scan(&has_access)
+	return has_access, err
+}
+
 const hasUserOrgMemberships = `-- name: HasUserOrgMemberships :one
 SELECT EXISTS(
     SELECT 1 FROM user_github_org_memberships WHERE user_id = $1
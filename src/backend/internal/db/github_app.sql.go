@@ -44,6 +44,29 @@ func (q *Queries) GetGitHubAppInstallationByAccountID(ctx context.Context, accou
 	return i, err
 }
 
+const getGitHubAppInstallationByAccountLogin = `-- name: GetGitHubAppInstallationByAccountLogin :one
+SELECT id, installation_id, account_type, account_id, account_login, account_avatar_url, installer_user_id, suspended_at, created_at, updated_at FROM github_app_installations
+WHERE lower(account_login) = lower($1)
+`
+
+func (q *Queries) GetGitHubAppInstallationByAccountLogin(ctx context.Context, accountLogin string) (GithubAppInstallation, error) {
+	row := q.db.QueryRow(ctx, getGitHubAppInstallationByAccountLogin, accountLogin)
+	var i GithubAppInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.InstallationID,
+		&i.AccountType,
+		&i.AccountID,
+		&i.AccountLogin,
+		&i.AccountAvatarUrl,
+		&i.InstallerUserID,
+		&i.SuspendedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getGitHubAppInstallationByID = `-- name: GetGitHubAppInstallationByID :one
 SELECT id, installation_id, account_type, account_id, account_login, account_avatar_url, installer_user_id, suspended_at, created_at, updated_at FROM github_app_installations
 WHERE installation_id = $1
@@ -11,6 +11,50 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AnalysisBatchItemStatus string
+
+const (
+	AnalysisBatchItemStatusPending AnalysisBatchItemStatus = "pending"
+	AnalysisBatchItemStatusQueued  AnalysisBatchItemStatus = "queued"
+	AnalysisBatchItemStatusCached  AnalysisBatchItemStatus = "cached"
+	AnalysisBatchItemStatusFailed  AnalysisBatchItemStatus = "failed"
+)
+
+func (e *AnalysisBatchItemStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AnalysisBatchItemStatus(s)
+	case string:
+		*e = AnalysisBatchItemStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AnalysisBatchItemStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAnalysisBatchItemStatus struct {
+	AnalysisBatchItemStatus AnalysisBatchItemStatus `json:"analysis_batch_item_status"`
+	Valid                   bool                    `json:"valid"` // Valid is true if AnalysisBatchItemStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAnalysisBatchItemStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AnalysisBatchItemStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AnalysisBatchItemStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAnalysisBatchItemStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AnalysisBatchItemStatus), nil
+}
+
 type AnalysisStatus string
 
 const (
@@ -55,6 +99,92 @@ func (ns NullAnalysisStatus) Value() (driver.Value, error) {
 	return string(ns.AnalysisStatus), nil
 }
 
+type BulkReanalysisStatus string
+
+const (
+	BulkReanalysisStatusPending   BulkReanalysisStatus = "pending"
+	BulkReanalysisStatusRunning   BulkReanalysisStatus = "running"
+	BulkReanalysisStatusCompleted BulkReanalysisStatus = "completed"
+	BulkReanalysisStatusFailed    BulkReanalysisStatus = "failed"
+)
+
+func (e *BulkReanalysisStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BulkReanalysisStatus(s)
+	case string:
+		*e = BulkReanalysisStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BulkReanalysisStatus: %T", src)
+	}
+	return nil
+}
+
+type NullBulkReanalysisStatus struct {
+	BulkReanalysisStatus BulkReanalysisStatus `json:"bulk_reanalysis_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if BulkReanalysisStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBulkReanalysisStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.BulkReanalysisStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BulkReanalysisStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBulkReanalysisStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BulkReanalysisStatus), nil
+}
+
+type BulkReanalysisTrigger string
+
+const (
+	BulkReanalysisTriggerManual BulkReanalysisTrigger = "manual"
+	BulkReanalysisTriggerAuto   BulkReanalysisTrigger = "auto"
+)
+
+func (e *BulkReanalysisTrigger) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BulkReanalysisTrigger(s)
+	case string:
+		*e = BulkReanalysisTrigger(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BulkReanalysisTrigger: %T", src)
+	}
+	return nil
+}
+
+type NullBulkReanalysisTrigger struct {
+	BulkReanalysisTrigger BulkReanalysisTrigger `json:"bulk_reanalysis_trigger"`
+	Valid                 bool                  `json:"valid"` // Valid is true if BulkReanalysisTrigger is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBulkReanalysisTrigger) Scan(value interface{}) error {
+	if value == nil {
+		ns.BulkReanalysisTrigger, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BulkReanalysisTrigger.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBulkReanalysisTrigger) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BulkReanalysisTrigger), nil
+}
+
 type GithubAccountType string
 
 const (
@@ -374,6 +504,29 @@ type Analysis struct {
 	TotalTests    int32              `json:"total_tests"`
 	CommittedAt   pgtype.Timestamptz `json:"committed_at"`
 	ParserVersion string             `json:"parser_version"`
+	Source        string             `json:"source"`
+	UploadedBy    pgtype.UUID        `json:"uploaded_by"`
+}
+
+type AnalysisBatchItem struct {
+	ID           pgtype.UUID             `json:"id"`
+	BatchID      pgtype.UUID             `json:"batch_id"`
+	Owner        string                  `json:"owner"`
+	Repo         string                  `json:"repo"`
+	Status       AnalysisBatchItemStatus `json:"status"`
+	CommitSha    pgtype.Text             `json:"commit_sha"`
+	ErrorMessage pgtype.Text             `json:"error_message"`
+	UpdatedAt    pgtype.Timestamptz      `json:"updated_at"`
+}
+
+type AnalysisBatch struct {
+	ID           pgtype.UUID        `json:"id"`
+	UserID       pgtype.UUID        `json:"user_id"`
+	OrgLogin     pgtype.Text        `json:"org_login"`
+	TotalCount   int32              `json:"total_count"`
+	SkippedCount int32              `json:"skipped_count"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	SubmittedAt  pgtype.Timestamptz `json:"submitted_at"`
 }
 
 type AtlasSchemaRevision struct {
@@ -398,6 +551,23 @@ type BehaviorCach struct {
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
 }
 
+type BulkReanalysisRun struct {
+	ID             pgtype.UUID           `json:"id"`
+	ParserVersion  string                `json:"parser_version"`
+	Trigger        BulkReanalysisTrigger `json:"trigger"`
+	Status         BulkReanalysisStatus  `json:"status"`
+	TriggeredBy    pgtype.UUID           `json:"triggered_by"`
+	TotalCount     int32                 `json:"total_count"`
+	EnqueuedCount  int32                 `json:"enqueued_count"`
+	FailedCount    int32                 `json:"failed_count"`
+	LastCodebaseID pgtype.UUID           `json:"last_codebase_id"`
+	ErrorMessage   pgtype.Text           `json:"error_message"`
+	CreatedAt      pgtype.Timestamptz    `json:"created_at"`
+	StartedAt      pgtype.Timestamptz    `json:"started_at"`
+	CompletedAt    pgtype.Timestamptz    `json:"completed_at"`
+	UpdatedAt      pgtype.Timestamptz    `json:"updated_at"`
+}
+
 type ClassificationCach struct {
 	ID           pgtype.UUID        `json:"id"`
 	ContentHash  []byte             `json:"content_hash"`
@@ -408,6 +578,12 @@ type ClassificationCach struct {
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 }
 
+type CodebaseDailyView struct {
+	CodebaseID pgtype.UUID `json:"codebase_id"`
+	ViewDate   pgtype.Date `json:"view_date"`
+	ViewCount  int32       `json:"view_count"`
+}
+
 type Codebasis struct {
 	ID             pgtype.UUID        `json:"id"`
 	Host           string             `json:"host"`
@@ -458,6 +634,19 @@ type OauthAccount struct {
 	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
 }
 
+type PersonalAccessToken struct {
+	ID          pgtype.UUID        `json:"id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	Name        string             `json:"name"`
+	TokenHash   string             `json:"token_hash"`
+	TokenPrefix string             `json:"token_prefix"`
+	Scopes      []string           `json:"scopes"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	RevokedAt   pgtype.Timestamptz `json:"revoked_at"`
+}
+
 type QuotaReservation struct {
 	ID             pgtype.UUID        `json:"id"`
 	UserID         pgtype.UUID        `json:"user_id"`
@@ -468,6 +657,13 @@ type QuotaReservation struct {
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 }
 
+type RateLimitCounter struct {
+	BucketKey   string             `json:"bucket_key"`
+	WindowStart pgtype.Timestamptz `json:"window_start"`
+	HitCount    int32              `json:"hit_count"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+}
+
 type RefreshToken struct {
 	ID        pgtype.UUID        `json:"id"`
 	UserID    pgtype.UUID        `json:"user_id"`
@@ -534,6 +730,21 @@ type RiverQueue struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type SpecBehaviorEdit struct {
+	ID               pgtype.UUID        `json:"id"`
+	UserID           pgtype.UUID        `json:"user_id"`
+	CodebaseID       pgtype.UUID        `json:"codebase_id"`
+	Language         string             `json:"language"`
+	FilePath         string             `json:"file_path"`
+	TestName         string             `json:"test_name"`
+	SourceTestCaseID pgtype.UUID        `json:"source_test_case_id"`
+	Description      pgtype.Text        `json:"description"`
+	Hidden           bool               `json:"hidden"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceID      pgtype.UUID        `json:"workspace_id"`
+}
+
 type SpecBehavior struct {
 	ID                   pgtype.UUID        `json:"id"`
 	FeatureID            pgtype.UUID        `json:"feature_id"`
@@ -542,6 +753,42 @@ type SpecBehavior struct {
 	ConvertedDescription string             `json:"converted_description"`
 	SortOrder            int32              `json:"sort_order"`
 	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	SearchVector         interface{}        `json:"search_vector"`
+}
+
+type SpecCommentMention struct {
+	CommentID pgtype.UUID        `json:"comment_id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type SpecCommentThread struct {
+	ID                pgtype.UUID        `json:"id"`
+	UserID            pgtype.UUID        `json:"user_id"`
+	CodebaseID        pgtype.UUID        `json:"codebase_id"`
+	Language          string             `json:"language"`
+	DocumentID        pgtype.UUID        `json:"document_id"`
+	DocumentCreatedAt pgtype.Timestamptz `json:"document_created_at"`
+	TargetType        string             `json:"target_type"`
+	DomainName        string             `json:"domain_name"`
+	FeatureName       string             `json:"feature_name"`
+	FilePath          string             `json:"file_path"`
+	TestName          string             `json:"test_name"`
+	SourceTestCaseID  pgtype.UUID        `json:"source_test_case_id"`
+	ResolvedAt        pgtype.Timestamptz `json:"resolved_at"`
+	ResolvedBy        pgtype.UUID        `json:"resolved_by"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceID       pgtype.UUID        `json:"workspace_id"`
+}
+
+type SpecComment struct {
+	ID        pgtype.UUID        `json:"id"`
+	ThreadID  pgtype.UUID        `json:"thread_id"`
+	AuthorID  pgtype.UUID        `json:"author_id"`
+	Body      string             `json:"body"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
 type SpecDocument struct {
@@ -556,6 +803,7 @@ type SpecDocument struct {
 	Version                 int32              `json:"version"`
 	UserID                  pgtype.UUID        `json:"user_id"`
 	RetentionDaysAtCreation pgtype.Int4        `json:"retention_days_at_creation"`
+	WorkspaceID             pgtype.UUID        `json:"workspace_id"`
 }
 
 type SpecDomain struct {
@@ -567,26 +815,64 @@ type SpecDomain struct {
 	ClassificationConfidence pgtype.Numeric     `json:"classification_confidence"`
 	CreatedAt                pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
+	SearchVector             interface{}        `json:"search_vector"`
 }
 
-type SpecFeature struct {
+type SpecFeatureEdit struct {
 	ID          pgtype.UUID        `json:"id"`
-	DomainID    pgtype.UUID        `json:"domain_id"`
-	Name        string             `json:"name"`
-	Description pgtype.Text        `json:"description"`
-	SortOrder   int32              `json:"sort_order"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	CodebaseID  pgtype.UUID        `json:"codebase_id"`
+	Language    string             `json:"language"`
+	DomainName  string             `json:"domain_name"`
+	FeatureName string             `json:"feature_name"`
+	NewName     string             `json:"new_name"`
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceID pgtype.UUID        `json:"workspace_id"`
+}
+
+type SpecFeature struct {
+	ID           pgtype.UUID        `json:"id"`
+	DomainID     pgtype.UUID        `json:"domain_id"`
+	Name         string             `json:"name"`
+	Description  pgtype.Text        `json:"description"`
+	SortOrder    int32              `json:"sort_order"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	SearchVector interface{}        `json:"search_vector"`
+}
+
+type SpecShareLinkAccess struct {
+	ID          pgtype.UUID        `json:"id"`
+	ShareLinkID pgtype.UUID        `json:"share_link_id"`
+	IpAddress   pgtype.Text        `json:"ip_address"`
+	AccessedAt  pgtype.Timestamptz `json:"accessed_at"`
+}
+
+type SpecShareLink struct {
+	ID             pgtype.UUID        `json:"id"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	TokenHash      string             `json:"token_hash"`
+	TokenPrefix    string             `json:"token_prefix"`
+	CodebaseID     pgtype.UUID        `json:"codebase_id"`
+	Language       string             `json:"language"`
+	DocumentID     pgtype.UUID        `json:"document_id"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt      pgtype.Timestamptz `json:"revoked_at"`
+	LastAccessedAt pgtype.Timestamptz `json:"last_accessed_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID    pgtype.UUID        `json:"workspace_id"`
 }
 
 type SubscriptionPlan struct {
-	ID                   pgtype.UUID        `json:"id"`
-	Tier                 PlanTier           `json:"tier"`
-	SpecviewMonthlyLimit pgtype.Int4        `json:"specview_monthly_limit"`
-	AnalysisMonthlyLimit pgtype.Int4        `json:"analysis_monthly_limit"`
-	RetentionDays        pgtype.Int4        `json:"retention_days"`
-	CreatedAt            pgtype.Timestamptz `json:"created_at"`
-	MonthlyPrice         pgtype.Int4        `json:"monthly_price"`
+	ID                    pgtype.UUID        `json:"id"`
+	Tier                  PlanTier           `json:"tier"`
+	SpecviewMonthlyLimit  pgtype.Int4        `json:"specview_monthly_limit"`
+	AnalysisMonthlyLimit  pgtype.Int4        `json:"analysis_monthly_limit"`
+	RetentionDays         pgtype.Int4        `json:"retention_days"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	MonthlyPrice          pgtype.Int4        `json:"monthly_price"`
+	ApiRateLimitPerMinute pgtype.Int4        `json:"api_rate_limit_per_minute"`
 }
 
 type SystemConfig struct {
@@ -623,13 +909,14 @@ type TestSuite struct {
 }
 
 type UsageEvent struct {
-	ID          pgtype.UUID        `json:"id"`
-	UserID      pgtype.UUID        `json:"user_id"`
-	EventType   UsageEventType     `json:"event_type"`
-	AnalysisID  pgtype.UUID        `json:"analysis_id"`
-	DocumentID  pgtype.UUID        `json:"document_id"`
-	QuotaAmount int32              `json:"quota_amount"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	ID           pgtype.UUID        `json:"id"`
+	UserID       pgtype.UUID        `json:"user_id"`
+	EventType    UsageEventType     `json:"event_type"`
+	AnalysisID   pgtype.UUID        `json:"analysis_id"`
+	DocumentID   pgtype.UUID        `json:"document_id"`
+	QuotaAmount  int32              `json:"quota_amount"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	BilledUserID pgtype.UUID        `json:"billed_user_id"`
 }
 
 type User struct {
@@ -710,3 +997,27 @@ type UserSubscription struct {
 	CreatedAt          pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
 }
+
+type Workspace struct {
+	ID        pgtype.UUID        `json:"id"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WorkspaceInvitation struct {
+	ID          pgtype.UUID        `json:"id"`
+	WorkspaceID pgtype.UUID        `json:"workspace_id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	Role        string             `json:"role"`
+	InvitedBy   pgtype.UUID        `json:"invited_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type WorkspaceMember struct {
+	WorkspaceID pgtype.UUID        `json:"workspace_id"`
+	UserID      pgtype.UUID        `json:"user_id"`
+	Role        string             `json:"role"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
@@ -14,6 +14,18 @@
 CREATE SCHEMA public;
 
 
+--
+-- Name: analysis_batch_item_status; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.analysis_batch_item_status AS ENUM (
+    'pending',
+    'queued',
+    'cached',
+    'failed'
+);
+
+
 --
 -- Name: analysis_status; Type: TYPE; Schema: public; Owner: -
 --
@@ -26,6 +38,28 @@ CREATE TYPE public.analysis_status AS ENUM (
 );
 
 
+--
+-- Name: bulk_reanalysis_status; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.bulk_reanalysis_status AS ENUM (
+    'pending',
+    'running',
+    'completed',
+    'failed'
+);
+
+
+--
+-- Name: bulk_reanalysis_trigger; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.bulk_reanalysis_trigger AS ENUM (
+    'manual',
+    'auto'
+);
+
+
 --
 -- Name: github_account_type; Type: TYPE; Schema: public; Owner: -
 --
@@ -128,6 +162,103 @@ CREATE FUNCTION public.river_job_state_in_bitmask(bitmask bit, state public.rive
 $$;
 
 
+--
+-- Name: spec_behaviors_search_vector_update(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.spec_behaviors_search_vector_update() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    config regconfig;
+BEGIN
+    SELECT public.spec_search_config(sd.language) INTO config
+    FROM public.spec_features sf
+    JOIN public.spec_domains dm ON dm.id = sf.domain_id
+    JOIN public.spec_documents sd ON sd.id = dm.document_id
+    WHERE sf.id = NEW.feature_id;
+
+    NEW.search_vector := to_tsvector(COALESCE(config, 'simple'::regconfig), NEW.converted_description);
+    RETURN NEW;
+END;
+$$;
+
+
+--
+-- Name: spec_domains_search_vector_update(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.spec_domains_search_vector_update() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    config regconfig;
+BEGIN
+    SELECT public.spec_search_config(sd.language) INTO config
+    FROM public.spec_documents sd
+    WHERE sd.id = NEW.document_id;
+
+    config := COALESCE(config, 'simple'::regconfig);
+    NEW.search_vector :=
+        setweight(to_tsvector(config, NEW.name), 'A') ||
+        setweight(to_tsvector(config, COALESCE(NEW.description, '')), 'B');
+    RETURN NEW;
+END;
+$$;
+
+
+--
+-- Name: spec_features_search_vector_update(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.spec_features_search_vector_update() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    config regconfig;
+BEGIN
+    SELECT public.spec_search_config(sd.language) INTO config
+    FROM public.spec_domains dm
+    JOIN public.spec_documents sd ON sd.id = dm.document_id
+    WHERE dm.id = NEW.domain_id;
+
+    config := COALESCE(config, 'simple'::regconfig);
+    NEW.search_vector :=
+        setweight(to_tsvector(config, NEW.name), 'A') ||
+        setweight(to_tsvector(config, COALESCE(NEW.description, '')), 'B');
+    RETURN NEW;
+END;
+$$;
+
+
+--
+-- Name: spec_search_config(text); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.spec_search_config(language text) RETURNS regconfig
+    LANGUAGE sql IMMUTABLE
+    AS $$
+    SELECT CASE language
+        WHEN 'Arabic'     THEN 'pg_catalog.arabic'
+        WHEN 'Danish'     THEN 'pg_catalog.danish'
+        WHEN 'Dutch'      THEN 'pg_catalog.dutch'
+        WHEN 'English'    THEN 'pg_catalog.english'
+        WHEN 'Finnish'    THEN 'pg_catalog.finnish'
+        WHEN 'French'     THEN 'pg_catalog.french'
+        WHEN 'German'     THEN 'pg_catalog.german'
+        WHEN 'Greek'      THEN 'pg_catalog.greek'
+        WHEN 'Indonesian' THEN 'pg_catalog.indonesian'
+        WHEN 'Italian'    THEN 'pg_catalog.italian'
+        WHEN 'Portuguese' THEN 'pg_catalog.portuguese'
+        WHEN 'Russian'    THEN 'pg_catalog.russian'
+        WHEN 'Spanish'    THEN 'pg_catalog.spanish'
+        WHEN 'Swedish'    THEN 'pg_catalog.swedish'
+        WHEN 'Turkish'    THEN 'pg_catalog.turkish'
+        ELSE 'pg_catalog.simple'
+    END::regconfig;
+$$;
+
+
 
 
 --
@@ -147,7 +278,41 @@ CREATE TABLE public.analyses (
     total_suites integer DEFAULT 0 NOT NULL,
     total_tests integer DEFAULT 0 NOT NULL,
     committed_at timestamp with time zone,
-    parser_version character varying(100) DEFAULT 'legacy'::character varying NOT NULL
+    parser_version character varying(100) DEFAULT 'legacy'::character varying NOT NULL,
+    source character varying(20) DEFAULT 'worker'::character varying NOT NULL,
+    uploaded_by uuid,
+    CONSTRAINT chk_analyses_source CHECK (((source)::text = ANY ((ARRAY['worker'::character varying, 'ingest'::character varying])::text[])))
+);
+
+
+--
+-- Name: analysis_batch_items; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.analysis_batch_items (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    batch_id uuid NOT NULL,
+    owner character varying(255) NOT NULL,
+    repo character varying(255) NOT NULL,
+    status public.analysis_batch_item_status DEFAULT 'pending'::public.analysis_batch_item_status NOT NULL,
+    commit_sha character varying(40),
+    error_message text,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: analysis_batches; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.analysis_batches (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    org_login character varying(255),
+    total_count integer NOT NULL,
+    skipped_count integer DEFAULT 0 NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    submitted_at timestamp with time zone
 );
 
 
@@ -183,6 +348,28 @@ CREATE TABLE public.behavior_caches (
 );
 
 
+--
+-- Name: bulk_reanalysis_runs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.bulk_reanalysis_runs (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    parser_version character varying(100) NOT NULL,
+    trigger public.bulk_reanalysis_trigger NOT NULL,
+    status public.bulk_reanalysis_status DEFAULT 'pending'::public.bulk_reanalysis_status NOT NULL,
+    triggered_by uuid,
+    total_count integer DEFAULT 0 NOT NULL,
+    enqueued_count integer DEFAULT 0 NOT NULL,
+    failed_count integer DEFAULT 0 NOT NULL,
+    last_codebase_id uuid,
+    error_message text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    started_at timestamp with time zone,
+    completed_at timestamp with time zone,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: classification_caches; Type: TABLE; Schema: public; Owner: -
 --
@@ -198,6 +385,28 @@ CREATE TABLE public.classification_caches (
 );
 
 
+--
+-- Name: codebase_daily_viewers; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.codebase_daily_viewers (
+    codebase_id uuid NOT NULL,
+    view_date date NOT NULL,
+    viewer text NOT NULL
+);
+
+
+--
+-- Name: codebase_daily_views; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.codebase_daily_views (
+    codebase_id uuid NOT NULL,
+    view_date date NOT NULL,
+    view_count integer DEFAULT 0 NOT NULL
+);
+
+
 --
 -- Name: codebases; Type: TABLE; Schema: public; Owner: -
 --
@@ -268,6 +477,24 @@ CREATE TABLE public.oauth_accounts (
 );
 
 
+--
+-- Name: personal_access_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.personal_access_tokens (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    name character varying(100) NOT NULL,
+    token_hash text NOT NULL,
+    token_prefix character varying(16) NOT NULL,
+    scopes text[] NOT NULL,
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    revoked_at timestamp with time zone
+);
+
+
 --
 -- Name: quota_reservations; Type: TABLE; Schema: public; Owner: -
 --
@@ -284,6 +511,18 @@ CREATE TABLE public.quota_reservations (
 );
 
 
+--
+-- Name: rate_limit_counters; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.rate_limit_counters (
+    bucket_key text NOT NULL,
+    window_start timestamp with time zone NOT NULL,
+    hit_count integer DEFAULT 0 NOT NULL,
+    expires_at timestamp with time zone NOT NULL
+);
+
+
 --
 -- Name: refresh_tokens; Type: TABLE; Schema: public; Owner: -
 --
@@ -410,6 +649,27 @@ CREATE TABLE public.river_queue (
 );
 
 
+--
+-- Name: spec_behavior_edits; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.spec_behavior_edits (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    codebase_id uuid NOT NULL,
+    language character varying(10) NOT NULL,
+    file_path text DEFAULT ''::text NOT NULL,
+    test_name character varying(2000) NOT NULL,
+    source_test_case_id uuid,
+    description text,
+    hidden boolean DEFAULT false NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    workspace_id uuid,
+    CONSTRAINT chk_spec_behavior_edits_change CHECK (((description IS NOT NULL) OR hidden))
+);
+
+
 --
 -- Name: spec_behaviors; Type: TABLE; Schema: public; Owner: -
 --
@@ -421,10 +681,62 @@ CREATE TABLE public.spec_behaviors (
     original_name character varying(2000) NOT NULL,
     converted_description text NOT NULL,
     sort_order integer DEFAULT 0 NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    search_vector tsvector
+);
+
+
+--
+-- Name: spec_comment_mentions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.spec_comment_mentions (
+    comment_id uuid NOT NULL,
+    user_id uuid NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL
 );
 
 
+--
+-- Name: spec_comment_threads; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.spec_comment_threads (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    codebase_id uuid NOT NULL,
+    language character varying(10) NOT NULL,
+    document_id uuid,
+    document_created_at timestamp with time zone NOT NULL,
+    target_type character varying(20) NOT NULL,
+    domain_name character varying(255) NOT NULL,
+    feature_name character varying(255) DEFAULT ''::character varying NOT NULL,
+    file_path text DEFAULT ''::text NOT NULL,
+    test_name character varying(2000) DEFAULT ''::character varying NOT NULL,
+    source_test_case_id uuid,
+    resolved_at timestamp with time zone,
+    resolved_by uuid,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    workspace_id uuid,
+    CONSTRAINT chk_spec_comment_threads_target_type CHECK (((target_type)::text = ANY ((ARRAY['domain'::character varying, 'feature'::character varying, 'behavior'::character varying])::text[])))
+);
+
+
+--
+-- Name: spec_comments; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.spec_comments (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    thread_id uuid NOT NULL,
+    author_id uuid NOT NULL,
+    body text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: spec_documents; Type: TABLE; Schema: public; Owner: -
 --
@@ -441,6 +753,7 @@ CREATE TABLE public.spec_documents (
     version integer DEFAULT 1 NOT NULL,
     user_id uuid NOT NULL,
     retention_days_at_creation integer,
+    workspace_id uuid,
     CONSTRAINT chk_retention_days_positive CHECK (((retention_days_at_creation IS NULL) OR (retention_days_at_creation > 0)))
 );
 
@@ -457,7 +770,26 @@ CREATE TABLE public.spec_domains (
     sort_order integer DEFAULT 0 NOT NULL,
     classification_confidence numeric(3,2),
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    search_vector tsvector
+);
+
+
+--
+-- Name: spec_feature_edits; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.spec_feature_edits (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    codebase_id uuid NOT NULL,
+    language character varying(10) NOT NULL,
+    domain_name character varying(255) NOT NULL,
+    feature_name character varying(255) NOT NULL,
+    new_name character varying(255) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    workspace_id uuid
 );
 
 
@@ -472,7 +804,40 @@ CREATE TABLE public.spec_features (
     description text,
     sort_order integer DEFAULT 0 NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    updated_at timestamp with time zone DEFAULT now() NOT NULL
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    search_vector tsvector
+);
+
+
+--
+-- Name: spec_share_link_accesses; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.spec_share_link_accesses (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    share_link_id uuid NOT NULL,
+    ip_address text,
+    accessed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: spec_share_links; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.spec_share_links (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    user_id uuid NOT NULL,
+    token_hash text NOT NULL,
+    token_prefix character varying(16) NOT NULL,
+    codebase_id uuid NOT NULL,
+    language character varying(10) NOT NULL,
+    document_id uuid,
+    expires_at timestamp with time zone,
+    revoked_at timestamp with time zone,
+    last_accessed_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    workspace_id uuid
 );
 
 
@@ -487,7 +852,8 @@ CREATE TABLE public.subscription_plans (
     analysis_monthly_limit integer,
     retention_days integer,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
-    monthly_price integer
+    monthly_price integer,
+    api_rate_limit_per_minute integer DEFAULT 60
 );
 
 
@@ -557,6 +923,7 @@ CREATE TABLE public.usage_events (
     document_id uuid,
     quota_amount integer NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
+    billed_user_id uuid,
     CONSTRAINT chk_usage_events_resource CHECK (((((analysis_id IS NOT NULL))::integer + ((document_id IS NOT NULL))::integer) = 1))
 );
 
@@ -677,6 +1044,47 @@ CREATE TABLE public.users (
 );
 
 
+--
+-- Name: workspace_members; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workspace_members (
+    workspace_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    role character varying(20) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT chk_workspace_members_role CHECK (((role)::text = ANY ((ARRAY['owner'::character varying, 'editor'::character varying, 'viewer'::character varying])::text[])))
+);
+
+
+--
+-- Name: workspace_invitations; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workspace_invitations (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    workspace_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    role character varying(20) NOT NULL,
+    invited_by uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT chk_workspace_invitations_role CHECK (((role)::text = ANY ((ARRAY['editor'::character varying, 'viewer'::character varying])::text[])))
+);
+
+
+--
+-- Name: workspaces; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.workspaces (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    name character varying(100) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: river_job id; Type: DEFAULT; Schema: public; Owner: -
 --
@@ -692,6 +1100,22 @@ ALTER TABLE ONLY public.analyses
     ADD CONSTRAINT analyses_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: analysis_batch_items analysis_batch_items_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_batch_items
+    ADD CONSTRAINT analysis_batch_items_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: analysis_batches analysis_batches_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_batches
+    ADD CONSTRAINT analysis_batches_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: atlas_schema_revisions atlas_schema_revisions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -708,6 +1132,14 @@ ALTER TABLE ONLY public.behavior_caches
     ADD CONSTRAINT behavior_caches_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: bulk_reanalysis_runs bulk_reanalysis_runs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bulk_reanalysis_runs
+    ADD CONSTRAINT bulk_reanalysis_runs_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: classification_caches classification_caches_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -716,6 +1148,22 @@ ALTER TABLE ONLY public.classification_caches
     ADD CONSTRAINT classification_caches_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: codebase_daily_viewers codebase_daily_viewers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.codebase_daily_viewers
+    ADD CONSTRAINT codebase_daily_viewers_pkey PRIMARY KEY (codebase_id, view_date, viewer);
+
+
+--
+-- Name: codebase_daily_views codebase_daily_views_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.codebase_daily_views
+    ADD CONSTRAINT codebase_daily_views_pkey PRIMARY KEY (codebase_id, view_date);
+
+
 --
 -- Name: codebases codebases_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -748,6 +1196,14 @@ ALTER TABLE ONLY public.oauth_accounts
     ADD CONSTRAINT oauth_accounts_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: personal_access_tokens personal_access_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.personal_access_tokens
+    ADD CONSTRAINT personal_access_tokens_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: quota_reservations quota_reservations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -757,11 +1213,19 @@ ALTER TABLE ONLY public.quota_reservations
 
 
 --
--- Name: refresh_tokens refresh_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+-- Name: rate_limit_counters rate_limit_counters_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
 
-ALTER TABLE ONLY public.refresh_tokens
-    ADD CONSTRAINT refresh_tokens_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.rate_limit_counters
+    ADD CONSTRAINT rate_limit_counters_pkey PRIMARY KEY (bucket_key, window_start);
+
+
+--
+-- Name: refresh_tokens refresh_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.refresh_tokens
+    ADD CONSTRAINT refresh_tokens_pkey PRIMARY KEY (id);
 
 
 --
@@ -804,6 +1268,14 @@ ALTER TABLE ONLY public.river_queue
     ADD CONSTRAINT river_queue_pkey PRIMARY KEY (name);
 
 
+--
+-- Name: spec_behavior_edits spec_behavior_edits_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_behavior_edits
+    ADD CONSTRAINT spec_behavior_edits_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: spec_behaviors spec_behaviors_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -812,6 +1284,30 @@ ALTER TABLE ONLY public.spec_behaviors
     ADD CONSTRAINT spec_behaviors_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: spec_comment_mentions spec_comment_mentions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_mentions
+    ADD CONSTRAINT spec_comment_mentions_pkey PRIMARY KEY (comment_id, user_id);
+
+
+--
+-- Name: spec_comment_threads spec_comment_threads_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_threads
+    ADD CONSTRAINT spec_comment_threads_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spec_comments spec_comments_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comments
+    ADD CONSTRAINT spec_comments_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: spec_documents spec_documents_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -828,6 +1324,14 @@ ALTER TABLE ONLY public.spec_domains
     ADD CONSTRAINT spec_domains_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: spec_feature_edits spec_feature_edits_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_feature_edits
+    ADD CONSTRAINT spec_feature_edits_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: spec_features spec_features_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -836,6 +1340,22 @@ ALTER TABLE ONLY public.spec_features
     ADD CONSTRAINT spec_features_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: spec_share_link_accesses spec_share_link_accesses_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_link_accesses
+    ADD CONSTRAINT spec_share_link_accesses_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: spec_share_links spec_share_links_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_links
+    ADD CONSTRAINT spec_share_links_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: subscription_plans subscription_plans_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -876,6 +1396,14 @@ ALTER TABLE ONLY public.test_suites
     ADD CONSTRAINT test_suites_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: analysis_batch_items uq_analysis_batch_items_batch_repo; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_batch_items
+    ADD CONSTRAINT uq_analysis_batch_items_batch_repo UNIQUE (batch_id, owner, repo);
+
+
 --
 -- Name: behavior_caches uq_behavior_caches_key; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -924,6 +1452,14 @@ ALTER TABLE ONLY public.oauth_accounts
     ADD CONSTRAINT uq_oauth_provider_user UNIQUE (provider, provider_user_id);
 
 
+--
+-- Name: personal_access_tokens uq_personal_access_tokens_hash; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.personal_access_tokens
+    ADD CONSTRAINT uq_personal_access_tokens_hash UNIQUE (token_hash);
+
+
 --
 -- Name: quota_reservations uq_quota_reservations_job_id; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -956,6 +1492,14 @@ ALTER TABLE ONLY public.spec_documents
     ADD CONSTRAINT uq_spec_documents_user_hash_lang_model_version UNIQUE (user_id, content_hash, language, model_id, version);
 
 
+--
+-- Name: spec_share_links uq_spec_share_links_hash; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_links
+    ADD CONSTRAINT uq_spec_share_links_hash UNIQUE (token_hash);
+
+
 --
 -- Name: subscription_plans uq_subscription_plans_tier; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -1076,6 +1620,30 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: workspace_invitations workspace_invitations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspace_invitations
+    ADD CONSTRAINT workspace_invitations_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: workspace_members workspace_members_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspace_members
+    ADD CONSTRAINT workspace_members_pkey PRIMARY KEY (workspace_id, user_id);
+
+
+--
+-- Name: workspaces workspaces_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspaces
+    ADD CONSTRAINT workspaces_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: idx_analyses_codebase_status; Type: INDEX; Schema: public; Owner: -
 --
@@ -1090,6 +1658,13 @@ CREATE INDEX idx_analyses_codebase_status ON public.analyses USING btree (codeba
 CREATE INDEX idx_analyses_created ON public.analyses USING btree (codebase_id, created_at);
 
 
+--
+-- Name: idx_analysis_batches_user_created; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_analysis_batches_user_created ON public.analysis_batches USING btree (user_id, created_at DESC);
+
+
 --
 -- Name: idx_behavior_caches_created_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -1097,6 +1672,13 @@ CREATE INDEX idx_analyses_created ON public.analyses USING btree (codebase_id, c
 CREATE INDEX idx_behavior_caches_created_at ON public.behavior_caches USING btree (created_at);
 
 
+--
+-- Name: idx_bulk_reanalysis_runs_created; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_bulk_reanalysis_runs_created ON public.bulk_reanalysis_runs USING btree (created_at);
+
+
 --
 -- Name: idx_classification_caches_created_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -1167,6 +1749,13 @@ CREATE INDEX idx_oauth_accounts_user_id ON public.oauth_accounts USING btree (us
 CREATE INDEX idx_oauth_accounts_user_provider ON public.oauth_accounts USING btree (user_id, provider);
 
 
+--
+-- Name: idx_personal_access_tokens_user_active; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_personal_access_tokens_user_active ON public.personal_access_tokens USING btree (user_id, created_at DESC) WHERE (revoked_at IS NULL);
+
+
 --
 -- Name: idx_quota_reservations_expires; Type: INDEX; Schema: public; Owner: -
 --
@@ -1181,6 +1770,13 @@ CREATE INDEX idx_quota_reservations_expires ON public.quota_reservations USING b
 CREATE INDEX idx_quota_reservations_user_event ON public.quota_reservations USING btree (user_id, event_type);
 
 
+--
+-- Name: idx_rate_limit_counters_expires; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_rate_limit_counters_expires ON public.rate_limit_counters USING btree (expires_at);
+
+
 --
 -- Name: idx_refresh_tokens_expires; Type: INDEX; Schema: public; Owner: -
 --
@@ -1209,6 +1805,13 @@ CREATE INDEX idx_refresh_tokens_user ON public.refresh_tokens USING btree (user_
 CREATE INDEX idx_spec_behaviors_feature_sort ON public.spec_behaviors USING btree (feature_id, sort_order);
 
 
+--
+-- Name: idx_spec_behaviors_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_behaviors_search ON public.spec_behaviors USING gin (search_vector);
+
+
 --
 -- Name: idx_spec_behaviors_source; Type: INDEX; Schema: public; Owner: -
 --
@@ -1216,6 +1819,34 @@ CREATE INDEX idx_spec_behaviors_feature_sort ON public.spec_behaviors USING btre
 CREATE INDEX idx_spec_behaviors_source ON public.spec_behaviors USING btree (source_test_case_id) WHERE (source_test_case_id IS NOT NULL);
 
 
+--
+-- Name: idx_spec_comment_mentions_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_comment_mentions_user ON public.spec_comment_mentions USING btree (user_id, created_at DESC);
+
+
+--
+-- Name: idx_spec_comment_threads_scope; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_comment_threads_scope ON public.spec_comment_threads USING btree (user_id, codebase_id, language);
+
+
+--
+-- Name: idx_spec_comment_threads_workspace_scope; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_comment_threads_workspace_scope ON public.spec_comment_threads USING btree (workspace_id, codebase_id, language) WHERE (workspace_id IS NOT NULL);
+
+
+--
+-- Name: idx_spec_comments_thread; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_comments_thread ON public.spec_comments USING btree (thread_id, created_at);
+
+
 --
 -- Name: idx_spec_documents_analysis; Type: INDEX; Schema: public; Owner: -
 --
@@ -1244,6 +1875,13 @@ CREATE INDEX idx_spec_documents_retention_cleanup ON public.spec_documents USING
 CREATE INDEX idx_spec_documents_user_created ON public.spec_documents USING btree (user_id, created_at);
 
 
+--
+-- Name: idx_spec_documents_workspace; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_documents_workspace ON public.spec_documents USING btree (workspace_id, analysis_id, language) WHERE (workspace_id IS NOT NULL);
+
+
 --
 -- Name: idx_spec_domains_document_sort; Type: INDEX; Schema: public; Owner: -
 --
@@ -1251,6 +1889,13 @@ CREATE INDEX idx_spec_documents_user_created ON public.spec_documents USING btre
 CREATE INDEX idx_spec_domains_document_sort ON public.spec_domains USING btree (document_id, sort_order);
 
 
+--
+-- Name: idx_spec_domains_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_domains_search ON public.spec_domains USING gin (search_vector);
+
+
 --
 -- Name: idx_spec_features_domain_sort; Type: INDEX; Schema: public; Owner: -
 --
@@ -1258,6 +1903,34 @@ CREATE INDEX idx_spec_domains_document_sort ON public.spec_domains USING btree (
 CREATE INDEX idx_spec_features_domain_sort ON public.spec_features USING btree (domain_id, sort_order);
 
 
+--
+-- Name: idx_spec_features_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_features_search ON public.spec_features USING gin (search_vector);
+
+
+--
+-- Name: idx_spec_share_link_accesses_link; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_share_link_accesses_link ON public.spec_share_link_accesses USING btree (share_link_id, accessed_at DESC);
+
+
+--
+-- Name: idx_spec_share_links_user_active; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_share_links_user_active ON public.spec_share_links USING btree (user_id, created_at DESC) WHERE (revoked_at IS NULL);
+
+
+--
+-- Name: idx_spec_share_links_workspace_active; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_spec_share_links_workspace_active ON public.spec_share_links USING btree (workspace_id, created_at DESC) WHERE ((revoked_at IS NULL) AND (workspace_id IS NOT NULL));
+
+
 --
 -- Name: idx_test_cases_status; Type: INDEX; Schema: public; Owner: -
 --
@@ -1293,6 +1966,13 @@ CREATE INDEX idx_test_suites_file ON public.test_suites USING btree (file_id);
 CREATE INDEX idx_test_suites_parent ON public.test_suites USING btree (parent_id) WHERE (parent_id IS NOT NULL);
 
 
+--
+-- Name: idx_usage_events_billed_quota_lookup; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_usage_events_billed_quota_lookup ON public.usage_events USING btree (COALESCE(billed_user_id, user_id), event_type, created_at);
+
+
 --
 -- Name: idx_usage_events_analysis; Type: INDEX; Schema: public; Owner: -
 --
@@ -1433,6 +2113,34 @@ CREATE UNIQUE INDEX idx_users_email ON public.users USING btree (email) WHERE (e
 CREATE INDEX idx_users_username ON public.users USING btree (username);
 
 
+--
+-- Name: idx_workspace_invitations_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workspace_invitations_user ON public.workspace_invitations USING btree (user_id, created_at DESC);
+
+
+--
+-- Name: idx_workspace_invitations_workspace_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_workspace_invitations_workspace_user ON public.workspace_invitations USING btree (workspace_id, user_id);
+
+
+--
+-- Name: idx_workspace_members_owner; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_workspace_members_owner ON public.workspace_members USING btree (workspace_id) WHERE ((role)::text = 'owner'::text);
+
+
+--
+-- Name: idx_workspace_members_user; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_workspace_members_user ON public.workspace_members USING btree (user_id);
+
+
 --
 -- Name: river_job_args_index; Type: INDEX; Schema: public; Owner: -
 --
@@ -1479,7 +2187,63 @@ CREATE UNIQUE INDEX river_job_unique_idx ON public.river_job USING btree (unique
 -- Name: uq_analyses_completed_commit_version; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE UNIQUE INDEX uq_analyses_completed_commit_version ON public.analyses USING btree (codebase_id, commit_sha, parser_version) WHERE (status = 'completed'::public.analysis_status);
+CREATE UNIQUE INDEX uq_analyses_completed_commit_version ON public.analyses USING btree (codebase_id, commit_sha, parser_version) WHERE ((status = 'completed'::public.analysis_status) AND ((source)::text = 'worker'::text));
+
+
+--
+-- Name: uq_analyses_ingested_commit_version; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uq_analyses_ingested_commit_version ON public.analyses USING btree (codebase_id, commit_sha, parser_version) WHERE ((status = 'completed'::public.analysis_status) AND ((source)::text = 'ingest'::text));
+
+
+--
+-- Name: uq_spec_behavior_edits_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uq_spec_behavior_edits_key ON public.spec_behavior_edits USING btree (user_id, codebase_id, language, file_path, test_name) WHERE (workspace_id IS NULL);
+
+
+--
+-- Name: uq_spec_behavior_edits_workspace_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uq_spec_behavior_edits_workspace_key ON public.spec_behavior_edits USING btree (workspace_id, codebase_id, language, file_path, test_name) WHERE (workspace_id IS NOT NULL);
+
+
+--
+-- Name: uq_spec_feature_edits_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uq_spec_feature_edits_key ON public.spec_feature_edits USING btree (user_id, codebase_id, language, domain_name, feature_name) WHERE (workspace_id IS NULL);
+
+
+--
+-- Name: uq_spec_feature_edits_workspace_key; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uq_spec_feature_edits_workspace_key ON public.spec_feature_edits USING btree (workspace_id, codebase_id, language, domain_name, feature_name) WHERE (workspace_id IS NOT NULL);
+
+
+--
+-- Name: spec_behaviors trg_spec_behaviors_search_vector; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER trg_spec_behaviors_search_vector BEFORE INSERT OR UPDATE OF converted_description, feature_id ON public.spec_behaviors FOR EACH ROW EXECUTE FUNCTION public.spec_behaviors_search_vector_update();
+
+
+--
+-- Name: spec_domains trg_spec_domains_search_vector; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER trg_spec_domains_search_vector BEFORE INSERT OR UPDATE OF name, description, document_id ON public.spec_domains FOR EACH ROW EXECUTE FUNCTION public.spec_domains_search_vector_update();
+
+
+--
+-- Name: spec_features trg_spec_features_search_vector; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER trg_spec_features_search_vector BEFORE INSERT OR UPDATE OF name, description, domain_id ON public.spec_features FOR EACH ROW EXECUTE FUNCTION public.spec_features_search_vector_update();
 
 
 --
@@ -1490,6 +2254,54 @@ ALTER TABLE ONLY public.analyses
     ADD CONSTRAINT fk_analyses_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
 
 
+--
+-- Name: analyses fk_analyses_uploaded_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analyses
+    ADD CONSTRAINT fk_analyses_uploaded_by FOREIGN KEY (uploaded_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: analysis_batch_items fk_analysis_batch_items_batch; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_batch_items
+    ADD CONSTRAINT fk_analysis_batch_items_batch FOREIGN KEY (batch_id) REFERENCES public.analysis_batches(id) ON DELETE CASCADE;
+
+
+--
+-- Name: analysis_batches fk_analysis_batches_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.analysis_batches
+    ADD CONSTRAINT fk_analysis_batches_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: bulk_reanalysis_runs fk_bulk_reanalysis_runs_triggered_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.bulk_reanalysis_runs
+    ADD CONSTRAINT fk_bulk_reanalysis_runs_triggered_by FOREIGN KEY (triggered_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: codebase_daily_viewers fk_codebase_daily_viewers_codebase; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.codebase_daily_viewers
+    ADD CONSTRAINT fk_codebase_daily_viewers_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
+
+
+--
+-- Name: codebase_daily_views fk_codebase_daily_views_codebase; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.codebase_daily_views
+    ADD CONSTRAINT fk_codebase_daily_views_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
+
+
 --
 -- Name: github_app_installations fk_github_app_installations_installer; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1506,6 +2318,14 @@ ALTER TABLE ONLY public.oauth_accounts
     ADD CONSTRAINT fk_oauth_accounts_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: personal_access_tokens fk_personal_access_tokens_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.personal_access_tokens
+    ADD CONSTRAINT fk_personal_access_tokens_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: quota_reservations fk_quota_reservations_user; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1530,6 +2350,38 @@ ALTER TABLE ONLY public.refresh_tokens
     ADD CONSTRAINT fk_refresh_tokens_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: spec_behavior_edits fk_spec_behavior_edits_codebase; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_behavior_edits
+    ADD CONSTRAINT fk_spec_behavior_edits_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_behavior_edits fk_spec_behavior_edits_test_case; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_behavior_edits
+    ADD CONSTRAINT fk_spec_behavior_edits_test_case FOREIGN KEY (source_test_case_id) REFERENCES public.test_cases(id) ON DELETE SET NULL;
+
+
+--
+-- Name: spec_behavior_edits fk_spec_behavior_edits_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_behavior_edits
+    ADD CONSTRAINT fk_spec_behavior_edits_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_behavior_edits fk_spec_behavior_edits_workspace; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_behavior_edits
+    ADD CONSTRAINT fk_spec_behavior_edits_workspace FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+
 --
 -- Name: spec_behaviors fk_spec_behaviors_feature; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1546,6 +2398,86 @@ ALTER TABLE ONLY public.spec_behaviors
     ADD CONSTRAINT fk_spec_behaviors_test_case FOREIGN KEY (source_test_case_id) REFERENCES public.test_cases(id) ON DELETE SET NULL;
 
 
+--
+-- Name: spec_comment_mentions fk_spec_comment_mentions_comment; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_mentions
+    ADD CONSTRAINT fk_spec_comment_mentions_comment FOREIGN KEY (comment_id) REFERENCES public.spec_comments(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_comment_mentions fk_spec_comment_mentions_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_mentions
+    ADD CONSTRAINT fk_spec_comment_mentions_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_comment_threads fk_spec_comment_threads_codebase; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_threads
+    ADD CONSTRAINT fk_spec_comment_threads_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_comment_threads fk_spec_comment_threads_document; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_threads
+    ADD CONSTRAINT fk_spec_comment_threads_document FOREIGN KEY (document_id) REFERENCES public.spec_documents(id) ON DELETE SET NULL;
+
+
+--
+-- Name: spec_comment_threads fk_spec_comment_threads_resolved_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_threads
+    ADD CONSTRAINT fk_spec_comment_threads_resolved_by FOREIGN KEY (resolved_by) REFERENCES public.users(id) ON DELETE SET NULL;
+
+
+--
+-- Name: spec_comment_threads fk_spec_comment_threads_test_case; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_threads
+    ADD CONSTRAINT fk_spec_comment_threads_test_case FOREIGN KEY (source_test_case_id) REFERENCES public.test_cases(id) ON DELETE SET NULL;
+
+
+--
+-- Name: spec_comment_threads fk_spec_comment_threads_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_threads
+    ADD CONSTRAINT fk_spec_comment_threads_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_comment_threads fk_spec_comment_threads_workspace; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comment_threads
+    ADD CONSTRAINT fk_spec_comment_threads_workspace FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_comments fk_spec_comments_author; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comments
+    ADD CONSTRAINT fk_spec_comments_author FOREIGN KEY (author_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_comments fk_spec_comments_thread; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_comments
+    ADD CONSTRAINT fk_spec_comments_thread FOREIGN KEY (thread_id) REFERENCES public.spec_comment_threads(id) ON DELETE CASCADE;
+
+
 --
 -- Name: spec_documents fk_spec_documents_analysis; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1562,6 +2494,14 @@ ALTER TABLE ONLY public.spec_documents
     ADD CONSTRAINT fk_spec_documents_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: spec_documents fk_spec_documents_workspace; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_documents
+    ADD CONSTRAINT fk_spec_documents_workspace FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE SET NULL;
+
+
 --
 -- Name: spec_domains fk_spec_domains_document; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1570,6 +2510,30 @@ ALTER TABLE ONLY public.spec_domains
     ADD CONSTRAINT fk_spec_domains_document FOREIGN KEY (document_id) REFERENCES public.spec_documents(id) ON DELETE CASCADE;
 
 
+--
+-- Name: spec_feature_edits fk_spec_feature_edits_codebase; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_feature_edits
+    ADD CONSTRAINT fk_spec_feature_edits_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_feature_edits fk_spec_feature_edits_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_feature_edits
+    ADD CONSTRAINT fk_spec_feature_edits_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_feature_edits fk_spec_feature_edits_workspace; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_feature_edits
+    ADD CONSTRAINT fk_spec_feature_edits_workspace FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+
 --
 -- Name: spec_features fk_spec_features_domain; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1578,6 +2542,46 @@ ALTER TABLE ONLY public.spec_features
     ADD CONSTRAINT fk_spec_features_domain FOREIGN KEY (domain_id) REFERENCES public.spec_domains(id) ON DELETE CASCADE;
 
 
+--
+-- Name: spec_share_link_accesses fk_spec_share_link_accesses_link; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_link_accesses
+    ADD CONSTRAINT fk_spec_share_link_accesses_link FOREIGN KEY (share_link_id) REFERENCES public.spec_share_links(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_share_links fk_spec_share_links_codebase; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_links
+    ADD CONSTRAINT fk_spec_share_links_codebase FOREIGN KEY (codebase_id) REFERENCES public.codebases(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_share_links fk_spec_share_links_document; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_links
+    ADD CONSTRAINT fk_spec_share_links_document FOREIGN KEY (document_id) REFERENCES public.spec_documents(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_share_links fk_spec_share_links_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_links
+    ADD CONSTRAINT fk_spec_share_links_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: spec_share_links fk_spec_share_links_workspace; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.spec_share_links
+    ADD CONSTRAINT fk_spec_share_links_workspace FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+
 --
 -- Name: test_cases fk_test_cases_suite; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1610,6 +2614,14 @@ ALTER TABLE ONLY public.test_suites
     ADD CONSTRAINT fk_test_suites_parent FOREIGN KEY (parent_id) REFERENCES public.test_suites(id) ON DELETE CASCADE;
 
 
+--
+-- Name: usage_events fk_usage_events_billed_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.usage_events
+    ADD CONSTRAINT fk_usage_events_billed_user FOREIGN KEY (billed_user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: usage_events fk_usage_events_analysis; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -1730,6 +2742,46 @@ ALTER TABLE ONLY public.user_subscriptions
     ADD CONSTRAINT fk_user_subscriptions_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 
 
+--
+-- Name: workspace_invitations fk_workspace_invitations_invited_by; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspace_invitations
+    ADD CONSTRAINT fk_workspace_invitations_invited_by FOREIGN KEY (invited_by) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workspace_invitations fk_workspace_invitations_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspace_invitations
+    ADD CONSTRAINT fk_workspace_invitations_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workspace_invitations fk_workspace_invitations_workspace; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspace_invitations
+    ADD CONSTRAINT fk_workspace_invitations_workspace FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workspace_members fk_workspace_members_user; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspace_members
+    ADD CONSTRAINT fk_workspace_members_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: workspace_members fk_workspace_members_workspace; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.workspace_members
+    ADD CONSTRAINT fk_workspace_members_workspace FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
+
+
 --
 -- Name: river_client_queue river_client_queue_river_client_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
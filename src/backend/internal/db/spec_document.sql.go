@@ -61,6 +61,62 @@ func (q *Queries) CheckSpecDocumentExistsByLanguage(ctx context.Context, arg Che
 	return exists, err
 }
 
+const getActiveSpecGenerationJobID = `-- name: GetActiveSpecGenerationJobID :one
+SELECT rj.id
+FROM river_job rj
+WHERE rj.kind = 'specview:generate'
+  AND rj.args->>'analysis_id' = $1
+  AND rj.args->>'user_id' = $2
+  AND rj.args->>'language' = $3
+  AND rj.state IN ('available', 'pending', 'retryable', 'running', 'scheduled')
+  AND NOT rj.metadata ? 'cancel_attempted_at'
+ORDER BY rj.created_at DESC
+LIMIT 1
+`
+
+type GetActiveSpecGenerationJobIDParams struct {
+	AnalysisID []byte `json:"analysis_id"`
+	UserID     []byte `json:"user_id"`
+	Language   []byte `json:"language"`
+}
+
+// Returns the pending or running generation job for a user, analysis, and language.
+// A running job already marked for cancellation is not active.
+func (q *Queries) GetActiveSpecGenerationJobID(ctx context.Context, arg GetActiveSpecGenerationJobIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getActiveSpecGenerationJobID, arg.AnalysisID, arg.UserID, arg.Language)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getActiveWorkspaceSpecGenerationJobID = `-- name: GetActiveWorkspaceSpecGenerationJobID :one
+SELECT rj.id
+FROM river_job rj
+WHERE rj.kind = 'specview:generate'
+  AND rj.args->>'analysis_id' = $1
+  AND rj.args->>'workspace_id' = $2
+  AND rj.args->>'language' = $3
+  AND rj.state IN ('available', 'pending', 'retryable', 'running', 'scheduled')
+  AND NOT rj.metadata ? 'cancel_attempted_at'
+ORDER BY rj.created_at DESC
+LIMIT 1
+`
+
+type GetActiveWorkspaceSpecGenerationJobIDParams struct {
+	AnalysisID  []byte `json:"analysis_id"`
+	WorkspaceID []byte `json:"workspace_id"`
+	Language    []byte `json:"language"`
+}
+
+// Returns the pending or running generation job for a workspace, analysis, and language,
+// whichever member requested it. A running job already marked for cancellation is not active.
+func (q *Queries) GetActiveWorkspaceSpecGenerationJobID(ctx context.Context, arg GetActiveWorkspaceSpecGenerationJobIDParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getActiveWorkspaceSpecGenerationJobID, arg.AnalysisID, arg.WorkspaceID, arg.Language)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getAiSpecSummariesByCodebaseIDs = `-- name: GetAiSpecSummariesByCodebaseIDs :many
 SELECT
     a.codebase_id,
@@ -107,6 +163,34 @@ func (q *Queries) GetAiSpecSummariesByCodebaseIDs(ctx context.Context, arg GetAi
 	return items, nil
 }
 
+const getAnalysisSource = `-- name: GetAnalysisSource :one
+SELECT c.host, c.owner, c.name, a.commit_sha
+FROM analyses a
+JOIN codebases c ON c.id = a.codebase_id
+WHERE a.id = $1
+`
+
+type GetAnalysisSourceRow struct {
+	Host      string `json:"host"`
+	Owner     string `json:"owner"`
+	Name      string `json:"name"`
+	CommitSha string `json:"commit_sha"`
+}
+
+// Returns the repository and commit an analysis was run against.
+// Used to build source links when exporting spec documents.
+func (q *Queries) GetAnalysisSource(ctx context.Context, id pgtype.UUID) (GetAnalysisSourceRow, error) {
+	row := q.db.QueryRow(ctx, getAnalysisSource, id)
+	var i GetAnalysisSourceRow
+	err := row.Scan(
+		&i.Host,
+		&i.Owner,
+		&i.Name,
+		&i.CommitSha,
+	)
+	return i, err
+}
+
 const getAnalysisTestCount = `-- name: GetAnalysisTestCount :one
 SELECT total_tests FROM analyses WHERE id = $1 AND status = 'completed'
 `
@@ -260,6 +344,48 @@ func (q *Queries) GetAvailableLanguagesByUserAndAnalysis(ctx context.Context, ar
 	return items, nil
 }
 
+const getAvailableLanguagesByWorkspaceAndAnalysis = `-- name: GetAvailableLanguagesByWorkspaceAndAnalysis :many
+SELECT DISTINCT ON (sd.language)
+    sd.language,
+    sd.version AS latest_version,
+    sd.created_at
+FROM spec_documents sd
+WHERE sd.workspace_id = $1 AND sd.analysis_id = $2
+ORDER BY sd.language, sd.created_at DESC
+`
+
+type GetAvailableLanguagesByWorkspaceAndAnalysisParams struct {
+	WorkspaceID pgtype.UUID `json:"workspace_id"`
+	AnalysisID  pgtype.UUID `json:"analysis_id"`
+}
+
+type GetAvailableLanguagesByWorkspaceAndAnalysisRow struct {
+	Language      string             `json:"language"`
+	LatestVersion int32              `json:"latest_version"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+// Returns the languages a workspace has spec documents in, with the most recent document's version
+func (q *Queries) GetAvailableLanguagesByWorkspaceAndAnalysis(ctx context.Context, arg GetAvailableLanguagesByWorkspaceAndAnalysisParams) ([]GetAvailableLanguagesByWorkspaceAndAnalysisRow, error) {
+	rows, err := q.db.Query(ctx, getAvailableLanguagesByWorkspaceAndAnalysis, arg.WorkspaceID, arg.AnalysisID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAvailableLanguagesByWorkspaceAndAnalysisRow
+	for rows.Next() {
+		var i GetAvailableLanguagesByWorkspaceAndAnalysisRow
+		if err := rows.Scan(&i.Language, &i.LatestVersion, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCachePredictionData = `-- name: GetCachePredictionData :one
 WITH previous_spec AS (
     -- Get the most recent spec document for this codebase (different analysis) in the specified language
@@ -962,6 +1088,79 @@ func (q *Queries) GetSpecDocumentByVersion(ctx context.Context, arg GetSpecDocum
 	return i, err
 }
 
+const getSpecDocumentRevisionByRepository = `-- name: GetSpecDocumentRevisionByRepository :one
+SELECT
+    sd.version,
+    sd.updated_at,
+    docs.document_count,
+    docs.latest_created_at,
+    edits.edit_count,
+    edits.latest_edit_at
+FROM spec_documents sd
+JOIN analyses a ON a.id = sd.analysis_id
+JOIN codebases c ON c.id = a.codebase_id
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::int AS document_count, MAX(sd2.created_at)::timestamptz AS latest_created_at
+    FROM spec_documents sd2
+    JOIN analyses a2 ON a2.id = sd2.analysis_id
+    JOIN codebases c2 ON c2.id = a2.codebase_id
+    WHERE c2.owner = c.owner AND c2.name = c.name
+      AND sd2.user_id = sd.user_id
+) docs
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::int AS edit_count, MAX(e.updated_at)::timestamptz AS latest_edit_at
+    FROM (
+        SELECT be.updated_at FROM spec_behavior_edits be
+        WHERE be.codebase_id = c.id AND be.language = sd.language
+          AND be.user_id = sd.user_id AND be.workspace_id IS NULL
+        UNION ALL
+        SELECT fe.updated_at FROM spec_feature_edits fe
+        WHERE fe.codebase_id = c.id AND fe.language = sd.language
+          AND fe.user_id = sd.user_id AND fe.workspace_id IS NULL
+    ) e
+) edits
+WHERE c.owner = $1 AND c.name = $2
+  AND sd.user_id = $3
+  AND sd.id = $4
+`
+
+type GetSpecDocumentRevisionByRepositoryParams struct {
+	Owner      string      `json:"owner"`
+	Repo       string      `json:"repo"`
+	UserID     pgtype.UUID `json:"user_id"`
+	DocumentID pgtype.UUID `json:"document_id"`
+}
+
+type GetSpecDocumentRevisionByRepositoryRow struct {
+	Version         int32              `json:"version"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	DocumentCount   int32              `json:"document_count"`
+	LatestCreatedAt pgtype.Timestamptz `json:"latest_created_at"`
+	EditCount       int32              `json:"edit_count"`
+	LatestEditAt    pgtype.Timestamptz `json:"latest_edit_at"`
+}
+
+// Returns what a repository spec document response depends on without loading it:
+// the document, the user's edits in its language and the repository's other documents
+func (q *Queries) GetSpecDocumentRevisionByRepository(ctx context.Context, arg GetSpecDocumentRevisionByRepositoryParams) (GetSpecDocumentRevisionByRepositoryRow, error) {
+	row := q.db.QueryRow(ctx, getSpecDocumentRevisionByRepository,
+		arg.Owner,
+		arg.Repo,
+		arg.UserID,
+		arg.DocumentID,
+	)
+	var i GetSpecDocumentRevisionByRepositoryRow
+	err := row.Scan(
+		&i.Version,
+		&i.UpdatedAt,
+		&i.DocumentCount,
+		&i.LatestCreatedAt,
+		&i.EditCount,
+		&i.LatestEditAt,
+	)
+	return i, err
+}
+
 const getSpecDomainsByDocumentID = `-- name: GetSpecDomainsByDocumentID :many
 SELECT
     d.id,
@@ -1057,6 +1256,7 @@ func (q *Queries) GetSpecFeaturesByDomainIDs(ctx context.Context, dollar_1 []pgt
 const getSpecGenerationStatus = `-- name: GetSpecGenerationStatus :one
 SELECT
     rj.state,
+    rj.metadata ? 'cancel_attempted_at' AS cancel_attempted,
     rj.created_at,
     rj.finalized_at,
     rj.errors
@@ -1074,10 +1274,11 @@ type GetSpecGenerationStatusParams struct {
 }
 
 type GetSpecGenerationStatusRow struct {
-	State       RiverJobState      `json:"state"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	FinalizedAt pgtype.Timestamptz `json:"finalized_at"`
-	Errors      [][]byte           `json:"errors"`
+	State           RiverJobState      `json:"state"`
+	CancelAttempted bool               `json:"cancel_attempted"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	FinalizedAt     pgtype.Timestamptz `json:"finalized_at"`
+	Errors          [][]byte           `json:"errors"`
 }
 
 // Returns latest generation status for a specific user and analysis (any language)
@@ -1086,6 +1287,7 @@ func (q *Queries) GetSpecGenerationStatus(ctx context.Context, arg GetSpecGenera
 	var i GetSpecGenerationStatusRow
 	err := row.Scan(
 		&i.State,
+		&i.CancelAttempted,
 		&i.CreatedAt,
 		&i.FinalizedAt,
 		&i.Errors,
@@ -1096,6 +1298,7 @@ func (q *Queries) GetSpecGenerationStatus(ctx context.Context, arg GetSpecGenera
 const getSpecGenerationStatusByLanguage = `-- name: GetSpecGenerationStatusByLanguage :one
 SELECT
     rj.state,
+    rj.metadata ? 'cancel_attempted_at' AS cancel_attempted,
     rj.created_at,
     rj.finalized_at,
     rj.errors
@@ -1115,10 +1318,11 @@ type GetSpecGenerationStatusByLanguageParams struct {
 }
 
 type GetSpecGenerationStatusByLanguageRow struct {
-	State       RiverJobState      `json:"state"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	FinalizedAt pgtype.Timestamptz `json:"finalized_at"`
-	Errors      [][]byte           `json:"errors"`
+	State           RiverJobState      `json:"state"`
+	CancelAttempted bool               `json:"cancel_attempted"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	FinalizedAt     pgtype.Timestamptz `json:"finalized_at"`
+	Errors          [][]byte           `json:"errors"`
 }
 
 // Returns generation status for a specific user, analysis, and language combination
@@ -1127,6 +1331,7 @@ func (q *Queries) GetSpecGenerationStatusByLanguage(ctx context.Context, arg Get
 	var i GetSpecGenerationStatusByLanguageRow
 	err := row.Scan(
 		&i.State,
+		&i.CancelAttempted,
 		&i.CreatedAt,
 		&i.FinalizedAt,
 		&i.Errors,
@@ -1291,6 +1496,208 @@ func (q *Queries) GetVersionsByUserAndLanguage(ctx context.Context, arg GetVersi
 	return items, nil
 }
 
+const getVersionsByWorkspaceAndLanguage = `-- name: GetVersionsByWorkspaceAndLanguage :many
+SELECT DISTINCT ON (sd.version)
+    sd.version,
+    sd.created_at,
+    sd.model_id
+FROM spec_documents sd
+WHERE sd.workspace_id = $1 AND sd.analysis_id = $2 AND sd.language = $3
+ORDER BY sd.version DESC, sd.created_at DESC
+`
+
+type GetVersionsByWorkspaceAndLanguageParams struct {
+	WorkspaceID pgtype.UUID `json:"workspace_id"`
+	AnalysisID  pgtype.UUID `json:"analysis_id"`
+	Language    string      `json:"language"`
+}
+
+type GetVersionsByWorkspaceAndLanguageRow struct {
+	Version   int32              `json:"version"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ModelID   string             `json:"model_id"`
+}
+
+// Returns the versions of a workspace's spec documents for an analysis and language, ordered by version descending.
+// Each version appears once, as its most recent document.
+func (q *Queries) GetVersionsByWorkspaceAndLanguage(ctx context.Context, arg GetVersionsByWorkspaceAndLanguageParams) ([]GetVersionsByWorkspaceAndLanguageRow, error) {
+	rows, err := q.db.Query(ctx, getVersionsByWorkspaceAndLanguage, arg.WorkspaceID, arg.AnalysisID, arg.Language)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetVersionsByWorkspaceAndLanguageRow
+	for rows.Next() {
+		var i GetVersionsByWorkspaceAndLanguageRow
+		if err := rows.Scan(&i.Version, &i.CreatedAt, &i.ModelID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWorkspaceSpecDocument = `-- name: GetWorkspaceSpecDocument :one
+SELECT
+    sd.id,
+    sd.analysis_id,
+    sd.user_id,
+    sd.language,
+    sd.version,
+    sd.executive_summary,
+    sd.model_id,
+    sd.created_at
+FROM spec_documents sd
+WHERE sd.workspace_id = $1
+  AND sd.analysis_id = $2
+  AND ($3::text IS NULL OR sd.language = $3::text)
+ORDER BY sd.created_at DESC
+LIMIT 1
+`
+
+type GetWorkspaceSpecDocumentParams struct {
+	WorkspaceID pgtype.UUID `json:"workspace_id"`
+	AnalysisID  pgtype.UUID `json:"analysis_id"`
+	Language    pgtype.Text `json:"language"`
+}
+
+type GetWorkspaceSpecDocumentRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	AnalysisID       pgtype.UUID        `json:"analysis_id"`
+	UserID           pgtype.UUID        `json:"user_id"`
+	Language         string             `json:"language"`
+	Version          int32              `json:"version"`
+	ExecutiveSummary pgtype.Text        `json:"executive_summary"`
+	ModelID          string             `json:"model_id"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+// Returns the most recent spec document generated for a workspace.
+// If language is NULL, returns the most recent document regardless of language.
+func (q *Queries) GetWorkspaceSpecDocument(ctx context.Context, arg GetWorkspaceSpecDocumentParams) (GetWorkspaceSpecDocumentRow, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceSpecDocument, arg.WorkspaceID, arg.AnalysisID, arg.Language)
+	var i GetWorkspaceSpecDocumentRow
+	err := row.Scan(
+		&i.ID,
+		&i.AnalysisID,
+		&i.UserID,
+		&i.Language,
+		&i.Version,
+		&i.ExecutiveSummary,
+		&i.ModelID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWorkspaceSpecDocumentByVersion = `-- name: GetWorkspaceSpecDocumentByVersion :one
+SELECT
+    sd.id,
+    sd.analysis_id,
+    sd.user_id,
+    sd.language,
+    sd.version,
+    sd.executive_summary,
+    sd.model_id,
+    sd.created_at
+FROM spec_documents sd
+WHERE sd.workspace_id = $1
+  AND sd.analysis_id = $2
+  AND sd.language = $3
+  AND sd.version = $4
+ORDER BY sd.created_at DESC
+LIMIT 1
+`
+
+type GetWorkspaceSpecDocumentByVersionParams struct {
+	WorkspaceID pgtype.UUID `json:"workspace_id"`
+	AnalysisID  pgtype.UUID `json:"analysis_id"`
+	Language    string      `json:"language"`
+	Version     int32       `json:"version"`
+}
+
+type GetWorkspaceSpecDocumentByVersionRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	AnalysisID       pgtype.UUID        `json:"analysis_id"`
+	UserID           pgtype.UUID        `json:"user_id"`
+	Language         string             `json:"language"`
+	Version          int32              `json:"version"`
+	ExecutiveSummary pgtype.Text        `json:"executive_summary"`
+	ModelID          string             `json:"model_id"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+// Returns a specific version of a workspace's spec document.
+// Versions are numbered per requesting member, so the most recent document with the version wins.
+func (q *Queries) GetWorkspaceSpecDocumentByVersion(ctx context.Context, arg GetWorkspaceSpecDocumentByVersionParams) (GetWorkspaceSpecDocumentByVersionRow, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceSpecDocumentByVersion,
+		arg.WorkspaceID,
+		arg.AnalysisID,
+		arg.Language,
+		arg.Version,
+	)
+	var i GetWorkspaceSpecDocumentByVersionRow
+	err := row.Scan(
+		&i.ID,
+		&i.AnalysisID,
+		&i.UserID,
+		&i.Language,
+		&i.Version,
+		&i.ExecutiveSummary,
+		&i.ModelID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWorkspaceSpecGenerationStatus = `-- name: GetWorkspaceSpecGenerationStatus :one
+SELECT
+    rj.state,
+    rj.metadata ? 'cancel_attempted_at' AS cancel_attempted,
+    rj.created_at,
+    rj.finalized_at,
+    rj.errors
+FROM river_job rj
+WHERE rj.kind = 'specview:generate'
+  AND rj.args->>'analysis_id' = $1
+  AND rj.args->>'workspace_id' = $2
+  AND ($3::text IS NULL OR rj.args->>'language' = $3::text)
+ORDER BY rj.created_at DESC
+LIMIT 1
+`
+
+type GetWorkspaceSpecGenerationStatusParams struct {
+	AnalysisID  []byte      `json:"analysis_id"`
+	WorkspaceID []byte      `json:"workspace_id"`
+	Language    pgtype.Text `json:"language"`
+}
+
+type GetWorkspaceSpecGenerationStatusRow struct {
+	State           RiverJobState      `json:"state"`
+	CancelAttempted bool               `json:"cancel_attempted"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	FinalizedAt     pgtype.Timestamptz `json:"finalized_at"`
+	Errors          [][]byte           `json:"errors"`
+}
+
+// Returns the latest generation status for a workspace and analysis.
+// If language is NULL, matches jobs in any language.
+func (q *Queries) GetWorkspaceSpecGenerationStatus(ctx context.Context, arg GetWorkspaceSpecGenerationStatusParams) (GetWorkspaceSpecGenerationStatusRow, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceSpecGenerationStatus, arg.AnalysisID, arg.WorkspaceID, arg.Language)
+	var i GetWorkspaceSpecGenerationStatusRow
+	err := row.Scan(
+		&i.State,
+		&i.CancelAttempted,
+		&i.CreatedAt,
+		&i.FinalizedAt,
+		&i.Errors,
+	)
+	return i, err
+}
+
 const hasPreviousSpecByLanguage = `-- name: HasPreviousSpecByLanguage :one
 SELECT EXISTS(
     SELECT 1 FROM spec_documents sd
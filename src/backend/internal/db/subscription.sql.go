@@ -68,26 +68,28 @@ SELECT
     sp.tier AS plan_tier,
     sp.specview_monthly_limit AS plan_specview_monthly_limit,
     sp.analysis_monthly_limit AS plan_analysis_monthly_limit,
-    sp.retention_days AS plan_retention_days
+    sp.retention_days AS plan_retention_days,
+    sp.api_rate_limit_per_minute AS plan_api_rate_limit_per_minute
 FROM user_subscriptions us
 JOIN subscription_plans sp ON us.plan_id = sp.id
 WHERE us.user_id = $1 AND us.status = 'active'
 `
 
 type GetActiveSubscriptionWithPlanRow struct {
-	ID                       pgtype.UUID        `json:"id"`
-	UserID                   pgtype.UUID        `json:"user_id"`
-	PlanID                   pgtype.UUID        `json:"plan_id"`
-	Status                   SubscriptionStatus `json:"status"`
-	CurrentPeriodStart       pgtype.Timestamptz `json:"current_period_start"`
-	CurrentPeriodEnd         pgtype.Timestamptz `json:"current_period_end"`
-	CanceledAt               pgtype.Timestamptz `json:"canceled_at"`
-	CreatedAt                pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
-	PlanTier                 PlanTier           `json:"plan_tier"`
-	PlanSpecviewMonthlyLimit pgtype.Int4        `json:"plan_specview_monthly_limit"`
-	PlanAnalysisMonthlyLimit pgtype.Int4        `json:"plan_analysis_monthly_limit"`
-	PlanRetentionDays        pgtype.Int4        `json:"plan_retention_days"`
+	ID                        pgtype.UUID        `json:"id"`
+	UserID                    pgtype.UUID        `json:"user_id"`
+	PlanID                    pgtype.UUID        `json:"plan_id"`
+	Status                    SubscriptionStatus `json:"status"`
+	CurrentPeriodStart        pgtype.Timestamptz `json:"current_period_start"`
+	CurrentPeriodEnd          pgtype.Timestamptz `json:"current_period_end"`
+	CanceledAt                pgtype.Timestamptz `json:"canceled_at"`
+	CreatedAt                 pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                 pgtype.Timestamptz `json:"updated_at"`
+	PlanTier                  PlanTier           `json:"plan_tier"`
+	PlanSpecviewMonthlyLimit  pgtype.Int4        `json:"plan_specview_monthly_limit"`
+	PlanAnalysisMonthlyLimit  pgtype.Int4        `json:"plan_analysis_monthly_limit"`
+	PlanRetentionDays         pgtype.Int4        `json:"plan_retention_days"`
+	PlanApiRateLimitPerMinute pgtype.Int4        `json:"plan_api_rate_limit_per_minute"`
 }
 
 func (q *Queries) GetActiveSubscriptionWithPlan(ctx context.Context, userID pgtype.UUID) (GetActiveSubscriptionWithPlanRow, error) {
@@ -107,6 +109,7 @@ func (q *Queries) GetActiveSubscriptionWithPlan(ctx context.Context, userID pgty
 		&i.PlanSpecviewMonthlyLimit,
 		&i.PlanAnalysisMonthlyLimit,
 		&i.PlanRetentionDays,
+		&i.PlanApiRateLimitPerMinute,
 	)
 	return i, err
 }
@@ -13,12 +13,12 @@ import (
 
 const getMonthlyUsage = `-- name: GetMonthlyUsage :one
 SELECT
-    COALESCE(SUM(quota_amount), 0)::bigint AS total
-FROM usage_events
-WHERE user_id = $1
-    AND event_type = $2
-    AND created_at >= $3
-    AND created_at < $4
+    COALESCE(SUM(ue.quota_amount), 0)::bigint AS total
+FROM usage_events ue
+WHERE COALESCE(ue.billed_user_id, ue.user_id) = $1::uuid
+    AND ue.event_type = $2
+    AND ue.created_at >= $3
+    AND ue.created_at < $4
 `
 
 type GetMonthlyUsageParams struct {
@@ -28,6 +28,8 @@ type GetMonthlyUsageParams struct {
 	CreatedAt_2 pgtype.Timestamptz `json:"created_at_2"`
 }
 
+// Usage is charged to billed_user_id when set (workspace generations, recorded at
+// charge time) and to the requesting user otherwise.
 func (q *Queries) GetMonthlyUsage(ctx context.Context, arg GetMonthlyUsageParams) (int64, error) {
 	row := q.db.QueryRow(ctx, getMonthlyUsage,
 		arg.UserID,
@@ -42,13 +44,13 @@ func (q *Queries) GetMonthlyUsage(ctx context.Context, arg GetMonthlyUsageParams
 
 const getUsageByPeriod = `-- name: GetUsageByPeriod :many
 SELECT
-    event_type,
-    COALESCE(SUM(quota_amount), 0)::bigint AS total
-FROM usage_events
-WHERE user_id = $1
-    AND created_at >= $2
-    AND created_at < $3
-GROUP BY event_type
+    ue.event_type,
+    COALESCE(SUM(ue.quota_amount), 0)::bigint AS total
+FROM usage_events ue
+WHERE COALESCE(ue.billed_user_id, ue.user_id) = $1::uuid
+    AND ue.created_at >= $2
+    AND ue.created_at < $3
+GROUP BY ue.event_type
 `
 
 type GetUsageByPeriodParams struct {
@@ -62,6 +64,8 @@ type GetUsageByPeriodRow struct {
 	Total     int64          `json:"total"`
 }
 
+// Usage is charged to billed_user_id when set (workspace generations, recorded at
+// charge time) and to the requesting user otherwise.
 func (q *Queries) GetUsageByPeriod(ctx context.Context, arg GetUsageByPeriodParams) ([]GetUsageByPeriodRow, error) {
 	rows, err := q.db.Query(ctx, getUsageByPeriod, arg.UserID, arg.CreatedAt, arg.CreatedAt_2)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -17,8 +18,19 @@ import (
 	ghappport "github.com/specvital/web/src/backend/modules/github-app/domain/port"
 )
 
+const (
+	defaultCommitCacheTTL = 30 * time.Second
+	// Push invalidation only reaches one replica, so the others serve a stale
+	// commit for up to the TTL.
+	maxCommitCacheTTL      = time.Minute
+	riverWorkerStopTimeout = 10 * time.Second
+)
+
 type Container struct {
+	AdminUserIDs           []string
 	River                  *RiverClient
+	RiverWorker            *RiverWorker
+	CommitCacheTTL         time.Duration
 	CookieDomain           string
 	DB                     *pgxpool.Pool
 	Encryptor              crypto.Encryptor
@@ -29,10 +41,13 @@ type Container struct {
 	GitHubAppWebhookSecret string
 	GitHubOAuth            authport.OAuthClient
 	JWTManager             authport.TokenManager
+	MetricsToken           string
 	SecureCookie           bool
 }
 
 type Config struct {
+	AdminUserIDs            []string
+	CommitCacheTTL          time.Duration
 	CookieDomain            string
 	DatabaseURL             string
 	EncryptionKey           string
@@ -46,6 +61,8 @@ type Config struct {
 	GitHubOAuthClientSecret string
 	GitHubOAuthRedirectURL  string
 	JWTSecret               string
+	MetricsToken            string
+	RiverWorkerSchema       string
 	SecureCookie            bool
 }
 
@@ -70,7 +87,27 @@ func ConfigFromEnv() Config {
 		ghAppPrivateKey = []byte(strings.ReplaceAll(key, "\\n", "\n"))
 	}
 
+	riverWorkerSchema := os.Getenv("RIVER_WORKER_SCHEMA")
+	if riverWorkerSchema == "" {
+		riverWorkerSchema = DefaultRiverWorkerSchema
+	}
+
+	commitCacheTTL := defaultCommitCacheTTL
+	if v := os.Getenv("COMMIT_SHA_CACHE_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl <= 0 {
+			slog.Warn("invalid COMMIT_SHA_CACHE_TTL, using default", "value", v, "default", defaultCommitCacheTTL)
+		} else if ttl > maxCommitCacheTTL {
+			slog.Warn("COMMIT_SHA_CACHE_TTL too long, capping", "value", v, "max", maxCommitCacheTTL)
+			commitCacheTTL = maxCommitCacheTTL
+		} else {
+			commitCacheTTL = ttl
+		}
+	}
+
 	return Config{
+		AdminUserIDs:            parseList(os.Getenv("ADMIN_USER_IDS")),
+		CommitCacheTTL:          commitCacheTTL,
 		CookieDomain:            os.Getenv("COOKIE_DOMAIN"),
 		DatabaseURL:             os.Getenv("DATABASE_URL"),
 		EncryptionKey:           os.Getenv("ENCRYPTION_KEY"),
@@ -84,6 +121,8 @@ func ConfigFromEnv() Config {
 		GitHubOAuthClientSecret: os.Getenv("GITHUB_OAUTH_CLIENT_SECRET"),
 		GitHubOAuthRedirectURL:  os.Getenv("GITHUB_OAUTH_REDIRECT_URL"),
 		JWTSecret:               os.Getenv("JWT_SECRET"),
+		MetricsToken:            os.Getenv("METRICS_TOKEN"),
+		RiverWorkerSchema:       riverWorkerSchema,
 		SecureCookie:            os.Getenv("SECURE_COOKIE") == "true",
 	}
 }
@@ -120,6 +159,12 @@ func NewContainer(ctx context.Context, cfg Config) (*Container, error) {
 		return nil, fmt.Errorf("river: %w", err)
 	}
 
+	riverWorker, err := NewRiverWorker(ctx, pool, cfg.RiverWorkerSchema)
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("river worker: %w", err)
+	}
+
 	encryptor, err := crypto.NewEncryptorFromBase64(cfg.EncryptionKey)
 	if err != nil {
 		cleanup()
@@ -137,8 +182,6 @@ func NewContainer(ctx context.Context, cfg Config) (*Container, error) {
 		return nil, fmt.Errorf("github oauth: %w", err)
 	}
 
-	gitClient := client.NewGitClient()
-
 	ghAppClient, err := client.NewGitHubAppClient(client.GitHubAppConfig{
 		AppID:      cfg.GitHubAppID,
 		AppSlug:    cfg.GitHubAppSlug,
@@ -150,17 +193,21 @@ func NewContainer(ctx context.Context, cfg Config) (*Container, error) {
 	}
 
 	return &Container{
+		AdminUserIDs:           cfg.AdminUserIDs,
 		River:                  riverClient,
+		RiverWorker:            riverWorker,
+		CommitCacheTTL:         cfg.CommitCacheTTL,
 		CookieDomain:           cfg.CookieDomain,
 		DB:                     pool,
 		Encryptor:              encryptor,
 		Environment:            cfg.Environment,
 		FrontendURL:            cfg.FrontendURL,
-		GitClient:              gitClient,
+		GitClient:              client.NewGitClient(),
 		GitHubAppClient:        ghAppClient,
 		GitHubAppWebhookSecret: cfg.GitHubAppWebhookSecret,
 		GitHubOAuth:            githubClient,
 		JWTManager:             jwtManager,
+		MetricsToken:           cfg.MetricsToken,
 		SecureCookie:           cfg.SecureCookie,
 	}, nil
 }
@@ -205,6 +252,14 @@ func validateConfig(cfg Config) error {
 func (c *Container) Close() error {
 	var errs []error
 
+	if c.RiverWorker != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), riverWorkerStopTimeout)
+		if err := c.RiverWorker.Stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("river worker: %w", err))
+		}
+		cancel()
+	}
+
 	if c.DB != nil {
 		c.DB.Close()
 	}
@@ -214,3 +269,13 @@ func (c *Container) Close() error {
 	}
 	return nil
 }
+
+func parseList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
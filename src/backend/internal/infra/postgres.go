@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/specvital/web/src/backend/common/tracing"
 )
 
 const (
@@ -36,6 +38,7 @@ func NewPostgresPool(ctx context.Context, cfg PostgresConfig) (*pgxpool.Pool, er
 
 	poolConfig.MaxConns = maxConns
 	poolConfig.MinConns = minConns
+	poolConfig.ConnConfig.Tracer = tracing.NewQueryTracer()
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
@@ -1,10 +1,16 @@
 package infra
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/specvital/web/src/backend/common/tracing"
 )
 
 type RiverClient struct {
@@ -12,7 +18,9 @@ type RiverClient struct {
 }
 
 func NewRiverClient(pool *pgxpool.Pool) (*RiverClient, error) {
-	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{})
+	riverClient, err := river.NewClient(riverpgxv5.New(pool), &river.Config{
+		Middleware: []rivertype.Middleware{tracing.NewRiverMiddleware()},
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -23,3 +31,11 @@ func NewRiverClient(pool *pgxpool.Pool) (*RiverClient, error) {
 func (r *RiverClient) Client() *river.Client[pgx.Tx] {
 	return r.client
 }
+
+// Ping confirms River's tables are reachable.
+func (r *RiverClient) Ping(ctx context.Context) error {
+	if _, err := r.client.QueueList(ctx, river.NewQueueListParams().First(1)); err != nil {
+		return fmt.Errorf("list river queues: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package infra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+	"github.com/riverqueue/river/rivermigrate"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/specvital/web/src/backend/common/queue"
+	"github.com/specvital/web/src/backend/common/tracing"
+)
+
+const (
+	DefaultRiverWorkerSchema = "river_web"
+
+	webBatchMaxWorkers       = 4
+	webMaintenanceMaxWorkers = 2
+)
+
+// RiverWorker is a job-working River client owned by the web service.
+// It lives in its own schema so that its leader election, job rescuer and
+// periodic jobs never interfere with the worker service that shares the
+// public River schema (which would otherwise discard job kinds it does not know).
+type RiverWorker struct {
+	client  *river.Client[pgx.Tx]
+	schema  string
+	workers *river.Workers
+}
+
+func NewRiverWorker(ctx context.Context, pool *pgxpool.Pool, schema string) (*RiverWorker, error) {
+	if schema == "" {
+		schema = DefaultRiverWorkerSchema
+	}
+
+	if _, err := pool.Exec(ctx, "CREATE SCHEMA IF NOT EXISTS "+pgx.Identifier{schema}.Sanitize()); err != nil {
+		return nil, fmt.Errorf("create schema %s: %w", schema, err)
+	}
+
+	driver := riverpgxv5.New(pool)
+
+	migrator, err := rivermigrate.New(driver, &rivermigrate.Config{Schema: schema})
+	if err != nil {
+		return nil, fmt.Errorf("create migrator: %w", err)
+	}
+	if _, err := migrator.Migrate(ctx, rivermigrate.DirectionUp, nil); err != nil {
+		return nil, fmt.Errorf("migrate schema %s: %w", schema, err)
+	}
+
+	workers := river.NewWorkers()
+	client, err := river.NewClient(driver, &river.Config{
+		Middleware: []rivertype.Middleware{tracing.NewRiverMiddleware()},
+		Queues: map[string]river.QueueConfig{
+			queue.QueueWebBatch:       {MaxWorkers: webBatchMaxWorkers},
+			queue.QueueWebMaintenance: {MaxWorkers: webMaintenanceMaxWorkers},
+		},
+		Schema:  schema,
+		Workers: workers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RiverWorker{client: client, schema: schema, workers: workers}, nil
+}
+
+func (r *RiverWorker) Client() *river.Client[pgx.Tx] {
+	return r.client
+}
+
+// Schema returns the schema holding this client's River tables.
+func (r *RiverWorker) Schema() string {
+	return r.schema
+}
+
+// Workers returns the bundle job workers are registered on. Registration must
+// happen before Start.
+func (r *RiverWorker) Workers() *river.Workers {
+	return r.workers
+}
+
+// Ping confirms the worker has not stopped and its schema is reachable.
+func (r *RiverWorker) Ping(ctx context.Context) error {
+	select {
+	case <-r.client.Stopped():
+		return errors.New("river worker stopped")
+	default:
+	}
+	if _, err := r.client.QueueList(ctx, river.NewQueueListParams().First(1)); err != nil {
+		return fmt.Errorf("list river queues in %s: %w", r.schema, err)
+	}
+	return nil
+}
+
+func (r *RiverWorker) Start(ctx context.Context) error {
+	return r.client.Start(ctx)
+}
+
+func (r *RiverWorker) Stop(ctx context.Context) error {
+	return r.client.Stop(ctx)
+}
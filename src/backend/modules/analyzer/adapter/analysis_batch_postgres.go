@@ -0,0 +1,199 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+var _ port.AnalysisBatchRepository = (*AnalysisBatchPostgresRepository)(nil)
+
+type AnalysisBatchPostgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewAnalysisBatchPostgresRepository(pool *pgxpool.Pool, queries *db.Queries) *AnalysisBatchPostgresRepository {
+	return &AnalysisBatchPostgresRepository{pool: pool, queries: queries}
+}
+
+func (r *AnalysisBatchPostgresRepository) CreateBatch(ctx context.Context, params port.CreateAnalysisBatchParams) (*entity.AnalysisBatch, error) {
+	userID, err := stringToUUID(params.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("parse user ID: %w", err)
+	}
+
+	var orgLogin pgtype.Text
+	if params.OrgLogin != nil {
+		orgLogin = pgtype.Text{String: *params.OrgLogin, Valid: true}
+	}
+
+	owners := make([]string, len(params.Repositories))
+	repos := make([]string, len(params.Repositories))
+	items := make([]entity.AnalysisBatchItem, len(params.Repositories))
+	for i, ref := range params.Repositories {
+		owners[i] = ref.Owner
+		repos[i] = ref.Repo
+		items[i] = entity.AnalysisBatchItem{
+			Owner:  ref.Owner,
+			Repo:   ref.Repo,
+			Status: entity.AnalysisBatchItemStatusPending,
+		}
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := r.queries.WithTx(tx)
+	row, err := qtx.CreateAnalysisBatch(ctx, db.CreateAnalysisBatchParams{
+		UserID:       userID,
+		OrgLogin:     orgLogin,
+		TotalCount:   int32(len(params.Repositories)),
+		SkippedCount: int32(params.SkippedCount),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create analysis batch: %w", err)
+	}
+
+	if err := qtx.CreateAnalysisBatchItems(ctx, db.CreateAnalysisBatchItemsParams{
+		BatchID: row.ID,
+		Owners:  owners,
+		Repos:   repos,
+	}); err != nil {
+		return nil, fmt.Errorf("create analysis batch items: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	batch := mapAnalysisBatch(row)
+	batch.Items = items
+	return batch, nil
+}
+
+func (r *AnalysisBatchPostgresRepository) GetBatch(ctx context.Context, batchID string) (*entity.AnalysisBatch, error) {
+	id, err := stringToUUID(batchID)
+	if err != nil {
+		return nil, domain.ErrAnalysisBatchNotFound
+	}
+
+	row, err := r.queries.GetAnalysisBatch(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAnalysisBatchNotFound
+		}
+		return nil, fmt.Errorf("get analysis batch: %w", err)
+	}
+
+	rows, err := r.queries.ListAnalysisBatchItemsWithStatus(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list analysis batch items: %w", err)
+	}
+
+	batch := mapAnalysisBatch(row)
+	batch.Items = make([]entity.AnalysisBatchItem, len(rows))
+	for i, item := range rows {
+		batch.Items[i] = entity.AnalysisBatchItem{
+			CommitSHA:    textToPtr(item.CommitSha),
+			ErrorMessage: textToPtr(item.ErrorMessage),
+			Owner:        item.Owner,
+			Repo:         item.Repo,
+			Status:       entity.AnalysisBatchItemStatus(item.Status),
+		}
+		if item.AnalysisStatus.Valid {
+			status := entity.AnalysisStatus(item.AnalysisStatus.AnalysisStatus)
+			batch.Items[i].AnalysisStatus = &status
+		}
+	}
+	return batch, nil
+}
+
+func (r *AnalysisBatchPostgresRepository) ListPendingItems(ctx context.Context, batchID string) ([]port.PendingAnalysisBatchItem, error) {
+	id, err := stringToUUID(batchID)
+	if err != nil {
+		return nil, fmt.Errorf("parse batch ID: %w", err)
+	}
+
+	rows, err := r.queries.ListPendingAnalysisBatchItems(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("list pending analysis batch items: %w", err)
+	}
+
+	items := make([]port.PendingAnalysisBatchItem, len(rows))
+	for i, row := range rows {
+		items[i] = port.PendingAnalysisBatchItem{
+			ID:    uuidToString(row.ID),
+			Owner: row.Owner,
+			Repo:  row.Repo,
+		}
+	}
+	return items, nil
+}
+
+func (r *AnalysisBatchPostgresRepository) MarkSubmitted(ctx context.Context, batchID string) error {
+	id, err := stringToUUID(batchID)
+	if err != nil {
+		return fmt.Errorf("parse batch ID: %w", err)
+	}
+	if err := r.queries.MarkAnalysisBatchSubmitted(ctx, id); err != nil {
+		return fmt.Errorf("mark analysis batch submitted: %w", err)
+	}
+	return nil
+}
+
+func (r *AnalysisBatchPostgresRepository) UpdateItem(ctx context.Context, itemID string, status entity.AnalysisBatchItemStatus, commitSHA, errorMessage *string) error {
+	id, err := stringToUUID(itemID)
+	if err != nil {
+		return fmt.Errorf("parse item ID: %w", err)
+	}
+	if err := r.queries.UpdateAnalysisBatchItem(ctx, db.UpdateAnalysisBatchItemParams{
+		ID:           id,
+		Status:       db.AnalysisBatchItemStatus(status),
+		CommitSha:    ptrToText(commitSHA),
+		ErrorMessage: ptrToText(errorMessage),
+	}); err != nil {
+		return fmt.Errorf("update analysis batch item: %w", err)
+	}
+	return nil
+}
+
+func mapAnalysisBatch(row db.AnalysisBatch) *entity.AnalysisBatch {
+	batch := &entity.AnalysisBatch{
+		CreatedAt:    row.CreatedAt.Time,
+		ID:           uuidToString(row.ID),
+		OrgLogin:     textToPtr(row.OrgLogin),
+		SkippedCount: int(row.SkippedCount),
+		TotalCount:   int(row.TotalCount),
+		UserID:       uuidToString(row.UserID),
+	}
+	if row.SubmittedAt.Valid {
+		batch.SubmittedAt = &row.SubmittedAt.Time
+	}
+	return batch
+}
+
+func textToPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+func ptrToText(s *string) pgtype.Text {
+	if s == nil {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *s, Valid: true}
+}
@@ -0,0 +1,49 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/specvital/web/src/backend/common/queue"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+var _ port.AnalysisBatchScheduler = (*RiverAnalysisBatchScheduler)(nil)
+
+const (
+	TypeAnalysisBatch = "web:analysis_batch"
+
+	// Finished items are persisted, so a retry only submits what is left.
+	analysisBatchMaxAttempts = 3
+)
+
+type AnalysisBatchArgs struct {
+	BatchID string `json:"batch_id" river:"unique"`
+}
+
+func (AnalysisBatchArgs) Kind() string { return TypeAnalysisBatch }
+
+type RiverAnalysisBatchScheduler struct {
+	client *river.Client[pgx.Tx]
+}
+
+func NewRiverAnalysisBatchScheduler(client *river.Client[pgx.Tx]) *RiverAnalysisBatchScheduler {
+	return &RiverAnalysisBatchScheduler{client: client}
+}
+
+func (s *RiverAnalysisBatchScheduler) ScheduleBatch(ctx context.Context, batchID string) error {
+	ctx, cancel := context.WithTimeout(ctx, enqueueTimeout)
+	defer cancel()
+
+	_, err := s.client.Insert(ctx, AnalysisBatchArgs{BatchID: batchID}, &river.InsertOpts{
+		MaxAttempts: analysisBatchMaxAttempts,
+		Queue:       queue.QueueWebBatch,
+		UniqueOpts:  river.UniqueOpts{ByArgs: true},
+	})
+	if err != nil {
+		return fmt.Errorf("schedule analysis batch %s: %w", batchID, err)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package adapter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+var _ port.AnalysisIngestRepository = (*AnalysisIngestPostgresRepository)(nil)
+
+type AnalysisIngestPostgresRepository struct {
+	pool    *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewAnalysisIngestPostgresRepository(pool *pgxpool.Pool, queries *db.Queries) *AnalysisIngestPostgresRepository {
+	return &AnalysisIngestPostgresRepository{pool: pool, queries: queries}
+}
+
+func (r *AnalysisIngestPostgresRepository) FindUserRepository(ctx context.Context, userID, owner, repo string) (*entity.IngestRepository, error) {
+	userUUID, err := stringToUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("parse user ID: %w", err)
+	}
+
+	row, err := r.queries.GetUserSyncedRepository(ctx, db.GetUserSyncedRepositoryParams{
+		UserID:   userUUID,
+		FullName: owner + "/" + repo,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrRepositoryAccessDenied
+		}
+		return nil, fmt.Errorf("get synced repository: %w", err)
+	}
+
+	// Prefer GitHub's canonical casing so the codebase matches what the
+	// worker would have created for the same repository.
+	canonicalOwner, canonicalName, ok := strings.Cut(row.FullName, "/")
+	if !ok {
+		canonicalOwner, canonicalName = owner, repo
+	}
+
+	return &entity.IngestRepository{
+		ExternalRepoID: strconv.FormatInt(row.GithubRepoID, 10),
+		IsPrivate:      row.IsPrivate,
+		Name:           canonicalName,
+		Owner:          canonicalOwner,
+	}, nil
+}
+
+func (r *AnalysisIngestPostgresRepository) StoreInventory(
+	ctx context.Context,
+	userID string,
+	repository *entity.IngestRepository,
+	inventory *entity.IngestedInventory,
+) (*entity.IngestedAnalysis, error) {
+	userUUID, err := stringToUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("parse user ID: %w", err)
+	}
+
+	rows, err := flattenInventory(inventory)
+	if err != nil {
+		return nil, err
+	}
+
+	var branch pgtype.Text
+	if inventory.Branch != nil {
+		branch = pgtype.Text{String: *inventory.Branch, Valid: true}
+	}
+	var committedAt pgtype.Timestamptz
+	if inventory.CommittedAt != nil {
+		committedAt = pgtype.Timestamptz{Time: *inventory.CommittedAt, Valid: true}
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := r.queries.WithTx(tx)
+
+	codebaseID, err := qtx.UpsertIngestedCodebase(ctx, db.UpsertIngestedCodebaseParams{
+		Host:           HostGitHub,
+		Owner:          repository.Owner,
+		Name:           repository.Name,
+		ExternalRepoID: repository.ExternalRepoID,
+		IsPrivate:      repository.IsPrivate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("upsert codebase: %w", err)
+	}
+
+	analysis, err := qtx.CreateIngestedAnalysis(ctx, db.CreateIngestedAnalysisParams{
+		CodebaseID:    codebaseID,
+		CommitSha:     inventory.CommitSHA,
+		BranchName:    branch,
+		CommittedAt:   committedAt,
+		ParserVersion: inventory.ParserVersion,
+		TotalSuites:   int32(len(rows.suites.Ids)),
+		TotalTests:    int32(len(rows.cases.Names)),
+		UploadedBy:    userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAnalysisAlreadyExists
+		}
+		return nil, fmt.Errorf("create analysis: %w", err)
+	}
+
+	rows.files.AnalysisID = analysis.ID
+	if err := qtx.InsertIngestedTestFiles(ctx, rows.files); err != nil {
+		return nil, fmt.Errorf("insert test files: %w", err)
+	}
+	if err := qtx.InsertIngestedTestSuites(ctx, rows.suites); err != nil {
+		return nil, fmt.Errorf("insert test suites: %w", err)
+	}
+	if err := qtx.InsertIngestedTestCases(ctx, rows.cases); err != nil {
+		return nil, fmt.Errorf("insert test cases: %w", err)
+	}
+
+	if _, err := qtx.AddUserAnalyzedRepository(ctx, db.AddUserAnalyzedRepositoryParams{
+		UserID:     userUUID,
+		AnalysisID: analysis.ID,
+	}); err != nil {
+		return nil, fmt.Errorf("add analysis history: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return &entity.IngestedAnalysis{
+		CommitSHA:     inventory.CommitSHA,
+		CompletedAt:   analysis.CompletedAt.Time,
+		ID:            uuidToString(analysis.ID),
+		ParserVersion: inventory.ParserVersion,
+		TotalSuites:   len(rows.suites.Ids),
+		TotalTests:    len(rows.cases.Names),
+	}, nil
+}
+
+// inventoryRows holds an inventory flattened into the column arrays of the
+// bulk insert queries. IDs are assigned up front so nested suites and cases
+// can reference their parents within the same statements.
+type inventoryRows struct {
+	cases  db.InsertIngestedTestCasesParams
+	files  db.InsertIngestedTestFilesParams
+	suites db.InsertIngestedTestSuitesParams
+}
+
+func flattenInventory(inventory *entity.IngestedInventory) (*inventoryRows, error) {
+	rows := &inventoryRows{}
+
+	for _, file := range inventory.Files {
+		fileID := newUUID()
+		rows.files.Ids = append(rows.files.Ids, fileID)
+		rows.files.FilePaths = append(rows.files.FilePaths, file.Path)
+		rows.files.Frameworks = append(rows.files.Frameworks, file.Framework)
+
+		// test_cases require a suite, so tests declared at file level are
+		// grouped under an unnamed root suite.
+		if len(file.Tests) > 0 {
+			rootID := rows.addSuite(fileID, pgtype.UUID{}, "", 0, 0)
+			if err := rows.addCases(rootID, file.Tests); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, suite := range file.Suites {
+			if err := rows.addSuiteTree(fileID, pgtype.UUID{}, suite, 0); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+func (rows *inventoryRows) addSuiteTree(fileID, parentID pgtype.UUID, suite entity.IngestedTestSuite, depth int) error {
+	suiteID := rows.addSuite(fileID, parentID, suite.Name, suite.Line, depth)
+	if err := rows.addCases(suiteID, suite.Tests); err != nil {
+		return err
+	}
+	for _, child := range suite.Suites {
+		if err := rows.addSuiteTree(fileID, suiteID, child, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rows *inventoryRows) addSuite(fileID, parentID pgtype.UUID, name string, line, depth int) pgtype.UUID {
+	id := newUUID()
+	rows.suites.Ids = append(rows.suites.Ids, id)
+	rows.suites.FileIds = append(rows.suites.FileIds, fileID)
+	rows.suites.ParentIds = append(rows.suites.ParentIds, parentID)
+	rows.suites.Names = append(rows.suites.Names, name)
+	rows.suites.LineNumbers = append(rows.suites.LineNumbers, int32(line))
+	rows.suites.Depths = append(rows.suites.Depths, int32(depth))
+	return id
+}
+
+func (rows *inventoryRows) addCases(suiteID pgtype.UUID, cases []entity.IngestedTestCase) error {
+	for _, tc := range cases {
+		tags := tc.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		encodedTags, err := json.Marshal(tags)
+		if err != nil {
+			return fmt.Errorf("encode tags: %w", err)
+		}
+
+		rows.cases.SuiteIds = append(rows.cases.SuiteIds, suiteID)
+		rows.cases.Names = append(rows.cases.Names, tc.Name)
+		rows.cases.LineNumbers = append(rows.cases.LineNumbers, int32(tc.Line))
+		rows.cases.Statuses = append(rows.cases.Statuses, string(tc.Status))
+		rows.cases.Tags = append(rows.cases.Tags, string(encodedTags))
+		rows.cases.Modifiers = append(rows.cases.Modifiers, tc.Modifier)
+	}
+	return nil
+}
+
+func newUUID() pgtype.UUID {
+	return pgtype.UUID{Bytes: uuid.New(), Valid: true}
+}
@@ -0,0 +1,72 @@
+package adapter
+
+import (
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+func TestFlattenInventory(t *testing.T) {
+	inventory := &entity.IngestedInventory{
+		Files: []entity.IngestedTestFile{
+			{
+				Framework: "vitest",
+				Path:      "src/app.test.ts",
+				Suites: []entity.IngestedTestSuite{
+					{
+						Line: 3,
+						Name: "App",
+						Suites: []entity.IngestedTestSuite{
+							{Name: "render", Tests: []entity.IngestedTestCase{{Name: "renders", Status: entity.TestStatusActive}}},
+						},
+						Tests: []entity.IngestedTestCase{{Name: "boots", Status: entity.TestStatusSkipped, Tags: []string{"slow"}}},
+					},
+				},
+				Tests: []entity.IngestedTestCase{{Name: "top-level", Status: entity.TestStatusTodo}},
+			},
+		},
+	}
+
+	rows, err := flattenInventory(inventory)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(rows.files.Ids) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(rows.files.Ids))
+	}
+
+	// implicit root suite, App, App > render
+	suites := rows.suites
+	if len(suites.Ids) != 3 {
+		t.Fatalf("expected 3 suites, got %d", len(suites.Ids))
+	}
+	if suites.Names[0] != "" || suites.ParentIds[0].Valid {
+		t.Errorf("expected unnamed root suite first, got %q", suites.Names[0])
+	}
+	if suites.Names[1] != "App" || suites.Depths[1] != 0 || suites.LineNumbers[1] != 3 {
+		t.Errorf("unexpected App suite: name=%q depth=%d line=%d", suites.Names[1], suites.Depths[1], suites.LineNumbers[1])
+	}
+	if suites.Names[2] != "render" || suites.Depths[2] != 1 || suites.ParentIds[2] != suites.Ids[1] {
+		t.Errorf("expected render nested under App")
+	}
+	for i, fileID := range suites.FileIds {
+		if fileID != rows.files.Ids[0] {
+			t.Errorf("suite %d not linked to file", i)
+		}
+	}
+
+	cases := rows.cases
+	if len(cases.Names) != 3 {
+		t.Fatalf("expected 3 test cases, got %d", len(cases.Names))
+	}
+	if cases.SuiteIds[0] != suites.Ids[0] || cases.Names[0] != "top-level" {
+		t.Errorf("expected top-level test under root suite")
+	}
+	if cases.SuiteIds[1] != suites.Ids[1] || cases.Tags[1] != `["slow"]` {
+		t.Errorf("unexpected App test: suite match=%v tags=%s", cases.SuiteIds[1] == suites.Ids[1], cases.Tags[1])
+	}
+	if cases.SuiteIds[2] != suites.Ids[2] || cases.Tags[2] != `[]` {
+		t.Errorf("unexpected render test: suite match=%v tags=%s", cases.SuiteIds[2] == suites.Ids[2], cases.Tags[2])
+	}
+}
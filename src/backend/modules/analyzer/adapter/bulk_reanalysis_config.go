@@ -0,0 +1,55 @@
+package adapter
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	defaultBulkReanalysisEnqueueRate  = 5
+	defaultParserUpgradeCheckInterval = 10 * time.Minute
+)
+
+type BulkReanalysisConfig struct {
+	// AutoEnabled starts a bulk re-analysis automatically when system_config's
+	// parser_version changes.
+	AutoEnabled bool
+	// CheckInterval is how often the parser version is compared against the last run.
+	CheckInterval time.Duration
+	// EnqueueRate caps analysis jobs inserted per second.
+	EnqueueRate rate.Limit
+}
+
+func BulkReanalysisConfigFromEnv() *BulkReanalysisConfig {
+	cfg := &BulkReanalysisConfig{
+		AutoEnabled:   os.Getenv("BULK_REANALYSIS_AUTO_ENABLED") == "true",
+		CheckInterval: defaultParserUpgradeCheckInterval,
+		EnqueueRate:   defaultBulkReanalysisEnqueueRate,
+	}
+
+	if v := os.Getenv("BULK_REANALYSIS_CHECK_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil || interval <= 0 {
+			slog.Warn("invalid BULK_REANALYSIS_CHECK_INTERVAL, using default",
+				"value", v, "default", defaultParserUpgradeCheckInterval)
+		} else {
+			cfg.CheckInterval = interval
+		}
+	}
+
+	if v := os.Getenv("BULK_REANALYSIS_RATE_PER_SECOND"); v != "" {
+		perSecond, err := strconv.ParseFloat(v, 64)
+		if err != nil || perSecond <= 0 {
+			slog.Warn("invalid BULK_REANALYSIS_RATE_PER_SECOND, using default",
+				"value", v, "default", defaultBulkReanalysisEnqueueRate)
+		} else {
+			cfg.EnqueueRate = rate.Limit(perSecond)
+		}
+	}
+
+	return cfg
+}
@@ -0,0 +1,227 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+var _ port.BulkReanalysisRepository = (*BulkReanalysisPostgresRepository)(nil)
+
+type BulkReanalysisPostgresRepository struct {
+	queries *db.Queries
+}
+
+func NewBulkReanalysisPostgresRepository(queries *db.Queries) *BulkReanalysisPostgresRepository {
+	return &BulkReanalysisPostgresRepository{queries: queries}
+}
+
+func (r *BulkReanalysisPostgresRepository) CompleteRun(ctx context.Context, runID string) error {
+	id, err := stringToUUID(runID)
+	if err != nil {
+		return fmt.Errorf("parse run ID: %w", err)
+	}
+	if err := r.queries.CompleteBulkReanalysisRun(ctx, id); err != nil {
+		return fmt.Errorf("complete bulk re-analysis run: %w", err)
+	}
+	return nil
+}
+
+func (r *BulkReanalysisPostgresRepository) CountOutdatedCodebases(ctx context.Context, parserVersion string) (int, error) {
+	total, err := r.queries.CountOutdatedCodebases(ctx, parserVersion)
+	if err != nil {
+		return 0, fmt.Errorf("count outdated codebases: %w", err)
+	}
+	return int(total), nil
+}
+
+func (r *BulkReanalysisPostgresRepository) CreateRun(ctx context.Context, params port.CreateBulkReanalysisRunParams) (*entity.BulkReanalysisRun, error) {
+	var triggeredBy pgtype.UUID
+	if params.TriggeredBy != nil {
+		var err error
+		triggeredBy, err = stringToUUID(*params.TriggeredBy)
+		if err != nil {
+			return nil, fmt.Errorf("parse triggered by: %w", err)
+		}
+	}
+
+	row, err := r.queries.CreateBulkReanalysisRun(ctx, db.CreateBulkReanalysisRunParams{
+		ParserVersion: params.ParserVersion,
+		Trigger:       db.BulkReanalysisTrigger(params.Trigger),
+		TriggeredBy:   triggeredBy,
+		TotalCount:    int32(params.TotalCount),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create bulk re-analysis run: %w", err)
+	}
+	return mapBulkReanalysisRun(row), nil
+}
+
+func (r *BulkReanalysisPostgresRepository) FailRun(ctx context.Context, runID, errorMessage string) error {
+	id, err := stringToUUID(runID)
+	if err != nil {
+		return fmt.Errorf("parse run ID: %w", err)
+	}
+	if err := r.queries.FailBulkReanalysisRun(ctx, db.FailBulkReanalysisRunParams{
+		ID:           id,
+		ErrorMessage: pgtype.Text{String: errorMessage, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("fail bulk re-analysis run: %w", err)
+	}
+	return nil
+}
+
+func (r *BulkReanalysisPostgresRepository) GetActiveRun(ctx context.Context) (*entity.BulkReanalysisRun, error) {
+	row, err := r.queries.GetActiveBulkReanalysisRun(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get active bulk re-analysis run: %w", err)
+	}
+	return mapBulkReanalysisRun(row), nil
+}
+
+func (r *BulkReanalysisPostgresRepository) GetLatestRun(ctx context.Context) (*entity.BulkReanalysisRun, error) {
+	row, err := r.queries.GetLatestBulkReanalysisRun(ctx)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get latest bulk re-analysis run: %w", err)
+	}
+	return mapBulkReanalysisRun(row), nil
+}
+
+func (r *BulkReanalysisPostgresRepository) GetRun(ctx context.Context, runID string) (*entity.BulkReanalysisRun, error) {
+	id, err := stringToUUID(runID)
+	if err != nil {
+		return nil, domain.ErrBulkReanalysisNotFound
+	}
+
+	row, err := r.queries.GetBulkReanalysisRun(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrBulkReanalysisNotFound
+		}
+		return nil, fmt.Errorf("get bulk re-analysis run: %w", err)
+	}
+	return mapBulkReanalysisRun(row), nil
+}
+
+func (r *BulkReanalysisPostgresRepository) ListOutdatedCodebases(ctx context.Context, parserVersion, afterCodebaseID string, limit int) ([]entity.OutdatedCodebase, error) {
+	// The zero UUID sorts before every generated ID, so it doubles as the initial cursor.
+	afterID := pgtype.UUID{Valid: true}
+	if afterCodebaseID != "" {
+		var err error
+		afterID, err = stringToUUID(afterCodebaseID)
+		if err != nil {
+			return nil, fmt.Errorf("parse codebase cursor: %w", err)
+		}
+	}
+
+	rows, err := r.queries.ListOutdatedCodebases(ctx, db.ListOutdatedCodebasesParams{
+		ParserVersion: parserVersion,
+		AfterID:       afterID,
+		PageLimit:     int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list outdated codebases: %w", err)
+	}
+
+	result := make([]entity.OutdatedCodebase, len(rows))
+	for i, row := range rows {
+		result[i] = entity.OutdatedCodebase{
+			CodebaseID: uuidToString(row.ID),
+			CommitSHA:  row.CommitSha,
+			Owner:      row.Owner,
+			Repo:       row.Name,
+		}
+	}
+	return result, nil
+}
+
+func (r *BulkReanalysisPostgresRepository) ListRuns(ctx context.Context, limit int) ([]*entity.BulkReanalysisRun, error) {
+	rows, err := r.queries.ListBulkReanalysisRuns(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("list bulk re-analysis runs: %w", err)
+	}
+
+	result := make([]*entity.BulkReanalysisRun, len(rows))
+	for i, row := range rows {
+		result[i] = mapBulkReanalysisRun(row)
+	}
+	return result, nil
+}
+
+func (r *BulkReanalysisPostgresRepository) MarkRunStarted(ctx context.Context, runID string) error {
+	id, err := stringToUUID(runID)
+	if err != nil {
+		return fmt.Errorf("parse run ID: %w", err)
+	}
+	if err := r.queries.MarkBulkReanalysisRunStarted(ctx, id); err != nil {
+		return fmt.Errorf("mark bulk re-analysis run started: %w", err)
+	}
+	return nil
+}
+
+func (r *BulkReanalysisPostgresRepository) UpdateRunProgress(ctx context.Context, runID string, enqueued, failed int, lastCodebaseID string) error {
+	id, err := stringToUUID(runID)
+	if err != nil {
+		return fmt.Errorf("parse run ID: %w", err)
+	}
+
+	var lastID pgtype.UUID
+	if lastCodebaseID != "" {
+		lastID, err = stringToUUID(lastCodebaseID)
+		if err != nil {
+			return fmt.Errorf("parse codebase cursor: %w", err)
+		}
+	}
+
+	if err := r.queries.UpdateBulkReanalysisRunProgress(ctx, db.UpdateBulkReanalysisRunProgressParams{
+		ID:             id,
+		EnqueuedCount:  int32(enqueued),
+		FailedCount:    int32(failed),
+		LastCodebaseID: lastID,
+	}); err != nil {
+		return fmt.Errorf("update bulk re-analysis run progress: %w", err)
+	}
+	return nil
+}
+
+func mapBulkReanalysisRun(row db.BulkReanalysisRun) *entity.BulkReanalysisRun {
+	run := &entity.BulkReanalysisRun{
+		CreatedAt:      row.CreatedAt.Time,
+		EnqueuedCount:  int(row.EnqueuedCount),
+		FailedCount:    int(row.FailedCount),
+		ID:             uuidToString(row.ID),
+		LastCodebaseID: uuidToString(row.LastCodebaseID),
+		ParserVersion:  row.ParserVersion,
+		Status:         entity.BulkReanalysisStatus(row.Status),
+		TotalCount:     int(row.TotalCount),
+		Trigger:        entity.BulkReanalysisTrigger(row.Trigger),
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+	if row.CompletedAt.Valid {
+		run.CompletedAt = &row.CompletedAt.Time
+	}
+	if row.ErrorMessage.Valid {
+		run.ErrorMessage = &row.ErrorMessage.String
+	}
+	if row.StartedAt.Valid {
+		run.StartedAt = &row.StartedAt.Time
+	}
+	if row.TriggeredBy.Valid {
+		triggeredBy := uuidToString(row.TriggeredBy)
+		run.TriggeredBy = &triggeredBy
+	}
+	return run
+}
@@ -0,0 +1,56 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+	"github.com/specvital/web/src/backend/common/queue"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+var _ port.BulkReanalysisScheduler = (*RiverBulkReanalysisScheduler)(nil)
+
+const (
+	TypeBulkReanalysis     = "web:bulk_reanalysis"
+	TypeParserUpgradeCheck = "web:parser_upgrade_check"
+
+	// A run resumes from its persisted cursor, so retries are cheap.
+	bulkReanalysisMaxAttempts = 5
+)
+
+type BulkReanalysisArgs struct {
+	RunID string `json:"run_id" river:"unique"`
+}
+
+func (BulkReanalysisArgs) Kind() string { return TypeBulkReanalysis }
+
+type ParserUpgradeCheckArgs struct{}
+
+func (ParserUpgradeCheckArgs) Kind() string { return TypeParserUpgradeCheck }
+
+// RiverBulkReanalysisScheduler inserts bulk re-analysis jobs into the web service's
+// own River client (see infra.RiverWorker), not the shared analysis queue.
+type RiverBulkReanalysisScheduler struct {
+	client *river.Client[pgx.Tx]
+}
+
+func NewRiverBulkReanalysisScheduler(client *river.Client[pgx.Tx]) *RiverBulkReanalysisScheduler {
+	return &RiverBulkReanalysisScheduler{client: client}
+}
+
+func (s *RiverBulkReanalysisScheduler) ScheduleRun(ctx context.Context, runID string) error {
+	ctx, cancel := context.WithTimeout(ctx, enqueueTimeout)
+	defer cancel()
+
+	_, err := s.client.Insert(ctx, BulkReanalysisArgs{RunID: runID}, &river.InsertOpts{
+		MaxAttempts: bulkReanalysisMaxAttempts,
+		Queue:       queue.QueueWebMaintenance,
+		UniqueOpts:  river.UniqueOpts{ByArgs: true},
+	})
+	if err != nil {
+		return fmt.Errorf("schedule bulk re-analysis run %s: %w", runID, err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+func ToAnalysisBatch(batch *entity.AnalysisBatch) (api.AnalysisBatch, error) {
+	id, err := uuid.Parse(batch.ID)
+	if err != nil {
+		return api.AnalysisBatch{}, fmt.Errorf("parse batch ID: %w", err)
+	}
+
+	items := make([]api.AnalysisBatchItem, len(batch.Items))
+	for i, item := range batch.Items {
+		items[i] = api.AnalysisBatchItem{
+			CommitSHA: item.CommitSHA,
+			Error:     item.ErrorMessage,
+			Owner:     item.Owner,
+			Repo:      item.Repo,
+			Status:    api.AnalysisBatchItemStatus(item.State()),
+		}
+	}
+
+	progress := batch.Progress()
+	status := api.AnalysisBatchStatusCompleted
+	switch {
+	case batch.SubmittedAt == nil:
+		status = api.AnalysisBatchStatusSubmitting
+	case progress.Analyzing > 0 || progress.Pending > 0:
+		status = api.AnalysisBatchStatusAnalyzing
+	}
+
+	return api.AnalysisBatch{
+		CreatedAt: batch.CreatedAt,
+		ID:        id,
+		Items:     items,
+		Org:       batch.OrgLogin,
+		Progress: api.AnalysisBatchProgress{
+			Analyzing: progress.Analyzing,
+			Completed: progress.Completed,
+			Failed:    progress.Failed,
+			Pending:   progress.Pending,
+		},
+		SkippedCount: batch.SkippedCount,
+		Status:       status,
+		SubmittedAt:  batch.SubmittedAt,
+		TotalCount:   batch.TotalCount,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+func ToIngestedInventory(body *api.IngestAnalysisRequest) *entity.IngestedInventory {
+	files := make([]entity.IngestedTestFile, len(body.Files))
+	for i, f := range body.Files {
+		files[i] = entity.IngestedTestFile{
+			Framework: string(f.Framework),
+			Path:      f.Path,
+			Suites:    toIngestedSuites(f.Suites),
+			Tests:     toIngestedTestCases(f.Tests),
+		}
+	}
+
+	return &entity.IngestedInventory{
+		Branch:        body.Branch,
+		CommitSHA:     body.CommitSHA,
+		CommittedAt:   body.CommittedAt,
+		Files:         files,
+		ParserVersion: body.ParserVersion,
+	}
+}
+
+func toIngestedSuites(suites *[]api.IngestTestSuite) []entity.IngestedTestSuite {
+	if suites == nil {
+		return nil
+	}
+	result := make([]entity.IngestedTestSuite, len(*suites))
+	for i, s := range *suites {
+		result[i] = entity.IngestedTestSuite{
+			Line:   derefInt(s.Line),
+			Name:   s.Name,
+			Suites: toIngestedSuites(s.Suites),
+			Tests:  toIngestedTestCases(s.Tests),
+		}
+	}
+	return result
+}
+
+func toIngestedTestCases(tests *[]api.IngestTestCase) []entity.IngestedTestCase {
+	if tests == nil {
+		return nil
+	}
+	result := make([]entity.IngestedTestCase, len(*tests))
+	for i, t := range *tests {
+		tc := entity.IngestedTestCase{
+			Line:   derefInt(t.Line),
+			Name:   t.Name,
+			Status: entity.TestStatus(t.Status),
+		}
+		if t.Modifier != nil {
+			tc.Modifier = *t.Modifier
+		}
+		if t.Tags != nil {
+			tc.Tags = *t.Tags
+		}
+		result[i] = tc
+	}
+	return result
+}
+
+func ToIngestAnalysisResponse(analysis *entity.IngestedAnalysis) (api.IngestAnalysisResponse, error) {
+	id, err := uuid.Parse(analysis.ID)
+	if err != nil {
+		return api.IngestAnalysisResponse{}, fmt.Errorf("parse analysis ID: %w", err)
+	}
+
+	return api.IngestAnalysisResponse{
+		AnalysisID:    id,
+		CommitSHA:     analysis.CommitSHA,
+		CompletedAt:   analysis.CompletedAt,
+		ParserVersion: analysis.ParserVersion,
+		TotalSuites:   analysis.TotalSuites,
+		TotalTests:    analysis.TotalTests,
+	}, nil
+}
+
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
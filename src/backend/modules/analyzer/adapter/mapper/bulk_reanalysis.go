@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+func ToBulkReanalysisRun(run *entity.BulkReanalysisRun) (api.BulkReanalysisRun, error) {
+	id, err := uuid.Parse(run.ID)
+	if err != nil {
+		return api.BulkReanalysisRun{}, fmt.Errorf("parse run ID: %w", err)
+	}
+
+	var progress float32
+	switch {
+	case run.TotalCount > 0:
+		progress = min(float32(run.ProcessedCount())*100/float32(run.TotalCount), 100)
+	case run.Status == entity.BulkReanalysisStatusCompleted:
+		progress = 100
+	}
+
+	return api.BulkReanalysisRun{
+		CompletedAt:   run.CompletedAt,
+		CreatedAt:     run.CreatedAt,
+		EnqueuedCount: run.EnqueuedCount,
+		ErrorMessage:  run.ErrorMessage,
+		FailedCount:   run.FailedCount,
+		ID:            id,
+		ParserVersion: run.ParserVersion,
+		Progress:      progress,
+		StartedAt:     run.StartedAt,
+		Status:        api.BulkReanalysisRunStatus(run.Status),
+		TotalCount:    run.TotalCount,
+		Trigger:       api.BulkReanalysisRunTrigger(run.Trigger),
+	}, nil
+}
+
+func ToBulkReanalysisRunsResponse(runs []*entity.BulkReanalysisRun) (api.BulkReanalysisRunsResponse, error) {
+	data := make([]api.BulkReanalysisRun, len(runs))
+	for i, run := range runs {
+		item, err := ToBulkReanalysisRun(run)
+		if err != nil {
+			return api.BulkReanalysisRunsResponse{}, err
+		}
+		data[i] = item
+	}
+	return api.BulkReanalysisRunsResponse{Data: data}, nil
+}
@@ -0,0 +1,45 @@
+package mapper
+
+import (
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+func ToOrgDashboard(dashboard *entity.OrgDashboard) api.OrgDashboard {
+	attention := make([]api.OrgAttentionRepository, len(dashboard.AttentionRepositories))
+	for i, repo := range dashboard.AttentionRepositories {
+		attention[i] = api.OrgAttentionRepository{
+			AnalyzedAt:   repo.AnalyzedAt,
+			FocusedCount: repo.FocusedCount,
+			Name:         repo.Name,
+			SkippedCount: repo.SkippedCount,
+			TotalTests:   repo.TotalTests,
+		}
+	}
+
+	changes := make([]api.OrgTestCountChange, len(dashboard.TestCountChanges))
+	for i, change := range dashboard.TestCountChanges {
+		changes[i] = api.OrgTestCountChange{
+			Change:        change.Change,
+			CurrentTests:  change.CurrentTests,
+			Name:          change.Name,
+			PreviousTests: change.PreviousTests,
+		}
+	}
+
+	return api.OrgDashboard{
+		AttentionRepositories: attention,
+		ChangeWindowStart:     dashboard.ChangeWindowStart,
+		Org:                   dashboard.Org,
+		RepositoryCount:       dashboard.RepositoryCount,
+		TestCountChanges:      changes,
+		TestSummary: api.TestStatusSummary{
+			Active:  dashboard.StatusSummary.Active,
+			Focused: dashboard.StatusSummary.Focused,
+			Skipped: dashboard.StatusSummary.Skipped,
+			Todo:    dashboard.StatusSummary.Todo,
+			Xfail:   dashboard.StatusSummary.Xfail,
+		},
+		TotalTests: dashboard.TotalTests,
+	}
+}
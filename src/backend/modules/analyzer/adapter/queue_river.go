@@ -8,12 +8,16 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
+	"github.com/specvital/web/src/backend/common/metrics"
 	"github.com/specvital/web/src/backend/common/queue"
 	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
 	subscription "github.com/specvital/web/src/backend/modules/subscription/domain/entity"
 )
 
-var _ port.QueueService = (*RiverQueueService)(nil)
+var (
+	_ port.QueueService          = (*RiverQueueService)(nil)
+	_ port.ScheduledQueueService = (*RiverQueueService)(nil)
+)
 
 const (
 	TypeAnalyze = "analysis:analyze"
@@ -53,7 +57,7 @@ func (s *RiverQueueService) Enqueue(ctx context.Context, owner, repo, commitSHA
 
 	targetQueue := queue.SelectQueueForAnalysis(tier, false)
 
-	_, err := s.client.Insert(ctx, args, &river.InsertOpts{
+	result, err := s.client.Insert(ctx, args, &river.InsertOpts{
 		MaxAttempts: maxRetries,
 		Queue:       targetQueue,
 		UniqueOpts: river.UniqueOpts{
@@ -70,6 +74,9 @@ func (s *RiverQueueService) Enqueue(ctx context.Context, owner, repo, commitSHA
 	if err != nil {
 		return fmt.Errorf("enqueue task for %s/%s: %w", owner, repo, err)
 	}
+	if !result.UniqueSkippedAsDuplicate {
+		metrics.RecordEnqueue(targetQueue, string(tier))
+	}
 
 	return nil
 }
@@ -104,10 +111,51 @@ func (s *RiverQueueService) EnqueueTx(ctx context.Context, tx pgx.Tx, owner, rep
 	if err != nil {
 		return 0, fmt.Errorf("enqueue task for %s/%s: %w", owner, repo, err)
 	}
+	if !result.UniqueSkippedAsDuplicate {
+		metrics.RecordEnqueue(targetQueue, string(tier))
+	}
 
 	return result.Job.ID, nil
 }
 
+// EnqueueScheduled enqueues a system-initiated analysis (no user attribution)
+// on the scheduled queue so it never competes with interactive requests.
+func (s *RiverQueueService) EnqueueScheduled(ctx context.Context, owner, repo, commitSHA string) error {
+	ctx, cancel := context.WithTimeout(ctx, enqueueTimeout)
+	defer cancel()
+
+	args := AnalyzeArgs{
+		CommitSHA: commitSHA,
+		Owner:     owner,
+		Repo:      repo,
+	}
+
+	targetQueue := queue.SelectQueueForAnalysis("", true)
+
+	result, err := s.client.Insert(ctx, args, &river.InsertOpts{
+		MaxAttempts: maxRetries,
+		Queue:       targetQueue,
+		UniqueOpts: river.UniqueOpts{
+			ByArgs: true,
+			ByState: []rivertype.JobState{
+				rivertype.JobStateAvailable,
+				rivertype.JobStatePending,
+				rivertype.JobStateRunning,
+				rivertype.JobStateRetryable,
+				rivertype.JobStateScheduled,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue scheduled task for %s/%s: %w", owner, repo, err)
+	}
+	if !result.UniqueSkippedAsDuplicate {
+		metrics.RecordEnqueue(targetQueue, "")
+	}
+
+	return nil
+}
+
 func (s *RiverQueueService) FindTaskByRepo(ctx context.Context, owner, repo string) (*port.TaskInfo, error) {
 	info, err := s.repo.FindActiveRiverJobByRepo(ctx, TypeAnalyze, owner, repo)
 	if err != nil {
@@ -0,0 +1,69 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/internal/client"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+var _ port.RepositoryAccessChecker = (*GitHubRepositoryAccessChecker)(nil)
+
+// GitHubRepositoryAccessChecker asks GitHub for the user's permissions with
+// their own token, and for the backend's reach with the owner's GitHub App
+// installation token.
+type GitHubRepositoryAccessChecker struct {
+	clients       client.GitHubClientFactory
+	installations client.InstallationTokenSource
+	tokens        port.TokenProvider
+}
+
+func NewGitHubRepositoryAccessChecker(
+	clients client.GitHubClientFactory,
+	installations client.InstallationTokenSource,
+	tokens port.TokenProvider,
+) *GitHubRepositoryAccessChecker {
+	return &GitHubRepositoryAccessChecker{
+		clients:       clients,
+		installations: installations,
+		tokens:        tokens,
+	}
+}
+
+func (c *GitHubRepositoryAccessChecker) CanPush(ctx context.Context, userID, owner, repo string) (bool, error) {
+	token, err := c.tokens.GetUserGitHubToken(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("get user github token: %w", err)
+	}
+
+	repository, err := c.clients(token).GetRepository(ctx, owner, repo)
+	if err != nil {
+		if errors.Is(err, client.ErrGitHubNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get repository %s/%s: %w", owner, repo, err)
+	}
+	return repository.CanPush, nil
+}
+
+func (c *GitHubRepositoryAccessChecker) CanClone(ctx context.Context, owner, repo string) (bool, error) {
+	token, err := c.installations.InstallationToken(ctx, owner)
+	if err != nil {
+		if errors.Is(err, client.ErrNoInstallation) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get installation token for %s: %w", owner, err)
+	}
+
+	// An installation limited to selected repositories cannot see the others.
+	if _, err := c.clients(token).GetRepository(ctx, owner, repo); err != nil {
+		if errors.Is(err, client.ErrGitHubNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get repository %s/%s: %w", owner, repo, err)
+	}
+	return true, nil
+}
@@ -368,6 +368,37 @@ func (r *PostgresRepository) GetTestSuitesWithCases(ctx context.Context, analysi
 	return suites, nil
 }
 
+func (r *PostgresRepository) GetOrgRepositoryTestSummaries(ctx context.Context, owner string, since time.Time) ([]port.OrgRepositoryTestSummary, error) {
+	rows, err := r.queries.GetOrgRepositoryTestSummaries(ctx, db.GetOrgRepositoryTestSummariesParams{
+		Owner: owner,
+		Since: pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get org repository test summaries: %w", err)
+	}
+
+	summaries := make([]port.OrgRepositoryTestSummary, len(rows))
+	for i, row := range rows {
+		summaries[i] = port.OrgRepositoryTestSummary{
+			AnalyzedAt: row.AnalyzedAt.Time,
+			Name:       row.Name,
+			StatusSummary: entity.TestStatusSummary{
+				Active:  int(row.ActiveCount),
+				Focused: int(row.FocusedCount),
+				Skipped: int(row.SkippedCount),
+				Todo:    int(row.TodoCount),
+				Xfail:   int(row.XfailCount),
+			},
+			TotalTests: int(row.TotalTests),
+		}
+		if row.BaselineTotalTests.Valid {
+			baseline := int(row.BaselineTotalTests.Int32)
+			summaries[i].BaselineTotalTests = &baseline
+		}
+	}
+	return summaries, nil
+}
+
 func (r *PostgresRepository) GetPaginatedRepositories(ctx context.Context, params port.PaginationParams) ([]port.PaginatedRepository, error) {
 	var userUUID pgtype.UUID
 	if params.UserID != "" {
@@ -394,6 +425,8 @@ func (r *PostgresRepository) GetPaginatedRepositories(ctx context.Context, param
 		return r.getPaginatedByName(ctx, userUUID, cursorID, params)
 	case entity.SortByTests:
 		return r.getPaginatedByTests(ctx, userUUID, cursorID, params)
+	case entity.SortByTrending:
+		return r.getPaginatedByTrending(ctx, userUUID, cursorID, params)
 	default:
 		return r.getPaginatedByRecent(ctx, userUUID, cursorID, params)
 	}
@@ -519,11 +552,55 @@ func (r *PostgresRepository) getPaginatedByTests(ctx context.Context, userUUID,
 	return repos, nil
 }
 
-func (r *PostgresRepository) UpdateLastViewed(ctx context.Context, owner, repo string) error {
-	if err := r.queries.UpdateCodebaseLastViewed(ctx, db.UpdateCodebaseLastViewedParams{
-		Host:  HostGitHub,
-		Owner: owner,
-		Name:  repo,
+func (r *PostgresRepository) getPaginatedByTrending(ctx context.Context, userUUID, cursorID pgtype.UUID, params port.PaginationParams) ([]port.PaginatedRepository, error) {
+	var cursorTrendingScore int32
+	if params.Cursor != nil {
+		cursorTrendingScore = int32(params.Cursor.TrendingScore)
+	}
+
+	rows, err := r.queries.GetPaginatedRepositoriesByTrending(ctx, db.GetPaginatedRepositoriesByTrendingParams{
+		UserID:              userUUID,
+		TrendingDays:        int32(params.TrendingDays),
+		ViewFilter:          params.View.String(),
+		OwnershipFilter:     params.Ownership.String(),
+		CursorTrendingScore: cursorTrendingScore,
+		SortOrder:           params.SortOrder.String(),
+		CursorID:            cursorID,
+		PageLimit:           int32(params.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get paginated repositories by trending: %w", err)
+	}
+
+	repos := make([]port.PaginatedRepository, len(rows))
+	for i, row := range rows {
+		repos[i] = port.PaginatedRepository{
+			ActiveCount:    int(row.ActiveCount),
+			AnalysisID:     uuidToString(row.AnalysisID),
+			AnalyzedAt:     row.AnalyzedAt.Time,
+			CodebaseID:     uuidToString(row.CodebaseID),
+			CommitSHA:      row.CommitSha,
+			FocusedCount:   int(row.FocusedCount),
+			IsAnalyzedByMe: row.IsAnalyzedByMe,
+			Name:           row.Name,
+			Owner:          row.Owner,
+			SkippedCount:   int(row.SkippedCount),
+			TodoCount:      int(row.TodoCount),
+			TotalTests:     int(row.TotalTests),
+			TrendingScore:  int(row.TrendingScore),
+			XfailCount:     int(row.XfailCount),
+		}
+	}
+	return repos, nil
+}
+
+// UpdateLastViewed also counts the view towards the repository's trending score.
+func (r *PostgresRepository) UpdateLastViewed(ctx context.Context, owner, repo, viewer string) error {
+	if err := r.queries.RecordCodebaseView(ctx, db.RecordCodebaseViewParams{
+		Host:   HostGitHub,
+		Owner:  owner,
+		Name:   repo,
+		Viewer: viewer,
 	}); err != nil {
 		return fmt.Errorf("update last viewed for %s/%s: %w", owner, repo, err)
 	}
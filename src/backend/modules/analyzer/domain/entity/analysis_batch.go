@@ -0,0 +1,101 @@
+package entity
+
+import "time"
+
+// AnalysisBatchItemStatus records what happened when a batch item was submitted.
+type AnalysisBatchItemStatus string
+
+const (
+	// AnalysisBatchItemStatusCached means an existing analysis was reused and nothing was enqueued.
+	AnalysisBatchItemStatusCached  AnalysisBatchItemStatus = "cached"
+	AnalysisBatchItemStatusFailed  AnalysisBatchItemStatus = "failed"
+	AnalysisBatchItemStatusPending AnalysisBatchItemStatus = "pending"
+	AnalysisBatchItemStatusQueued  AnalysisBatchItemStatus = "queued"
+)
+
+func (s AnalysisBatchItemStatus) String() string {
+	return string(s)
+}
+
+type RepositoryRef struct {
+	Owner string
+	Repo  string
+}
+
+// AnalysisBatch groups analyses requested together, typically every repository of an organization.
+// SubmittedAt is set once every item has been handed to the analysis queue.
+type AnalysisBatch struct {
+	CreatedAt    time.Time
+	ID           string
+	Items        []AnalysisBatchItem
+	OrgLogin     *string
+	SkippedCount int
+	SubmittedAt  *time.Time
+	TotalCount   int
+	UserID       string
+}
+
+type AnalysisBatchItem struct {
+	// AnalysisStatus is the status of the analysis for CommitSHA, nil until the worker creates it.
+	AnalysisStatus *AnalysisStatus
+	CommitSHA      *string
+	ErrorMessage   *string
+	Owner          string
+	Repo           string
+	Status         AnalysisBatchItemStatus
+}
+
+// AnalysisBatchItemState is the user-facing state of an item, combining the
+// submission outcome with the status of the analysis it produced.
+type AnalysisBatchItemState string
+
+const (
+	AnalysisBatchItemStateAnalyzing AnalysisBatchItemState = "analyzing"
+	AnalysisBatchItemStateCompleted AnalysisBatchItemState = "completed"
+	AnalysisBatchItemStateFailed    AnalysisBatchItemState = "failed"
+	AnalysisBatchItemStatePending   AnalysisBatchItemState = "pending"
+)
+
+func (i AnalysisBatchItem) State() AnalysisBatchItemState {
+	switch {
+	case i.Status == AnalysisBatchItemStatusPending:
+		return AnalysisBatchItemStatePending
+	case i.Status == AnalysisBatchItemStatusFailed:
+		return AnalysisBatchItemStateFailed
+	case i.Status == AnalysisBatchItemStatusCached:
+		return AnalysisBatchItemStateCompleted
+	case i.AnalysisStatus == nil:
+		return AnalysisBatchItemStateAnalyzing
+	case *i.AnalysisStatus == AnalysisStatusCompleted:
+		return AnalysisBatchItemStateCompleted
+	case *i.AnalysisStatus == AnalysisStatusFailed:
+		return AnalysisBatchItemStateFailed
+	default:
+		return AnalysisBatchItemStateAnalyzing
+	}
+}
+
+// AnalysisBatchProgress counts items per state.
+type AnalysisBatchProgress struct {
+	Analyzing int
+	Completed int
+	Failed    int
+	Pending   int
+}
+
+func (b *AnalysisBatch) Progress() AnalysisBatchProgress {
+	var p AnalysisBatchProgress
+	for _, item := range b.Items {
+		switch item.State() {
+		case AnalysisBatchItemStateAnalyzing:
+			p.Analyzing++
+		case AnalysisBatchItemStateCompleted:
+			p.Completed++
+		case AnalysisBatchItemStateFailed:
+			p.Failed++
+		case AnalysisBatchItemStatePending:
+			p.Pending++
+		}
+	}
+	return p
+}
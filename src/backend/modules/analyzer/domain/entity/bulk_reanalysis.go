@@ -0,0 +1,64 @@
+package entity
+
+import "time"
+
+type BulkReanalysisStatus string
+
+const (
+	BulkReanalysisStatusCompleted BulkReanalysisStatus = "completed"
+	BulkReanalysisStatusFailed    BulkReanalysisStatus = "failed"
+	BulkReanalysisStatusPending   BulkReanalysisStatus = "pending"
+	BulkReanalysisStatusRunning   BulkReanalysisStatus = "running"
+)
+
+func (s BulkReanalysisStatus) IsActive() bool {
+	return s == BulkReanalysisStatusPending || s == BulkReanalysisStatusRunning
+}
+
+func (s BulkReanalysisStatus) String() string {
+	return string(s)
+}
+
+type BulkReanalysisTrigger string
+
+const (
+	BulkReanalysisTriggerAuto   BulkReanalysisTrigger = "auto"
+	BulkReanalysisTriggerManual BulkReanalysisTrigger = "manual"
+)
+
+func (t BulkReanalysisTrigger) String() string {
+	return string(t)
+}
+
+// BulkReanalysisRun tracks a corpus-wide re-analysis after a parser upgrade.
+// LastCodebaseID is the keyset cursor that lets an interrupted run resume.
+type BulkReanalysisRun struct {
+	CompletedAt    *time.Time
+	CreatedAt      time.Time
+	EnqueuedCount  int
+	ErrorMessage   *string
+	FailedCount    int
+	ID             string
+	LastCodebaseID string
+	ParserVersion  string
+	StartedAt      *time.Time
+	Status         BulkReanalysisStatus
+	TotalCount     int
+	Trigger        BulkReanalysisTrigger
+	TriggeredBy    *string
+	UpdatedAt      time.Time
+}
+
+// ProcessedCount is the number of codebases handled so far, successfully or not.
+func (r *BulkReanalysisRun) ProcessedCount() int {
+	return r.EnqueuedCount + r.FailedCount
+}
+
+// OutdatedCodebase is a codebase whose latest completed analysis was produced
+// by a parser version other than the current one.
+type OutdatedCodebase struct {
+	CodebaseID string
+	CommitSHA  string
+	Owner      string
+	Repo       string
+}
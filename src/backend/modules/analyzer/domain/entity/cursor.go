@@ -3,9 +3,10 @@ package entity
 import "time"
 
 type RepositoryCursor struct {
-	AnalyzedAt time.Time
-	ID         string
-	Name       string
-	SortBy     SortBy
-	TestCount  int
+	AnalyzedAt    time.Time
+	ID            string
+	Name          string
+	SortBy        SortBy
+	TestCount     int
+	TrendingScore int
 }
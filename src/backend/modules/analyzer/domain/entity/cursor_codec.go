@@ -10,20 +10,22 @@ import (
 var ErrInvalidCursor = errors.New("invalid cursor format")
 
 type cursorPayload struct {
-	AnalyzedAt time.Time `json:"at,omitempty"`
-	ID         string    `json:"id"`
-	Name       string    `json:"n,omitempty"`
-	SortBy     string    `json:"sb"`
-	TestCount  int       `json:"tc,omitempty"`
+	AnalyzedAt    time.Time `json:"at,omitempty"`
+	ID            string    `json:"id"`
+	Name          string    `json:"n,omitempty"`
+	SortBy        string    `json:"sb"`
+	TestCount     int       `json:"tc,omitempty"`
+	TrendingScore int       `json:"ts,omitempty"`
 }
 
 func EncodeCursor(c RepositoryCursor) string {
 	payload := cursorPayload{
-		AnalyzedAt: c.AnalyzedAt,
-		ID:         c.ID,
-		Name:       c.Name,
-		SortBy:     string(c.SortBy),
-		TestCount:  c.TestCount,
+		AnalyzedAt:    c.AnalyzedAt,
+		ID:            c.ID,
+		Name:          c.Name,
+		SortBy:        string(c.SortBy),
+		TestCount:     c.TestCount,
+		TrendingScore: c.TrendingScore,
 	}
 	b, _ := json.Marshal(payload)
 	return base64.RawURLEncoding.EncodeToString(b)
@@ -51,10 +53,11 @@ func DecodeCursor(encoded string, expectedSortBy SortBy) (*RepositoryCursor, err
 	}
 
 	return &RepositoryCursor{
-		AnalyzedAt: payload.AnalyzedAt,
-		ID:         payload.ID,
-		Name:       payload.Name,
-		SortBy:     SortBy(payload.SortBy),
-		TestCount:  payload.TestCount,
+		AnalyzedAt:    payload.AnalyzedAt,
+		ID:            payload.ID,
+		Name:          payload.Name,
+		SortBy:        SortBy(payload.SortBy),
+		TestCount:     payload.TestCount,
+		TrendingScore: payload.TrendingScore,
 	}, nil
 }
@@ -131,6 +131,7 @@ func TestEncodeDecode_AllSortBy(t *testing.T) {
 		{"recent", entity.SortByRecent},
 		{"name", entity.SortByName},
 		{"tests", entity.SortByTests},
+		{"trending", entity.SortByTrending},
 	}
 
 	for _, tt := range tests {
@@ -157,3 +158,21 @@ func TestEncodeDecode_AllSortBy(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodeDecode_TrendingScore(t *testing.T) {
+	t.Parallel()
+
+	original := entity.RepositoryCursor{
+		ID:            "test-id",
+		SortBy:        entity.SortByTrending,
+		TrendingScore: 42,
+	}
+
+	decoded, err := entity.DecodeCursor(entity.EncodeCursor(original), entity.SortByTrending)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.TrendingScore != original.TrendingScore {
+		t.Errorf("TrendingScore mismatch: got %d, want %d", decoded.TrendingScore, original.TrendingScore)
+	}
+}
@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+// IngestedInventory is a test inventory parsed outside the service (e.g. in CI)
+// and uploaded to be stored as a completed analysis.
+type IngestedInventory struct {
+	Branch        *string
+	CommitSHA     string
+	CommittedAt   *time.Time
+	Files         []IngestedTestFile
+	ParserVersion string
+}
+
+type IngestedTestFile struct {
+	Framework string
+	Path      string
+	Suites    []IngestedTestSuite
+	// Tests are declared outside any suite.
+	Tests []IngestedTestCase
+}
+
+type IngestedTestSuite struct {
+	Line   int
+	Name   string
+	Suites []IngestedTestSuite
+	Tests  []IngestedTestCase
+}
+
+type IngestedTestCase struct {
+	Line     int
+	Modifier string
+	Name     string
+	Status   TestStatus
+	Tags     []string
+}
+
+// IngestedAnalysis is the completed analysis created from an upload.
+type IngestedAnalysis struct {
+	CommitSHA     string
+	CompletedAt   time.Time
+	ID            string
+	ParserVersion string
+	TotalSuites   int
+	TotalTests    int
+}
+
+// IngestRepository identifies the repository an upload is stored against.
+type IngestRepository struct {
+	ExternalRepoID string
+	IsPrivate      bool
+	Name           string
+	Owner          string
+}
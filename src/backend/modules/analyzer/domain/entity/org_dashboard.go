@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// OrgDashboard aggregates the latest completed analysis of every repository an
+// organization owns.
+type OrgDashboard struct {
+	AttentionRepositories []OrgRepositoryAttention
+	ChangeWindowStart     time.Time
+	Org                   string
+	RepositoryCount       int
+	StatusSummary         TestStatusSummary
+	TestCountChanges      []OrgTestCountChange
+	TotalTests            int
+}
+
+// OrgRepositoryAttention is a repository with skipped or focused tests, which
+// usually point at disabled coverage or a forgotten `.only`.
+type OrgRepositoryAttention struct {
+	AnalyzedAt   time.Time
+	FocusedCount int
+	Name         string
+	SkippedCount int
+	TotalTests   int
+}
+
+func (a OrgRepositoryAttention) Score() int {
+	return a.FocusedCount + a.SkippedCount
+}
+
+type OrgTestCountChange struct {
+	Change        int
+	CurrentTests  int
+	Name          string
+	PreviousTests int
+}
@@ -3,17 +3,23 @@ package entity
 type SortBy string
 
 const (
-	SortByName   SortBy = "name"
-	SortByRecent SortBy = "recent"
-	SortByTests  SortBy = "tests"
+	SortByName     SortBy = "name"
+	SortByRecent   SortBy = "recent"
+	SortByTests    SortBy = "tests"
+	SortByTrending SortBy = "trending"
 )
 
+// TrendingWindowDays is how many UTC days back views and analyses count towards SortByTrending.
+const TrendingWindowDays = 7
+
 func ParseSortBy(s string) SortBy {
 	switch s {
 	case "name":
 		return SortByName
 	case "tests":
 		return SortByTests
+	case "trending":
+		return SortByTrending
 	default:
 		return SortByRecent
 	}
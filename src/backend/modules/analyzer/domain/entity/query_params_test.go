@@ -10,6 +10,7 @@ func TestParseSortBy(t *testing.T) {
 		{"name", SortByName},
 		{"recent", SortByRecent},
 		{"tests", SortByTests},
+		{"trending", SortByTrending},
 		{"", SortByRecent},
 		{"invalid", SortByRecent},
 	}
@@ -75,6 +76,7 @@ func TestDefaultSortOrder(t *testing.T) {
 		{SortByName, SortOrderAsc},
 		{SortByRecent, SortOrderDesc},
 		{SortByTests, SortOrderDesc},
+		{SortByTrending, SortOrderDesc},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +31,14 @@ const (
 	TestStatusXfail   TestStatus = "xfail"
 )
 
+func (s TestStatus) IsValid() bool {
+	switch s {
+	case TestStatusActive, TestStatusFocused, TestStatusSkipped, TestStatusTodo, TestStatusXfail:
+		return true
+	}
+	return false
+}
+
 func (s TestStatus) String() string {
 	return string(s)
 }
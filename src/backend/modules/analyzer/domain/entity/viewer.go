@@ -0,0 +1,13 @@
+package entity
+
+// ViewerKey identifies who viewed a repository so each viewer counts towards
+// trending at most once a day: the user when signed in, otherwise the client IP.
+func ViewerKey(userID, clientIP string) string {
+	if userID != "" {
+		return "user:" + userID
+	}
+	if clientIP != "" {
+		return "ip:" + clientIP
+	}
+	return "ip:unknown"
+}
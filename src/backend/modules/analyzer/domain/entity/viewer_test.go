@@ -0,0 +1,24 @@
+package entity
+
+import "testing"
+
+func TestViewerKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   string
+		clientIP string
+		want     string
+	}{
+		{"signed in user", "u1", "203.0.113.7", "user:u1"},
+		{"anonymous", "", "203.0.113.7", "ip:203.0.113.7"},
+		{"unknown", "", "", "ip:unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ViewerKey(tt.userID, tt.clientIP); got != tt.want {
+				t.Errorf("ViewerKey(%q, %q) = %q, want %q", tt.userID, tt.clientIP, got, tt.want)
+			}
+		})
+	}
+}
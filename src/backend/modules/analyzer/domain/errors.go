@@ -8,10 +8,22 @@ import (
 )
 
 var (
+	ErrAnalysisAlreadyExists      = errors.New("analysis already exists for this commit and parser version")
+	ErrAnalysisBatchEmpty         = errors.New("analysis batch has no repositories")
+	ErrAnalysisBatchNotFound      = errors.New("analysis batch not found")
+	ErrAnalysisBatchTooLarge      = errors.New("analysis batch exceeds maximum size")
+	ErrBulkReanalysisInProgress   = errors.New("bulk re-analysis already in progress")
+	ErrBulkReanalysisNotFound     = errors.New("bulk re-analysis run not found")
+	ErrIngestNotPermitted         = errors.New("uploads require push access, or a private repository the service cannot clone")
+	ErrIngestTooLarge             = errors.New("test inventory exceeds maximum size")
 	ErrInvalidCursor              = entity.ErrInvalidCursor
 	ErrInvalidInput               = errors.New("invalid input")
 	ErrNotFound                   = errors.New("analysis not found")
+	ErrOrgAccessDenied            = errors.New("organization access denied")
+	ErrOrganizationNotFound       = errors.New("organization not found")
 	ErrParserVersionNotConfigured = errors.New("parser_version not configured in system_config")
+	ErrQuotaExceeded              = errors.New("quota exceeded")
+	ErrRepositoryAccessDenied     = errors.New("repository access denied")
 )
 
 func WrapNotFound(owner, repo string) error {
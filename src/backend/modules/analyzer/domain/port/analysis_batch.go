@@ -0,0 +1,46 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+type AnalysisBatchRepository interface {
+	// CreateBatch stores the batch and one pending item per repository atomically.
+	CreateBatch(ctx context.Context, params CreateAnalysisBatchParams) (*entity.AnalysisBatch, error)
+	// GetBatch returns the batch with its items and their current analysis status.
+	GetBatch(ctx context.Context, batchID string) (*entity.AnalysisBatch, error)
+	ListPendingItems(ctx context.Context, batchID string) ([]PendingAnalysisBatchItem, error)
+	MarkSubmitted(ctx context.Context, batchID string) error
+	UpdateItem(ctx context.Context, itemID string, status entity.AnalysisBatchItemStatus, commitSHA, errorMessage *string) error
+}
+
+type CreateAnalysisBatchParams struct {
+	OrgLogin     *string
+	Repositories []entity.RepositoryRef
+	SkippedCount int
+	UserID       string
+}
+
+type PendingAnalysisBatchItem struct {
+	ID    string
+	Owner string
+	Repo  string
+}
+
+type AnalysisBatchScheduler interface {
+	ScheduleBatch(ctx context.Context, batchID string) error
+}
+
+// OrgRepository is the subset of an organization repository the batch filters need.
+type OrgRepository struct {
+	Archived bool
+	Fork     bool
+	Name     string
+	Owner    string
+}
+
+type OrgRepositoryLister interface {
+	ListOrgRepositories(ctx context.Context, userID, orgLogin string) ([]OrgRepository, error)
+}
@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+type AnalysisIngestRepository interface {
+	// FindUserRepository returns owner/repo when it is among the user's synced
+	// GitHub repositories, or domain.ErrRepositoryAccessDenied otherwise.
+	FindUserRepository(ctx context.Context, userID, owner, repo string) (*entity.IngestRepository, error)
+	// StoreInventory stores the inventory as a completed analysis and adds it
+	// to the user's analysis history atomically. It returns
+	// domain.ErrAnalysisAlreadyExists when the commit was already ingested with
+	// the same parser version.
+	StoreInventory(ctx context.Context, userID string, repository *entity.IngestRepository, inventory *entity.IngestedInventory) (*entity.IngestedAnalysis, error)
+}
+
+// RepositoryAccessChecker decides who may upload an inventory for a repository.
+type RepositoryAccessChecker interface {
+	// CanPush reports whether the user has push or admin rights on the repository.
+	CanPush(ctx context.Context, userID, owner, repo string) (bool, error)
+	// CanClone reports whether the backend can clone the repository itself,
+	// without the user's credentials.
+	CanClone(ctx context.Context, owner, repo string) (bool, error)
+}
@@ -0,0 +1,41 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+)
+
+type BulkReanalysisRepository interface {
+	CompleteRun(ctx context.Context, runID string) error
+	CountOutdatedCodebases(ctx context.Context, parserVersion string) (int, error)
+	CreateRun(ctx context.Context, params CreateBulkReanalysisRunParams) (*entity.BulkReanalysisRun, error)
+	FailRun(ctx context.Context, runID, errorMessage string) error
+	// GetActiveRun returns nil when no run is pending or running.
+	GetActiveRun(ctx context.Context) (*entity.BulkReanalysisRun, error)
+	// GetLatestRun returns nil when no run has ever been created.
+	GetLatestRun(ctx context.Context) (*entity.BulkReanalysisRun, error)
+	GetRun(ctx context.Context, runID string) (*entity.BulkReanalysisRun, error)
+	ListOutdatedCodebases(ctx context.Context, parserVersion, afterCodebaseID string, limit int) ([]entity.OutdatedCodebase, error)
+	ListRuns(ctx context.Context, limit int) ([]*entity.BulkReanalysisRun, error)
+	MarkRunStarted(ctx context.Context, runID string) error
+	UpdateRunProgress(ctx context.Context, runID string, enqueued, failed int, lastCodebaseID string) error
+}
+
+type CreateBulkReanalysisRunParams struct {
+	ParserVersion string
+	TotalCount    int
+	Trigger       entity.BulkReanalysisTrigger
+	TriggeredBy   *string
+}
+
+// BulkReanalysisScheduler hands a created run over to a background worker.
+type BulkReanalysisScheduler interface {
+	ScheduleRun(ctx context.Context, runID string) error
+}
+
+// ScheduledQueueService enqueues system-initiated analyses on the scheduled queue,
+// keeping them out of the way of user-triggered work.
+type ScheduledQueueService interface {
+	EnqueueScheduled(ctx context.Context, owner, repo, commitSHA string) error
+}
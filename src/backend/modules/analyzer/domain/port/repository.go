@@ -16,11 +16,15 @@ type Repository interface {
 	GetCodebaseID(ctx context.Context, owner, repo string) (string, error)
 	GetCompletedAnalysisByCommitSHA(ctx context.Context, owner, repo, commitSHA string) (*CompletedAnalysis, error)
 	GetLatestCompletedAnalysis(ctx context.Context, owner, repo string) (*CompletedAnalysis, error)
+	// GetOrgRepositoryTestSummaries returns the latest completed analysis of every
+	// repository owned by owner, with the test count at since as a baseline.
+	GetOrgRepositoryTestSummaries(ctx context.Context, owner string, since time.Time) ([]OrgRepositoryTestSummary, error)
 	GetPaginatedRepositories(ctx context.Context, params PaginationParams) ([]PaginatedRepository, error)
 	GetPreviousAnalysis(ctx context.Context, codebaseID, currentAnalysisID string) (*PreviousAnalysis, error)
 	GetRepositoryStats(ctx context.Context, userID string) (*entity.RepositoryStats, error)
 	GetTestSuitesWithCases(ctx context.Context, analysisID string) ([]TestSuiteWithCases, error)
-	UpdateLastViewed(ctx context.Context, owner, repo string) error
+	// UpdateLastViewed counts at most one view per viewer (see entity.ViewerKey) per UTC day.
+	UpdateLastViewed(ctx context.Context, owner, repo, viewer string) error
 }
 
 type PaginationParams struct {
@@ -29,8 +33,10 @@ type PaginationParams struct {
 	Ownership entity.OwnershipFilter
 	SortBy    entity.SortBy
 	SortOrder entity.SortOrder
-	UserID    string
-	View      entity.ViewFilter
+	// TrendingDays bounds the activity counted by SortByTrending, in UTC days.
+	TrendingDays int
+	UserID       string
+	View         entity.ViewFilter
 }
 
 type PaginatedRepository struct {
@@ -46,6 +52,7 @@ type PaginatedRepository struct {
 	SkippedCount   int
 	TodoCount      int
 	TotalTests     int
+	TrendingScore  int
 	XfailCount     int
 }
 
@@ -97,10 +104,22 @@ type AnalysisHistoryItem struct {
 	TotalTests  int
 }
 
+type OrgRepositoryTestSummary struct {
+	AnalyzedAt         time.Time
+	BaselineTotalTests *int
+	Name               string
+	StatusSummary      entity.TestStatusSummary
+	TotalTests         int
+}
+
 type HistoryChecker interface {
 	CheckUserHistoryExists(ctx context.Context, userID, owner, repo string) (bool, error)
 }
 
+type OrgAccessChecker interface {
+	HasOrgAccess(ctx context.Context, userID, orgLogin string) (bool, error)
+}
+
 type TierLookup interface {
 	GetUserTier(ctx context.Context, userID string) (string, error)
 }
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+// AdminHandler serves operator-only endpoints. Access is limited to the user IDs
+// configured via ADMIN_USER_IDS.
+type AdminHandler struct {
+	adminUserIDs           map[string]struct{}
+	getBulkReanalysisRun   *usecase.GetBulkReanalysisRunUseCase
+	listBulkReanalysisRuns *usecase.ListBulkReanalysisRunsUseCase
+	logger                 *logger.Logger
+	startBulkReanalysis    *usecase.StartBulkReanalysisUseCase
+}
+
+type AdminHandlerConfig struct {
+	AdminUserIDs           []string
+	GetBulkReanalysisRun   *usecase.GetBulkReanalysisRunUseCase
+	ListBulkReanalysisRuns *usecase.ListBulkReanalysisRunsUseCase
+	Logger                 *logger.Logger
+	StartBulkReanalysis    *usecase.StartBulkReanalysisUseCase
+}
+
+var _ api.AdminHandlers = (*AdminHandler)(nil)
+
+func NewAdminHandler(cfg *AdminHandlerConfig) (*AdminHandler, error) {
+	if cfg == nil {
+		return nil, errors.New("handler config is required")
+	}
+	if cfg.GetBulkReanalysisRun == nil {
+		return nil, errors.New("GetBulkReanalysisRun usecase is required")
+	}
+	if cfg.ListBulkReanalysisRuns == nil {
+		return nil, errors.New("ListBulkReanalysisRuns usecase is required")
+	}
+	if cfg.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+	if cfg.StartBulkReanalysis == nil {
+		return nil, errors.New("StartBulkReanalysis usecase is required")
+	}
+
+	adminUserIDs := make(map[string]struct{}, len(cfg.AdminUserIDs))
+	for _, id := range cfg.AdminUserIDs {
+		adminUserIDs[id] = struct{}{}
+	}
+
+	return &AdminHandler{
+		adminUserIDs:           adminUserIDs,
+		getBulkReanalysisRun:   cfg.GetBulkReanalysisRun,
+		listBulkReanalysisRuns: cfg.ListBulkReanalysisRuns,
+		logger:                 cfg.Logger,
+		startBulkReanalysis:    cfg.StartBulkReanalysis,
+	}, nil
+}
+
+func (h *AdminHandler) GetBulkReanalysisRun(ctx context.Context, request api.GetBulkReanalysisRunRequestObject) (api.GetBulkReanalysisRunResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.GetBulkReanalysisRun401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+	if !h.isAdmin(userID) {
+		return api.GetBulkReanalysisRun403ApplicationProblemPlusJSONResponse{
+			ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("admin access required"),
+		}, nil
+	}
+
+	run, err := h.getBulkReanalysisRun.Execute(ctx, usecase.GetBulkReanalysisRunInput{
+		RunID: request.RunID.String(),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrBulkReanalysisNotFound) {
+			return api.GetBulkReanalysisRun404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("bulk re-analysis run not found"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to get bulk re-analysis run", "run_id", request.RunID.String(), "error", err)
+		return api.GetBulkReanalysisRun500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get bulk re-analysis run"),
+		}, nil
+	}
+
+	response, err := mapper.ToBulkReanalysisRun(run)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map bulk re-analysis run", "run_id", run.ID, "error", err)
+		return api.GetBulkReanalysisRun500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get bulk re-analysis run"),
+		}, nil
+	}
+
+	return api.GetBulkReanalysisRun200JSONResponse(response), nil
+}
+
+func (h *AdminHandler) ListBulkReanalysisRuns(ctx context.Context, request api.ListBulkReanalysisRunsRequestObject) (api.ListBulkReanalysisRunsResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.ListBulkReanalysisRuns401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+	if !h.isAdmin(userID) {
+		return api.ListBulkReanalysisRuns403ApplicationProblemPlusJSONResponse{
+			ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("admin access required"),
+		}, nil
+	}
+
+	var limit int
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	runs, err := h.listBulkReanalysisRuns.Execute(ctx, usecase.ListBulkReanalysisRunsInput{Limit: limit})
+	if err != nil {
+		h.logger.Error(ctx, "failed to list bulk re-analysis runs", "error", err)
+		return api.ListBulkReanalysisRuns500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list bulk re-analysis runs"),
+		}, nil
+	}
+
+	response, err := mapper.ToBulkReanalysisRunsResponse(runs)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map bulk re-analysis runs", "error", err)
+		return api.ListBulkReanalysisRuns500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list bulk re-analysis runs"),
+		}, nil
+	}
+
+	return api.ListBulkReanalysisRuns200JSONResponse(response), nil
+}
+
+func (h *AdminHandler) StartBulkReanalysis(ctx context.Context, _ api.StartBulkReanalysisRequestObject) (api.StartBulkReanalysisResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.StartBulkReanalysis401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+	if !h.isAdmin(userID) {
+		return api.StartBulkReanalysis403ApplicationProblemPlusJSONResponse{
+			ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("admin access required"),
+		}, nil
+	}
+
+	run, err := h.startBulkReanalysis.Execute(ctx, usecase.StartBulkReanalysisInput{
+		Trigger:     entity.BulkReanalysisTriggerManual,
+		TriggeredBy: &userID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrBulkReanalysisInProgress) {
+			return api.StartBulkReanalysis409ApplicationProblemPlusJSONResponse{
+				ConflictApplicationProblemPlusJSONResponse: api.NewConflict("a bulk re-analysis run is already in progress"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to start bulk re-analysis", "user_id", userID, "error", err)
+		return api.StartBulkReanalysis500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to start bulk re-analysis"),
+		}, nil
+	}
+
+	h.logger.Info(ctx, "bulk re-analysis started",
+		"run_id", run.ID, "user_id", userID, "parser_version", run.ParserVersion, "total", run.TotalCount)
+
+	response, err := mapper.ToBulkReanalysisRun(run)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map bulk re-analysis run", "run_id", run.ID, "error", err)
+		return api.StartBulkReanalysis500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to start bulk re-analysis"),
+		}, nil
+	}
+
+	return api.StartBulkReanalysis202JSONResponse(response), nil
+}
+
+func (h *AdminHandler) isAdmin(userID string) bool {
+	_, ok := h.adminUserIDs[userID]
+	return ok
+}
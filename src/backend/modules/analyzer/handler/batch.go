@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+	subscriptiondomain "github.com/specvital/web/src/backend/modules/subscription/domain"
+)
+
+// BatchHandler serves batch analysis of many repositories, either every
+// repository of an organization or an explicit list.
+type BatchHandler struct {
+	getAnalysisBatch   *usecase.GetAnalysisBatchUseCase
+	logger             *logger.Logger
+	startAnalysisBatch *usecase.StartAnalysisBatchUseCase
+}
+
+type BatchHandlerConfig struct {
+	GetAnalysisBatch   *usecase.GetAnalysisBatchUseCase
+	Logger             *logger.Logger
+	StartAnalysisBatch *usecase.StartAnalysisBatchUseCase
+}
+
+var _ api.AnalysisBatchHandlers = (*BatchHandler)(nil)
+
+func NewBatchHandler(cfg *BatchHandlerConfig) (*BatchHandler, error) {
+	if cfg == nil {
+		return nil, errors.New("handler config is required")
+	}
+	if cfg.GetAnalysisBatch == nil {
+		return nil, errors.New("GetAnalysisBatch usecase is required")
+	}
+	if cfg.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+	if cfg.StartAnalysisBatch == nil {
+		return nil, errors.New("StartAnalysisBatch usecase is required")
+	}
+
+	return &BatchHandler{
+		getAnalysisBatch:   cfg.GetAnalysisBatch,
+		logger:             cfg.Logger,
+		startAnalysisBatch: cfg.StartAnalysisBatch,
+	}, nil
+}
+
+func (h *BatchHandler) GetAnalysisBatch(ctx context.Context, request api.GetAnalysisBatchRequestObject) (api.GetAnalysisBatchResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.GetAnalysisBatch401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+
+	batch, err := h.getAnalysisBatch.Execute(ctx, usecase.GetAnalysisBatchInput{
+		BatchID: request.BatchID.String(),
+		UserID:  userID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrAnalysisBatchNotFound) {
+			return api.GetAnalysisBatch404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("analysis batch not found"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to get analysis batch", "batch_id", request.BatchID.String(), "error", err)
+		return api.GetAnalysisBatch500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get analysis batch"),
+		}, nil
+	}
+
+	response, err := mapper.ToAnalysisBatch(batch)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map analysis batch", "batch_id", batch.ID, "error", err)
+		return api.GetAnalysisBatch500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get analysis batch"),
+		}, nil
+	}
+
+	return api.GetAnalysisBatch200JSONResponse(response), nil
+}
+
+func (h *BatchHandler) StartAnalysisBatch(ctx context.Context, request api.StartAnalysisBatchRequestObject) (api.StartAnalysisBatchResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.StartAnalysisBatch401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+
+	input, err := toStartAnalysisBatchInput(userID, request.Body)
+	if err != nil {
+		return api.StartAnalysisBatch400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest(err.Error()),
+		}, nil
+	}
+
+	batch, err := h.startAnalysisBatch.Execute(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput),
+			errors.Is(err, domain.ErrAnalysisBatchEmpty),
+			errors.Is(err, domain.ErrAnalysisBatchTooLarge):
+			return api.StartAnalysisBatch400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest(err.Error()),
+			}, nil
+		case errors.Is(err, domain.ErrOrganizationNotFound):
+			return api.StartAnalysisBatch404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("organization not found"),
+			}, nil
+		case errors.Is(err, subscriptiondomain.ErrNoActiveSubscription):
+			return api.StartAnalysisBatch403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("an active subscription is required"),
+			}, nil
+		case errors.Is(err, domain.ErrQuotaExceeded):
+			return api.StartAnalysisBatch429ApplicationProblemPlusJSONResponse{
+				TooManyRequestsApplicationProblemPlusJSONResponse: api.TooManyRequestsApplicationProblemPlusJSONResponse{
+					Detail: "Monthly analysis quota is not sufficient for this batch.",
+					Status: 429,
+					Title:  "Quota Exceeded",
+				},
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to start analysis batch", "user_id", userID, "error", err)
+		return api.StartAnalysisBatch500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to start analysis batch"),
+		}, nil
+	}
+
+	h.logger.Info(ctx, "analysis batch started",
+		"batch_id", batch.ID, "user_id", userID, "total", batch.TotalCount, "skipped", batch.SkippedCount)
+
+	response, err := mapper.ToAnalysisBatch(batch)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map analysis batch", "batch_id", batch.ID, "error", err)
+		return api.StartAnalysisBatch500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to start analysis batch"),
+		}, nil
+	}
+
+	return api.StartAnalysisBatch202JSONResponse(response), nil
+}
+
+func toStartAnalysisBatchInput(userID string, body *api.StartAnalysisBatchJSONRequestBody) (usecase.StartAnalysisBatchInput, error) {
+	input := usecase.StartAnalysisBatchInput{UserID: userID}
+	if body == nil {
+		return input, errors.New("request body is required")
+	}
+
+	if body.Org != nil && *body.Org != "" {
+		if !validNamePattern.MatchString(*body.Org) {
+			return input, errors.New("invalid org format")
+		}
+		input.OrgLogin = *body.Org
+	}
+	if body.ExcludeArchived != nil {
+		input.ExcludeArchived = *body.ExcludeArchived
+	}
+	if body.ExcludeForks != nil {
+		input.ExcludeForks = *body.ExcludeForks
+	}
+
+	if body.Repositories != nil {
+		refs := *body.Repositories
+		if len(refs) > usecase.MaxAnalysisBatchSize {
+			return input, fmt.Errorf("too many repositories (max %d)", usecase.MaxAnalysisBatchSize)
+		}
+		input.Repositories = make([]entity.RepositoryRef, len(refs))
+		for i, ref := range refs {
+			if err := validateOwnerRepo(ref.Owner, ref.Repo); err != nil {
+				return input, fmt.Errorf("repositories[%d]: %w", i, err)
+			}
+			input.Repositories[i] = entity.RepositoryRef{Owner: ref.Owner, Repo: ref.Repo}
+		}
+	}
+
+	return input, nil
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"regexp"
 
@@ -10,7 +11,6 @@ import (
 
 	"github.com/specvital/web/src/backend/common/logger"
 	"github.com/specvital/web/src/backend/common/middleware"
-	"github.com/specvital/web/src/backend/common/ratelimit"
 	"github.com/specvital/web/src/backend/internal/api"
 	"github.com/specvital/web/src/backend/internal/client"
 	"github.com/specvital/web/src/backend/modules/analyzer/adapter/mapper"
@@ -24,17 +24,16 @@ import (
 var validNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
 
 type Handler struct {
-	analyzeRepository    *usecase.AnalyzeRepositoryUseCase
-	anonymousRateLimiter *ratelimit.IPRateLimiter
-	getAnalysis          *usecase.GetAnalysisUseCase
-	getAnalysisHistory   *usecase.GetAnalysisHistoryUseCase
-	getRepositoryStats   *usecase.GetRepositoryStatsUseCase
-	getUpdateStatus      *usecase.GetUpdateStatusUseCase
-	historyChecker       port.HistoryChecker
-	listRepositoryCards  *usecase.ListRepositoryCardsUseCase
-	logger               *logger.Logger
-	reanalyzeRepository  *usecase.ReanalyzeRepositoryUseCase
-	tierLookup           port.TierLookup
+	analyzeRepository   *usecase.AnalyzeRepositoryUseCase
+	getAnalysis         *usecase.GetAnalysisUseCase
+	getAnalysisHistory  *usecase.GetAnalysisHistoryUseCase
+	getRepositoryStats  *usecase.GetRepositoryStatsUseCase
+	getUpdateStatus     *usecase.GetUpdateStatusUseCase
+	historyChecker      port.HistoryChecker
+	listRepositoryCards *usecase.ListRepositoryCardsUseCase
+	logger              *logger.Logger
+	reanalyzeRepository *usecase.ReanalyzeRepositoryUseCase
+	tierLookup          port.TierLookup
 }
 
 var _ api.AnalyzerHandlers = (*Handler)(nil)
@@ -50,21 +49,19 @@ func NewHandler(
 	getRepositoryStats *usecase.GetRepositoryStatsUseCase,
 	reanalyzeRepository *usecase.ReanalyzeRepositoryUseCase,
 	historyChecker port.HistoryChecker,
-	anonymousRateLimiter *ratelimit.IPRateLimiter,
 	tierLookup port.TierLookup,
 ) *Handler {
 	return &Handler{
-		analyzeRepository:    analyzeRepository,
-		anonymousRateLimiter: anonymousRateLimiter,
-		getAnalysis:          getAnalysis,
-		getAnalysisHistory:   getAnalysisHistory,
-		getRepositoryStats:   getRepositoryStats,
-		getUpdateStatus:      getUpdateStatus,
-		historyChecker:       historyChecker,
-		listRepositoryCards:  listRepositoryCards,
-		logger:               logger,
-		reanalyzeRepository:  reanalyzeRepository,
-		tierLookup:           tierLookup,
+		analyzeRepository:   analyzeRepository,
+		getAnalysis:         getAnalysis,
+		getAnalysisHistory:  getAnalysisHistory,
+		getRepositoryStats:  getRepositoryStats,
+		getUpdateStatus:     getUpdateStatus,
+		historyChecker:      historyChecker,
+		listRepositoryCards: listRepositoryCards,
+		logger:              logger,
+		reanalyzeRepository: reanalyzeRepository,
+		tierLookup:          tierLookup,
 	}
 }
 
@@ -90,30 +87,14 @@ func (h *Handler) AnalyzeRepository(ctx context.Context, request api.AnalyzeRepo
 		return h.analyzeRepositoryByCommit(ctx, owner, repo, *request.Params.Commit, userID, log)
 	}
 
-	if userID == "" && h.anonymousRateLimiter != nil {
-		clientIP := middleware.GetClientIP(ctx)
-		if clientIP == "" {
-			clientIP = "unknown"
-		}
-		if !h.anonymousRateLimiter.Allow(clientIP) {
-			log.Warn(ctx, "rate limit exceeded for anonymous user", "client_ip", clientIP)
-			return api.AnalyzeRepository429ApplicationProblemPlusJSONResponse{
-				TooManyRequestsApplicationProblemPlusJSONResponse: api.TooManyRequestsApplicationProblemPlusJSONResponse{
-					Detail: "Rate limit exceeded. Please sign in for higher limits or try again later.",
-					Status: 429,
-					Title:  "Too Many Requests",
-				},
-			}, nil
-		}
-	}
-
 	tier := h.lookupUserTier(ctx, log, userID)
 
 	result, err := h.analyzeRepository.Execute(ctx, usecase.AnalyzeRepositoryInput{
-		Owner:  owner,
-		Repo:   repo,
-		Tier:   tier,
-		UserID: userID,
+		ClientIP: middleware.GetClientIP(ctx),
+		Owner:    owner,
+		Repo:     repo,
+		Tier:     tier,
+		UserID:   userID,
 	})
 	if err != nil {
 		if errors.Is(err, client.ErrRepoNotFound) {
@@ -171,9 +152,11 @@ func (h *Handler) AnalyzeRepository(ctx context.Context, request api.AnalyzeRepo
 
 func (h *Handler) analyzeRepositoryByCommit(ctx context.Context, owner, repo, commitSHA, userID string, log *logger.Logger) (api.AnalyzeRepositoryResponseObject, error) {
 	result, err := h.getAnalysis.Execute(ctx, usecase.GetAnalysisInput{
+		ClientIP:  middleware.GetClientIP(ctx),
 		CommitSHA: commitSHA,
 		Owner:     owner,
 		Repo:      repo,
+		UserID:    userID,
 	})
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
@@ -211,6 +194,34 @@ func (h *Handler) analyzeRepositoryByCommit(ctx context.Context, owner, repo, co
 	return api.AnalyzeRepository200JSONResponse(completed), nil
 }
 
+// AnalyzeRepositoryValidator lets a cached analysis for a pinned commit be
+// revalidated without loading it. See api.ValidatorFunc.
+func (h *Handler) AnalyzeRepositoryValidator(ctx context.Context, request any) (string, bool, error) {
+	req, ok := request.(api.AnalyzeRepositoryRequestObject)
+	if !ok || req.Params.Commit == nil {
+		return "", false, nil
+	}
+	if validateOwnerRepo(req.Owner, req.Repo) != nil || validateCommitSHA(*req.Params.Commit) != nil {
+		return "", false, nil
+	}
+
+	validator, ok, err := h.getAnalysis.Validator(ctx, usecase.GetAnalysisInput{
+		CommitSHA: *req.Params.Commit,
+		Owner:     req.Owner,
+		Repo:      req.Repo,
+	})
+	if err != nil || !ok {
+		return "", false, err
+	}
+
+	// The response also tells the viewer whether the repository is in their history
+	opts := h.buildHistoryOptions(ctx, middleware.GetUserID(ctx), req.Owner, req.Repo)
+	if opts.IsInMyHistory != nil {
+		validator = fmt.Sprintf("%s:%t", validator, *opts.IsInMyHistory)
+	}
+	return validator, true, nil
+}
+
 func (h *Handler) GetAnalysisHistory(ctx context.Context, request api.GetAnalysisHistoryRequestObject) (api.GetAnalysisHistoryResponseObject, error) {
 	owner, repo := request.Owner, request.Repo
 	log := h.logger.With("owner", owner, "repo", repo)
@@ -285,8 +296,10 @@ func (h *Handler) GetAnalysisStatus(ctx context.Context, request api.GetAnalysis
 	}
 
 	result, err := h.getAnalysis.Execute(ctx, usecase.GetAnalysisInput{
-		Owner: owner,
-		Repo:  repo,
+		ClientIP: middleware.GetClientIP(ctx),
+		Owner:    owner,
+		Repo:     repo,
+		UserID:   userID,
 	})
 	if err != nil {
 		if errors.Is(err, domain.ErrNotFound) {
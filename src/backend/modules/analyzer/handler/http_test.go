@@ -100,7 +100,7 @@ func TestGetRecentRepositories_DefaultParams(t *testing.T) {
 	log := newTestLogger()
 	listUC := usecase.NewListRepositoryCardsUseCase(&mockGitClient{}, mock, &mockTokenProvider{})
 	getHistoryUC := usecase.NewGetAnalysisHistoryUseCase(mock)
-	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil, nil)
+	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil)
 
 	req := api.GetRecentRepositoriesRequestObject{
 		Params: api.GetRecentRepositoriesParams{},
@@ -143,7 +143,7 @@ func TestGetRecentRepositories_WithPaginationParams(t *testing.T) {
 	log := newTestLogger()
 	listUC := usecase.NewListRepositoryCardsUseCase(&mockGitClient{}, mock, &mockTokenProvider{})
 	getHistoryUC := usecase.NewGetAnalysisHistoryUseCase(mock)
-	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil, nil)
+	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil)
 
 	limit := 20
 
@@ -183,7 +183,7 @@ func TestGetRecentRepositories_InvalidCursor(t *testing.T) {
 	log := newTestLogger()
 	listUC := usecase.NewListRepositoryCardsUseCase(&mockGitClient{}, mock, &mockTokenProvider{})
 	getHistoryUC := usecase.NewGetAnalysisHistoryUseCase(mock)
-	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil, nil)
+	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil)
 
 	invalidCursor := "invalid-cursor-data"
 	req := api.GetRecentRepositoriesRequestObject{
@@ -209,7 +209,7 @@ func TestGetRecentRepositories_SortByMismatch_RestartsFromBeginning(t *testing.T
 	log := newTestLogger()
 	listUC := usecase.NewListRepositoryCardsUseCase(&mockGitClient{}, mock, &mockTokenProvider{})
 	getHistoryUC := usecase.NewGetAnalysisHistoryUseCase(mock)
-	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil, nil)
+	h := NewHandler(log, nil, nil, getHistoryUC, listUC, nil, nil, nil, nil, nil)
 
 	cursor := entity.EncodeCursor(entity.RepositoryCursor{
 		ID:         "c1",
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+// IngestHandler accepts test inventories parsed in CI for repositories the
+// worker cannot clone.
+type IngestHandler struct {
+	ingestAnalysis *usecase.IngestAnalysisUseCase
+	logger         *logger.Logger
+}
+
+type IngestHandlerConfig struct {
+	IngestAnalysis *usecase.IngestAnalysisUseCase
+	Logger         *logger.Logger
+}
+
+var _ api.AnalysisIngestHandlers = (*IngestHandler)(nil)
+
+func NewIngestHandler(cfg *IngestHandlerConfig) (*IngestHandler, error) {
+	if cfg == nil {
+		return nil, errors.New("handler config is required")
+	}
+	if cfg.IngestAnalysis == nil {
+		return nil, errors.New("IngestAnalysis usecase is required")
+	}
+	if cfg.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+
+	return &IngestHandler{
+		ingestAnalysis: cfg.IngestAnalysis,
+		logger:         cfg.Logger,
+	}, nil
+}
+
+func (h *IngestHandler) IngestAnalysis(ctx context.Context, request api.IngestAnalysisRequestObject) (api.IngestAnalysisResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.IngestAnalysis401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+
+	owner, repo := request.Owner, request.Repo
+	if err := validateOwnerRepo(owner, repo); err != nil {
+		return api.IngestAnalysis400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest(err.Error()),
+		}, nil
+	}
+	if request.Body == nil {
+		return api.IngestAnalysis400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	log := h.logger.With("owner", owner, "repo", repo, "user_id", userID)
+
+	analysis, err := h.ingestAnalysis.Execute(ctx, usecase.IngestAnalysisInput{
+		Inventory: mapper.ToIngestedInventory(request.Body),
+		Owner:     owner,
+		Repo:      repo,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return api.IngestAnalysis400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest(err.Error()),
+			}, nil
+		case errors.Is(err, domain.ErrIngestTooLarge):
+			return api.IngestAnalysis413ApplicationProblemPlusJSONResponse{
+				PayloadTooLargeApplicationProblemPlusJSONResponse: api.NewPayloadTooLarge(err.Error()),
+			}, nil
+		case errors.Is(err, domain.ErrRepositoryAccessDenied):
+			return api.IngestAnalysis403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("repository is not among your synced GitHub repositories; refresh your repository list and retry"),
+			}, nil
+		case errors.Is(err, domain.ErrIngestNotPermitted):
+			return api.IngestAnalysis403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden(err.Error()),
+			}, nil
+		case errors.Is(err, domain.ErrAnalysisAlreadyExists):
+			return api.IngestAnalysis409ApplicationProblemPlusJSONResponse{
+				ConflictApplicationProblemPlusJSONResponse: api.NewConflict(err.Error()),
+			}, nil
+		}
+		log.Error(ctx, "failed to ingest analysis", "error", err)
+		return api.IngestAnalysis500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to store analysis"),
+		}, nil
+	}
+
+	log.Info(ctx, "analysis ingested",
+		"analysis_id", analysis.ID, "commit", analysis.CommitSHA, "tests", analysis.TotalTests)
+
+	response, err := mapper.ToIngestAnalysisResponse(analysis)
+	if err != nil {
+		log.Error(ctx, "failed to map ingested analysis", "analysis_id", analysis.ID, "error", err)
+		return api.IngestAnalysis500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to store analysis"),
+		}, nil
+	}
+
+	return api.IngestAnalysis201JSONResponse(response), nil
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/analyzer/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+type OrgDashboardHandler struct {
+	getOrgDashboard *usecase.GetOrgDashboardUseCase
+	logger          *logger.Logger
+}
+
+type OrgDashboardHandlerConfig struct {
+	GetOrgDashboard *usecase.GetOrgDashboardUseCase
+	Logger          *logger.Logger
+}
+
+var _ api.OrgDashboardHandlers = (*OrgDashboardHandler)(nil)
+
+func NewOrgDashboardHandler(cfg *OrgDashboardHandlerConfig) (*OrgDashboardHandler, error) {
+	if cfg == nil {
+		return nil, errors.New("handler config is required")
+	}
+	if cfg.GetOrgDashboard == nil {
+		return nil, errors.New("GetOrgDashboard usecase is required")
+	}
+	if cfg.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+
+	return &OrgDashboardHandler{
+		getOrgDashboard: cfg.GetOrgDashboard,
+		logger:          cfg.Logger,
+	}, nil
+}
+
+func (h *OrgDashboardHandler) GetOrgDashboard(ctx context.Context, request api.GetOrgDashboardRequestObject) (api.GetOrgDashboardResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.GetOrgDashboard401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+
+	if !validNamePattern.MatchString(request.Org) {
+		return api.GetOrgDashboard400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid org format"),
+		}, nil
+	}
+
+	dashboard, err := h.getOrgDashboard.Execute(ctx, usecase.GetOrgDashboardInput{
+		OrgLogin: request.Org,
+		UserID:   userID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrOrgAccessDenied) {
+			return api.GetOrgDashboard403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("organization membership required"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to get org dashboard", "org", request.Org, "error", err)
+		return api.GetOrgDashboard500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get organization dashboard"),
+		}, nil
+	}
+
+	return api.GetOrgDashboard200JSONResponse(mapper.ToOrgDashboard(dashboard)), nil
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/metrics"
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/common/ratelimit"
+	"github.com/specvital/web/src/backend/internal/api"
+)
+
+// AnonymousAnalyzeRateLimit limits AnalyzeRepository calls from signed-out
+// clients per IP. It runs as strict middleware so RateLimit-* headers are
+// written on every limited response, not only on rejection.
+// Commit-specific lookups only read existing results and are not limited.
+func AnonymousAnalyzeRateLimit(limiter middleware.RateLimiter, log *logger.Logger) api.StrictMiddlewareFunc {
+	return func(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+		if operationID != "AnalyzeRepository" {
+			return f
+		}
+
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+			req, ok := request.(api.AnalyzeRepositoryRequestObject)
+			if !ok || req.Params.Commit != nil || middleware.GetUserID(ctx) != "" {
+				return f(ctx, w, r, request)
+			}
+
+			clientIP := middleware.GetClientIP(ctx)
+			if clientIP == "" {
+				clientIP = "unknown"
+			}
+
+			decision, err := limiter.Allow(ctx, clientIP)
+			if err != nil {
+				log.Warn(ctx, "rate limiter unavailable, allowing request", "error", err)
+				return f(ctx, w, r, request)
+			}
+
+			ratelimit.SetHeaders(w.Header(), decision)
+			if !decision.Allowed {
+				metrics.RecordRateLimitRejection("anonymous_analyze")
+				log.Warn(ctx, "rate limit exceeded for anonymous user",
+					"owner", req.Owner, "repo", req.Repo, "client_ip", clientIP)
+				return api.AnalyzeRepository429ApplicationProblemPlusJSONResponse{
+					TooManyRequestsApplicationProblemPlusJSONResponse: api.TooManyRequestsApplicationProblemPlusJSONResponse{
+						Detail: "Rate limit exceeded. Please sign in for higher limits or try again later.",
+						Status: 429,
+						Title:  "Too Many Requests",
+					},
+				}, nil
+			}
+
+			return f(ctx, w, r, request)
+		}
+	}
+}
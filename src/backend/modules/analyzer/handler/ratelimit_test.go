@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/common/ratelimit"
+	"github.com/specvital/web/src/backend/internal/api"
+	authentity "github.com/specvital/web/src/backend/modules/auth/domain/entity"
+)
+
+func TestAnonymousAnalyzeRateLimit(t *testing.T) {
+	store := ratelimit.NewMemoryStore(time.Minute)
+	defer func() { _ = store.Close() }()
+
+	next := func(context.Context, http.ResponseWriter, *http.Request, any) (any, error) {
+		return "ok", nil
+	}
+	call := func(ctx context.Context, limiter *ratelimit.Limiter, operationID string, request any) (any, *httptest.ResponseRecorder) {
+		f := AnonymousAnalyzeRateLimit(limiter, newTestLogger())(next, operationID)
+		rec := httptest.NewRecorder()
+		resp, err := f(ctx, rec, httptest.NewRequest(http.MethodPost, "/", nil), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return resp, rec
+	}
+	analyzeRequest := api.AnalyzeRepositoryRequestObject{Owner: "owner", Repo: "repo"}
+
+	t.Run("limits anonymous requests and sets headers", func(t *testing.T) {
+		limiter := ratelimit.NewLimiter(store, "anon", 1, time.Minute)
+
+		resp, rec := call(context.Background(), limiter, "AnalyzeRepository", analyzeRequest)
+		if resp != "ok" {
+			t.Fatalf("expected first request to pass, got %v", resp)
+		}
+		if got := rec.Header().Get("RateLimit-Remaining"); got != "0" {
+			t.Errorf("expected RateLimit-Remaining 0, got %q", got)
+		}
+
+		resp, rec = call(context.Background(), limiter, "AnalyzeRepository", analyzeRequest)
+		if _, ok := resp.(api.AnalyzeRepository429ApplicationProblemPlusJSONResponse); !ok {
+			t.Fatalf("expected 429 response, got %T", resp)
+		}
+		if rec.Header().Get("Retry-After") == "" {
+			t.Error("expected Retry-After header")
+		}
+	})
+
+	t.Run("skips authenticated users", func(t *testing.T) {
+		limiter := ratelimit.NewLimiter(store, "auth-user", 0, time.Minute)
+		ctx := middleware.WithClaims(context.Background(), &authentity.Claims{Subject: "user-1"})
+
+		if resp, _ := call(ctx, limiter, "AnalyzeRepository", analyzeRequest); resp != "ok" {
+			t.Errorf("expected authenticated request to pass, got %v", resp)
+		}
+	})
+
+	t.Run("skips commit lookups and other operations", func(t *testing.T) {
+		limiter := ratelimit.NewLimiter(store, "commit", 0, time.Minute)
+		commit := "abc1234"
+		commitRequest := api.AnalyzeRepositoryRequestObject{
+			Owner:  "owner",
+			Repo:   "repo",
+			Params: api.AnalyzeRepositoryParams{Commit: &commit},
+		}
+
+		if resp, _ := call(context.Background(), limiter, "AnalyzeRepository", commitRequest); resp != "ok" {
+			t.Errorf("expected commit lookup to pass, got %v", resp)
+		}
+		if resp, _ := call(context.Background(), limiter, "GetAnalysisStatus", nil); resp != "ok" {
+			t.Errorf("expected other operation to pass, got %v", resp)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -118,6 +119,9 @@ func TestAnalyzeRepository(t *testing.T) {
 		if repo.lastViewedOwner != "owner" || repo.lastViewedRepo != "repo" {
 			t.Errorf("expected UpdateLastViewed(owner, repo), got (%s, %s)", repo.lastViewedOwner, repo.lastViewedRepo)
 		}
+		if !strings.HasPrefix(repo.lastViewedViewer, "ip:") {
+			t.Errorf("expected anonymous view to be keyed by client IP, got %q", repo.lastViewedViewer)
+		}
 	})
 }
 
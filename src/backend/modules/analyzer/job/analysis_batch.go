@@ -0,0 +1,40 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/adapter"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+// analysisBatchTimeout leaves room for one GitHub lookup per item of the largest batch.
+const analysisBatchTimeout = 30 * time.Minute
+
+type AnalysisBatchWorker struct {
+	river.WorkerDefaults[adapter.AnalysisBatchArgs]
+	process *usecase.ProcessAnalysisBatchUseCase
+}
+
+func NewAnalysisBatchWorker(process *usecase.ProcessAnalysisBatchUseCase) *AnalysisBatchWorker {
+	return &AnalysisBatchWorker{process: process}
+}
+
+func (w *AnalysisBatchWorker) Timeout(*river.Job[adapter.AnalysisBatchArgs]) time.Duration {
+	return analysisBatchTimeout
+}
+
+func (w *AnalysisBatchWorker) Work(ctx context.Context, job *river.Job[adapter.AnalysisBatchArgs]) error {
+	result, err := w.process.Execute(ctx, usecase.ProcessAnalysisBatchInput{BatchID: job.Args.BatchID})
+	if err != nil {
+		return fmt.Errorf("process analysis batch %s: %w", job.Args.BatchID, err)
+	}
+
+	slog.InfoContext(ctx, "analysis batch submitted",
+		"batch_id", job.Args.BatchID, "queued", result.Queued, "cached", result.Cached, "failed", result.Failed)
+	return river.RecordOutput(ctx, result)
+}
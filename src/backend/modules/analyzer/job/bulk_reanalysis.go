@@ -0,0 +1,82 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/specvital/web/src/backend/common/queue"
+	"github.com/specvital/web/src/backend/modules/analyzer/adapter"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+// bulkReanalysisTimeout bounds a single attempt. Long runs that hit it resume
+// from the persisted cursor on the next attempt.
+const bulkReanalysisTimeout = 2 * time.Hour
+
+type BulkReanalysisWorker struct {
+	river.WorkerDefaults[adapter.BulkReanalysisArgs]
+	process    *usecase.ProcessBulkReanalysisUseCase
+	repository port.BulkReanalysisRepository
+}
+
+func NewBulkReanalysisWorker(process *usecase.ProcessBulkReanalysisUseCase, repository port.BulkReanalysisRepository) *BulkReanalysisWorker {
+	return &BulkReanalysisWorker{process: process, repository: repository}
+}
+
+func (w *BulkReanalysisWorker) Timeout(*river.Job[adapter.BulkReanalysisArgs]) time.Duration {
+	return bulkReanalysisTimeout
+}
+
+func (w *BulkReanalysisWorker) Work(ctx context.Context, job *river.Job[adapter.BulkReanalysisArgs]) error {
+	result, err := w.process.Execute(ctx, usecase.ProcessBulkReanalysisInput{RunID: job.Args.RunID})
+	if err != nil {
+		if job.Attempt >= job.MaxAttempts {
+			if failErr := w.repository.FailRun(context.WithoutCancel(ctx), job.Args.RunID, err.Error()); failErr != nil {
+				slog.ErrorContext(ctx, "failed to mark bulk re-analysis run failed", "run_id", job.Args.RunID, "error", failErr)
+			}
+		}
+		return fmt.Errorf("process bulk re-analysis run %s: %w", job.Args.RunID, err)
+	}
+
+	slog.InfoContext(ctx, "bulk re-analysis run completed",
+		"run_id", job.Args.RunID, "enqueued", result.Enqueued, "failed", result.Failed)
+	return river.RecordOutput(ctx, result)
+}
+
+type ParserUpgradeWatcher struct {
+	river.WorkerDefaults[adapter.ParserUpgradeCheckArgs]
+	detect *usecase.DetectParserUpgradeUseCase
+}
+
+func NewParserUpgradeWatcher(detect *usecase.DetectParserUpgradeUseCase) *ParserUpgradeWatcher {
+	return &ParserUpgradeWatcher{detect: detect}
+}
+
+func (w *ParserUpgradeWatcher) Work(ctx context.Context, _ *river.Job[adapter.ParserUpgradeCheckArgs]) error {
+	run, err := w.detect.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("detect parser upgrade: %w", err)
+	}
+	if run != nil {
+		slog.InfoContext(ctx, "parser upgrade detected, bulk re-analysis started",
+			"run_id", run.ID, "parser_version", run.ParserVersion, "total", run.TotalCount)
+	}
+	return nil
+}
+
+// NewParserUpgradeCheckPeriodicJob schedules ParserUpgradeWatcher. River only
+// enqueues periodic jobs from the elected leader, so replicas do not race.
+func NewParserUpgradeCheckPeriodicJob(interval time.Duration) *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(interval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return adapter.ParserUpgradeCheckArgs{}, &river.InsertOpts{Queue: queue.QueueWebMaintenance}
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	)
+}
@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jackc/pgx/v5"
@@ -26,6 +27,7 @@ type mockRepository struct {
 	lastViewedCalled  bool
 	lastViewedOwner   string
 	lastViewedRepo    string
+	lastViewedViewer  string
 	suitesWithCases   []port.TestSuiteWithCases
 }
 
@@ -55,10 +57,11 @@ func (m *mockRepository) GetTestSuitesWithCases(ctx context.Context, analysisID
 	return m.suitesWithCases, nil
 }
 
-func (m *mockRepository) UpdateLastViewed(ctx context.Context, owner, repo string) error {
+func (m *mockRepository) UpdateLastViewed(ctx context.Context, owner, repo, viewer string) error {
 	m.lastViewedCalled = true
 	m.lastViewedOwner = owner
 	m.lastViewedRepo = repo
+	m.lastViewedViewer = viewer
 	return nil
 }
 
@@ -80,6 +83,10 @@ func (m *mockRepository) GetCompletedAnalysisByCommitSHA(ctx context.Context, ow
 	return nil, domain.ErrNotFound
 }
 
+func (m *mockRepository) GetOrgRepositoryTestSummaries(ctx context.Context, owner string, since time.Time) ([]port.OrgRepositoryTestSummary, error) {
+	return nil, nil
+}
+
 func (m *mockRepository) GetRepositoryStats(ctx context.Context, userID string) (*entity.RepositoryStats, error) {
 	return &entity.RepositoryStats{}, nil
 }
@@ -242,11 +249,10 @@ func setupTestHandlerWithMocks(repo *mockRepository, queue *mockQueueService, gi
 		reanalyzeRepositoryUC,
 		nil,
 		nil,
-		nil,
 	)
 
 	r := chi.NewRouter()
-	apiHandlers := api.NewAPIHandlers(h, user.NewMockHandler(), authhandler.NewMockHandler(), user.NewMockHandler(), NewMockGitHubHandler(), NewMockGitHubAppHandler(), NewMockPricingHandler(), h, specviewhandler.NewMockHandler(), NewMockSubscriptionHandler(), NewMockUsageHandler(), user.NewMockHandler(), nil)
+	apiHandlers := api.NewAPIHandlers(nil, h, nil, user.NewMockHandler(), nil, authhandler.NewMockHandler(), user.NewMockHandler(), NewMockGitHubHandler(), NewMockGitHubAppHandler(), nil, NewMockPricingHandler(), h, specviewhandler.NewMockHandler(), NewMockSubscriptionHandler(), NewMockUsageHandler(), user.NewMockHandler(), nil, nil)
 	strictHandler := api.NewStrictHandler(apiHandlers, nil)
 	api.HandlerFromMux(strictHandler, r)
 
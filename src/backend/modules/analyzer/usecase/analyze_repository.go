@@ -19,10 +19,12 @@ import (
 )
 
 type AnalyzeRepositoryInput struct {
-	Owner  string
-	Repo   string
-	Tier   subscription.PlanTier
-	UserID string
+	// ClientIP identifies anonymous viewers when counting the view. Optional.
+	ClientIP string
+	Owner    string
+	Repo     string
+	Tier     subscription.PlanTier
+	UserID   string
 }
 
 type AnalyzeRepositoryUseCase struct {
@@ -90,7 +92,7 @@ func (uc *AnalyzeRepositoryUseCase) Execute(ctx context.Context, input AnalyzeRe
 				return nil, fmt.Errorf("build analysis for %s/%s: %w", input.Owner, input.Repo, buildErr)
 			}
 			// Non-critical: UpdateLastViewed failure doesn't affect main flow
-			_ = uc.repository.UpdateLastViewed(ctx, input.Owner, input.Repo)
+			_ = uc.repository.UpdateLastViewed(ctx, input.Owner, input.Repo, entity.ViewerKey(input.UserID, input.ClientIP))
 			return &AnalyzeResult{Analysis: analysis}, nil
 		}
 	}
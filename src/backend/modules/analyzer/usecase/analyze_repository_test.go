@@ -91,13 +91,17 @@ func (m *mockRepositoryForAnalyze) GetPaginatedRepositories(_ context.Context, _
 func (m *mockRepositoryForAnalyze) GetPreviousAnalysis(_ context.Context, _, _ string) (*port.PreviousAnalysis, error) {
 	return nil, nil
 }
+func (m *mockRepositoryForAnalyze) GetOrgRepositoryTestSummaries(_ context.Context, _ string, _ time.Time) ([]port.OrgRepositoryTestSummary, error) {
+	return nil, nil
+}
+
 func (m *mockRepositoryForAnalyze) GetRepositoryStats(_ context.Context, _ string) (*entity.RepositoryStats, error) {
 	return nil, nil
 }
 func (m *mockRepositoryForAnalyze) GetTestSuitesWithCases(_ context.Context, _ string) ([]port.TestSuiteWithCases, error) {
 	return m.suitesWithCases, nil
 }
-func (m *mockRepositoryForAnalyze) UpdateLastViewed(_ context.Context, _, _ string) error {
+func (m *mockRepositoryForAnalyze) UpdateLastViewed(_ context.Context, _, _, _ string) error {
 	return nil
 }
 func (m *mockRepositoryForAnalyze) GetAiSpecSummaries(_ context.Context, _ []string, _ string) (map[string]*entity.AiSpecSummary, error) {
@@ -142,12 +146,19 @@ func (m *mockQueueServiceForAnalyze) Close() error {
 type mockGitClientForAnalyze struct {
 	latestSHA string
 	err       error
+	errByRepo map[string]error
 }
 
-func (m *mockGitClientForAnalyze) GetLatestCommitSHA(_ context.Context, _, _ string) (string, error) {
+func (m *mockGitClientForAnalyze) GetLatestCommitSHA(_ context.Context, _, repo string) (string, error) {
+	if err := m.errByRepo[repo]; err != nil {
+		return "", err
+	}
 	return m.latestSHA, m.err
 }
-func (m *mockGitClientForAnalyze) GetLatestCommitSHAWithToken(_ context.Context, _, _, _ string) (string, error) {
+func (m *mockGitClientForAnalyze) GetLatestCommitSHAWithToken(_ context.Context, _, repo, _ string) (string, error) {
+	if err := m.errByRepo[repo]; err != nil {
+		return "", err
+	}
 	return m.latestSHA, m.err
 }
 
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+// DetectParserUpgradeUseCase starts an automatic bulk re-analysis when the
+// parser_version in system_config differs from the version of the most recent run.
+// A version is handled once: if its run fails, an operator has to retry manually.
+// The very first check only records the current version as a baseline, so a fresh
+// deploy does not re-analyze the whole corpus.
+type DetectParserUpgradeUseCase struct {
+	repository   port.BulkReanalysisRepository
+	start        *StartBulkReanalysisUseCase
+	systemConfig port.SystemConfigReader
+}
+
+func NewDetectParserUpgradeUseCase(
+	repository port.BulkReanalysisRepository,
+	start *StartBulkReanalysisUseCase,
+	systemConfig port.SystemConfigReader,
+) *DetectParserUpgradeUseCase {
+	return &DetectParserUpgradeUseCase{
+		repository:   repository,
+		start:        start,
+		systemConfig: systemConfig,
+	}
+}
+
+// Execute returns the started run, or nil when nothing needed to be done.
+func (uc *DetectParserUpgradeUseCase) Execute(ctx context.Context) (*entity.BulkReanalysisRun, error) {
+	parserVersion, err := uc.systemConfig.GetParserVersion(ctx)
+	if err != nil {
+		if errors.Is(err, domain.ErrParserVersionNotConfigured) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get parser version: %w", err)
+	}
+
+	latest, err := uc.repository.GetLatestRun(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get latest run: %w", err)
+	}
+	if latest == nil {
+		return nil, uc.recordBaseline(ctx, parserVersion)
+	}
+	if latest.ParserVersion == parserVersion {
+		return nil, nil
+	}
+
+	run, err := uc.start.Execute(ctx, StartBulkReanalysisInput{
+		Trigger: entity.BulkReanalysisTriggerAuto,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrBulkReanalysisInProgress) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// recordBaseline stores an empty completed run for parserVersion without enqueueing anything.
+func (uc *DetectParserUpgradeUseCase) recordBaseline(ctx context.Context, parserVersion string) error {
+	run, err := uc.repository.CreateRun(ctx, port.CreateBulkReanalysisRunParams{
+		ParserVersion: parserVersion,
+		Trigger:       entity.BulkReanalysisTriggerAuto,
+	})
+	if err != nil {
+		return fmt.Errorf("create baseline run: %w", err)
+	}
+	if err := uc.repository.CompleteRun(ctx, run.ID); err != nil {
+		return fmt.Errorf("complete baseline run: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+func TestDetectParserUpgradeUseCase_Execute(t *testing.T) {
+	newUseCase := func(repo *mockBulkReanalysisRepository, scheduler *mockBulkReanalysisScheduler) *usecase.DetectParserUpgradeUseCase {
+		versions := &mockParserVersionReader{version: "v2"}
+		start := usecase.NewStartBulkReanalysisUseCase(repo, scheduler, versions)
+		return usecase.NewDetectParserUpgradeUseCase(repo, start, versions)
+	}
+
+	t.Run("records a baseline on the first check without enqueueing", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{codebases: newOutdatedCodebases(5)}
+		scheduler := &mockBulkReanalysisScheduler{}
+
+		run, err := newUseCase(repo, scheduler).Execute(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if run != nil {
+			t.Errorf("expected no run to be started, got %+v", run)
+		}
+		if repo.createParams == nil || repo.createParams.ParserVersion != "v2" || repo.createParams.TotalCount != 0 {
+			t.Errorf("expected empty baseline run for v2, got %+v", repo.createParams)
+		}
+		if !repo.completed {
+			t.Error("expected baseline run to be completed")
+		}
+		if len(scheduler.scheduled) != 0 {
+			t.Errorf("expected nothing scheduled, got %v", scheduler.scheduled)
+		}
+	})
+
+	t.Run("does nothing when the version is unchanged", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{
+			run: &entity.BulkReanalysisRun{ID: "run-0", ParserVersion: "v2", Status: entity.BulkReanalysisStatusCompleted},
+		}
+		scheduler := &mockBulkReanalysisScheduler{}
+
+		run, err := newUseCase(repo, scheduler).Execute(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if run != nil || repo.createParams != nil {
+			t.Errorf("expected no run, got %+v", run)
+		}
+	})
+
+	t.Run("starts a run when the version changed", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{
+			codebases: newOutdatedCodebases(3),
+			run:       &entity.BulkReanalysisRun{ID: "run-0", ParserVersion: "v1", Status: entity.BulkReanalysisStatusCompleted},
+		}
+		scheduler := &mockBulkReanalysisScheduler{}
+
+		run, err := newUseCase(repo, scheduler).Execute(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if run == nil || run.Trigger != entity.BulkReanalysisTriggerAuto || run.TotalCount != 3 {
+			t.Fatalf("expected auto run over 3 codebases, got %+v", run)
+		}
+		if len(scheduler.scheduled) != 1 {
+			t.Errorf("expected run to be scheduled, got %v", scheduler.scheduled)
+		}
+	})
+}
@@ -13,9 +13,13 @@ import (
 )
 
 type GetAnalysisInput struct {
+	// ClientIP identifies anonymous viewers when counting the view. Optional.
+	ClientIP  string
 	CommitSHA string
 	Owner     string
 	Repo      string
+	// UserID identifies the viewer when counting the view. Optional.
+	UserID string
 }
 
 type GetAnalysisUseCase struct {
@@ -69,7 +73,7 @@ func (uc *GetAnalysisUseCase) Execute(ctx context.Context, input GetAnalysisInpu
 			return nil, fmt.Errorf("build analysis for %s/%s: %w", input.Owner, input.Repo, buildErr)
 		}
 		// Non-critical: UpdateLastViewed failure doesn't affect main flow
-		_ = uc.repository.UpdateLastViewed(ctx, input.Owner, input.Repo)
+		_ = uc.repository.UpdateLastViewed(ctx, input.Owner, input.Repo, entity.ViewerKey(input.UserID, input.ClientIP))
 		return &AnalyzeResult{Analysis: analysis}, nil
 	}
 
@@ -80,6 +84,24 @@ func (uc *GetAnalysisUseCase) Execute(ctx context.Context, input GetAnalysisInpu
 	return nil, domain.ErrNotFound
 }
 
+// Validator returns a value that changes whenever Execute's analysis would,
+// without loading its test suites. ok is false unless the input pins a
+// commit, since the latest analysis also depends on the queue.
+func (uc *GetAnalysisUseCase) Validator(ctx context.Context, input GetAnalysisInput) (validator string, ok bool, err error) {
+	if input.Owner == "" || input.Repo == "" || input.CommitSHA == "" {
+		return "", false, nil
+	}
+
+	completed, err := uc.repository.GetCompletedAnalysisByCommitSHA(ctx, input.Owner, input.Repo, input.CommitSHA)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("get analysis validator for %s/%s@%s: %w", input.Owner, input.Repo, input.CommitSHA, err)
+	}
+	return fmt.Sprintf("%s:%d", completed.ID, completed.CompletedAt.UnixNano()), true, nil
+}
+
 func (uc *GetAnalysisUseCase) executeByCommitSHA(ctx context.Context, input GetAnalysisInput) (*AnalyzeResult, error) {
 	completed, err := uc.repository.GetCompletedAnalysisByCommitSHA(ctx, input.Owner, input.Repo, input.CommitSHA)
 	if err != nil {
@@ -95,7 +117,7 @@ func (uc *GetAnalysisUseCase) executeByCommitSHA(ctx context.Context, input GetA
 	}
 
 	// Non-critical: UpdateLastViewed failure doesn't affect main flow
-	_ = uc.repository.UpdateLastViewed(ctx, input.Owner, input.Repo)
+	_ = uc.repository.UpdateLastViewed(ctx, input.Owner, input.Repo, entity.ViewerKey(input.UserID, input.ClientIP))
 
 	return &AnalyzeResult{Analysis: analysis}, nil
 }
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+type GetAnalysisBatchInput struct {
+	BatchID string
+	UserID  string
+}
+
+type GetAnalysisBatchUseCase struct {
+	repository port.AnalysisBatchRepository
+}
+
+func NewGetAnalysisBatchUseCase(repository port.AnalysisBatchRepository) *GetAnalysisBatchUseCase {
+	return &GetAnalysisBatchUseCase{repository: repository}
+}
+
+func (uc *GetAnalysisBatchUseCase) Execute(ctx context.Context, input GetAnalysisBatchInput) (*entity.AnalysisBatch, error) {
+	if input.BatchID == "" || input.UserID == "" {
+		return nil, errors.New("batch ID and user ID are required")
+	}
+
+	batch, err := uc.repository.GetBatch(ctx, input.BatchID)
+	if err != nil {
+		return nil, err
+	}
+	// Batches of other users are reported as missing rather than forbidden.
+	if batch.UserID != input.UserID {
+		return nil, domain.ErrAnalysisBatchNotFound
+	}
+	return batch, nil
+}
@@ -83,13 +83,17 @@ func (m *mockRepositoryForGetAnalysis) GetPaginatedRepositories(_ context.Contex
 func (m *mockRepositoryForGetAnalysis) GetPreviousAnalysis(_ context.Context, _, _ string) (*port.PreviousAnalysis, error) {
 	return nil, nil
 }
+func (m *mockRepositoryForGetAnalysis) GetOrgRepositoryTestSummaries(_ context.Context, _ string, _ time.Time) ([]port.OrgRepositoryTestSummary, error) {
+	return nil, nil
+}
+
 func (m *mockRepositoryForGetAnalysis) GetRepositoryStats(_ context.Context, _ string) (*entity.RepositoryStats, error) {
 	return nil, nil
 }
 func (m *mockRepositoryForGetAnalysis) GetTestSuitesWithCases(_ context.Context, _ string) ([]port.TestSuiteWithCases, error) {
 	return m.suitesWithCases, nil
 }
-func (m *mockRepositoryForGetAnalysis) UpdateLastViewed(_ context.Context, _, _ string) error {
+func (m *mockRepositoryForGetAnalysis) UpdateLastViewed(_ context.Context, _, _, _ string) error {
 	m.lastViewedCalled = true
 	return nil
 }
@@ -220,3 +224,49 @@ func TestGetAnalysisUseCase_ExecuteByCommitSHA(t *testing.T) {
 		}
 	})
 }
+
+func TestGetAnalysisUseCase_Validator(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	input := usecase.GetAnalysisInput{Owner: "testowner", Repo: "testrepo", CommitSHA: "abc1234567890"}
+
+	t.Run("has no validator for the latest analysis", func(t *testing.T) {
+		t.Parallel()
+
+		mocks := newGetAnalysisMocks()
+		_, ok, err := mocks.newUseCase().Validator(context.Background(), usecase.GetAnalysisInput{Owner: "testowner", Repo: "testrepo"})
+		if err != nil || ok {
+			t.Errorf("Validator() = ok %v, error %v; want no validator", ok, err)
+		}
+	})
+
+	t.Run("has no validator for an unknown commit", func(t *testing.T) {
+		t.Parallel()
+
+		mocks := newGetAnalysisMocks()
+		_, ok, err := mocks.newUseCase().Validator(context.Background(), input)
+		if err != nil || ok {
+			t.Errorf("Validator() = ok %v, error %v; want no validator", ok, err)
+		}
+	})
+
+	t.Run("changes when the commit is analyzed again", func(t *testing.T) {
+		t.Parallel()
+
+		mocks := newGetAnalysisMocks()
+		mocks.repository.completedAnalysisBySHA = &port.CompletedAnalysis{ID: "analysis-1", CommitSHA: input.CommitSHA, CompletedAt: completedAt}
+		uc := mocks.newUseCase()
+
+		before, ok, err := uc.Validator(context.Background(), input)
+		if err != nil || !ok {
+			t.Fatalf("Validator() = ok %v, error %v", ok, err)
+		}
+		mocks.repository.completedAnalysisBySHA = &port.CompletedAnalysis{ID: "analysis-2", CommitSHA: input.CommitSHA, CompletedAt: completedAt.Add(time.Hour)}
+		after, _, _ := uc.Validator(context.Background(), input)
+
+		if before == after {
+			t.Errorf("validator %q did not change for a new analysis", before)
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+type GetBulkReanalysisRunInput struct {
+	RunID string
+}
+
+type GetBulkReanalysisRunUseCase struct {
+	repository port.BulkReanalysisRepository
+}
+
+func NewGetBulkReanalysisRunUseCase(repository port.BulkReanalysisRepository) *GetBulkReanalysisRunUseCase {
+	return &GetBulkReanalysisRunUseCase{repository: repository}
+}
+
+func (uc *GetBulkReanalysisRunUseCase) Execute(ctx context.Context, input GetBulkReanalysisRunInput) (*entity.BulkReanalysisRun, error) {
+	if input.RunID == "" {
+		return nil, errors.New("run ID is required")
+	}
+	return uc.repository.GetRun(ctx, input.RunID)
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+const (
+	OrgDashboardChangeWindow = 30 * 24 * time.Hour
+	orgDashboardListLimit    = 10
+)
+
+type GetOrgDashboardInput struct {
+	OrgLogin string
+	UserID   string
+}
+
+type GetOrgDashboardUseCase struct {
+	accessChecker port.OrgAccessChecker
+	repository    port.Repository
+}
+
+func NewGetOrgDashboardUseCase(accessChecker port.OrgAccessChecker, repository port.Repository) *GetOrgDashboardUseCase {
+	return &GetOrgDashboardUseCase{
+		accessChecker: accessChecker,
+		repository:    repository,
+	}
+}
+
+func (uc *GetOrgDashboardUseCase) Execute(ctx context.Context, input GetOrgDashboardInput) (*entity.OrgDashboard, error) {
+	if input.UserID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if input.OrgLogin == "" {
+		return nil, fmt.Errorf("%w: org is required", domain.ErrInvalidInput)
+	}
+
+	allowed, err := uc.accessChecker.HasOrgAccess(ctx, input.UserID, input.OrgLogin)
+	if err != nil {
+		return nil, fmt.Errorf("check org access: %w", err)
+	}
+	if !allowed {
+		return nil, domain.ErrOrgAccessDenied
+	}
+
+	since := time.Now().Add(-OrgDashboardChangeWindow)
+	summaries, err := uc.repository.GetOrgRepositoryTestSummaries(ctx, input.OrgLogin, since)
+	if err != nil {
+		return nil, fmt.Errorf("get org repository test summaries: %w", err)
+	}
+
+	return buildOrgDashboard(input.OrgLogin, since, summaries), nil
+}
+
+func buildOrgDashboard(org string, since time.Time, summaries []port.OrgRepositoryTestSummary) *entity.OrgDashboard {
+	dashboard := &entity.OrgDashboard{
+		AttentionRepositories: []entity.OrgRepositoryAttention{},
+		ChangeWindowStart:     since,
+		Org:                   org,
+		RepositoryCount:       len(summaries),
+		TestCountChanges:      []entity.OrgTestCountChange{},
+	}
+
+	for _, s := range summaries {
+		dashboard.TotalTests += s.TotalTests
+		dashboard.StatusSummary.Active += s.StatusSummary.Active
+		dashboard.StatusSummary.Focused += s.StatusSummary.Focused
+		dashboard.StatusSummary.Skipped += s.StatusSummary.Skipped
+		dashboard.StatusSummary.Todo += s.StatusSummary.Todo
+		dashboard.StatusSummary.Xfail += s.StatusSummary.Xfail
+
+		if s.StatusSummary.Focused+s.StatusSummary.Skipped > 0 {
+			dashboard.AttentionRepositories = append(dashboard.AttentionRepositories, entity.OrgRepositoryAttention{
+				AnalyzedAt:   s.AnalyzedAt,
+				FocusedCount: s.StatusSummary.Focused,
+				Name:         s.Name,
+				SkippedCount: s.StatusSummary.Skipped,
+				TotalTests:   s.TotalTests,
+			})
+		}
+
+		if s.BaselineTotalTests != nil && *s.BaselineTotalTests != s.TotalTests {
+			dashboard.TestCountChanges = append(dashboard.TestCountChanges, entity.OrgTestCountChange{
+				Change:        s.TotalTests - *s.BaselineTotalTests,
+				CurrentTests:  s.TotalTests,
+				Name:          s.Name,
+				PreviousTests: *s.BaselineTotalTests,
+			})
+		}
+	}
+
+	attention := dashboard.AttentionRepositories
+	sort.SliceStable(attention, func(i, j int) bool {
+		if attention[i].Score() != attention[j].Score() {
+			return attention[i].Score() > attention[j].Score()
+		}
+		return attention[i].Name < attention[j].Name
+	})
+	if len(attention) > orgDashboardListLimit {
+		dashboard.AttentionRepositories = attention[:orgDashboardListLimit]
+	}
+
+	changes := dashboard.TestCountChanges
+	sort.SliceStable(changes, func(i, j int) bool {
+		if absInt(changes[i].Change) != absInt(changes[j].Change) {
+			return absInt(changes[i].Change) > absInt(changes[j].Change)
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	if len(changes) > orgDashboardListLimit {
+		dashboard.TestCountChanges = changes[:orgDashboardListLimit]
+	}
+
+	return dashboard
+}
+
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+type mockOrgAccessChecker struct {
+	allowed bool
+	err     error
+}
+
+func (m *mockOrgAccessChecker) HasOrgAccess(_ context.Context, _, _ string) (bool, error) {
+	return m.allowed, m.err
+}
+
+func intPtr(n int) *int {
+	return &n
+}
+
+func TestGetOrgDashboardUseCase_Execute(t *testing.T) {
+	t.Run("aggregates repositories of the organization", func(t *testing.T) {
+		repo := &mockRepository{orgSummaries: []port.OrgRepositoryTestSummary{
+			{
+				BaselineTotalTests: intPtr(100),
+				Name:               "api",
+				StatusSummary:      entity.TestStatusSummary{Active: 110, Skipped: 10},
+				TotalTests:         120,
+			},
+			{
+				BaselineTotalTests: intPtr(80),
+				Name:               "cli",
+				StatusSummary:      entity.TestStatusSummary{Active: 80},
+				TotalTests:         80,
+			},
+			{
+				BaselineTotalTests: intPtr(90),
+				Name:               "web",
+				StatusSummary:      entity.TestStatusSummary{Active: 40, Focused: 2, Skipped: 9, Todo: 3},
+				TotalTests:         53,
+			},
+		}}
+		uc := usecase.NewGetOrgDashboardUseCase(&mockOrgAccessChecker{allowed: true}, repo)
+
+		dashboard, err := uc.Execute(context.Background(), usecase.GetOrgDashboardInput{OrgLogin: "acme", UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if repo.orgSummariesOwner != "acme" {
+			t.Errorf("expected owner acme, got %q", repo.orgSummariesOwner)
+		}
+		if dashboard.RepositoryCount != 3 || dashboard.TotalTests != 253 {
+			t.Errorf("expected 3 repositories and 253 tests, got %d/%d", dashboard.RepositoryCount, dashboard.TotalTests)
+		}
+		want := entity.TestStatusSummary{Active: 230, Focused: 2, Skipped: 19, Todo: 3}
+		if dashboard.StatusSummary != want {
+			t.Errorf("expected status summary %+v, got %+v", want, dashboard.StatusSummary)
+		}
+
+		if len(dashboard.AttentionRepositories) != 2 {
+			t.Fatalf("expected 2 attention repositories, got %+v", dashboard.AttentionRepositories)
+		}
+		if dashboard.AttentionRepositories[0].Name != "web" || dashboard.AttentionRepositories[1].Name != "api" {
+			t.Errorf("expected web before api, got %+v", dashboard.AttentionRepositories)
+		}
+
+		if len(dashboard.TestCountChanges) != 2 {
+			t.Fatalf("expected unchanged repositories to be omitted, got %+v", dashboard.TestCountChanges)
+		}
+		if dashboard.TestCountChanges[0].Name != "web" || dashboard.TestCountChanges[0].Change != -37 {
+			t.Errorf("expected web -37 first, got %+v", dashboard.TestCountChanges[0])
+		}
+		if dashboard.TestCountChanges[1].Name != "api" || dashboard.TestCountChanges[1].Change != 20 {
+			t.Errorf("expected api +20 second, got %+v", dashboard.TestCountChanges[1])
+		}
+	})
+
+	t.Run("limits ranked lists", func(t *testing.T) {
+		summaries := make([]port.OrgRepositoryTestSummary, 15)
+		for i := range summaries {
+			summaries[i] = port.OrgRepositoryTestSummary{
+				BaselineTotalTests: intPtr(0),
+				Name:               fmt.Sprintf("repo-%02d", i),
+				StatusSummary:      entity.TestStatusSummary{Skipped: i + 1},
+				TotalTests:         i + 1,
+			}
+		}
+		uc := usecase.NewGetOrgDashboardUseCase(&mockOrgAccessChecker{allowed: true}, &mockRepository{orgSummaries: summaries})
+
+		dashboard, err := uc.Execute(context.Background(), usecase.GetOrgDashboardInput{OrgLogin: "acme", UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(dashboard.AttentionRepositories) != 10 || len(dashboard.TestCountChanges) != 10 {
+			t.Errorf("expected 10 entries each, got %d/%d", len(dashboard.AttentionRepositories), len(dashboard.TestCountChanges))
+		}
+		if dashboard.AttentionRepositories[0].Name != "repo-14" {
+			t.Errorf("expected repo-14 first, got %s", dashboard.AttentionRepositories[0].Name)
+		}
+	})
+
+	t.Run("rejects users without org access", func(t *testing.T) {
+		repo := &mockRepository{}
+		uc := usecase.NewGetOrgDashboardUseCase(&mockOrgAccessChecker{}, repo)
+
+		_, err := uc.Execute(context.Background(), usecase.GetOrgDashboardInput{OrgLogin: "acme", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrOrgAccessDenied) {
+			t.Errorf("expected ErrOrgAccessDenied, got %v", err)
+		}
+		if repo.orgSummariesOwner != "" {
+			t.Error("expected no repository lookup")
+		}
+	})
+
+	t.Run("returns access check errors", func(t *testing.T) {
+		uc := usecase.NewGetOrgDashboardUseCase(&mockOrgAccessChecker{err: errors.New("db down")}, &mockRepository{})
+
+		_, err := uc.Execute(context.Background(), usecase.GetOrgDashboardInput{OrgLogin: "acme", UserID: "user-1"})
+		if err == nil || errors.Is(err, domain.ErrOrgAccessDenied) {
+			t.Errorf("expected wrapped access check error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+// Limits for uploaded inventories. Field lengths mirror the column sizes of
+// the analysis tables.
+const (
+	MaxIngestFiles      = 5000
+	MaxIngestSuiteDepth = 32
+	MaxIngestSuites     = 50000
+	MaxIngestTests      = 100000
+
+	maxIngestBranchLength    = 255
+	maxIngestFilePathLength  = 1000
+	maxIngestFrameworkLength = 50
+	maxIngestModifierLength  = 50
+	maxIngestParserVersion   = 100
+	maxIngestSuiteNameLength = 500
+	maxIngestTagLength       = 100
+	maxIngestTagsPerTest     = 50
+	maxIngestTestNameLength  = 2000
+)
+
+var fullCommitSHAPattern = regexp.MustCompile(`^[a-f0-9]{40}$`)
+
+type IngestAnalysisInput struct {
+	Inventory *entity.IngestedInventory
+	Owner     string
+	Repo      string
+	UserID    string
+}
+
+// IngestAnalysisUseCase stores a test inventory parsed in CI as a completed
+// analysis, for repositories the worker cannot clone. Uploads are only
+// accepted for repositories in the user's synced GitHub repository list that
+// the user can push to, or that are private and out of the backend's reach.
+// Ingested analyses are marked with their source and uploader and never take
+// the place of an analysis produced by the worker.
+type IngestAnalysisUseCase struct {
+	access     port.RepositoryAccessChecker
+	repository port.AnalysisIngestRepository
+}
+
+func NewIngestAnalysisUseCase(repository port.AnalysisIngestRepository, access port.RepositoryAccessChecker) *IngestAnalysisUseCase {
+	if repository == nil {
+		panic("repository is required")
+	}
+	if access == nil {
+		panic("access checker is required")
+	}
+	return &IngestAnalysisUseCase{access: access, repository: repository}
+}
+
+func (uc *IngestAnalysisUseCase) Execute(ctx context.Context, input IngestAnalysisInput) (*entity.IngestedAnalysis, error) {
+	if input.UserID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if input.Owner == "" || input.Repo == "" {
+		return nil, fmt.Errorf("%w: owner and repo are required", domain.ErrInvalidInput)
+	}
+	if input.Inventory == nil {
+		return nil, fmt.Errorf("%w: inventory is required", domain.ErrInvalidInput)
+	}
+
+	inventory := *input.Inventory
+	inventory.CommitSHA = strings.ToLower(strings.TrimSpace(inventory.CommitSHA))
+	inventory.ParserVersion = strings.TrimSpace(inventory.ParserVersion)
+	if err := validateInventory(&inventory); err != nil {
+		return nil, err
+	}
+
+	repository, err := uc.repository.FindUserRepository(ctx, input.UserID, input.Owner, input.Repo)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkUploadAllowed(ctx, input.UserID, repository); err != nil {
+		return nil, err
+	}
+
+	analysis, err := uc.repository.StoreInventory(ctx, input.UserID, repository, &inventory)
+	if err != nil {
+		if errors.Is(err, domain.ErrAnalysisAlreadyExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("store inventory for %s/%s: %w", input.Owner, input.Repo, err)
+	}
+	return analysis, nil
+}
+
+// checkUploadAllowed lets users with push rights upload for any repository,
+// and other members only for private repositories the backend cannot clone,
+// where no worker analysis can exist.
+func (uc *IngestAnalysisUseCase) checkUploadAllowed(ctx context.Context, userID string, repository *entity.IngestRepository) error {
+	canPush, err := uc.access.CanPush(ctx, userID, repository.Owner, repository.Name)
+	if err != nil {
+		return fmt.Errorf("check push access to %s/%s: %w", repository.Owner, repository.Name, err)
+	}
+	if canPush {
+		return nil
+	}
+	if !repository.IsPrivate {
+		return domain.ErrIngestNotPermitted
+	}
+
+	canClone, err := uc.access.CanClone(ctx, repository.Owner, repository.Name)
+	if err != nil {
+		return fmt.Errorf("check backend access to %s/%s: %w", repository.Owner, repository.Name, err)
+	}
+	if canClone {
+		return domain.ErrIngestNotPermitted
+	}
+	return nil
+}
+
+func validateInventory(inventory *entity.IngestedInventory) error {
+	if !fullCommitSHAPattern.MatchString(inventory.CommitSHA) {
+		return fmt.Errorf("%w: commitSha must be a full 40-character hex SHA", domain.ErrInvalidInput)
+	}
+	if inventory.ParserVersion == "" || len(inventory.ParserVersion) > maxIngestParserVersion {
+		return fmt.Errorf("%w: parserVersion is required and at most %d characters", domain.ErrInvalidInput, maxIngestParserVersion)
+	}
+	if inventory.Branch != nil && utf8.RuneCountInString(*inventory.Branch) > maxIngestBranchLength {
+		return fmt.Errorf("%w: branch exceeds %d characters", domain.ErrInvalidInput, maxIngestBranchLength)
+	}
+	if len(inventory.Files) == 0 {
+		return fmt.Errorf("%w: at least one test file is required", domain.ErrInvalidInput)
+	}
+	if len(inventory.Files) > MaxIngestFiles {
+		return fmt.Errorf("%w: %d files (max %d)", domain.ErrIngestTooLarge, len(inventory.Files), MaxIngestFiles)
+	}
+
+	v := inventoryValidator{paths: make(map[string]struct{}, len(inventory.Files))}
+	for _, file := range inventory.Files {
+		if err := v.file(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inventoryValidator walks an inventory checking field limits and counting
+// suites and tests so oversized uploads fail before touching the database.
+type inventoryValidator struct {
+	paths  map[string]struct{}
+	suites int
+	tests  int
+}
+
+func (v *inventoryValidator) file(file entity.IngestedTestFile) error {
+	if file.Path == "" || utf8.RuneCountInString(file.Path) > maxIngestFilePathLength {
+		return fmt.Errorf("%w: file path is required and at most %d characters", domain.ErrInvalidInput, maxIngestFilePathLength)
+	}
+	if _, dup := v.paths[file.Path]; dup {
+		return fmt.Errorf("%w: duplicate file %q", domain.ErrInvalidInput, file.Path)
+	}
+	v.paths[file.Path] = struct{}{}
+
+	if file.Framework == "" || len(file.Framework) > maxIngestFrameworkLength {
+		return fmt.Errorf("%w: %s: framework is required and at most %d characters", domain.ErrInvalidInput, file.Path, maxIngestFrameworkLength)
+	}
+
+	if len(file.Tests) > 0 {
+		// File-level tests are stored under an implicit root suite.
+		if err := v.addSuite(); err != nil {
+			return err
+		}
+		if err := v.testCases(file.Path, file.Tests); err != nil {
+			return err
+		}
+	}
+	for _, suite := range file.Suites {
+		if err := v.suite(file.Path, suite, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *inventoryValidator) suite(path string, suite entity.IngestedTestSuite, depth int) error {
+	if depth >= MaxIngestSuiteDepth {
+		return fmt.Errorf("%w: %s: suites nested deeper than %d levels", domain.ErrInvalidInput, path, MaxIngestSuiteDepth)
+	}
+	if suite.Name == "" || utf8.RuneCountInString(suite.Name) > maxIngestSuiteNameLength {
+		return fmt.Errorf("%w: %s: suite name is required and at most %d characters", domain.ErrInvalidInput, path, maxIngestSuiteNameLength)
+	}
+	if suite.Line < 0 {
+		return fmt.Errorf("%w: %s: negative line number", domain.ErrInvalidInput, path)
+	}
+	if err := v.addSuite(); err != nil {
+		return err
+	}
+	if err := v.testCases(path, suite.Tests); err != nil {
+		return err
+	}
+	for _, child := range suite.Suites {
+		if err := v.suite(path, child, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *inventoryValidator) addSuite() error {
+	v.suites++
+	if v.suites > MaxIngestSuites {
+		return fmt.Errorf("%w: more than %d suites", domain.ErrIngestTooLarge, MaxIngestSuites)
+	}
+	return nil
+}
+
+func (v *inventoryValidator) testCases(path string, tests []entity.IngestedTestCase) error {
+	v.tests += len(tests)
+	if v.tests > MaxIngestTests {
+		return fmt.Errorf("%w: more than %d tests", domain.ErrIngestTooLarge, MaxIngestTests)
+	}
+
+	for _, tc := range tests {
+		if tc.Name == "" || utf8.RuneCountInString(tc.Name) > maxIngestTestNameLength {
+			return fmt.Errorf("%w: %s: test name is required and at most %d characters", domain.ErrInvalidInput, path, maxIngestTestNameLength)
+		}
+		if !tc.Status.IsValid() {
+			return fmt.Errorf("%w: %s: invalid test status %q", domain.ErrInvalidInput, path, tc.Status)
+		}
+		if tc.Line < 0 {
+			return fmt.Errorf("%w: %s: negative line number", domain.ErrInvalidInput, path)
+		}
+		if len(tc.Modifier) > maxIngestModifierLength {
+			return fmt.Errorf("%w: %s: modifier exceeds %d characters", domain.ErrInvalidInput, path, maxIngestModifierLength)
+		}
+		if len(tc.Tags) > maxIngestTagsPerTest {
+			return fmt.Errorf("%w: %s: more than %d tags on a test", domain.ErrInvalidInput, path, maxIngestTagsPerTest)
+		}
+		for _, tag := range tc.Tags {
+			if tag == "" || utf8.RuneCountInString(tag) > maxIngestTagLength {
+				return fmt.Errorf("%w: %s: tags must be non-empty and at most %d characters", domain.ErrInvalidInput, path, maxIngestTagLength)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+const testCommitSHA = "0123456789abcdef0123456789abcdef01234567"
+
+type mockAnalysisIngestRepository struct {
+	findErr  error
+	public   bool
+	storeErr error
+	stored   *entity.IngestedInventory
+}
+
+func (m *mockAnalysisIngestRepository) FindUserRepository(_ context.Context, _, owner, repo string) (*entity.IngestRepository, error) {
+	if m.findErr != nil {
+		return nil, m.findErr
+	}
+	return &entity.IngestRepository{ExternalRepoID: "42", IsPrivate: !m.public, Name: repo, Owner: owner}, nil
+}
+
+func (m *mockAnalysisIngestRepository) StoreInventory(_ context.Context, _ string, _ *entity.IngestRepository, inventory *entity.IngestedInventory) (*entity.IngestedAnalysis, error) {
+	if m.storeErr != nil {
+		return nil, m.storeErr
+	}
+	m.stored = inventory
+	return &entity.IngestedAnalysis{
+		CommitSHA:     inventory.CommitSHA,
+		ID:            "analysis-1",
+		ParserVersion: inventory.ParserVersion,
+	}, nil
+}
+
+type mockRepositoryAccessChecker struct {
+	canClone bool
+	canPush  bool
+	err      error
+}
+
+func (m *mockRepositoryAccessChecker) CanClone(_ context.Context, _, _ string) (bool, error) {
+	return m.canClone, m.err
+}
+
+func (m *mockRepositoryAccessChecker) CanPush(_ context.Context, _, _, _ string) (bool, error) {
+	return m.canPush, m.err
+}
+
+func pushAccess() *mockRepositoryAccessChecker {
+	return &mockRepositoryAccessChecker{canPush: true}
+}
+
+func validInventory() *entity.IngestedInventory {
+	return &entity.IngestedInventory{
+		CommitSHA: strings.ToUpper(testCommitSHA),
+		Files: []entity.IngestedTestFile{
+			{
+				Framework: "vitest",
+				Path:      "src/app.test.ts",
+				Suites: []entity.IngestedTestSuite{
+					{
+						Name: "App",
+						Suites: []entity.IngestedTestSuite{
+							{Name: "render", Tests: []entity.IngestedTestCase{{Name: "renders", Status: entity.TestStatusActive}}},
+						},
+						Tests: []entity.IngestedTestCase{{Name: "boots", Status: entity.TestStatusSkipped, Tags: []string{"slow"}}},
+					},
+				},
+				Tests: []entity.IngestedTestCase{{Name: "top-level", Status: entity.TestStatusTodo}},
+			},
+		},
+		ParserVersion: " v1.5.1 ",
+	}
+}
+
+func TestIngestAnalysisUseCase_Execute(t *testing.T) {
+	t.Run("stores normalized inventory", func(t *testing.T) {
+		repo := &mockAnalysisIngestRepository{}
+		uc := usecase.NewIngestAnalysisUseCase(repo, pushAccess())
+
+		result, err := uc.Execute(context.Background(), usecase.IngestAnalysisInput{
+			Inventory: validInventory(),
+			Owner:     "owner",
+			Repo:      "repo",
+			UserID:    "user-1",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.ID != "analysis-1" {
+			t.Errorf("expected analysis-1, got %s", result.ID)
+		}
+		if repo.stored.CommitSHA != testCommitSHA {
+			t.Errorf("expected lowercased commit SHA, got %s", repo.stored.CommitSHA)
+		}
+		if repo.stored.ParserVersion != "v1.5.1" {
+			t.Errorf("expected trimmed parser version, got %q", repo.stored.ParserVersion)
+		}
+	})
+
+	t.Run("rejects invalid inventories", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			mutate func(inv *entity.IngestedInventory)
+		}{
+			{"short commit SHA", func(inv *entity.IngestedInventory) { inv.CommitSHA = "abc1234" }},
+			{"missing parser version", func(inv *entity.IngestedInventory) { inv.ParserVersion = "" }},
+			{"no files", func(inv *entity.IngestedInventory) { inv.Files = nil }},
+			{"duplicate file", func(inv *entity.IngestedInventory) { inv.Files = append(inv.Files, inv.Files[0]) }},
+			{"missing framework", func(inv *entity.IngestedInventory) { inv.Files[0].Framework = "" }},
+			{"empty suite name", func(inv *entity.IngestedInventory) { inv.Files[0].Suites[0].Name = "" }},
+			{"invalid status", func(inv *entity.IngestedInventory) { inv.Files[0].Tests[0].Status = "passed" }},
+			{"empty test name", func(inv *entity.IngestedInventory) { inv.Files[0].Tests[0].Name = "" }},
+			{"negative line", func(inv *entity.IngestedInventory) { inv.Files[0].Tests[0].Line = -1 }},
+			{"empty tag", func(inv *entity.IngestedInventory) { inv.Files[0].Tests[0].Tags = []string{""} }},
+			{"suites nested too deep", func(inv *entity.IngestedInventory) {
+				suite := entity.IngestedTestSuite{Name: "leaf"}
+				for range usecase.MaxIngestSuiteDepth {
+					suite = entity.IngestedTestSuite{Name: "level", Suites: []entity.IngestedTestSuite{suite}}
+				}
+				inv.Files[0].Suites = []entity.IngestedTestSuite{suite}
+			}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				repo := &mockAnalysisIngestRepository{}
+				uc := usecase.NewIngestAnalysisUseCase(repo, pushAccess())
+
+				inventory := validInventory()
+				tt.mutate(inventory)
+
+				_, err := uc.Execute(context.Background(), usecase.IngestAnalysisInput{
+					Inventory: inventory,
+					Owner:     "owner",
+					Repo:      "repo",
+					UserID:    "user-1",
+				})
+				if !errors.Is(err, domain.ErrInvalidInput) {
+					t.Fatalf("expected ErrInvalidInput, got %v", err)
+				}
+				if repo.stored != nil {
+					t.Error("expected nothing to be stored")
+				}
+			})
+		}
+	})
+
+	t.Run("rejects oversized inventories", func(t *testing.T) {
+		uc := usecase.NewIngestAnalysisUseCase(&mockAnalysisIngestRepository{}, pushAccess())
+
+		inventory := validInventory()
+		tests := make([]entity.IngestedTestCase, usecase.MaxIngestTests+1)
+		for i := range tests {
+			tests[i] = entity.IngestedTestCase{Name: "t", Status: entity.TestStatusActive}
+		}
+		inventory.Files[0].Tests = tests
+
+		_, err := uc.Execute(context.Background(), usecase.IngestAnalysisInput{
+			Inventory: inventory,
+			Owner:     "owner",
+			Repo:      "repo",
+			UserID:    "user-1",
+		})
+		if !errors.Is(err, domain.ErrIngestTooLarge) {
+			t.Fatalf("expected ErrIngestTooLarge, got %v", err)
+		}
+	})
+
+	t.Run("propagates repository errors", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			repo    *mockAnalysisIngestRepository
+			wantErr error
+		}{
+			{"repository not synced", &mockAnalysisIngestRepository{findErr: domain.ErrRepositoryAccessDenied}, domain.ErrRepositoryAccessDenied},
+			{"already analyzed", &mockAnalysisIngestRepository{storeErr: domain.ErrAnalysisAlreadyExists}, domain.ErrAnalysisAlreadyExists},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				uc := usecase.NewIngestAnalysisUseCase(tt.repo, pushAccess())
+
+				_, err := uc.Execute(context.Background(), usecase.IngestAnalysisInput{
+					Inventory: validInventory(),
+					Owner:     "owner",
+					Repo:      "repo",
+					UserID:    "user-1",
+				})
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+			})
+		}
+	})
+	t.Run("checks upload permission", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			repo    *mockAnalysisIngestRepository
+			access  *mockRepositoryAccessChecker
+			wantErr error
+		}{
+			{"push access to public repository", &mockAnalysisIngestRepository{public: true}, &mockRepositoryAccessChecker{canPush: true, canClone: true}, nil},
+			{"read access to public repository", &mockAnalysisIngestRepository{public: true}, &mockRepositoryAccessChecker{}, domain.ErrIngestNotPermitted},
+			{"read access to private repository the backend can clone", &mockAnalysisIngestRepository{}, &mockRepositoryAccessChecker{canClone: true}, domain.ErrIngestNotPermitted},
+			{"read access to private repository the backend cannot clone", &mockAnalysisIngestRepository{}, &mockRepositoryAccessChecker{}, nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				uc := usecase.NewIngestAnalysisUseCase(tt.repo, tt.access)
+
+				_, err := uc.Execute(context.Background(), usecase.IngestAnalysisInput{
+					Inventory: validInventory(),
+					Owner:     "owner",
+					Repo:      "repo",
+					UserID:    "user-1",
+				})
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if stored := tt.repo.stored != nil; stored != (tt.wantErr == nil) {
+					t.Errorf("stored = %v, want %v", stored, tt.wantErr == nil)
+				}
+			})
+		}
+	})
+}
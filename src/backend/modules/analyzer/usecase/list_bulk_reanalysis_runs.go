@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+const defaultBulkReanalysisRunsLimit = 20
+
+type ListBulkReanalysisRunsInput struct {
+	Limit int
+}
+
+type ListBulkReanalysisRunsUseCase struct {
+	repository port.BulkReanalysisRepository
+}
+
+func NewListBulkReanalysisRunsUseCase(repository port.BulkReanalysisRepository) *ListBulkReanalysisRunsUseCase {
+	return &ListBulkReanalysisRunsUseCase{repository: repository}
+}
+
+func (uc *ListBulkReanalysisRunsUseCase) Execute(ctx context.Context, input ListBulkReanalysisRunsInput) ([]*entity.BulkReanalysisRun, error) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultBulkReanalysisRunsLimit
+	}
+	return uc.repository.ListRuns(ctx, limit)
+}
@@ -57,13 +57,14 @@ func (uc *ListRepositoryCardsUseCase) ExecutePaginated(ctx context.Context, inpu
 	}
 
 	repos, err := uc.repository.GetPaginatedRepositories(ctx, port.PaginationParams{
-		Cursor:    cursor,
-		Limit:     limit + 1,
-		Ownership: ownership,
-		SortBy:    sortBy,
-		SortOrder: sortOrder,
-		UserID:    input.UserID,
-		View:      view,
+		Cursor:       cursor,
+		Limit:        limit + 1,
+		Ownership:    ownership,
+		SortBy:       sortBy,
+		SortOrder:    sortOrder,
+		TrendingDays: entity.TrendingWindowDays,
+		UserID:       input.UserID,
+		View:         view,
 	})
 	if err != nil {
 		return entity.PaginatedRepositoryCards{}, fmt.Errorf("get paginated repositories: %w", err)
@@ -85,11 +86,12 @@ func (uc *ListRepositoryCardsUseCase) ExecutePaginated(ctx context.Context, inpu
 	if hasNext && len(repos) > 0 {
 		last := repos[len(repos)-1]
 		encoded := entity.EncodeCursor(entity.RepositoryCursor{
-			AnalyzedAt: last.AnalyzedAt,
-			ID:         last.CodebaseID,
-			Name:       last.Name,
-			SortBy:     sortBy,
-			TestCount:  last.TotalTests,
+			AnalyzedAt:    last.AnalyzedAt,
+			ID:            last.CodebaseID,
+			Name:          last.Name,
+			SortBy:        sortBy,
+			TestCount:     last.TotalTests,
+			TrendingScore: last.TrendingScore,
 		})
 		nextCursor = &encoded
 	}
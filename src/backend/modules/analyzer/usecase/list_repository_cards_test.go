@@ -13,6 +13,8 @@ import (
 type mockRepository struct {
 	bookmarkedIDs      []string
 	getPaginatedCalled bool
+	orgSummaries       []port.OrgRepositoryTestSummary
+	orgSummariesOwner  string
 	paginatedRepos     []port.PaginatedRepository
 	paginationParams   port.PaginationParams
 	previousAnalysis   *port.PreviousAnalysis
@@ -52,6 +54,11 @@ func (m *mockRepository) GetPreviousAnalysis(_ context.Context, _, _ string) (*p
 	return m.previousAnalysis, nil
 }
 
+func (m *mockRepository) GetOrgRepositoryTestSummaries(_ context.Context, owner string, _ time.Time) ([]port.OrgRepositoryTestSummary, error) {
+	m.orgSummariesOwner = owner
+	return m.orgSummaries, nil
+}
+
 func (m *mockRepository) GetRepositoryStats(_ context.Context, _ string) (*entity.RepositoryStats, error) {
 	return nil, nil
 }
@@ -60,7 +67,7 @@ func (m *mockRepository) GetTestSuitesWithCases(_ context.Context, _ string) ([]
 	return nil, nil
 }
 
-func (m *mockRepository) UpdateLastViewed(_ context.Context, _, _ string) error {
+func (m *mockRepository) UpdateLastViewed(_ context.Context, _, _, _ string) error {
 	return nil
 }
 
@@ -230,6 +237,50 @@ func TestExecutePaginated_WithCursor(t *testing.T) {
 	}
 }
 
+func TestExecutePaginated_Trending(t *testing.T) {
+	t.Parallel()
+
+	repos := make([]port.PaginatedRepository, 3)
+	for i := range repos {
+		repos[i] = port.PaginatedRepository{
+			AnalysisID:    "analysis",
+			CodebaseID:    "codebase-" + string(rune('a'+i)),
+			Name:          "repo" + string(rune('a'+i)),
+			Owner:         "owner",
+			TrendingScore: 30 - i*10,
+		}
+	}
+
+	repo := &mockRepository{paginatedRepos: repos}
+	uc := usecase.NewListRepositoryCardsUseCase(&mockGitClient{}, repo, &mockTokenProvider{})
+
+	result, err := uc.ExecutePaginated(context.Background(), usecase.ListRepositoryCardsPaginatedInput{
+		Limit:  2,
+		SortBy: entity.SortByTrending,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if repo.paginationParams.SortOrder != entity.SortOrderDesc {
+		t.Errorf("expected desc order for trending, got %s", repo.paginationParams.SortOrder)
+	}
+	if days := repo.paginationParams.TrendingDays; days != entity.TrendingWindowDays {
+		t.Errorf("expected trending window of %d days, got %d", entity.TrendingWindowDays, days)
+	}
+
+	if result.NextCursor == nil {
+		t.Fatal("expected next cursor")
+	}
+	cursor, err := entity.DecodeCursor(*result.NextCursor, entity.SortByTrending)
+	if err != nil {
+		t.Fatalf("unexpected cursor error: %v", err)
+	}
+	if cursor.TrendingScore != 20 || cursor.ID != "codebase-b" {
+		t.Errorf("expected cursor at codebase-b with score 20, got %+v", cursor)
+	}
+}
+
 func TestExecutePaginated_SortByMismatch_RestartsFromBeginning(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/specvital/web/src/backend/internal/client"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	subscription "github.com/specvital/web/src/backend/modules/subscription/domain/entity"
+)
+
+type ProcessAnalysisBatchInput struct {
+	BatchID string
+}
+
+type ProcessAnalysisBatchResult struct {
+	Cached int `json:"cached"`
+	Failed int `json:"failed"`
+	Queued int `json:"queued"`
+}
+
+// ProcessAnalysisBatchUseCase submits every pending item of a batch through the
+// regular analyze flow, so cache hits, in-flight jobs and quota reservations
+// behave exactly as if the user had clicked "analyze" on each repository.
+// Item outcomes are persisted one by one, so a retried job skips finished items.
+type ProcessAnalysisBatchUseCase struct {
+	analyze    *AnalyzeRepositoryUseCase
+	repository port.AnalysisBatchRepository
+	tierLookup port.TierLookup
+}
+
+func NewProcessAnalysisBatchUseCase(
+	analyze *AnalyzeRepositoryUseCase,
+	repository port.AnalysisBatchRepository,
+	tierLookup port.TierLookup,
+) *ProcessAnalysisBatchUseCase {
+	return &ProcessAnalysisBatchUseCase{
+		analyze:    analyze,
+		repository: repository,
+		tierLookup: tierLookup,
+	}
+}
+
+func (uc *ProcessAnalysisBatchUseCase) Execute(ctx context.Context, input ProcessAnalysisBatchInput) (*ProcessAnalysisBatchResult, error) {
+	if input.BatchID == "" {
+		return nil, errors.New("batch ID is required")
+	}
+
+	batch, err := uc.repository.GetBatch(ctx, input.BatchID)
+	if err != nil {
+		return nil, fmt.Errorf("get batch: %w", err)
+	}
+
+	items, err := uc.repository.ListPendingItems(ctx, batch.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list pending items: %w", err)
+	}
+
+	tier := uc.lookupTier(ctx, batch.UserID)
+	result := &ProcessAnalysisBatchResult{}
+
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		status, commitSHA, errorMessage := uc.submit(ctx, batch.UserID, tier, item)
+		switch status {
+		case entity.AnalysisBatchItemStatusCached:
+			result.Cached++
+		case entity.AnalysisBatchItemStatusFailed:
+			result.Failed++
+		default:
+			result.Queued++
+		}
+
+		if err := uc.repository.UpdateItem(ctx, item.ID, status, commitSHA, errorMessage); err != nil {
+			return result, fmt.Errorf("update item %s/%s: %w", item.Owner, item.Repo, err)
+		}
+	}
+
+	if err := uc.repository.MarkSubmitted(ctx, batch.ID); err != nil {
+		return result, fmt.Errorf("mark batch submitted: %w", err)
+	}
+
+	return result, nil
+}
+
+func (uc *ProcessAnalysisBatchUseCase) submit(
+	ctx context.Context,
+	userID string,
+	tier subscription.PlanTier,
+	item port.PendingAnalysisBatchItem,
+) (entity.AnalysisBatchItemStatus, *string, *string) {
+	analyzed, err := uc.analyze.Execute(ctx, AnalyzeRepositoryInput{
+		Owner:  item.Owner,
+		Repo:   item.Repo,
+		Tier:   tier,
+		UserID: userID,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "batch analysis submit failed",
+			"owner", item.Owner, "repo", item.Repo, "error", err)
+		message := batchItemErrorMessage(err)
+		return entity.AnalysisBatchItemStatusFailed, nil, &message
+	}
+
+	if analyzed.Analysis != nil {
+		return entity.AnalysisBatchItemStatusCached, &analyzed.Analysis.CommitSHA, nil
+	}
+	return entity.AnalysisBatchItemStatusQueued, &analyzed.Progress.CommitSHA, nil
+}
+
+func (uc *ProcessAnalysisBatchUseCase) lookupTier(ctx context.Context, userID string) subscription.PlanTier {
+	if uc.tierLookup == nil {
+		return ""
+	}
+	tier, err := uc.tierLookup.GetUserTier(ctx, userID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to lookup user tier, using default", "user_id", userID, "error", err)
+		return ""
+	}
+	return subscription.PlanTier(tier)
+}
+
+// batchItemErrorMessage keeps internal error details out of the user-facing batch status.
+func batchItemErrorMessage(err error) string {
+	switch {
+	case errors.Is(err, client.ErrRepoNotFound):
+		return "repository not found"
+	case errors.Is(err, client.ErrForbidden):
+		return "repository access forbidden"
+	default:
+		return "failed to queue analysis"
+	}
+}
@@ -0,0 +1,214 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/internal/client"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+	subscription "github.com/specvital/web/src/backend/modules/subscription/domain/entity"
+)
+
+type mockTierLookup struct {
+	err  error
+	tier string
+}
+
+func (m *mockTierLookup) GetUserTier(_ context.Context, _ string) (string, error) {
+	return m.tier, m.err
+}
+
+func newPendingBatchRepository(repos ...string) *mockAnalysisBatchRepository {
+	items := make([]port.PendingAnalysisBatchItem, len(repos))
+	for i, repo := range repos {
+		items[i] = port.PendingAnalysisBatchItem{ID: "item-" + repo, Owner: "acme", Repo: repo}
+	}
+	return &mockAnalysisBatchRepository{
+		batch: &entity.AnalysisBatch{ID: "batch-1", UserID: "user-1"},
+		items: items,
+	}
+}
+
+func TestProcessAnalysisBatchUseCase_Execute(t *testing.T) {
+	t.Run("queues every pending item with the batch owner's tier", func(t *testing.T) {
+		mocks := newAnalyzeRepoMocks()
+		mocks.gitClient.latestSHA = "abc123"
+		repo := newPendingBatchRepository("api", "web", "worker")
+		uc := usecase.NewProcessAnalysisBatchUseCase(mocks.newUseCase(), repo, &mockTierLookup{tier: "pro"})
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessAnalysisBatchInput{BatchID: "batch-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Queued != 3 || result.Cached != 0 || result.Failed != 0 {
+			t.Errorf("expected 3 queued, got %+v", result)
+		}
+		if len(repo.updates) != 3 {
+			t.Fatalf("expected 3 item updates, got %d", len(repo.updates))
+		}
+		for _, u := range repo.updates {
+			if u.status != entity.AnalysisBatchItemStatusQueued || u.commitSHA == nil || *u.commitSHA != "abc123" {
+				t.Errorf("unexpected update for %s: %+v", u.itemID, u)
+			}
+		}
+		if mocks.queue.enqueuedTier != subscription.PlanTier("pro") {
+			t.Errorf("expected pro tier, got %q", mocks.queue.enqueuedTier)
+		}
+		if !repo.submitted {
+			t.Error("expected batch to be marked submitted")
+		}
+	})
+
+	t.Run("records cached items without enqueueing", func(t *testing.T) {
+		mocks := newAnalyzeRepoMocks()
+		version := "v1.0.0"
+		mocks.gitClient.latestSHA = "abc123"
+		mocks.systemConfig.parserVersion = version
+		mocks.repository.completedAnalysis = &port.CompletedAnalysis{
+			CommitSHA:     "abc123",
+			CompletedAt:   time.Now(),
+			ID:            "analysis-1",
+			Owner:         "acme",
+			ParserVersion: &version,
+			Repo:          "api",
+		}
+		repo := newPendingBatchRepository("api")
+		uc := usecase.NewProcessAnalysisBatchUseCase(mocks.newUseCase(), repo, nil)
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessAnalysisBatchInput{BatchID: "batch-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Cached != 1 {
+			t.Errorf("expected 1 cached, got %+v", result)
+		}
+		if repo.updates[0].status != entity.AnalysisBatchItemStatusCached {
+			t.Errorf("expected cached status, got %s", repo.updates[0].status)
+		}
+		if mocks.queue.enqueueCalled {
+			t.Error("expected no enqueue for cached item")
+		}
+	})
+
+	t.Run("keeps going when individual items fail", func(t *testing.T) {
+		mocks := newAnalyzeRepoMocks()
+		mocks.gitClient.latestSHA = "abc123"
+		mocks.gitClient.errByRepo = map[string]error{
+			"gone":    client.ErrRepoNotFound,
+			"private": client.ErrForbidden,
+		}
+		repo := newPendingBatchRepository("api", "gone", "private", "web")
+		uc := usecase.NewProcessAnalysisBatchUseCase(mocks.newUseCase(), repo, nil)
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessAnalysisBatchInput{BatchID: "batch-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Queued != 2 || result.Failed != 2 {
+			t.Errorf("expected 2 queued and 2 failed, got %+v", result)
+		}
+
+		messages := map[string]string{}
+		for _, u := range repo.updates {
+			if u.status == entity.AnalysisBatchItemStatusFailed {
+				if u.errorMessage == nil {
+					t.Fatalf("expected error message for %s", u.itemID)
+				}
+				messages[u.itemID] = *u.errorMessage
+			}
+		}
+		if messages["item-gone"] != "repository not found" {
+			t.Errorf("unexpected message for missing repo: %q", messages["item-gone"])
+		}
+		if messages["item-private"] != "repository access forbidden" {
+			t.Errorf("unexpected message for forbidden repo: %q", messages["item-private"])
+		}
+		if !repo.submitted {
+			t.Error("expected batch to be marked submitted despite item failures")
+		}
+	})
+
+	t.Run("hides internal errors from item messages", func(t *testing.T) {
+		mocks := newAnalyzeRepoMocks()
+		mocks.gitClient.latestSHA = "abc123"
+		mocks.queue.enqueueErr = errors.New("connection refused on 10.0.0.3")
+		repo := newPendingBatchRepository("api")
+		uc := usecase.NewProcessAnalysisBatchUseCase(mocks.newUseCase(), repo, nil)
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessAnalysisBatchInput{BatchID: "batch-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Failed != 1 {
+			t.Fatalf("expected 1 failed, got %+v", result)
+		}
+		if msg := repo.updates[0].errorMessage; msg == nil || *msg != "failed to queue analysis" {
+			t.Errorf("expected generic message, got %v", msg)
+		}
+	})
+
+	t.Run("falls back to the default tier when lookup fails", func(t *testing.T) {
+		mocks := newAnalyzeRepoMocks()
+		mocks.gitClient.latestSHA = "abc123"
+		repo := newPendingBatchRepository("api")
+		uc := usecase.NewProcessAnalysisBatchUseCase(mocks.newUseCase(), repo, &mockTierLookup{err: errors.New("db down")})
+
+		if _, err := uc.Execute(context.Background(), usecase.ProcessAnalysisBatchInput{BatchID: "batch-1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if mocks.queue.enqueuedTier != "" {
+			t.Errorf("expected default tier, got %q", mocks.queue.enqueuedTier)
+		}
+	})
+
+	t.Run("stops without marking submitted when an item update fails", func(t *testing.T) {
+		mocks := newAnalyzeRepoMocks()
+		mocks.gitClient.latestSHA = "abc123"
+		repo := newPendingBatchRepository("api", "web")
+		repo.updateErr = errors.New("update failed")
+		uc := usecase.NewProcessAnalysisBatchUseCase(mocks.newUseCase(), repo, nil)
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessAnalysisBatchInput{BatchID: "batch-1"})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if result == nil || result.Queued != 1 {
+			t.Errorf("expected partial result with 1 queued, got %+v", result)
+		}
+		if repo.submitted {
+			t.Error("expected batch not to be marked submitted")
+		}
+	})
+
+	t.Run("stops without marking submitted when the context is cancelled", func(t *testing.T) {
+		mocks := newAnalyzeRepoMocks()
+		mocks.gitClient.latestSHA = "abc123"
+		repo := newPendingBatchRepository("api", "web")
+		uc := usecase.NewProcessAnalysisBatchUseCase(mocks.newUseCase(), repo, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := uc.Execute(ctx, usecase.ProcessAnalysisBatchInput{BatchID: "batch-1"})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		if len(repo.updates) != 0 || repo.submitted {
+			t.Errorf("expected no progress, got %d updates, submitted=%v", len(repo.updates), repo.submitted)
+		}
+	})
+
+	t.Run("returns not found for unknown batch", func(t *testing.T) {
+		uc := usecase.NewProcessAnalysisBatchUseCase(newAnalyzeRepoMocks().newUseCase(), &mockAnalysisBatchRepository{}, nil)
+
+		_, err := uc.Execute(context.Background(), usecase.ProcessAnalysisBatchInput{BatchID: "missing"})
+		if !errors.Is(err, domain.ErrAnalysisBatchNotFound) {
+			t.Errorf("expected ErrAnalysisBatchNotFound, got %v", err)
+		}
+	})
+}
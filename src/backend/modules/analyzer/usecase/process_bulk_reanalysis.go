@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golang.org/x/time/rate"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+const bulkReanalysisBatchSize = 100
+
+type ProcessBulkReanalysisInput struct {
+	RunID string
+}
+
+type ProcessBulkReanalysisResult struct {
+	Enqueued int `json:"enqueued"`
+	Failed   int `json:"failed"`
+}
+
+// ProcessBulkReanalysisUseCase walks every outdated codebase of a run in keyset
+// order and enqueues a scheduled re-analysis of its latest analyzed commit.
+// Progress is persisted after each batch so a retried job resumes where it stopped.
+type ProcessBulkReanalysisUseCase struct {
+	limiter    *rate.Limiter
+	queue      port.ScheduledQueueService
+	repository port.BulkReanalysisRepository
+}
+
+// NewProcessBulkReanalysisUseCase creates the use case. enqueueRate caps how many
+// analysis jobs are inserted per second.
+func NewProcessBulkReanalysisUseCase(
+	queue port.ScheduledQueueService,
+	repository port.BulkReanalysisRepository,
+	enqueueRate rate.Limit,
+) *ProcessBulkReanalysisUseCase {
+	return &ProcessBulkReanalysisUseCase{
+		limiter:    rate.NewLimiter(enqueueRate, 1),
+		queue:      queue,
+		repository: repository,
+	}
+}
+
+func (uc *ProcessBulkReanalysisUseCase) Execute(ctx context.Context, input ProcessBulkReanalysisInput) (*ProcessBulkReanalysisResult, error) {
+	if input.RunID == "" {
+		return nil, errors.New("run ID is required")
+	}
+
+	run, err := uc.repository.GetRun(ctx, input.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("get run: %w", err)
+	}
+
+	result := &ProcessBulkReanalysisResult{
+		Enqueued: run.EnqueuedCount,
+		Failed:   run.FailedCount,
+	}
+	if !run.Status.IsActive() {
+		return result, nil
+	}
+
+	if err := uc.repository.MarkRunStarted(ctx, run.ID); err != nil {
+		return nil, fmt.Errorf("mark run started: %w", err)
+	}
+
+	cursor := run.LastCodebaseID
+	for {
+		batch, err := uc.repository.ListOutdatedCodebases(ctx, run.ParserVersion, cursor, bulkReanalysisBatchSize)
+		if err != nil {
+			return result, fmt.Errorf("list outdated codebases: %w", err)
+		}
+
+		for _, codebase := range batch {
+			if err := uc.limiter.Wait(ctx); err != nil {
+				uc.saveProgress(ctx, run.ID, result, cursor)
+				return result, fmt.Errorf("wait for rate limiter: %w", err)
+			}
+
+			if err := uc.queue.EnqueueScheduled(ctx, codebase.Owner, codebase.Repo, codebase.CommitSHA); err != nil {
+				slog.WarnContext(ctx, "bulk re-analysis enqueue failed",
+					"run_id", run.ID, "owner", codebase.Owner, "repo", codebase.Repo, "error", err)
+				result.Failed++
+			} else {
+				result.Enqueued++
+			}
+			cursor = codebase.CodebaseID
+		}
+
+		if len(batch) > 0 {
+			if err := uc.repository.UpdateRunProgress(ctx, run.ID, result.Enqueued, result.Failed, cursor); err != nil {
+				return result, fmt.Errorf("update run progress: %w", err)
+			}
+		}
+
+		if len(batch) < bulkReanalysisBatchSize {
+			break
+		}
+	}
+
+	if err := uc.repository.CompleteRun(ctx, run.ID); err != nil {
+		return result, fmt.Errorf("complete run: %w", err)
+	}
+
+	return result, nil
+}
+
+// saveProgress persists the cursor on interruption using a context that outlives the canceled one.
+func (uc *ProcessBulkReanalysisUseCase) saveProgress(ctx context.Context, runID string, result *ProcessBulkReanalysisResult, cursor string) {
+	if cursor == "" {
+		return
+	}
+	if err := uc.repository.UpdateRunProgress(context.WithoutCancel(ctx), runID, result.Enqueued, result.Failed, cursor); err != nil {
+		slog.WarnContext(ctx, "failed to save bulk re-analysis progress", "run_id", runID, "error", err)
+	}
+}
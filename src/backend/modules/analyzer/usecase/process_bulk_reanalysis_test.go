@@ -0,0 +1,218 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"golang.org/x/time/rate"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+// mockBulkReanalysisRepository implements port.BulkReanalysisRepository.
+type mockBulkReanalysisRepository struct {
+	activeRun    *entity.BulkReanalysisRun
+	codebases    []entity.OutdatedCodebase
+	completed    bool
+	createParams *port.CreateBulkReanalysisRunParams
+	failedReason string
+	run          *entity.BulkReanalysisRun
+	started      bool
+
+	progressCursor   string
+	progressEnqueued int
+	progressFailed   int
+	progressUpdates  int
+}
+
+func (m *mockBulkReanalysisRepository) CompleteRun(_ context.Context, _ string) error {
+	m.completed = true
+	return nil
+}
+func (m *mockBulkReanalysisRepository) CountOutdatedCodebases(_ context.Context, _ string) (int, error) {
+	return len(m.codebases), nil
+}
+func (m *mockBulkReanalysisRepository) CreateRun(_ context.Context, params port.CreateBulkReanalysisRunParams) (*entity.BulkReanalysisRun, error) {
+	m.createParams = &params
+	return &entity.BulkReanalysisRun{
+		ID:            "run-1",
+		ParserVersion: params.ParserVersion,
+		Status:        entity.BulkReanalysisStatusPending,
+		TotalCount:    params.TotalCount,
+		Trigger:       params.Trigger,
+		TriggeredBy:   params.TriggeredBy,
+	}, nil
+}
+func (m *mockBulkReanalysisRepository) FailRun(_ context.Context, _, errorMessage string) error {
+	m.failedReason = errorMessage
+	return nil
+}
+func (m *mockBulkReanalysisRepository) GetActiveRun(_ context.Context) (*entity.BulkReanalysisRun, error) {
+	return m.activeRun, nil
+}
+func (m *mockBulkReanalysisRepository) GetLatestRun(_ context.Context) (*entity.BulkReanalysisRun, error) {
+	return m.run, nil
+}
+func (m *mockBulkReanalysisRepository) GetRun(_ context.Context, _ string) (*entity.BulkReanalysisRun, error) {
+	if m.run == nil {
+		return nil, domain.ErrBulkReanalysisNotFound
+	}
+	return m.run, nil
+}
+func (m *mockBulkReanalysisRepository) ListOutdatedCodebases(_ context.Context, _, afterCodebaseID string, limit int) ([]entity.OutdatedCodebase, error) {
+	start := 0
+	if afterCodebaseID != "" {
+		for i, c := range m.codebases {
+			if c.CodebaseID == afterCodebaseID {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end := min(start+limit, len(m.codebases))
+	return m.codebases[start:end], nil
+}
+func (m *mockBulkReanalysisRepository) ListRuns(_ context.Context, _ int) ([]*entity.BulkReanalysisRun, error) {
+	return nil, nil
+}
+func (m *mockBulkReanalysisRepository) MarkRunStarted(_ context.Context, _ string) error {
+	m.started = true
+	return nil
+}
+func (m *mockBulkReanalysisRepository) UpdateRunProgress(_ context.Context, _ string, enqueued, failed int, lastCodebaseID string) error {
+	m.progressUpdates++
+	m.progressEnqueued = enqueued
+	m.progressFailed = failed
+	m.progressCursor = lastCodebaseID
+	return nil
+}
+
+// mockScheduledQueueService implements port.ScheduledQueueService.
+type mockScheduledQueueService struct {
+	enqueued []string
+	failFor  map[string]bool
+}
+
+func (m *mockScheduledQueueService) EnqueueScheduled(_ context.Context, owner, repo, _ string) error {
+	if m.failFor[repo] {
+		return errors.New("insert failed")
+	}
+	m.enqueued = append(m.enqueued, owner+"/"+repo)
+	return nil
+}
+
+func newOutdatedCodebases(n int) []entity.OutdatedCodebase {
+	codebases := make([]entity.OutdatedCodebase, n)
+	for i := range codebases {
+		codebases[i] = entity.OutdatedCodebase{
+			CodebaseID: fmt.Sprintf("codebase-%03d", i),
+			CommitSHA:  fmt.Sprintf("sha-%03d", i),
+			Owner:      "owner",
+			Repo:       fmt.Sprintf("repo-%03d", i),
+		}
+	}
+	return codebases
+}
+
+func TestProcessBulkReanalysisUseCase_Execute(t *testing.T) {
+	t.Run("enqueues every outdated codebase across batches", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{
+			codebases: newOutdatedCodebases(250),
+			run:       &entity.BulkReanalysisRun{ID: "run-1", ParserVersion: "v2", Status: entity.BulkReanalysisStatusPending},
+		}
+		queue := &mockScheduledQueueService{}
+		uc := usecase.NewProcessBulkReanalysisUseCase(queue, repo, rate.Inf)
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessBulkReanalysisInput{RunID: "run-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Enqueued != 250 || result.Failed != 0 {
+			t.Errorf("expected 250 enqueued and 0 failed, got %+v", result)
+		}
+		if !repo.started || !repo.completed {
+			t.Errorf("expected run to be started and completed, got started=%v completed=%v", repo.started, repo.completed)
+		}
+		if repo.progressUpdates != 3 {
+			t.Errorf("expected 3 progress updates, got %d", repo.progressUpdates)
+		}
+		if repo.progressCursor != "codebase-249" {
+			t.Errorf("expected cursor codebase-249, got %s", repo.progressCursor)
+		}
+	})
+
+	t.Run("counts enqueue failures without aborting", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{
+			codebases: newOutdatedCodebases(3),
+			run:       &entity.BulkReanalysisRun{ID: "run-1", Status: entity.BulkReanalysisStatusRunning},
+		}
+		queue := &mockScheduledQueueService{failFor: map[string]bool{"repo-001": true}}
+		uc := usecase.NewProcessBulkReanalysisUseCase(queue, repo, rate.Inf)
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessBulkReanalysisInput{RunID: "run-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Enqueued != 2 || result.Failed != 1 {
+			t.Errorf("expected 2 enqueued and 1 failed, got %+v", result)
+		}
+		if repo.progressEnqueued != 2 || repo.progressFailed != 1 {
+			t.Errorf("expected persisted progress 2/1, got %d/%d", repo.progressEnqueued, repo.progressFailed)
+		}
+	})
+
+	t.Run("resumes from the saved cursor", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{
+			codebases: newOutdatedCodebases(5),
+			run: &entity.BulkReanalysisRun{
+				EnqueuedCount:  2,
+				ID:             "run-1",
+				LastCodebaseID: "codebase-001",
+				Status:         entity.BulkReanalysisStatusRunning,
+			},
+		}
+		queue := &mockScheduledQueueService{}
+		uc := usecase.NewProcessBulkReanalysisUseCase(queue, repo, rate.Inf)
+
+		result, err := uc.Execute(context.Background(), usecase.ProcessBulkReanalysisInput{RunID: "run-1"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(queue.enqueued) != 3 {
+			t.Errorf("expected 3 new enqueues, got %d", len(queue.enqueued))
+		}
+		if result.Enqueued != 5 {
+			t.Errorf("expected cumulative enqueued 5, got %d", result.Enqueued)
+		}
+	})
+
+	t.Run("skips finished runs", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{
+			codebases: newOutdatedCodebases(3),
+			run:       &entity.BulkReanalysisRun{ID: "run-1", Status: entity.BulkReanalysisStatusCompleted},
+		}
+		queue := &mockScheduledQueueService{}
+		uc := usecase.NewProcessBulkReanalysisUseCase(queue, repo, rate.Inf)
+
+		if _, err := uc.Execute(context.Background(), usecase.ProcessBulkReanalysisInput{RunID: "run-1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if repo.started || len(queue.enqueued) != 0 {
+			t.Error("expected completed run to be left untouched")
+		}
+	})
+
+	t.Run("returns not found for unknown run", func(t *testing.T) {
+		uc := usecase.NewProcessBulkReanalysisUseCase(&mockScheduledQueueService{}, &mockBulkReanalysisRepository{}, rate.Inf)
+
+		_, err := uc.Execute(context.Background(), usecase.ProcessBulkReanalysisInput{RunID: "missing"})
+		if !errors.Is(err, domain.ErrBulkReanalysisNotFound) {
+			t.Errorf("expected ErrBulkReanalysisNotFound, got %v", err)
+		}
+	})
+}
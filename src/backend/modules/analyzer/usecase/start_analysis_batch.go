@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	usageentity "github.com/specvital/web/src/backend/modules/usage/domain/entity"
+	usageusecase "github.com/specvital/web/src/backend/modules/usage/usecase"
+)
+
+const MaxAnalysisBatchSize = 500
+
+// StartAnalysisBatchInput selects repositories either by organization or by an
+// explicit list. Archived/fork filters only apply to organization batches since
+// explicit entries carry no repository metadata.
+type StartAnalysisBatchInput struct {
+	ExcludeArchived bool
+	ExcludeForks    bool
+	OrgLogin        string
+	Repositories    []entity.RepositoryRef
+	UserID          string
+}
+
+// StartAnalysisBatchUseCase resolves the repositories of a batch, checks the
+// whole batch against the user's monthly analysis quota and hands it to the
+// background worker that enqueues the individual analyses.
+type StartAnalysisBatchUseCase struct {
+	checkQuota *usageusecase.CheckQuotaUseCase
+	orgLister  port.OrgRepositoryLister
+	repository port.AnalysisBatchRepository
+	scheduler  port.AnalysisBatchScheduler
+}
+
+func NewStartAnalysisBatchUseCase(
+	orgLister port.OrgRepositoryLister,
+	repository port.AnalysisBatchRepository,
+	scheduler port.AnalysisBatchScheduler,
+	checkQuota *usageusecase.CheckQuotaUseCase,
+) *StartAnalysisBatchUseCase {
+	return &StartAnalysisBatchUseCase{
+		checkQuota: checkQuota,
+		orgLister:  orgLister,
+		repository: repository,
+		scheduler:  scheduler,
+	}
+}
+
+func (uc *StartAnalysisBatchUseCase) Execute(ctx context.Context, input StartAnalysisBatchInput) (*entity.AnalysisBatch, error) {
+	if input.UserID == "" {
+		return nil, errors.New("user ID is required")
+	}
+	if (input.OrgLogin == "") == (len(input.Repositories) == 0) {
+		return nil, fmt.Errorf("%w: exactly one of org or repositories is required", domain.ErrInvalidInput)
+	}
+
+	var orgLogin *string
+	var candidates []entity.RepositoryRef
+	skipped := 0
+
+	if input.OrgLogin != "" {
+		orgLogin = &input.OrgLogin
+		repos, err := uc.orgLister.ListOrgRepositories(ctx, input.UserID, input.OrgLogin)
+		if err != nil {
+			return nil, fmt.Errorf("list repositories of %s: %w", input.OrgLogin, err)
+		}
+		for _, repo := range repos {
+			if (input.ExcludeArchived && repo.Archived) || (input.ExcludeForks && repo.Fork) {
+				skipped++
+				continue
+			}
+			candidates = append(candidates, entity.RepositoryRef{Owner: repo.Owner, Repo: repo.Name})
+		}
+	} else {
+		candidates = input.Repositories
+	}
+
+	refs := dedupeRepositoryRefs(candidates)
+	if len(refs) == 0 {
+		return nil, domain.ErrAnalysisBatchEmpty
+	}
+	if len(refs) > MaxAnalysisBatchSize {
+		return nil, fmt.Errorf("%w: %d repositories (max %d)", domain.ErrAnalysisBatchTooLarge, len(refs), MaxAnalysisBatchSize)
+	}
+
+	// The whole batch is checked up front so a large organization is rejected
+	// instead of being half-analyzed. Cached repositories will not actually consume quota.
+	if uc.checkQuota != nil {
+		quota, err := uc.checkQuota.Execute(ctx, usageusecase.CheckQuotaInput{
+			Amount:    len(refs),
+			EventType: usageentity.EventTypeAnalysis,
+			UserID:    input.UserID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("check quota for user %s: %w", input.UserID, err)
+		}
+		if !quota.IsAllowed {
+			return nil, domain.ErrQuotaExceeded
+		}
+	}
+
+	batch, err := uc.repository.CreateBatch(ctx, port.CreateAnalysisBatchParams{
+		OrgLogin:     orgLogin,
+		Repositories: refs,
+		SkippedCount: skipped,
+		UserID:       input.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create batch: %w", err)
+	}
+
+	if err := uc.scheduler.ScheduleBatch(ctx, batch.ID); err != nil {
+		return nil, fmt.Errorf("schedule batch: %w", err)
+	}
+
+	return batch, nil
+}
+
+func dedupeRepositoryRefs(refs []entity.RepositoryRef) []entity.RepositoryRef {
+	seen := make(map[string]struct{}, len(refs))
+	result := make([]entity.RepositoryRef, 0, len(refs))
+	for _, ref := range refs {
+		key := strings.ToLower(ref.Owner + "/" + ref.Repo)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		result = append(result, ref)
+	}
+	return result
+}
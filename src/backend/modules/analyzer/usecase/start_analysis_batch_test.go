@@ -0,0 +1,192 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+type mockOrgRepositoryLister struct {
+	err   error
+	repos []port.OrgRepository
+}
+
+func (m *mockOrgRepositoryLister) ListOrgRepositories(_ context.Context, _, _ string) ([]port.OrgRepository, error) {
+	return m.repos, m.err
+}
+
+type mockAnalysisBatchRepository struct {
+	batch     *entity.AnalysisBatch
+	created   *port.CreateAnalysisBatchParams
+	items     []port.PendingAnalysisBatchItem
+	submitted bool
+	updateErr error
+	updates   []batchItemUpdate
+}
+
+type batchItemUpdate struct {
+	commitSHA    *string
+	errorMessage *string
+	itemID       string
+	status       entity.AnalysisBatchItemStatus
+}
+
+func (m *mockAnalysisBatchRepository) CreateBatch(_ context.Context, params port.CreateAnalysisBatchParams) (*entity.AnalysisBatch, error) {
+	m.created = &params
+	return &entity.AnalysisBatch{
+		ID:           "batch-1",
+		SkippedCount: params.SkippedCount,
+		TotalCount:   len(params.Repositories),
+		UserID:       params.UserID,
+	}, nil
+}
+func (m *mockAnalysisBatchRepository) GetBatch(_ context.Context, _ string) (*entity.AnalysisBatch, error) {
+	if m.batch == nil {
+		return nil, domain.ErrAnalysisBatchNotFound
+	}
+	return m.batch, nil
+}
+func (m *mockAnalysisBatchRepository) ListPendingItems(_ context.Context, _ string) ([]port.PendingAnalysisBatchItem, error) {
+	return m.items, nil
+}
+func (m *mockAnalysisBatchRepository) MarkSubmitted(_ context.Context, _ string) error {
+	m.submitted = true
+	return nil
+}
+func (m *mockAnalysisBatchRepository) UpdateItem(_ context.Context, itemID string, status entity.AnalysisBatchItemStatus, commitSHA, errorMessage *string) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	m.updates = append(m.updates, batchItemUpdate{
+		commitSHA:    commitSHA,
+		errorMessage: errorMessage,
+		itemID:       itemID,
+		status:       status,
+	})
+	return nil
+}
+
+type mockAnalysisBatchScheduler struct {
+	scheduled []string
+}
+
+func (m *mockAnalysisBatchScheduler) ScheduleBatch(_ context.Context, batchID string) error {
+	m.scheduled = append(m.scheduled, batchID)
+	return nil
+}
+
+func TestStartAnalysisBatchUseCase_Execute(t *testing.T) {
+	t.Run("filters organization repositories", func(t *testing.T) {
+		lister := &mockOrgRepositoryLister{repos: []port.OrgRepository{
+			{Owner: "acme", Name: "api"},
+			{Owner: "acme", Name: "legacy", Archived: true},
+			{Owner: "acme", Name: "upstream-fork", Fork: true},
+			{Owner: "acme", Name: "web"},
+		}}
+		repo := &mockAnalysisBatchRepository{}
+		scheduler := &mockAnalysisBatchScheduler{}
+		uc := usecase.NewStartAnalysisBatchUseCase(lister, repo, scheduler, nil)
+
+		batch, err := uc.Execute(context.Background(), usecase.StartAnalysisBatchInput{
+			ExcludeArchived: true,
+			ExcludeForks:    true,
+			OrgLogin:        "acme",
+			UserID:          "user-1",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if batch.TotalCount != 2 || batch.SkippedCount != 2 {
+			t.Errorf("expected 2 total and 2 skipped, got %d/%d", batch.TotalCount, batch.SkippedCount)
+		}
+		if repo.created.OrgLogin == nil || *repo.created.OrgLogin != "acme" {
+			t.Errorf("expected org login to be stored, got %v", repo.created.OrgLogin)
+		}
+		if len(scheduler.scheduled) != 1 || scheduler.scheduled[0] != "batch-1" {
+			t.Errorf("expected batch to be scheduled, got %v", scheduler.scheduled)
+		}
+	})
+
+	t.Run("dedupes explicit repositories case-insensitively", func(t *testing.T) {
+		repo := &mockAnalysisBatchRepository{}
+		uc := usecase.NewStartAnalysisBatchUseCase(&mockOrgRepositoryLister{}, repo, &mockAnalysisBatchScheduler{}, nil)
+
+		_, err := uc.Execute(context.Background(), usecase.StartAnalysisBatchInput{
+			Repositories: []entity.RepositoryRef{
+				{Owner: "acme", Repo: "api"},
+				{Owner: "ACME", Repo: "API"},
+				{Owner: "acme", Repo: "web"},
+			},
+			UserID: "user-1",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(repo.created.Repositories) != 2 {
+			t.Errorf("expected 2 repositories, got %v", repo.created.Repositories)
+		}
+		if repo.created.OrgLogin != nil {
+			t.Errorf("expected no org login, got %v", *repo.created.OrgLogin)
+		}
+	})
+
+	t.Run("rejects org and repositories together", func(t *testing.T) {
+		uc := usecase.NewStartAnalysisBatchUseCase(&mockOrgRepositoryLister{}, &mockAnalysisBatchRepository{}, &mockAnalysisBatchScheduler{}, nil)
+
+		_, err := uc.Execute(context.Background(), usecase.StartAnalysisBatchInput{
+			OrgLogin:     "acme",
+			Repositories: []entity.RepositoryRef{{Owner: "acme", Repo: "api"}},
+			UserID:       "user-1",
+		})
+		if !errors.Is(err, domain.ErrInvalidInput) {
+			t.Errorf("expected ErrInvalidInput, got %v", err)
+		}
+	})
+
+	t.Run("rejects empty batch after filtering", func(t *testing.T) {
+		lister := &mockOrgRepositoryLister{repos: []port.OrgRepository{{Owner: "acme", Name: "old", Archived: true}}}
+		scheduler := &mockAnalysisBatchScheduler{}
+		uc := usecase.NewStartAnalysisBatchUseCase(lister, &mockAnalysisBatchRepository{}, scheduler, nil)
+
+		_, err := uc.Execute(context.Background(), usecase.StartAnalysisBatchInput{
+			ExcludeArchived: true,
+			OrgLogin:        "acme",
+			UserID:          "user-1",
+		})
+		if !errors.Is(err, domain.ErrAnalysisBatchEmpty) {
+			t.Errorf("expected ErrAnalysisBatchEmpty, got %v", err)
+		}
+		if len(scheduler.scheduled) != 0 {
+			t.Errorf("expected nothing scheduled, got %v", scheduler.scheduled)
+		}
+	})
+
+	t.Run("rejects batches over the size limit", func(t *testing.T) {
+		repos := make([]port.OrgRepository, usecase.MaxAnalysisBatchSize+1)
+		for i := range repos {
+			repos[i] = port.OrgRepository{Owner: "acme", Name: fmt.Sprintf("repo-%d", i)}
+		}
+		uc := usecase.NewStartAnalysisBatchUseCase(&mockOrgRepositoryLister{repos: repos}, &mockAnalysisBatchRepository{}, &mockAnalysisBatchScheduler{}, nil)
+
+		_, err := uc.Execute(context.Background(), usecase.StartAnalysisBatchInput{OrgLogin: "acme", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrAnalysisBatchTooLarge) {
+			t.Errorf("expected ErrAnalysisBatchTooLarge, got %v", err)
+		}
+	})
+
+	t.Run("propagates organization lookup errors", func(t *testing.T) {
+		lister := &mockOrgRepositoryLister{err: domain.ErrOrganizationNotFound}
+		uc := usecase.NewStartAnalysisBatchUseCase(lister, &mockAnalysisBatchRepository{}, &mockAnalysisBatchScheduler{}, nil)
+
+		_, err := uc.Execute(context.Background(), usecase.StartAnalysisBatchInput{OrgLogin: "ghost", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrOrganizationNotFound) {
+			t.Errorf("expected ErrOrganizationNotFound, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+)
+
+type StartBulkReanalysisInput struct {
+	Trigger     entity.BulkReanalysisTrigger
+	TriggeredBy *string
+}
+
+// StartBulkReanalysisUseCase records a new bulk re-analysis run for the current
+// parser version and hands it to the background worker. Only one run may be active.
+type StartBulkReanalysisUseCase struct {
+	repository   port.BulkReanalysisRepository
+	scheduler    port.BulkReanalysisScheduler
+	systemConfig port.SystemConfigReader
+}
+
+func NewStartBulkReanalysisUseCase(
+	repository port.BulkReanalysisRepository,
+	scheduler port.BulkReanalysisScheduler,
+	systemConfig port.SystemConfigReader,
+) *StartBulkReanalysisUseCase {
+	return &StartBulkReanalysisUseCase{
+		repository:   repository,
+		scheduler:    scheduler,
+		systemConfig: systemConfig,
+	}
+}
+
+func (uc *StartBulkReanalysisUseCase) Execute(ctx context.Context, input StartBulkReanalysisInput) (*entity.BulkReanalysisRun, error) {
+	parserVersion, err := uc.systemConfig.GetParserVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get parser version: %w", err)
+	}
+
+	active, err := uc.repository.GetActiveRun(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get active run: %w", err)
+	}
+	if active != nil {
+		return nil, domain.ErrBulkReanalysisInProgress
+	}
+
+	total, err := uc.repository.CountOutdatedCodebases(ctx, parserVersion)
+	if err != nil {
+		return nil, fmt.Errorf("count outdated codebases: %w", err)
+	}
+
+	trigger := input.Trigger
+	if trigger == "" {
+		trigger = entity.BulkReanalysisTriggerManual
+	}
+
+	run, err := uc.repository.CreateRun(ctx, port.CreateBulkReanalysisRunParams{
+		ParserVersion: parserVersion,
+		TotalCount:    total,
+		Trigger:       trigger,
+		TriggeredBy:   input.TriggeredBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create run: %w", err)
+	}
+
+	if err := uc.scheduler.ScheduleRun(ctx, run.ID); err != nil {
+		if failErr := uc.repository.FailRun(ctx, run.ID, "failed to schedule run"); failErr != nil {
+			return nil, fmt.Errorf("schedule run: %w (mark failed: %v)", err, failErr)
+		}
+		return nil, fmt.Errorf("schedule run: %w", err)
+	}
+
+	return run, nil
+}
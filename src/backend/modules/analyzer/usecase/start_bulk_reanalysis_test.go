@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/analyzer/domain"
+	"github.com/specvital/web/src/backend/modules/analyzer/domain/entity"
+	"github.com/specvital/web/src/backend/modules/analyzer/usecase"
+)
+
+type mockBulkReanalysisScheduler struct {
+	err       error
+	scheduled []string
+}
+
+func (m *mockBulkReanalysisScheduler) ScheduleRun(_ context.Context, runID string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.scheduled = append(m.scheduled, runID)
+	return nil
+}
+
+type mockParserVersionReader struct {
+	version string
+}
+
+func (m *mockParserVersionReader) GetParserVersion(_ context.Context) (string, error) {
+	return m.version, nil
+}
+
+func TestStartBulkReanalysisUseCase_Execute(t *testing.T) {
+	t.Run("creates and schedules a run for the current parser version", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{codebases: newOutdatedCodebases(7)}
+		scheduler := &mockBulkReanalysisScheduler{}
+		uc := usecase.NewStartBulkReanalysisUseCase(repo, scheduler, &mockParserVersionReader{version: "v2"})
+
+		userID := "user-1"
+		run, err := uc.Execute(context.Background(), usecase.StartBulkReanalysisInput{TriggeredBy: &userID})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if run.ParserVersion != "v2" || run.TotalCount != 7 {
+			t.Errorf("unexpected run: %+v", run)
+		}
+		if run.Trigger != entity.BulkReanalysisTriggerManual {
+			t.Errorf("expected manual trigger by default, got %s", run.Trigger)
+		}
+		if len(scheduler.scheduled) != 1 || scheduler.scheduled[0] != run.ID {
+			t.Errorf("expected run %s to be scheduled, got %v", run.ID, scheduler.scheduled)
+		}
+	})
+
+	t.Run("rejects when a run is already active", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{
+			activeRun: &entity.BulkReanalysisRun{ID: "run-0", Status: entity.BulkReanalysisStatusRunning},
+		}
+		scheduler := &mockBulkReanalysisScheduler{}
+		uc := usecase.NewStartBulkReanalysisUseCase(repo, scheduler, &mockParserVersionReader{version: "v2"})
+
+		_, err := uc.Execute(context.Background(), usecase.StartBulkReanalysisInput{})
+		if !errors.Is(err, domain.ErrBulkReanalysisInProgress) {
+			t.Errorf("expected ErrBulkReanalysisInProgress, got %v", err)
+		}
+		if repo.createParams != nil {
+			t.Error("expected no run to be created")
+		}
+	})
+
+	t.Run("marks the run failed when scheduling fails", func(t *testing.T) {
+		repo := &mockBulkReanalysisRepository{}
+		scheduler := &mockBulkReanalysisScheduler{err: errors.New("insert failed")}
+		uc := usecase.NewStartBulkReanalysisUseCase(repo, scheduler, &mockParserVersionReader{version: "v2"})
+
+		if _, err := uc.Execute(context.Background(), usecase.StartBulkReanalysisInput{}); err == nil {
+			t.Fatal("expected error")
+		}
+		if repo.failedReason == "" {
+			t.Error("expected run to be marked failed")
+		}
+	})
+}
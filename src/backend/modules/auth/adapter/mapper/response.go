@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"github.com/google/uuid"
+
 	"github.com/specvital/web/src/backend/internal/api"
 	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
 )
@@ -19,3 +21,37 @@ func ToLoginResponse(authURL string) api.LoginResponse {
 		AuthURL: authURL,
 	}
 }
+
+func ToPersonalAccessToken(token *entity.PersonalAccessToken) (api.PersonalAccessToken, error) {
+	id, err := uuid.Parse(token.ID)
+	if err != nil {
+		return api.PersonalAccessToken{}, err
+	}
+
+	scopes := make([]api.TokenScope, 0, len(token.Scopes))
+	for _, s := range token.Scopes {
+		scopes = append(scopes, api.TokenScope(s))
+	}
+
+	return api.PersonalAccessToken{
+		CreatedAt:   token.CreatedAt,
+		ExpiresAt:   token.ExpiresAt,
+		ID:          id,
+		LastUsedAt:  token.LastUsedAt,
+		Name:        token.Name,
+		Scopes:      scopes,
+		TokenPrefix: token.TokenPrefix,
+	}, nil
+}
+
+func ToPersonalAccessTokenList(tokens []entity.PersonalAccessToken) (api.PersonalAccessTokenListResponse, error) {
+	data := make([]api.PersonalAccessToken, 0, len(tokens))
+	for i := range tokens {
+		token, err := ToPersonalAccessToken(&tokens[i])
+		if err != nil {
+			return api.PersonalAccessTokenListResponse{}, err
+		}
+		data = append(data, token)
+	}
+	return api.PersonalAccessTokenListResponse{Data: data}, nil
+}
@@ -10,11 +10,16 @@ import (
 	"golang.org/x/oauth2"
 	oauthGithub "golang.org/x/oauth2/github"
 
+	"github.com/specvital/web/src/backend/common/tracing"
 	"github.com/specvital/web/src/backend/modules/auth/domain"
 	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
 	"github.com/specvital/web/src/backend/modules/auth/domain/port"
 )
 
+// tracedHTTPClient is passed to oauth2 through the context so token exchange
+// and user lookups appear in traces.
+var tracedHTTPClient = &http.Client{Transport: tracing.Transport(nil)}
+
 const (
 	githubUserURL = "https://api.github.com/user"
 	DefaultScope  = "user:email"
@@ -76,6 +81,7 @@ func (c *GitHubOAuthClient) ExchangeCode(ctx context.Context, code string) (stri
 		return "", errors.Wrap(domain.ErrInvalidCode, "code is empty")
 	}
 
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, tracedHTTPClient)
 	token, err := c.config.Exchange(ctx, code)
 	if err != nil {
 		var retrieveErr *oauth2.RetrieveError
@@ -103,6 +109,7 @@ func (c *GitHubOAuthClient) GetUserInfo(ctx context.Context, accessToken string)
 	}
 
 	token := &oauth2.Token{AccessToken: accessToken}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, tracedHTTPClient)
 	httpClient := c.config.Client(ctx, token)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, githubUserURL, nil)
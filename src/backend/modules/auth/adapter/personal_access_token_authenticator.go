@@ -0,0 +1,27 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+	"github.com/specvital/web/src/backend/modules/auth/usecase"
+)
+
+type PersonalAccessTokenAuthenticatorAdapter struct {
+	usecase *usecase.AuthenticatePersonalAccessTokenUseCase
+}
+
+func NewPersonalAccessTokenAuthenticatorAdapter(uc *usecase.AuthenticatePersonalAccessTokenUseCase) *PersonalAccessTokenAuthenticatorAdapter {
+	if uc == nil {
+		panic("usecase is required")
+	}
+	return &PersonalAccessTokenAuthenticatorAdapter{usecase: uc}
+}
+
+func (a *PersonalAccessTokenAuthenticatorAdapter) AuthenticatePersonalAccessToken(ctx context.Context, token string) (*entity.Claims, error) {
+	output, err := a.usecase.Execute(ctx, usecase.AuthenticatePersonalAccessTokenInput{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	return output.Claims, nil
+}
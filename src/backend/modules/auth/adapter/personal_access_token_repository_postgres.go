@@ -0,0 +1,191 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/auth/domain"
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+	"github.com/specvital/web/src/backend/modules/auth/domain/port"
+)
+
+type PersonalAccessTokenPostgresRepository struct {
+	queries *db.Queries
+}
+
+var _ port.PersonalAccessTokenRepository = (*PersonalAccessTokenPostgresRepository)(nil)
+
+func NewPersonalAccessTokenPostgresRepository(queries *db.Queries) *PersonalAccessTokenPostgresRepository {
+	if queries == nil {
+		panic("queries is required")
+	}
+	return &PersonalAccessTokenPostgresRepository{queries: queries}
+}
+
+func (r *PersonalAccessTokenPostgresRepository) CountActive(ctx context.Context, userID string) (int, error) {
+	userUUID, err := stringToUUID(userID)
+	if err != nil {
+		return 0, fmt.Errorf("parse user ID: %w", err)
+	}
+
+	count, err := r.queries.CountActivePersonalAccessTokens(ctx, userUUID)
+	if err != nil {
+		return 0, fmt.Errorf("count personal access tokens: %w", err)
+	}
+	return int(count), nil
+}
+
+func (r *PersonalAccessTokenPostgresRepository) Create(ctx context.Context, token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error) {
+	if token == nil {
+		return nil, errors.New("token is required")
+	}
+	if token.TokenHash == "" {
+		return nil, errors.New("token hash is required")
+	}
+
+	userUUID, err := stringToUUID(token.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("parse user ID: %w", err)
+	}
+
+	row, err := r.queries.CreatePersonalAccessToken(ctx, db.CreatePersonalAccessTokenParams{
+		UserID:      userUUID,
+		Name:        token.Name,
+		TokenHash:   token.TokenHash,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopesToStrings(token.Scopes),
+		ExpiresAt:   timePtrToTimestamptz(token.ExpiresAt),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create personal access token: %w", err)
+	}
+
+	return mapPersonalAccessTokenFromDB(&row), nil
+}
+
+func (r *PersonalAccessTokenPostgresRepository) GetByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	row, err := r.queries.GetPersonalAccessTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPersonalTokenNotFound
+		}
+		return nil, fmt.Errorf("get personal access token by hash: %w", err)
+	}
+
+	token := mapPersonalAccessTokenFromDB(&db.PersonalAccessToken{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Name:        row.Name,
+		TokenHash:   row.TokenHash,
+		TokenPrefix: row.TokenPrefix,
+		Scopes:      row.Scopes,
+		ExpiresAt:   row.ExpiresAt,
+		LastUsedAt:  row.LastUsedAt,
+		CreatedAt:   row.CreatedAt,
+		RevokedAt:   row.RevokedAt,
+	})
+	token.Username = row.Username
+	return token, nil
+}
+
+func (r *PersonalAccessTokenPostgresRepository) ListByUserID(ctx context.Context, userID string) ([]entity.PersonalAccessToken, error) {
+	userUUID, err := stringToUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("parse user ID: %w", err)
+	}
+
+	rows, err := r.queries.ListPersonalAccessTokensByUserID(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("list personal access tokens: %w", err)
+	}
+
+	tokens := make([]entity.PersonalAccessToken, 0, len(rows))
+	for i := range rows {
+		tokens = append(tokens, *mapPersonalAccessTokenFromDB(&rows[i]))
+	}
+	return tokens, nil
+}
+
+func (r *PersonalAccessTokenPostgresRepository) Revoke(ctx context.Context, userID, id string) error {
+	userUUID, err := stringToUUID(userID)
+	if err != nil {
+		return fmt.Errorf("parse user ID: %w", err)
+	}
+	tokenUUID, err := stringToUUID(id)
+	if err != nil {
+		return domain.ErrPersonalTokenNotFound
+	}
+
+	rowsAffected, err := r.queries.RevokePersonalAccessToken(ctx, db.RevokePersonalAccessTokenParams{
+		ID:     tokenUUID,
+		UserID: userUUID,
+	})
+	if err != nil {
+		return fmt.Errorf("revoke personal access token: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrPersonalTokenNotFound
+	}
+	return nil
+}
+
+func (r *PersonalAccessTokenPostgresRepository) TouchLastUsed(ctx context.Context, id string) error {
+	tokenUUID, err := stringToUUID(id)
+	if err != nil {
+		return fmt.Errorf("parse token ID: %w", err)
+	}
+
+	if err := r.queries.TouchPersonalAccessToken(ctx, tokenUUID); err != nil {
+		return fmt.Errorf("touch personal access token: %w", err)
+	}
+	return nil
+}
+
+func mapPersonalAccessTokenFromDB(row *db.PersonalAccessToken) *entity.PersonalAccessToken {
+	scopes := make([]entity.Scope, 0, len(row.Scopes))
+	for _, s := range row.Scopes {
+		scopes = append(scopes, entity.Scope(s))
+	}
+
+	return &entity.PersonalAccessToken{
+		CreatedAt:   row.CreatedAt.Time,
+		ExpiresAt:   timestamptzToTimePtr(row.ExpiresAt),
+		ID:          uuidToString(row.ID),
+		LastUsedAt:  timestamptzToTimePtr(row.LastUsedAt),
+		Name:        row.Name,
+		RevokedAt:   timestamptzToTimePtr(row.RevokedAt),
+		Scopes:      scopes,
+		TokenHash:   row.TokenHash,
+		TokenPrefix: row.TokenPrefix,
+		UserID:      uuidToString(row.UserID),
+	}
+}
+
+func scopesToStrings(scopes []entity.Scope) []string {
+	result := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		result = append(result, string(s))
+	}
+	return result
+}
+
+func timePtrToTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func timestamptzToTimePtr(ts pgtype.Timestamptz) *time.Time {
+	if !ts.Valid {
+		return nil
+	}
+	t := ts.Time
+	return &t
+}
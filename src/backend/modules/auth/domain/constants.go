@@ -6,3 +6,12 @@ const (
 	AccessTokenExpiry  = 15 * time.Minute
 	RefreshTokenExpiry = 7 * 24 * time.Hour
 )
+
+const (
+	MaxPersonalAccessTokenExpiry   = 365 * 24 * time.Hour
+	MaxPersonalAccessTokenName     = 100
+	MaxPersonalAccessTokensPerUser = 25
+	// PersonalAccessTokenPrefix marks personal access tokens so they are
+	// recognizable in logs and secret scanners.
+	PersonalAccessTokenPrefix = "svp_"
+)
@@ -1,6 +1,9 @@
 package entity
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type Claims struct {
 	ExpiresAt time.Time
@@ -8,8 +11,26 @@ type Claims struct {
 	Issuer    string
 	Login     string
 	Subject   string
+
+	// PersonalAccessTokenID is set when the request authenticated with a
+	// personal access token; Scopes then lists what the token may do.
+	PersonalAccessTokenID string
+	Scopes                []Scope
 }
 
 func (c *Claims) UserID() string {
 	return c.Subject
 }
+
+func (c *Claims) IsPersonalAccessToken() bool {
+	return c.PersonalAccessTokenID != ""
+}
+
+// HasScope reports whether the caller may act within scope. Cookie sessions
+// carry every scope.
+func (c *Claims) HasScope(scope Scope) bool {
+	if !c.IsPersonalAccessToken() {
+		return true
+	}
+	return slices.Contains(c.Scopes, scope)
+}
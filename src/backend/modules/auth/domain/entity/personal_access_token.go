@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// Scope limits what a personal access token may do. Cookie sessions are not
+// scoped and may do everything the user can.
+type Scope string
+
+const (
+	ScopeAnalysisRead  Scope = "analysis:read"
+	ScopeAnalysisWrite Scope = "analysis:write"
+	ScopeSpecRead      Scope = "spec:read"
+	ScopeSpecWrite     Scope = "spec:write"
+)
+
+var AllScopes = []Scope{ScopeAnalysisRead, ScopeAnalysisWrite, ScopeSpecRead, ScopeSpecWrite}
+
+func (s Scope) IsValid() bool {
+	return slices.Contains(AllScopes, s)
+}
+
+type PersonalAccessToken struct {
+	CreatedAt   time.Time
+	ExpiresAt   *time.Time
+	ID          string
+	LastUsedAt  *time.Time
+	Name        string
+	RevokedAt   *time.Time
+	Scopes      []Scope
+	TokenHash   string
+	TokenPrefix string
+	UserID      string
+	Username    string
+}
+
+func (t *PersonalAccessToken) IsExpiredAt(now time.Time) bool {
+	return t.ExpiresAt != nil && now.After(*t.ExpiresAt)
+}
+
+func (t *PersonalAccessToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+func (t *PersonalAccessToken) IsValidAt(now time.Time) bool {
+	return !t.IsExpiredAt(now) && !t.IsRevoked()
+}
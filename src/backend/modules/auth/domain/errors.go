@@ -3,19 +3,24 @@ package domain
 import "github.com/cockroachdb/errors"
 
 var (
-	ErrAccessDenied         = errors.New("access denied by user")
-	ErrDevLoginDisabled     = errors.New("dev login is disabled")
-	ErrInvalidCode          = errors.New("invalid authorization code")
-	ErrInvalidGitHubToken   = errors.New("invalid or expired github access token")
-	ErrInvalidOAuthCode     = errors.New("invalid oauth code")
-	ErrInvalidState         = errors.New("invalid oauth state")
-	ErrInvalidToken         = errors.New("invalid token")
-	ErrNetworkFailure       = errors.New("network communication failed")
-	ErrNoGitHubToken        = errors.New("user has no github access token")
-	ErrRateLimited          = errors.New("github api rate limit exceeded")
-	ErrRefreshTokenExpired  = errors.New("refresh token expired")
-	ErrRefreshTokenNotFound = errors.New("refresh token not found")
-	ErrTokenExpired         = errors.New("token expired")
-	ErrTokenReuseDetected   = errors.New("refresh token reuse detected")
-	ErrUserNotFound         = errors.New("user not found")
+	ErrAccessDenied          = errors.New("access denied by user")
+	ErrDevLoginDisabled      = errors.New("dev login is disabled")
+	ErrInvalidCode           = errors.New("invalid authorization code")
+	ErrInvalidGitHubToken    = errors.New("invalid or expired github access token")
+	ErrInvalidOAuthCode      = errors.New("invalid oauth code")
+	ErrInvalidScope          = errors.New("invalid token scope")
+	ErrInvalidState          = errors.New("invalid oauth state")
+	ErrInvalidToken          = errors.New("invalid token")
+	ErrInvalidTokenExpiry    = errors.New("invalid token expiry")
+	ErrInvalidTokenName      = errors.New("invalid token name")
+	ErrNetworkFailure        = errors.New("network communication failed")
+	ErrNoGitHubToken         = errors.New("user has no github access token")
+	ErrPersonalTokenLimit    = errors.New("personal access token limit reached")
+	ErrPersonalTokenNotFound = errors.New("personal access token not found")
+	ErrRateLimited           = errors.New("github api rate limit exceeded")
+	ErrRefreshTokenExpired   = errors.New("refresh token expired")
+	ErrRefreshTokenNotFound  = errors.New("refresh token not found")
+	ErrTokenExpired          = errors.New("token expired")
+	ErrTokenReuseDetected    = errors.New("refresh token reuse detected")
+	ErrUserNotFound          = errors.New("user not found")
 )
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+)
+
+type PersonalAccessTokenRepository interface {
+	CountActive(ctx context.Context, userID string) (int, error)
+	Create(ctx context.Context, token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error)
+	GetByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error)
+	ListByUserID(ctx context.Context, userID string) ([]entity.PersonalAccessToken, error)
+	Revoke(ctx context.Context, userID, id string) error
+	TouchLastUsed(ctx context.Context, id string) error
+}
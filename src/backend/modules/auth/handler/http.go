@@ -29,27 +29,33 @@ var (
 )
 
 type Handler struct {
-	cookieDomain        string
-	cookieSecure        bool
-	devLogin            *usecase.DevLoginUseCase
-	frontendURL         string
-	getCurrentUser      *usecase.GetCurrentUserUseCase
-	handleOAuthCallback *usecase.HandleOAuthCallbackUseCase
-	initiateOAuth       *usecase.InitiateOAuthUseCase
-	logger              *logger.Logger
-	refreshToken        *usecase.RefreshTokenUseCase
+	cookieDomain              string
+	cookieSecure              bool
+	createPersonalAccessToken *usecase.CreatePersonalAccessTokenUseCase
+	devLogin                  *usecase.DevLoginUseCase
+	frontendURL               string
+	getCurrentUser            *usecase.GetCurrentUserUseCase
+	handleOAuthCallback       *usecase.HandleOAuthCallbackUseCase
+	initiateOAuth             *usecase.InitiateOAuthUseCase
+	listPersonalAccessTokens  *usecase.ListPersonalAccessTokensUseCase
+	logger                    *logger.Logger
+	refreshToken              *usecase.RefreshTokenUseCase
+	revokePersonalAccessToken *usecase.RevokePersonalAccessTokenUseCase
 }
 
 type HandlerConfig struct {
-	CookieDomain        string
-	CookieSecure        bool
-	DevLogin            *usecase.DevLoginUseCase
-	FrontendURL         string
-	GetCurrentUser      *usecase.GetCurrentUserUseCase
-	HandleOAuthCallback *usecase.HandleOAuthCallbackUseCase
-	InitiateOAuth       *usecase.InitiateOAuthUseCase
-	Logger              *logger.Logger
-	RefreshToken        *usecase.RefreshTokenUseCase
+	CookieDomain              string
+	CookieSecure              bool
+	CreatePersonalAccessToken *usecase.CreatePersonalAccessTokenUseCase
+	DevLogin                  *usecase.DevLoginUseCase
+	FrontendURL               string
+	GetCurrentUser            *usecase.GetCurrentUserUseCase
+	HandleOAuthCallback       *usecase.HandleOAuthCallbackUseCase
+	InitiateOAuth             *usecase.InitiateOAuthUseCase
+	ListPersonalAccessTokens  *usecase.ListPersonalAccessTokensUseCase
+	Logger                    *logger.Logger
+	RefreshToken              *usecase.RefreshTokenUseCase
+	RevokePersonalAccessToken *usecase.RevokePersonalAccessTokenUseCase
 }
 
 var _ api.AuthHandlers = (*Handler)(nil)
@@ -76,16 +82,28 @@ func NewHandler(cfg *HandlerConfig) (*Handler, error) {
 	if cfg.RefreshToken == nil {
 		return nil, errors.New("RefreshToken usecase is required")
 	}
+	if cfg.CreatePersonalAccessToken == nil {
+		return nil, errors.New("CreatePersonalAccessToken usecase is required")
+	}
+	if cfg.ListPersonalAccessTokens == nil {
+		return nil, errors.New("ListPersonalAccessTokens usecase is required")
+	}
+	if cfg.RevokePersonalAccessToken == nil {
+		return nil, errors.New("RevokePersonalAccessToken usecase is required")
+	}
 	return &Handler{
-		cookieDomain:        cfg.CookieDomain,
-		cookieSecure:        cfg.CookieSecure,
-		devLogin:            cfg.DevLogin,
-		frontendURL:         cfg.FrontendURL,
-		getCurrentUser:      cfg.GetCurrentUser,
-		handleOAuthCallback: cfg.HandleOAuthCallback,
-		initiateOAuth:       cfg.InitiateOAuth,
-		logger:              cfg.Logger,
-		refreshToken:        cfg.RefreshToken,
+		cookieDomain:              cfg.CookieDomain,
+		cookieSecure:              cfg.CookieSecure,
+		createPersonalAccessToken: cfg.CreatePersonalAccessToken,
+		devLogin:                  cfg.DevLogin,
+		frontendURL:               cfg.FrontendURL,
+		getCurrentUser:            cfg.GetCurrentUser,
+		handleOAuthCallback:       cfg.HandleOAuthCallback,
+		initiateOAuth:             cfg.InitiateOAuth,
+		listPersonalAccessTokens:  cfg.ListPersonalAccessTokens,
+		logger:                    cfg.Logger,
+		refreshToken:              cfg.RefreshToken,
+		revokePersonalAccessToken: cfg.RevokePersonalAccessToken,
 	}, nil
 }
 
@@ -223,6 +223,34 @@ func (m *mockRefreshTokenRepo) RotateToken(ctx context.Context, oldTokenID strin
 	return "new-token-id", nil
 }
 
+type mockPersonalAccessTokenRepo struct{}
+
+var _ port.PersonalAccessTokenRepository = (*mockPersonalAccessTokenRepo)(nil)
+
+func (m *mockPersonalAccessTokenRepo) CountActive(_ context.Context, _ string) (int, error) {
+	return 0, nil
+}
+
+func (m *mockPersonalAccessTokenRepo) Create(_ context.Context, token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error) {
+	return token, nil
+}
+
+func (m *mockPersonalAccessTokenRepo) GetByHash(_ context.Context, _ string) (*entity.PersonalAccessToken, error) {
+	return nil, domain.ErrPersonalTokenNotFound
+}
+
+func (m *mockPersonalAccessTokenRepo) ListByUserID(_ context.Context, _ string) ([]entity.PersonalAccessToken, error) {
+	return nil, nil
+}
+
+func (m *mockPersonalAccessTokenRepo) Revoke(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (m *mockPersonalAccessTokenRepo) TouchLastUsed(_ context.Context, _ string) error {
+	return nil
+}
+
 type mockSubscriber struct {
 	assignDefaultPlanFunc func(ctx context.Context, userID string) error
 }
@@ -242,21 +270,25 @@ func createTestHandler(
 	initiateOAuthUC *usecase.InitiateOAuthUseCase,
 	refreshTokenUC *usecase.RefreshTokenUseCase,
 ) *Handler {
+	personalTokenRepo := &mockPersonalAccessTokenRepo{}
 	handler, _ := NewHandler(&HandlerConfig{
-		CookieSecure:        false,
-		FrontendURL:         "http://localhost:5173",
-		GetCurrentUser:      getCurrentUserUC,
-		HandleOAuthCallback: handleOAuthCallbackUC,
-		InitiateOAuth:       initiateOAuthUC,
-		Logger:              logger.New(),
-		RefreshToken:        refreshTokenUC,
+		CookieSecure:              false,
+		CreatePersonalAccessToken: usecase.NewCreatePersonalAccessTokenUseCase(personalTokenRepo, &mockTokenManager{}),
+		FrontendURL:               "http://localhost:5173",
+		GetCurrentUser:            getCurrentUserUC,
+		HandleOAuthCallback:       handleOAuthCallbackUC,
+		InitiateOAuth:             initiateOAuthUC,
+		ListPersonalAccessTokens:  usecase.NewListPersonalAccessTokensUseCase(personalTokenRepo),
+		Logger:                    logger.New(),
+		RefreshToken:              refreshTokenUC,
+		RevokePersonalAccessToken: usecase.NewRevokePersonalAccessTokenUseCase(personalTokenRepo),
 	})
 	return handler
 }
 
 func setupTestRouter(handler *Handler) *chi.Mux {
 	r := chi.NewRouter()
-	apiHandlers := api.NewAPIHandlers(&mockAnalyzerHandler{}, user.NewMockHandler(), handler, user.NewMockHandler(), &mockGitHubHandler{}, &mockGitHubAppHandler{}, &mockPricingHandler{}, &mockRepositoryHandler{}, specviewhandler.NewMockHandler(), &mockSubscriptionHandler{}, &mockUsageHandler{}, user.NewMockHandler(), nil)
+	apiHandlers := api.NewAPIHandlers(nil, &mockAnalyzerHandler{}, nil, user.NewMockHandler(), nil, handler, user.NewMockHandler(), &mockGitHubHandler{}, &mockGitHubAppHandler{}, nil, &mockPricingHandler{}, &mockRepositoryHandler{}, specviewhandler.NewMockHandler(), &mockSubscriptionHandler{}, &mockUsageHandler{}, user.NewMockHandler(), nil, nil)
 	strictHandler := api.NewStrictHandler(apiHandlers, nil)
 	api.HandlerFromMux(strictHandler, r)
 	return r
@@ -597,14 +629,18 @@ func TestAuthMe_Success(t *testing.T) {
 
 func TestBuildAccessCookie(t *testing.T) {
 	getCurrentUser, handleOAuthCallback, initiateOAuth, refreshToken, _, _, _, _ := createDefaultUseCases()
+	personalTokenRepo := &mockPersonalAccessTokenRepo{}
 	handler, _ := NewHandler(&HandlerConfig{
-		CookieSecure:        true,
-		FrontendURL:         "http://localhost:5173",
-		GetCurrentUser:      getCurrentUser,
-		HandleOAuthCallback: handleOAuthCallback,
-		InitiateOAuth:       initiateOAuth,
-		Logger:              logger.New(),
-		RefreshToken:        refreshToken,
+		CookieSecure:              true,
+		CreatePersonalAccessToken: usecase.NewCreatePersonalAccessTokenUseCase(personalTokenRepo, &mockTokenManager{}),
+		FrontendURL:               "http://localhost:5173",
+		GetCurrentUser:            getCurrentUser,
+		HandleOAuthCallback:       handleOAuthCallback,
+		InitiateOAuth:             initiateOAuth,
+		ListPersonalAccessTokens:  usecase.NewListPersonalAccessTokensUseCase(personalTokenRepo),
+		Logger:                    logger.New(),
+		RefreshToken:              refreshToken,
+		RevokePersonalAccessToken: usecase.NewRevokePersonalAccessTokenUseCase(personalTokenRepo),
 	})
 
 	cookie := handler.buildAccessCookie("test-token")
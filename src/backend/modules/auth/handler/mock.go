@@ -50,3 +50,21 @@ func (h *MockHandler) AuthDevLogin(_ context.Context, _ api.AuthDevLoginRequestO
 		ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("mock: dev login disabled"),
 	}, nil
 }
+
+func (h *MockHandler) CreatePersonalAccessToken(_ context.Context, _ api.CreatePersonalAccessTokenRequestObject) (api.CreatePersonalAccessTokenResponseObject, error) {
+	return api.CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse{
+		InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("mock: not implemented"),
+	}, nil
+}
+
+func (h *MockHandler) ListPersonalAccessTokens(_ context.Context, _ api.ListPersonalAccessTokensRequestObject) (api.ListPersonalAccessTokensResponseObject, error) {
+	return api.ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse{
+		InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("mock: not implemented"),
+	}, nil
+}
+
+func (h *MockHandler) RevokePersonalAccessToken(_ context.Context, _ api.RevokePersonalAccessTokenRequestObject) (api.RevokePersonalAccessTokenResponseObject, error) {
+	return api.RevokePersonalAccessToken500ApplicationProblemPlusJSONResponse{
+		InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("mock: not implemented"),
+	}, nil
+}
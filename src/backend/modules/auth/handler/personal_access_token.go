@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/auth/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/auth/domain"
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+	"github.com/specvital/web/src/backend/modules/auth/usecase"
+)
+
+func (h *Handler) CreatePersonalAccessToken(ctx context.Context, request api.CreatePersonalAccessTokenRequestObject) (api.CreatePersonalAccessTokenResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.CreatePersonalAccessToken401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+	if request.Body == nil {
+		return api.CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	scopes := make([]entity.Scope, 0, len(request.Body.Scopes))
+	for _, s := range request.Body.Scopes {
+		scopes = append(scopes, entity.Scope(s))
+	}
+
+	var expiresIn time.Duration
+	if request.Body.ExpiresInDays != nil {
+		if *request.Body.ExpiresInDays <= 0 {
+			return api.CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("expiresInDays must be between 1 and 365"),
+			}, nil
+		}
+		expiresIn = time.Duration(*request.Body.ExpiresInDays) * 24 * time.Hour
+	}
+
+	output, err := h.createPersonalAccessToken.Execute(ctx, usecase.CreatePersonalAccessTokenInput{
+		ExpiresIn: expiresIn,
+		Name:      request.Body.Name,
+		Scopes:    scopes,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTokenName):
+			return api.CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("name must be between 1 and 100 characters"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidScope):
+			return api.CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("at least one valid scope is required"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidTokenExpiry):
+			return api.CreatePersonalAccessToken400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("expiresInDays must be between 1 and 365"),
+			}, nil
+		case errors.Is(err, domain.ErrPersonalTokenLimit):
+			return api.CreatePersonalAccessToken409ApplicationProblemPlusJSONResponse{
+				ConflictApplicationProblemPlusJSONResponse: api.NewConflict("personal access token limit reached; revoke an unused token first"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to create personal access token", "error", err, "userID", userID)
+		return api.CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to create personal access token"),
+		}, nil
+	}
+
+	token, err := mapper.ToPersonalAccessToken(output.PersonalAccessToken)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map personal access token", "error", err)
+		return api.CreatePersonalAccessToken500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to create personal access token"),
+		}, nil
+	}
+
+	return api.CreatePersonalAccessToken201JSONResponse{
+		Data:  token,
+		Token: output.Token,
+	}, nil
+}
+
+func (h *Handler) ListPersonalAccessTokens(ctx context.Context, _ api.ListPersonalAccessTokensRequestObject) (api.ListPersonalAccessTokensResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.ListPersonalAccessTokens401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+
+	output, err := h.listPersonalAccessTokens.Execute(ctx, usecase.ListPersonalAccessTokensInput{UserID: userID})
+	if err != nil {
+		h.logger.Error(ctx, "failed to list personal access tokens", "error", err, "userID", userID)
+		return api.ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list personal access tokens"),
+		}, nil
+	}
+
+	resp, err := mapper.ToPersonalAccessTokenList(output.Tokens)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map personal access tokens", "error", err)
+		return api.ListPersonalAccessTokens500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list personal access tokens"),
+		}, nil
+	}
+
+	return api.ListPersonalAccessTokens200JSONResponse(resp), nil
+}
+
+func (h *Handler) RevokePersonalAccessToken(ctx context.Context, request api.RevokePersonalAccessTokenRequestObject) (api.RevokePersonalAccessTokenResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+	if userID == "" {
+		return api.RevokePersonalAccessToken401ApplicationProblemPlusJSONResponse{
+			UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+		}, nil
+	}
+
+	err := h.revokePersonalAccessToken.Execute(ctx, usecase.RevokePersonalAccessTokenInput{
+		TokenID: request.TokenID.String(),
+		UserID:  userID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonalTokenNotFound) {
+			return api.RevokePersonalAccessToken404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("personal access token not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to revoke personal access token", "error", err, "userID", userID)
+		return api.RevokePersonalAccessToken500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to revoke personal access token"),
+		}, nil
+	}
+
+	return api.RevokePersonalAccessToken204Response{}, nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain"
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+	"github.com/specvital/web/src/backend/modules/auth/domain/port"
+)
+
+type AuthenticatePersonalAccessTokenInput struct {
+	Token string
+}
+
+type AuthenticatePersonalAccessTokenOutput struct {
+	Claims *entity.Claims
+}
+
+type AuthenticatePersonalAccessTokenUseCase struct {
+	repository   port.PersonalAccessTokenRepository
+	tokenManager port.TokenManager
+}
+
+func NewAuthenticatePersonalAccessTokenUseCase(
+	repository port.PersonalAccessTokenRepository,
+	tokenManager port.TokenManager,
+) *AuthenticatePersonalAccessTokenUseCase {
+	if repository == nil {
+		panic("repository is required")
+	}
+	if tokenManager == nil {
+		panic("tokenManager is required")
+	}
+	return &AuthenticatePersonalAccessTokenUseCase{
+		repository:   repository,
+		tokenManager: tokenManager,
+	}
+}
+
+// Execute resolves a raw personal access token to claims for its owner, so
+// requests made with it count against the owner's quota and rate limits.
+func (uc *AuthenticatePersonalAccessTokenUseCase) Execute(ctx context.Context, input AuthenticatePersonalAccessTokenInput) (*AuthenticatePersonalAccessTokenOutput, error) {
+	if !strings.HasPrefix(input.Token, domain.PersonalAccessTokenPrefix) {
+		return nil, domain.ErrInvalidToken
+	}
+
+	token, err := uc.repository.GetByHash(ctx, uc.tokenManager.HashToken(input.Token))
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonalTokenNotFound) {
+			return nil, domain.ErrInvalidToken
+		}
+		return nil, fmt.Errorf("get personal access token: %w", err)
+	}
+
+	now := time.Now()
+	if token.IsRevoked() {
+		return nil, domain.ErrInvalidToken
+	}
+	if token.IsExpiredAt(now) {
+		return nil, domain.ErrTokenExpired
+	}
+
+	// Last-used tracking is informational; a failed write must not reject
+	// an otherwise valid token.
+	_ = uc.repository.TouchLastUsed(ctx, token.ID)
+
+	claims := &entity.Claims{
+		IssuedAt:              token.CreatedAt,
+		Login:                 token.Username,
+		PersonalAccessTokenID: token.ID,
+		Scopes:                token.Scopes,
+		Subject:               token.UserID,
+	}
+	if token.ExpiresAt != nil {
+		claims.ExpiresAt = *token.ExpiresAt
+	}
+
+	return &AuthenticatePersonalAccessTokenOutput{Claims: claims}, nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain"
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+	"github.com/specvital/web/src/backend/modules/auth/domain/port"
+)
+
+// tokenPrefixLength is how much of the raw token is stored in clear text so
+// users can tell their tokens apart.
+const tokenPrefixLength = 12
+
+type CreatePersonalAccessTokenInput struct {
+	// ExpiresIn of zero creates a token that never expires.
+	ExpiresIn time.Duration
+	Name      string
+	Scopes    []entity.Scope
+	UserID    string
+}
+
+type CreatePersonalAccessTokenOutput struct {
+	// Token is the raw secret. It is only available at creation time.
+	Token               string
+	PersonalAccessToken *entity.PersonalAccessToken
+}
+
+type CreatePersonalAccessTokenUseCase struct {
+	repository   port.PersonalAccessTokenRepository
+	tokenManager port.TokenManager
+}
+
+func NewCreatePersonalAccessTokenUseCase(
+	repository port.PersonalAccessTokenRepository,
+	tokenManager port.TokenManager,
+) *CreatePersonalAccessTokenUseCase {
+	if repository == nil {
+		panic("repository is required")
+	}
+	if tokenManager == nil {
+		panic("tokenManager is required")
+	}
+	return &CreatePersonalAccessTokenUseCase{
+		repository:   repository,
+		tokenManager: tokenManager,
+	}
+}
+
+func (uc *CreatePersonalAccessTokenUseCase) Execute(ctx context.Context, input CreatePersonalAccessTokenInput) (*CreatePersonalAccessTokenOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" || utf8.RuneCountInString(name) > domain.MaxPersonalAccessTokenName {
+		return nil, domain.ErrInvalidTokenName
+	}
+
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ExpiresIn < 0 || input.ExpiresIn > domain.MaxPersonalAccessTokenExpiry {
+		return nil, domain.ErrInvalidTokenExpiry
+	}
+
+	active, err := uc.repository.CountActive(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("count personal access tokens: %w", err)
+	}
+	if active >= domain.MaxPersonalAccessTokensPerUser {
+		return nil, domain.ErrPersonalTokenLimit
+	}
+
+	secret, err := uc.tokenManager.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("generate token: %w", err)
+	}
+	rawToken := domain.PersonalAccessTokenPrefix + secret.Token
+
+	token := &entity.PersonalAccessToken{
+		Name:        name,
+		Scopes:      scopes,
+		TokenHash:   uc.tokenManager.HashToken(rawToken),
+		TokenPrefix: rawToken[:tokenPrefixLength],
+		UserID:      input.UserID,
+	}
+	if input.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(input.ExpiresIn)
+		token.ExpiresAt = &expiresAt
+	}
+
+	created, err := uc.repository.Create(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("create personal access token: %w", err)
+	}
+
+	return &CreatePersonalAccessTokenOutput{
+		PersonalAccessToken: created,
+		Token:               rawToken,
+	}, nil
+}
+
+func normalizeScopes(scopes []entity.Scope) ([]entity.Scope, error) {
+	if len(scopes) == 0 {
+		return nil, domain.ErrInvalidScope
+	}
+
+	result := make([]entity.Scope, 0, len(scopes))
+	for _, s := range scopes {
+		if !s.IsValid() {
+			return nil, domain.ErrInvalidScope
+		}
+		if !slices.Contains(result, s) {
+			result = append(result, s)
+		}
+	}
+	slices.Sort(result)
+	return result, nil
+}
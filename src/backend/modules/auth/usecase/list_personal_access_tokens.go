@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+	"github.com/specvital/web/src/backend/modules/auth/domain/port"
+)
+
+type ListPersonalAccessTokensInput struct {
+	UserID string
+}
+
+type ListPersonalAccessTokensOutput struct {
+	Tokens []entity.PersonalAccessToken
+}
+
+type ListPersonalAccessTokensUseCase struct {
+	repository port.PersonalAccessTokenRepository
+}
+
+func NewListPersonalAccessTokensUseCase(repository port.PersonalAccessTokenRepository) *ListPersonalAccessTokensUseCase {
+	if repository == nil {
+		panic("repository is required")
+	}
+	return &ListPersonalAccessTokensUseCase{repository: repository}
+}
+
+func (uc *ListPersonalAccessTokensUseCase) Execute(ctx context.Context, input ListPersonalAccessTokensInput) (*ListPersonalAccessTokensOutput, error) {
+	tokens, err := uc.repository.ListByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("list personal access tokens: %w", err)
+	}
+	return &ListPersonalAccessTokensOutput{Tokens: tokens}, nil
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain"
+	"github.com/specvital/web/src/backend/modules/auth/domain/entity"
+)
+
+type mockPersonalAccessTokenRepository struct {
+	countActiveFunc   func(ctx context.Context, userID string) (int, error)
+	createFunc        func(ctx context.Context, token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error)
+	getByHashFunc     func(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error)
+	listByUserIDFunc  func(ctx context.Context, userID string) ([]entity.PersonalAccessToken, error)
+	revokeFunc        func(ctx context.Context, userID, id string) error
+	touchLastUsedFunc func(ctx context.Context, id string) error
+}
+
+func (m *mockPersonalAccessTokenRepository) CountActive(ctx context.Context, userID string) (int, error) {
+	if m.countActiveFunc != nil {
+		return m.countActiveFunc(ctx, userID)
+	}
+	return 0, nil
+}
+
+func (m *mockPersonalAccessTokenRepository) Create(ctx context.Context, token *entity.PersonalAccessToken) (*entity.PersonalAccessToken, error) {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, token)
+	}
+	created := *token
+	created.ID = "pat-123"
+	return &created, nil
+}
+
+func (m *mockPersonalAccessTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	if m.getByHashFunc != nil {
+		return m.getByHashFunc(ctx, tokenHash)
+	}
+	return nil, domain.ErrPersonalTokenNotFound
+}
+
+func (m *mockPersonalAccessTokenRepository) ListByUserID(ctx context.Context, userID string) ([]entity.PersonalAccessToken, error) {
+	if m.listByUserIDFunc != nil {
+		return m.listByUserIDFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockPersonalAccessTokenRepository) Revoke(ctx context.Context, userID, id string) error {
+	if m.revokeFunc != nil {
+		return m.revokeFunc(ctx, userID, id)
+	}
+	return nil
+}
+
+func (m *mockPersonalAccessTokenRepository) TouchLastUsed(ctx context.Context, id string) error {
+	if m.touchLastUsedFunc != nil {
+		return m.touchLastUsedFunc(ctx, id)
+	}
+	return nil
+}
+
+func TestCreatePersonalAccessTokenUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CreatePersonalAccessTokenInput
+		active  int
+		wantErr error
+	}{
+		{
+			name: "creates token with normalized scopes",
+			input: CreatePersonalAccessTokenInput{
+				ExpiresIn: 30 * 24 * time.Hour,
+				Name:      " ci ",
+				Scopes:    []entity.Scope{entity.ScopeSpecRead, entity.ScopeAnalysisRead, entity.ScopeSpecRead},
+				UserID:    "user-123",
+			},
+		},
+		{
+			name:    "empty name",
+			input:   CreatePersonalAccessTokenInput{Name: "  ", Scopes: []entity.Scope{entity.ScopeSpecRead}, UserID: "user-123"},
+			wantErr: domain.ErrInvalidTokenName,
+		},
+		{
+			name:    "no scopes",
+			input:   CreatePersonalAccessTokenInput{Name: "ci", UserID: "user-123"},
+			wantErr: domain.ErrInvalidScope,
+		},
+		{
+			name:    "unknown scope",
+			input:   CreatePersonalAccessTokenInput{Name: "ci", Scopes: []entity.Scope{"admin"}, UserID: "user-123"},
+			wantErr: domain.ErrInvalidScope,
+		},
+		{
+			name: "expiry beyond maximum",
+			input: CreatePersonalAccessTokenInput{
+				ExpiresIn: domain.MaxPersonalAccessTokenExpiry + time.Hour,
+				Name:      "ci",
+				Scopes:    []entity.Scope{entity.ScopeSpecRead},
+				UserID:    "user-123",
+			},
+			wantErr: domain.ErrInvalidTokenExpiry,
+		},
+		{
+			name:    "token limit reached",
+			input:   CreatePersonalAccessTokenInput{Name: "ci", Scopes: []entity.Scope{entity.ScopeSpecRead}, UserID: "user-123"},
+			active:  domain.MaxPersonalAccessTokensPerUser,
+			wantErr: domain.ErrPersonalTokenLimit,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockPersonalAccessTokenRepository{
+				countActiveFunc: func(_ context.Context, _ string) (int, error) {
+					return tt.active, nil
+				},
+			}
+			uc := NewCreatePersonalAccessTokenUseCase(repo, &mockTokenManager{})
+
+			output, err := uc.Execute(context.Background(), tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !strings.HasPrefix(output.Token, domain.PersonalAccessTokenPrefix) {
+				t.Errorf("token %q missing prefix", output.Token)
+			}
+			created := output.PersonalAccessToken
+			if created.Name != "ci" {
+				t.Errorf("expected trimmed name, got %q", created.Name)
+			}
+			if created.TokenHash != "hashed-"+output.Token {
+				t.Errorf("unexpected token hash %q", created.TokenHash)
+			}
+			if !strings.HasPrefix(output.Token, created.TokenPrefix) {
+				t.Errorf("stored prefix %q does not match token", created.TokenPrefix)
+			}
+			if len(created.Scopes) != 2 || created.Scopes[0] != entity.ScopeAnalysisRead || created.Scopes[1] != entity.ScopeSpecRead {
+				t.Errorf("unexpected scopes %v", created.Scopes)
+			}
+			if created.ExpiresAt == nil {
+				t.Error("expected expiry to be set")
+			}
+		})
+	}
+}
+
+func TestAuthenticatePersonalAccessTokenUseCase_Execute(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	rawToken := domain.PersonalAccessTokenPrefix + "secret"
+
+	validToken := &entity.PersonalAccessToken{
+		CreatedAt: now.Add(-24 * time.Hour),
+		ExpiresAt: &future,
+		ID:        "pat-123",
+		Scopes:    []entity.Scope{entity.ScopeSpecRead},
+		UserID:    "user-123",
+		Username:  "octocat",
+	}
+	revokedToken := *validToken
+	revokedToken.RevokedAt = &past
+	expiredToken := *validToken
+	expiredToken.ExpiresAt = &past
+
+	tests := []struct {
+		name    string
+		token   string
+		stored  *entity.PersonalAccessToken
+		wantErr error
+	}{
+		{name: "valid token", token: rawToken, stored: validToken},
+		{name: "missing prefix", token: "secret", stored: validToken, wantErr: domain.ErrInvalidToken},
+		{name: "unknown token", token: rawToken, wantErr: domain.ErrInvalidToken},
+		{name: "revoked token", token: rawToken, stored: &revokedToken, wantErr: domain.ErrInvalidToken},
+		{name: "expired token", token: rawToken, stored: &expiredToken, wantErr: domain.ErrTokenExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			touched := false
+			repo := &mockPersonalAccessTokenRepository{
+				getByHashFunc: func(_ context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+					if tt.stored == nil || tokenHash != "hashed-"+rawToken {
+						return nil, domain.ErrPersonalTokenNotFound
+					}
+					return tt.stored, nil
+				},
+				touchLastUsedFunc: func(_ context.Context, _ string) error {
+					touched = true
+					return errors.New("db unavailable")
+				},
+			}
+			uc := NewAuthenticatePersonalAccessTokenUseCase(repo, &mockTokenManager{})
+
+			output, err := uc.Execute(context.Background(), AuthenticatePersonalAccessTokenInput{Token: tt.token})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !touched {
+				t.Error("expected last-used timestamp to be touched")
+			}
+			claims := output.Claims
+			if claims.Subject != "user-123" || claims.Login != "octocat" {
+				t.Errorf("unexpected claims subject/login: %s/%s", claims.Subject, claims.Login)
+			}
+			if !claims.IsPersonalAccessToken() {
+				t.Error("expected personal access token claims")
+			}
+			if !claims.HasScope(entity.ScopeSpecRead) || claims.HasScope(entity.ScopeSpecWrite) {
+				t.Errorf("unexpected scopes %v", claims.Scopes)
+			}
+		})
+	}
+}
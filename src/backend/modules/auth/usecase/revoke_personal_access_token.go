@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/modules/auth/domain"
+	"github.com/specvital/web/src/backend/modules/auth/domain/port"
+)
+
+type RevokePersonalAccessTokenInput struct {
+	TokenID string
+	UserID  string
+}
+
+type RevokePersonalAccessTokenUseCase struct {
+	repository port.PersonalAccessTokenRepository
+}
+
+func NewRevokePersonalAccessTokenUseCase(repository port.PersonalAccessTokenRepository) *RevokePersonalAccessTokenUseCase {
+	if repository == nil {
+		panic("repository is required")
+	}
+	return &RevokePersonalAccessTokenUseCase{repository: repository}
+}
+
+func (uc *RevokePersonalAccessTokenUseCase) Execute(ctx context.Context, input RevokePersonalAccessTokenInput) error {
+	if err := uc.repository.Revoke(ctx, input.UserID, input.TokenID); err != nil {
+		if errors.Is(err, domain.ErrPersonalTokenNotFound) {
+			return err
+		}
+		return fmt.Errorf("revoke personal access token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/specvital/web/src/backend/internal/client"
+	"github.com/specvital/web/src/backend/modules/github-app/domain"
+	"github.com/specvital/web/src/backend/modules/github-app/domain/port"
+	"github.com/specvital/web/src/backend/modules/github-app/usecase"
+)
+
+// installationTokenRefreshMargin renews tokens before GitHub expires them so
+// an in-flight request never carries a token that lapses mid-call.
+const installationTokenRefreshMargin = 5 * time.Minute
+
+var _ client.InstallationTokenSource = (*InstallationTokenSource)(nil)
+
+// InstallationTokenSource resolves installation tokens by repository owner and
+// reuses them until shortly before they expire.
+type InstallationTokenSource struct {
+	getToken   *usecase.GetInstallationTokenUseCase
+	mu         sync.Mutex
+	now        func() time.Time
+	repository port.InstallationRepository
+	tokens     map[int64]cachedInstallationToken
+}
+
+type cachedInstallationToken struct {
+	expiresAt time.Time
+	token     string
+}
+
+func NewInstallationTokenSource(repository port.InstallationRepository, getToken *usecase.GetInstallationTokenUseCase) *InstallationTokenSource {
+	return &InstallationTokenSource{
+		getToken:   getToken,
+		now:        time.Now,
+		repository: repository,
+		tokens:     make(map[int64]cachedInstallationToken),
+	}
+}
+
+func (s *InstallationTokenSource) InstallationToken(ctx context.Context, owner string) (string, error) {
+	installation, err := s.repository.GetByAccountLogin(ctx, strings.TrimSpace(owner))
+	if err != nil {
+		if errors.Is(err, domain.ErrInstallationNotFound) {
+			return "", client.ErrNoInstallation
+		}
+		return "", err
+	}
+	if installation.IsSuspended() {
+		return "", client.ErrNoInstallation
+	}
+
+	if token, ok := s.cached(installation.InstallationID); ok {
+		return token, nil
+	}
+
+	output, err := s.getToken.Execute(ctx, usecase.GetInstallationTokenInput{
+		InstallationID: installation.InstallationID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInstallationNotFound) || errors.Is(err, domain.ErrInstallationSuspended) {
+			return "", client.ErrNoInstallation
+		}
+		return "", err
+	}
+
+	s.mu.Lock()
+	s.tokens[installation.InstallationID] = cachedInstallationToken{
+		expiresAt: output.ExpiresAt.Add(-installationTokenRefreshMargin),
+		token:     output.Token,
+	}
+	s.mu.Unlock()
+
+	return output.Token, nil
+}
+
+func (s *InstallationTokenSource) cached(installationID int64) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.tokens[installationID]
+	if !ok {
+		return "", false
+	}
+	if !s.now().Before(entry.expiresAt) {
+		delete(s.tokens, installationID)
+		return "", false
+	}
+	return entry.token, true
+}
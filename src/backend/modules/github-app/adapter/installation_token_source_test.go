@@ -0,0 +1,104 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/internal/client"
+	"github.com/specvital/web/src/backend/modules/github-app/domain"
+	"github.com/specvital/web/src/backend/modules/github-app/domain/entity"
+	"github.com/specvital/web/src/backend/modules/github-app/domain/port"
+	"github.com/specvital/web/src/backend/modules/github-app/usecase"
+)
+
+type stubInstallationRepository struct {
+	port.InstallationRepository
+	installations []*entity.Installation
+}
+
+func (r *stubInstallationRepository) GetByAccountLogin(_ context.Context, login string) (*entity.Installation, error) {
+	for _, inst := range r.installations {
+		if strings.EqualFold(inst.AccountLogin, login) {
+			return inst, nil
+		}
+	}
+	return nil, domain.ErrInstallationNotFound
+}
+
+func (r *stubInstallationRepository) GetByInstallationID(_ context.Context, installationID int64) (*entity.Installation, error) {
+	for _, inst := range r.installations {
+		if inst.InstallationID == installationID {
+			return inst, nil
+		}
+	}
+	return nil, domain.ErrInstallationNotFound
+}
+
+type stubGitHubAppClient struct {
+	calls     int
+	expiresAt time.Time
+}
+
+func (c *stubGitHubAppClient) CreateInstallationToken(_ context.Context, _ int64) (*port.InstallationToken, error) {
+	c.calls++
+	return &port.InstallationToken{ExpiresAt: c.expiresAt, Token: "installation-token"}, nil
+}
+
+func (c *stubGitHubAppClient) GetInstallationURL() string {
+	return ""
+}
+
+func (c *stubGitHubAppClient) VerifyCredentials(_ context.Context) error {
+	return nil
+}
+
+func newTestInstallationTokenSource(installations ...*entity.Installation) (*InstallationTokenSource, *stubGitHubAppClient, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	appClient := &stubGitHubAppClient{expiresAt: now.Add(time.Hour)}
+	repo := &stubInstallationRepository{installations: installations}
+
+	source := NewInstallationTokenSource(repo, usecase.NewGetInstallationTokenUseCase(appClient, repo))
+	source.now = func() time.Time { return now }
+	return source, appClient, &now
+}
+
+func TestInstallationTokenSource_ReusesTokenUntilNearExpiry(t *testing.T) {
+	source, appClient, now := newTestInstallationTokenSource(&entity.Installation{AccountLogin: "Acme", InstallationID: 1})
+	ctx := context.Background()
+
+	for range 2 {
+		token, err := source.InstallationToken(ctx, "acme")
+		if err != nil || token != "installation-token" {
+			t.Fatalf("unexpected result %q, %v", token, err)
+		}
+	}
+	if appClient.calls != 1 {
+		t.Errorf("expected cached token to be reused, got %d calls", appClient.calls)
+	}
+
+	*now = now.Add(time.Hour - installationTokenRefreshMargin)
+	if _, err := source.InstallationToken(ctx, "acme"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if appClient.calls != 2 {
+		t.Errorf("expected token refresh near expiry, got %d calls", appClient.calls)
+	}
+}
+
+func TestInstallationTokenSource_NoInstallation(t *testing.T) {
+	suspendedAt := time.Now()
+	source, appClient, _ := newTestInstallationTokenSource(&entity.Installation{AccountLogin: "suspended", InstallationID: 2, SuspendedAt: &suspendedAt})
+	ctx := context.Background()
+
+	for _, owner := range []string{"unknown", "suspended"} {
+		if _, err := source.InstallationToken(ctx, owner); !errors.Is(err, client.ErrNoInstallation) {
+			t.Errorf("owner %q: expected ErrNoInstallation, got %v", owner, err)
+		}
+	}
+	if appClient.calls != 0 {
+		t.Errorf("expected no token requests, got %d", appClient.calls)
+	}
+}
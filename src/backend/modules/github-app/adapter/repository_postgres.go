@@ -43,6 +43,21 @@ func (r *PostgresRepository) GetByAccountID(ctx context.Context, accountID int64
 	return toEntity(row), nil
 }
 
+func (r *PostgresRepository) GetByAccountLogin(ctx context.Context, login string) (*entity.Installation, error) {
+	if login == "" {
+		return nil, domain.ErrInstallationNotFound
+	}
+
+	row, err := r.queries.GetGitHubAppInstallationByAccountLogin(ctx, login)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInstallationNotFound
+		}
+		return nil, err
+	}
+	return toEntity(row), nil
+}
+
 func (r *PostgresRepository) GetByInstallationID(ctx context.Context, installationID int64) (*entity.Installation, error) {
 	if installationID <= 0 {
 		return nil, domain.ErrInstallationNotFound
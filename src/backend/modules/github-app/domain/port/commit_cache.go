@@ -0,0 +1,6 @@
+package port
+
+// CommitCacheInvalidator drops cached latest-commit lookups for a repository.
+type CommitCacheInvalidator interface {
+	InvalidateRepository(owner, repo string)
+}
@@ -8,6 +8,9 @@ import (
 type GitHubAppClient interface {
 	CreateInstallationToken(ctx context.Context, installationID int64) (*InstallationToken, error)
 	GetInstallationURL() string
+	// VerifyCredentials authenticates as the app, confirming GitHub still
+	// accepts its private key.
+	VerifyCredentials(ctx context.Context) error
 }
 
 type InstallationToken struct {
@@ -10,6 +10,7 @@ import (
 type InstallationRepository interface {
 	Delete(ctx context.Context, installationID int64) error
 	GetByAccountID(ctx context.Context, accountID int64) (*entity.Installation, error)
+	GetByAccountLogin(ctx context.Context, login string) (*entity.Installation, error)
 	GetByInstallationID(ctx context.Context, installationID int64) (*entity.Installation, error)
 	ListByAccountIDs(ctx context.Context, accountIDs []int64) ([]entity.Installation, error)
 	ListByUserID(ctx context.Context, userID string) ([]entity.Installation, error)
@@ -8,6 +8,7 @@ import (
 	"github.com/cockroachdb/errors"
 
 	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/metrics"
 	"github.com/specvital/web/src/backend/internal/api"
 	"github.com/specvital/web/src/backend/modules/github-app/domain"
 	"github.com/specvital/web/src/backend/modules/github-app/domain/port"
@@ -87,6 +88,8 @@ func (h *Handler) HandleGitHubAppWebhookRaw(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	metrics.RecordWebhookEvent(eventType, payload.Action)
+
 	input := usecase.HandleWebhookInput{
 		Action:    payload.Action,
 		EventType: eventType,
@@ -103,6 +106,13 @@ func (h *Handler) HandleGitHubAppWebhookRaw(w http.ResponseWriter, r *http.Reque
 		}
 	}
 
+	if payload.Repository != nil {
+		input.RepositoryName = payload.Repository.Name
+		if payload.Repository.Owner != nil {
+			input.RepositoryOwner = payload.Repository.Owner.Login
+		}
+	}
+
 	output, err := h.handleWebhook.Execute(ctx, input)
 	if err != nil {
 		if errors.Is(err, domain.ErrInvalidWebhookPayload) {
@@ -94,3 +94,7 @@ func (m *mockGitHubAppClient) CreateInstallationToken(_ context.Context, _ int64
 func (m *mockGitHubAppClient) GetInstallationURL() string {
 	return m.installURL
 }
+
+func (m *mockGitHubAppClient) VerifyCredentials(_ context.Context) error {
+	return nil
+}
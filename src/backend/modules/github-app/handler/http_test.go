@@ -25,7 +25,7 @@ func generateSignature(secret string, payload []byte) string {
 
 func TestHandleGitHubAppWebhookRaw_InstallationCreated(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -88,7 +88,7 @@ func TestHandleGitHubAppWebhookRaw_InstallationCreated(t *testing.T) {
 
 func TestHandleGitHubAppWebhookRaw_InstallationDeleted(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -142,7 +142,7 @@ func TestHandleGitHubAppWebhookRaw_InstallationDeleted(t *testing.T) {
 
 func TestHandleGitHubAppWebhookRaw_InvalidSignature(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -173,7 +173,7 @@ func TestHandleGitHubAppWebhookRaw_InvalidSignature(t *testing.T) {
 
 func TestHandleGitHubAppWebhookRaw_MissingSignature(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -203,7 +203,7 @@ func TestHandleGitHubAppWebhookRaw_MissingSignature(t *testing.T) {
 
 func TestHandleGitHubAppWebhookRaw_InstallationRepositoriesAdded(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -260,7 +260,7 @@ func TestHandleGitHubAppWebhookRaw_InstallationRepositoriesAdded(t *testing.T) {
 
 func TestHandleGitHubAppWebhookRaw_UnknownEvent(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -302,7 +302,7 @@ func TestHandleGitHubAppWebhookRaw_UnknownEvent(t *testing.T) {
 
 func TestHandleGitHubAppWebhookRaw_InstallationSuspend(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -357,7 +357,7 @@ func TestHandleGitHubAppWebhookRaw_InstallationSuspend(t *testing.T) {
 
 func TestHandleGitHubAppWebhookRaw_InstallationUnsuspend(t *testing.T) {
 	repo := newMockRepo()
-	uc := usecase.NewHandleWebhookUseCase(repo)
+	uc := usecase.NewHandleWebhookUseCase(repo, nil)
 	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
 	if err != nil {
 		t.Fatalf("failed to create verifier: %v", err)
@@ -408,3 +408,53 @@ func TestHandleGitHubAppWebhookRaw_InstallationUnsuspend(t *testing.T) {
 		t.Errorf("expected message 'installation unsuspended', got '%s'", resp.Message)
 	}
 }
+
+type mockCommitCache struct {
+	invalidated []string
+}
+
+func (m *mockCommitCache) InvalidateRepository(owner, repo string) {
+	m.invalidated = append(m.invalidated, owner+"/"+repo)
+}
+
+func TestHandleGitHubAppWebhookRaw_PushInvalidatesCommitCache(t *testing.T) {
+	cache := &mockCommitCache{}
+	uc := usecase.NewHandleWebhookUseCase(newMockRepo(), cache)
+	verifier, err := adapter.NewWebhookVerifier(testWebhookSecret)
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+
+	h, err := NewHandler(&HandlerConfig{
+		HandleWebhook: uc,
+		Logger:        logger.New(),
+		Verifier:      verifier,
+	})
+	if err != nil {
+		t.Fatalf("failed to create handler: %v", err)
+	}
+
+	payload := map[string]interface{}{
+		"ref": "refs/heads/main",
+		"repository": map[string]interface{}{
+			"name":  "api",
+			"owner": map[string]interface{}{"id": 67890, "login": "test-org"},
+		},
+		"installation": map[string]interface{}{"id": 12345},
+	}
+	body, _ := json.Marshal(payload)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/webhooks/github-app", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Hub-Signature-256", generateSignature(testWebhookSecret, body))
+
+	rr := httptest.NewRecorder()
+	h.HandleGitHubAppWebhookRaw(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rr.Code, rr.Body.String())
+	}
+	if len(cache.invalidated) != 1 || cache.invalidated[0] != "test-org/api" {
+		t.Errorf("expected test-org/api to be invalidated, got %v", cache.invalidated)
+	}
+}
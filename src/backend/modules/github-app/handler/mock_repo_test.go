@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/specvital/web/src/backend/modules/github-app/domain"
@@ -35,6 +36,15 @@ func (r *mockRepo) GetByAccountID(_ context.Context, accountID int64) (*entity.I
 	return nil, domain.ErrInstallationNotFound
 }
 
+func (r *mockRepo) GetByAccountLogin(_ context.Context, login string) (*entity.Installation, error) {
+	for _, inst := range r.installations {
+		if strings.EqualFold(inst.AccountLogin, login) {
+			return inst, nil
+		}
+	}
+	return nil, domain.ErrInstallationNotFound
+}
+
 func (r *mockRepo) GetByInstallationID(_ context.Context, installationID int64) (*entity.Installation, error) {
 	if inst, ok := r.installations[installationID]; ok {
 		return inst, nil
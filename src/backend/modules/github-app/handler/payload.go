@@ -5,6 +5,7 @@ import "encoding/json"
 type webhookPayload struct {
 	Action       string               `json:"action"`
 	Installation *webhookInstallation `json:"installation"`
+	Repository   *webhookRepository   `json:"repository"`
 	Sender       *webhookSender       `json:"sender"`
 }
 
@@ -21,6 +22,11 @@ type webhookAccount struct {
 	Type      string  `json:"type"`
 }
 
+type webhookRepository struct {
+	Name  string          `json:"name"`
+	Owner *webhookAccount `json:"owner"`
+}
+
 type webhookSender struct {
 	ID    int64  `json:"id"`
 	Login string `json:"login"`
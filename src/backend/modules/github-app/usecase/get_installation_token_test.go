@@ -30,6 +30,10 @@ func (m *mockGitHubAppClient) GetInstallationURL() string {
 	return "https://github.com/apps/test"
 }
 
+func (m *mockGitHubAppClient) VerifyCredentials(_ context.Context) error {
+	return nil
+}
+
 type mockInstallationRepository struct {
 	getByInstallationIDFn func(context.Context, int64) (*entity.Installation, error)
 }
@@ -42,6 +46,10 @@ func (m *mockInstallationRepository) GetByAccountID(ctx context.Context, account
 	return nil, nil
 }
 
+func (m *mockInstallationRepository) GetByAccountLogin(ctx context.Context, login string) (*entity.Installation, error) {
+	return nil, nil
+}
+
 func (m *mockInstallationRepository) GetByInstallationID(ctx context.Context, installationID int64) (*entity.Installation, error) {
 	if m.getByInstallationIDFn != nil {
 		return m.getByInstallationIDFn(ctx, installationID)
@@ -18,6 +18,8 @@ type HandleWebhookInput struct {
 	AccountType      string
 	AccountAvatarURL *string
 	SuspendedAt      *string
+	RepositoryOwner  string
+	RepositoryName   string
 }
 
 type HandleWebhookOutput struct {
@@ -25,11 +27,15 @@ type HandleWebhookOutput struct {
 }
 
 type HandleWebhookUseCase struct {
-	repo port.InstallationRepository
+	commitCache port.CommitCacheInvalidator
+	repo        port.InstallationRepository
 }
 
-func NewHandleWebhookUseCase(repo port.InstallationRepository) *HandleWebhookUseCase {
-	return &HandleWebhookUseCase{repo: repo}
+func NewHandleWebhookUseCase(repo port.InstallationRepository, commitCache port.CommitCacheInvalidator) *HandleWebhookUseCase {
+	return &HandleWebhookUseCase{
+		commitCache: commitCache,
+		repo:        repo,
+	}
 }
 
 func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input HandleWebhookInput) (*HandleWebhookOutput, error) {
@@ -38,6 +44,8 @@ func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input HandleWebhook
 		return uc.handleInstallation(ctx, input)
 	case "installation_repositories":
 		return uc.handleInstallationRepositories(ctx, input)
+	case "push":
+		return uc.handlePush(input)
 	default:
 		return &HandleWebhookOutput{Message: "event type ignored"}, nil
 	}
@@ -139,3 +147,15 @@ func (uc *HandleWebhookUseCase) handleInstallationRepositories(_ context.Context
 		return &HandleWebhookOutput{Message: "installation_repositories action ignored"}, nil
 	}
 }
+
+func (uc *HandleWebhookUseCase) handlePush(input HandleWebhookInput) (*HandleWebhookOutput, error) {
+	if input.RepositoryOwner == "" || input.RepositoryName == "" {
+		return nil, domain.ErrInvalidWebhookPayload
+	}
+
+	if uc.commitCache != nil {
+		uc.commitCache.InvalidateRepository(input.RepositoryOwner, input.RepositoryName)
+	}
+
+	return &HandleWebhookOutput{Message: "commit cache invalidated"}, nil
+}
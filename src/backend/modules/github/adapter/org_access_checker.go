@@ -0,0 +1,24 @@
+package adapter
+
+import (
+	"context"
+
+	analyzerport "github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	"github.com/specvital/web/src/backend/modules/github/domain/port"
+)
+
+var _ analyzerport.OrgAccessChecker = (*OrgAccessCheckerAdapter)(nil)
+
+// OrgAccessCheckerAdapter answers organization access from synced memberships
+// and GitHub App installations, without calling the GitHub API.
+type OrgAccessCheckerAdapter struct {
+	repository port.Repository
+}
+
+func NewOrgAccessCheckerAdapter(repository port.Repository) *OrgAccessCheckerAdapter {
+	return &OrgAccessCheckerAdapter{repository: repository}
+}
+
+func (a *OrgAccessCheckerAdapter) HasOrgAccess(ctx context.Context, userID, orgLogin string) (bool, error) {
+	return a.repository.HasOrgAccess(ctx, userID, orgLogin)
+}
@@ -0,0 +1,51 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	analyzerdomain "github.com/specvital/web/src/backend/modules/analyzer/domain"
+	analyzerport "github.com/specvital/web/src/backend/modules/analyzer/domain/port"
+	"github.com/specvital/web/src/backend/modules/github/domain"
+	"github.com/specvital/web/src/backend/modules/github/usecase"
+)
+
+var _ analyzerport.OrgRepositoryLister = (*OrgRepositoryListerAdapter)(nil)
+
+// OrgRepositoryListerAdapter exposes cached organization repositories to the
+// analyzer's batch analysis, reusing the installation-token fallback of ListOrgReposUseCase.
+type OrgRepositoryListerAdapter struct {
+	listOrgRepos *usecase.ListOrgReposUseCase
+}
+
+func NewOrgRepositoryListerAdapter(listOrgRepos *usecase.ListOrgReposUseCase) *OrgRepositoryListerAdapter {
+	return &OrgRepositoryListerAdapter{listOrgRepos: listOrgRepos}
+}
+
+func (a *OrgRepositoryListerAdapter) ListOrgRepositories(ctx context.Context, userID, orgLogin string) ([]analyzerport.OrgRepository, error) {
+	repos, err := a.listOrgRepos.Execute(ctx, usecase.ListOrgReposInput{
+		OrgLogin: orgLogin,
+		UserID:   userID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			return nil, fmt.Errorf("%s: %w", orgLogin, analyzerdomain.ErrOrganizationNotFound)
+		}
+		return nil, err
+	}
+
+	result := make([]analyzerport.OrgRepository, 0, len(repos))
+	for _, repo := range repos {
+		if repo.Disabled {
+			continue
+		}
+		result = append(result, analyzerport.OrgRepository{
+			Archived: repo.Archived,
+			Fork:     repo.Fork,
+			Name:     repo.Name,
+			Owner:    repo.Owner,
+		})
+	}
+	return result, nil
+}
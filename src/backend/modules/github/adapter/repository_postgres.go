@@ -124,6 +124,17 @@ func (r *PostgresRepository) GetUserRepositories(ctx context.Context, userID str
 	return repos, nil
 }
 
+func (r *PostgresRepository) HasOrgAccess(ctx context.Context, userID, orgLogin string) (bool, error) {
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return false, err
+	}
+	return r.queries.HasUserOrgAccess(ctx, db.HasUserOrgAccessParams{
+		UserID: uid,
+		Login:  orgLogin,
+	})
+}
+
 func (r *PostgresRepository) HasOrgRepositories(ctx context.Context, userID, orgID string) (bool, error) {
 	uid, err := parseUUID(userID)
 	if err != nil {
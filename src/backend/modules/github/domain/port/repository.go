@@ -13,6 +13,7 @@ type Repository interface {
 	GetOrgRepositories(ctx context.Context, userID, orgID string) ([]RepositoryRecord, error)
 	GetUserOrganizations(ctx context.Context, userID string) ([]OrganizationRecord, error)
 	GetUserRepositories(ctx context.Context, userID string) ([]RepositoryRecord, error)
+	HasOrgAccess(ctx context.Context, userID, orgLogin string) (bool, error)
 	HasOrgRepositories(ctx context.Context, userID, orgID string) (bool, error)
 	HasUserOrganizations(ctx context.Context, userID string) (bool, error)
 	HasUserRepositories(ctx context.Context, userID string) (bool, error)
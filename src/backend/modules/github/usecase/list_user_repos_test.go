@@ -118,6 +118,10 @@ func (m *mockRepository) GetUserRepositories(_ context.Context, _ string) ([]por
 	return m.repos, nil
 }
 
+func (m *mockRepository) HasOrgAccess(_ context.Context, _, _ string) (bool, error) {
+	return false, m.err
+}
+
 func (m *mockRepository) HasOrgRepositories(_ context.Context, _, _ string) (bool, error) {
 	return m.hasOrgRepos, m.err
 }
@@ -0,0 +1,60 @@
+package adapter
+
+import (
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultAnalysisGracePeriod = 7 * 24 * time.Hour
+	defaultPurgeBatchSize      = 1000
+	defaultPurgeInterval       = 6 * time.Hour
+)
+
+type PurgeConfig struct {
+	// AnalysisGracePeriod keeps unreferenced analyses until they are at least this old.
+	AnalysisGracePeriod time.Duration
+	// BatchSize caps rows removed per DELETE statement.
+	BatchSize int
+	// DryRun only counts what would be removed. It is on unless
+	// RETENTION_PURGE_DRY_RUN is explicitly "false", so deletion is opt-in.
+	DryRun bool
+	// Interval is how often the purge job runs.
+	Interval time.Duration
+}
+
+func PurgeConfigFromEnv() *PurgeConfig {
+	cfg := &PurgeConfig{
+		AnalysisGracePeriod: parseDurationEnv("RETENTION_ANALYSIS_GRACE_PERIOD", defaultAnalysisGracePeriod),
+		BatchSize:           defaultPurgeBatchSize,
+		DryRun:              os.Getenv("RETENTION_PURGE_DRY_RUN") != "false",
+		Interval:            parseDurationEnv("RETENTION_PURGE_INTERVAL", defaultPurgeInterval),
+	}
+
+	if v := os.Getenv("RETENTION_PURGE_BATCH_SIZE"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil || size <= 0 {
+			slog.Warn("invalid RETENTION_PURGE_BATCH_SIZE, using default",
+				"value", v, "default", defaultPurgeBatchSize)
+		} else {
+			cfg.BatchSize = size
+		}
+	}
+
+	return cfg
+}
+
+func parseDurationEnv(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		slog.Warn("invalid "+key+", using default", "value", v, "default", fallback)
+		return fallback
+	}
+	return d
+}
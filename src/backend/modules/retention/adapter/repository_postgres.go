@@ -0,0 +1,83 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/retention/domain/port"
+)
+
+type PostgresRepository struct {
+	queries *db.Queries
+}
+
+var _ port.PurgeRepository = (*PostgresRepository)(nil)
+
+func NewPostgresRepository(queries *db.Queries) *PostgresRepository {
+	return &PostgresRepository{queries: queries}
+}
+
+func (r *PostgresRepository) CountExpiredSpecDocuments(ctx context.Context, now time.Time) (int64, error) {
+	total, err := r.queries.CountExpiredSpecDocuments(ctx, toTimestamptz(now))
+	if err != nil {
+		return 0, fmt.Errorf("count expired spec documents: %w", err)
+	}
+	return total, nil
+}
+
+func (r *PostgresRepository) CountExpiredUserHistory(ctx context.Context, now time.Time) (int64, error) {
+	total, err := r.queries.CountExpiredUserAnalysisHistory(ctx, toTimestamptz(now))
+	if err != nil {
+		return 0, fmt.Errorf("count expired user analysis history: %w", err)
+	}
+	return total, nil
+}
+
+func (r *PostgresRepository) CountUnreferencedAnalyses(ctx context.Context, createdBefore time.Time) (int64, error) {
+	total, err := r.queries.CountUnreferencedAnalyses(ctx, toTimestamptz(createdBefore))
+	if err != nil {
+		return 0, fmt.Errorf("count unreferenced analyses: %w", err)
+	}
+	return total, nil
+}
+
+func (r *PostgresRepository) DeleteExpiredSpecDocuments(ctx context.Context, now time.Time, limit int) (int64, error) {
+	deleted, err := r.queries.DeleteExpiredSpecDocuments(ctx, db.DeleteExpiredSpecDocumentsParams{
+		Now:       toTimestamptz(now),
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete expired spec documents: %w", err)
+	}
+	return deleted, nil
+}
+
+func (r *PostgresRepository) DeleteExpiredUserHistory(ctx context.Context, now time.Time, limit int) (int64, error) {
+	deleted, err := r.queries.DeleteExpiredUserAnalysisHistory(ctx, db.DeleteExpiredUserAnalysisHistoryParams{
+		Now:       toTimestamptz(now),
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete expired user analysis history: %w", err)
+	}
+	return deleted, nil
+}
+
+func (r *PostgresRepository) DeleteUnreferencedAnalyses(ctx context.Context, createdBefore time.Time, limit int) (int64, error) {
+	deleted, err := r.queries.DeleteUnreferencedAnalyses(ctx, db.DeleteUnreferencedAnalysesParams{
+		CreatedBefore: toTimestamptz(createdBefore),
+		BatchSize:     int32(limit),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete unreferenced analyses: %w", err)
+	}
+	return deleted, nil
+}
+
+func toTimestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
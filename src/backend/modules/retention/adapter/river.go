@@ -0,0 +1,7 @@
+package adapter
+
+const TypeRetentionPurge = "web:retention_purge"
+
+type RetentionPurgeArgs struct{}
+
+func (RetentionPurgeArgs) Kind() string { return TypeRetentionPurge }
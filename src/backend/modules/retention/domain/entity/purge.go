@@ -0,0 +1,13 @@
+package entity
+
+// PurgeResult reports how many rows a retention purge removed, or would remove in dry-run mode.
+type PurgeResult struct {
+	AnalysesDeleted      int64 `json:"analyses_deleted"`
+	DryRun               bool  `json:"dry_run"`
+	SpecDocumentsDeleted int64 `json:"spec_documents_deleted"`
+	UserHistoryDeleted   int64 `json:"user_history_deleted"`
+}
+
+func (r *PurgeResult) Total() int64 {
+	return r.AnalysesDeleted + r.SpecDocumentsDeleted + r.UserHistoryDeleted
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// PurgeRepository finds and deletes data past its plan retention window.
+// Delete methods remove at most limit rows per call and return the number deleted.
+type PurgeRepository interface {
+	CountExpiredSpecDocuments(ctx context.Context, now time.Time) (int64, error)
+	CountExpiredUserHistory(ctx context.Context, now time.Time) (int64, error)
+	CountUnreferencedAnalyses(ctx context.Context, createdBefore time.Time) (int64, error)
+	DeleteExpiredSpecDocuments(ctx context.Context, now time.Time, limit int) (int64, error)
+	DeleteExpiredUserHistory(ctx context.Context, now time.Time, limit int) (int64, error)
+	DeleteUnreferencedAnalyses(ctx context.Context, createdBefore time.Time, limit int) (int64, error)
+}
@@ -0,0 +1,82 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/specvital/web/src/backend/common/metrics"
+	"github.com/specvital/web/src/backend/common/queue"
+	"github.com/specvital/web/src/backend/modules/retention/adapter"
+	"github.com/specvital/web/src/backend/modules/retention/domain/entity"
+	"github.com/specvital/web/src/backend/modules/retention/usecase"
+)
+
+const purgeTimeout = 30 * time.Minute
+
+// PurgeWorker runs the retention purge. Counts of removed rows are logged,
+// exported as Prometheus counters per table and recorded as the job's output.
+type PurgeWorker struct {
+	river.WorkerDefaults[adapter.RetentionPurgeArgs]
+	dryRun bool
+	purge  *usecase.PurgeExpiredDataUseCase
+}
+
+func NewPurgeWorker(purge *usecase.PurgeExpiredDataUseCase, dryRun bool) *PurgeWorker {
+	return &PurgeWorker{dryRun: dryRun, purge: purge}
+}
+
+func (w *PurgeWorker) Timeout(*river.Job[adapter.RetentionPurgeArgs]) time.Duration {
+	return purgeTimeout
+}
+
+func (w *PurgeWorker) Work(ctx context.Context, _ *river.Job[adapter.RetentionPurgeArgs]) error {
+	start := time.Now()
+	result, err := w.purge.Execute(ctx, usecase.PurgeExpiredDataInput{DryRun: w.dryRun})
+	if result != nil {
+		recordPurgeMetrics(result)
+	}
+	if err != nil {
+		if result != nil {
+			slog.WarnContext(ctx, "retention purge interrupted",
+				"user_history", result.UserHistoryDeleted,
+				"spec_documents", result.SpecDocumentsDeleted,
+				"analyses", result.AnalysesDeleted,
+				"error", err)
+		}
+		return fmt.Errorf("purge expired data: %w", err)
+	}
+
+	slog.InfoContext(ctx, "retention purge completed",
+		"dry_run", result.DryRun,
+		"user_history", result.UserHistoryDeleted,
+		"spec_documents", result.SpecDocumentsDeleted,
+		"analyses", result.AnalysesDeleted,
+		"total", result.Total(),
+		"duration_ms", time.Since(start).Milliseconds())
+	return river.RecordOutput(ctx, result)
+}
+
+func recordPurgeMetrics(result *entity.PurgeResult) {
+	record := metrics.RecordRetentionPurge
+	if result.DryRun {
+		record = metrics.SetRetentionPurgeCandidates
+	}
+	record("analyses", result.AnalysesDeleted)
+	record("spec_documents", result.SpecDocumentsDeleted)
+	record("user_analysis_history", result.UserHistoryDeleted)
+}
+
+// NewPurgePeriodicJob schedules PurgeWorker on the elected leader only.
+func NewPurgePeriodicJob(interval time.Duration) *river.PeriodicJob {
+	return river.NewPeriodicJob(
+		river.PeriodicInterval(interval),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return adapter.RetentionPurgeArgs{}, &river.InsertOpts{Queue: queue.QueueWebMaintenance}
+		},
+		nil,
+	)
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/retention/domain/entity"
+	"github.com/specvital/web/src/backend/modules/retention/domain/port"
+)
+
+type PurgeExpiredDataInput struct {
+	DryRun bool
+}
+
+// PurgeExpiredDataUseCase deletes user history entries and spec documents whose
+// retention_days_at_creation window has passed, then analyses nobody references anymore.
+// Rows are deleted in batches to keep each statement's locks short.
+type PurgeExpiredDataUseCase struct {
+	analysisGracePeriod time.Duration
+	batchSize           int
+	repository          port.PurgeRepository
+}
+
+// NewPurgeExpiredDataUseCase creates the use case. Unreferenced analyses younger
+// than analysisGracePeriod are kept so in-flight views are not pulled out from under users.
+func NewPurgeExpiredDataUseCase(
+	repository port.PurgeRepository,
+	batchSize int,
+	analysisGracePeriod time.Duration,
+) *PurgeExpiredDataUseCase {
+	return &PurgeExpiredDataUseCase{
+		analysisGracePeriod: analysisGracePeriod,
+		batchSize:           batchSize,
+		repository:          repository,
+	}
+}
+
+func (uc *PurgeExpiredDataUseCase) Execute(ctx context.Context, input PurgeExpiredDataInput) (*entity.PurgeResult, error) {
+	now := time.Now()
+	analysesBefore := now.Add(-uc.analysisGracePeriod)
+	result := &entity.PurgeResult{DryRun: input.DryRun}
+
+	if input.DryRun {
+		return uc.count(ctx, now, analysesBefore, result)
+	}
+
+	var err error
+	// Order matters: removing history and documents first lets the analysis
+	// pass pick up analyses they were the last reference to.
+	result.UserHistoryDeleted, err = uc.deleteInBatches(ctx, func(limit int) (int64, error) {
+		return uc.repository.DeleteExpiredUserHistory(ctx, now, limit)
+	})
+	if err != nil {
+		return result, fmt.Errorf("purge user history: %w", err)
+	}
+
+	result.SpecDocumentsDeleted, err = uc.deleteInBatches(ctx, func(limit int) (int64, error) {
+		return uc.repository.DeleteExpiredSpecDocuments(ctx, now, limit)
+	})
+	if err != nil {
+		return result, fmt.Errorf("purge spec documents: %w", err)
+	}
+
+	result.AnalysesDeleted, err = uc.deleteInBatches(ctx, func(limit int) (int64, error) {
+		return uc.repository.DeleteUnreferencedAnalyses(ctx, analysesBefore, limit)
+	})
+	if err != nil {
+		return result, fmt.Errorf("purge unreferenced analyses: %w", err)
+	}
+
+	return result, nil
+}
+
+// count reports what a purge would delete right now. Analyses that would only
+// become unreferenced once history and documents are removed are not included.
+func (uc *PurgeExpiredDataUseCase) count(ctx context.Context, now, analysesBefore time.Time, result *entity.PurgeResult) (*entity.PurgeResult, error) {
+	var err error
+
+	result.UserHistoryDeleted, err = uc.repository.CountExpiredUserHistory(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("count expired user history: %w", err)
+	}
+
+	result.SpecDocumentsDeleted, err = uc.repository.CountExpiredSpecDocuments(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("count expired spec documents: %w", err)
+	}
+
+	result.AnalysesDeleted, err = uc.repository.CountUnreferencedAnalyses(ctx, analysesBefore)
+	if err != nil {
+		return nil, fmt.Errorf("count unreferenced analyses: %w", err)
+	}
+
+	return result, nil
+}
+
+func (uc *PurgeExpiredDataUseCase) deleteInBatches(ctx context.Context, deleteBatch func(limit int) (int64, error)) (int64, error) {
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+
+		deleted, err := deleteBatch(uc.batchSize)
+		if err != nil {
+			return total, err
+		}
+		total += deleted
+
+		if deleted < int64(uc.batchSize) {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/retention/usecase"
+)
+
+// mockPurgeRepository implements port.PurgeRepository with a fixed number of
+// deletable rows per kind.
+type mockPurgeRepository struct {
+	analyses      int64
+	history       int64
+	specDocuments int64
+
+	analysesBefore time.Time
+	calls          []string
+	deleteErr      error
+}
+
+func (m *mockPurgeRepository) CountExpiredSpecDocuments(_ context.Context, _ time.Time) (int64, error) {
+	return m.specDocuments, nil
+}
+func (m *mockPurgeRepository) CountExpiredUserHistory(_ context.Context, _ time.Time) (int64, error) {
+	return m.history, nil
+}
+func (m *mockPurgeRepository) CountUnreferencedAnalyses(_ context.Context, createdBefore time.Time) (int64, error) {
+	m.analysesBefore = createdBefore
+	return m.analyses, nil
+}
+func (m *mockPurgeRepository) DeleteExpiredSpecDocuments(_ context.Context, _ time.Time, limit int) (int64, error) {
+	m.calls = append(m.calls, "spec_documents")
+	return take(&m.specDocuments, limit), nil
+}
+func (m *mockPurgeRepository) DeleteExpiredUserHistory(_ context.Context, _ time.Time, limit int) (int64, error) {
+	m.calls = append(m.calls, "history")
+	if m.deleteErr != nil {
+		return 0, m.deleteErr
+	}
+	return take(&m.history, limit), nil
+}
+func (m *mockPurgeRepository) DeleteUnreferencedAnalyses(_ context.Context, createdBefore time.Time, limit int) (int64, error) {
+	m.calls = append(m.calls, "analyses")
+	m.analysesBefore = createdBefore
+	return take(&m.analyses, limit), nil
+}
+
+func take(remaining *int64, limit int) int64 {
+	n := min(*remaining, int64(limit))
+	*remaining -= n
+	return n
+}
+
+func TestPurgeExpiredDataUseCase_Execute(t *testing.T) {
+	t.Run("deletes in batches until exhausted", func(t *testing.T) {
+		repo := &mockPurgeRepository{analyses: 3, history: 25, specDocuments: 10}
+		uc := usecase.NewPurgeExpiredDataUseCase(repo, 10, 24*time.Hour)
+
+		result, err := uc.Execute(context.Background(), usecase.PurgeExpiredDataInput{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.UserHistoryDeleted != 25 || result.SpecDocumentsDeleted != 10 || result.AnalysesDeleted != 3 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if result.Total() != 38 {
+			t.Errorf("expected total 38, got %d", result.Total())
+		}
+
+		// 3 history batches, 2 spec document batches (the second returns 0), 1 analysis batch.
+		want := []string{"history", "history", "history", "spec_documents", "spec_documents", "analyses"}
+		if len(repo.calls) != len(want) {
+			t.Fatalf("expected calls %v, got %v", want, repo.calls)
+		}
+		for i := range want {
+			if repo.calls[i] != want[i] {
+				t.Errorf("call %d: expected %s, got %s", i, want[i], repo.calls[i])
+			}
+		}
+	})
+
+	t.Run("applies the analysis grace period", func(t *testing.T) {
+		repo := &mockPurgeRepository{}
+		uc := usecase.NewPurgeExpiredDataUseCase(repo, 10, 48*time.Hour)
+
+		before := time.Now()
+		if _, err := uc.Execute(context.Background(), usecase.PurgeExpiredDataInput{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		cutoff := before.Add(-48 * time.Hour)
+		if repo.analysesBefore.Before(cutoff) || repo.analysesBefore.After(time.Now().Add(-48*time.Hour)) {
+			t.Errorf("expected cutoff about 48h ago, got %v", repo.analysesBefore)
+		}
+	})
+
+	t.Run("dry run counts without deleting", func(t *testing.T) {
+		repo := &mockPurgeRepository{analyses: 1, history: 2, specDocuments: 3}
+		uc := usecase.NewPurgeExpiredDataUseCase(repo, 10, time.Hour)
+
+		result, err := uc.Execute(context.Background(), usecase.PurgeExpiredDataInput{DryRun: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !result.DryRun {
+			t.Error("expected DryRun to be reported")
+		}
+		if result.UserHistoryDeleted != 2 || result.SpecDocumentsDeleted != 3 || result.AnalysesDeleted != 1 {
+			t.Errorf("unexpected result: %+v", result)
+		}
+		if len(repo.calls) != 0 {
+			t.Errorf("expected no deletes, got %v", repo.calls)
+		}
+	})
+
+	t.Run("stops on delete error", func(t *testing.T) {
+		repo := &mockPurgeRepository{deleteErr: errors.New("db down"), specDocuments: 5}
+		uc := usecase.NewPurgeExpiredDataUseCase(repo, 10, time.Hour)
+
+		if _, err := uc.Execute(context.Background(), usecase.PurgeExpiredDataInput{}); err == nil {
+			t.Fatal("expected error")
+		}
+		if len(repo.calls) != 1 {
+			t.Errorf("expected to stop after the failing call, got %v", repo.calls)
+		}
+	})
+
+	t.Run("stops when context is canceled", func(t *testing.T) {
+		repo := &mockPurgeRepository{history: 100}
+		uc := usecase.NewPurgeExpiredDataUseCase(repo, 10, time.Hour)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if _, err := uc.Execute(ctx, usecase.PurgeExpiredDataInput{}); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
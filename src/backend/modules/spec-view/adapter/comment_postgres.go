@@ -0,0 +1,354 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+var _ port.SpecCommentRepository = (*PostgresRepository)(nil)
+
+func (r *PostgresRepository) GetCommentDocument(ctx context.Context, access entity.DocumentAccess, documentID string) (*entity.CommentDocument, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return nil, err
+	}
+	documentUID, err := parseUUID(documentID)
+	if err != nil {
+		return nil, domain.ErrDocumentNotFound
+	}
+
+	row, err := r.queries.GetSpecCommentDocument(ctx, db.GetSpecCommentDocumentParams{
+		DocumentID:  documentUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrDocumentNotFound
+		}
+		return nil, err
+	}
+
+	domains, err := r.buildDomainHierarchy(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CommentDocument{
+		CreatedAt: row.CreatedAt.Time,
+		Domains:   domains,
+		ID:        uuidToString(row.ID),
+		Scope: entity.SpecEditScope{
+			CodebaseID: uuidToString(row.CodebaseID),
+			Language:   row.Language,
+			UserID:     access.UserID,
+		},
+		WorkspaceID: access.WorkspaceID,
+	}, nil
+}
+
+func (r *PostgresRepository) GetCommentThreads(ctx context.Context, document *entity.CommentDocument) ([]entity.CommentThread, error) {
+	userUID, codebaseUID, err := parseEditScope(document.Scope)
+	if err != nil {
+		return nil, err
+	}
+	workspaceUID, err := parseOptionalUUID(document.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.GetSpecCommentThreads(ctx, db.GetSpecCommentThreadsParams{
+		CodebaseID:        codebaseUID,
+		DocumentCreatedAt: pgtype.Timestamptz{Time: document.CreatedAt, Valid: true},
+		Language:          document.Scope.Language,
+		UserID:            userUID,
+		WorkspaceID:       workspaceUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []entity.CommentThread{}, nil
+	}
+
+	threads := make([]entity.CommentThread, len(rows))
+	threadIDs := make([]pgtype.UUID, len(rows))
+	threadIndex := make(map[string]int, len(rows))
+	for i, row := range rows {
+		threads[i] = entity.CommentThread{
+			Anchor: entity.CommentAnchor{
+				DomainName:       row.DomainName,
+				FeatureName:      row.FeatureName,
+				FilePath:         row.FilePath,
+				SourceTestCaseID: optionalUUID(row.SourceTestCaseID),
+				TestName:         row.TestName,
+				Type:             entity.CommentTargetType(row.TargetType),
+			},
+			CreatedAt:  row.CreatedAt.Time,
+			DocumentID: optionalUUID(row.DocumentID),
+			ID:         uuidToString(row.ID),
+			ResolvedBy: optionalUUID(row.ResolvedBy),
+		}
+		if row.ResolvedAt.Valid {
+			resolvedAt := row.ResolvedAt.Time
+			threads[i].ResolvedAt = &resolvedAt
+		}
+		if row.ResolvedByUsername.Valid {
+			threads[i].ResolvedByUsername = &row.ResolvedByUsername.String
+		}
+		threadIDs[i] = row.ID
+		threadIndex[threads[i].ID] = i
+	}
+
+	commentRows, err := r.queries.GetSpecCommentsByThreadIDs(ctx, threadIDs)
+	if err != nil {
+		return nil, err
+	}
+	comments := make([]entity.Comment, len(commentRows))
+	commentIDs := make([]pgtype.UUID, len(commentRows))
+	for i, row := range commentRows {
+		comments[i] = mapComment(db.GetSpecCommentByIDRow(row))
+		commentIDs[i] = row.ID
+	}
+	if err := r.attachMentions(ctx, comments, commentIDs); err != nil {
+		return nil, err
+	}
+
+	for _, c := range comments {
+		i := threadIndex[c.ThreadID]
+		threads[i].Comments = append(threads[i].Comments, c)
+	}
+	return threads, nil
+}
+
+func (r *PostgresRepository) CreateCommentThread(ctx context.Context, document *entity.CommentDocument, anchor entity.CommentAnchor, body string, mentions []string) (*entity.CommentThread, error) {
+	userUID, codebaseUID, err := parseEditScope(document.Scope)
+	if err != nil {
+		return nil, err
+	}
+	documentUID, err := parseUUID(document.ID)
+	if err != nil {
+		return nil, err
+	}
+	workspaceUID, err := parseOptionalUUID(document.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.CreateSpecCommentThreadParams{
+		Body:              body,
+		CodebaseID:        codebaseUID,
+		DocumentCreatedAt: pgtype.Timestamptz{Time: document.CreatedAt, Valid: true},
+		DocumentID:        documentUID,
+		DomainName:        anchor.DomainName,
+		FeatureName:       anchor.FeatureName,
+		FilePath:          anchor.FilePath,
+		Language:          document.Scope.Language,
+		TargetType:        string(anchor.Type),
+		TestName:          anchor.TestName,
+		UserID:            userUID,
+		WorkspaceID:       workspaceUID,
+	}
+	if anchor.SourceTestCaseID != nil {
+		if params.SourceTestCaseID, err = parseUUID(*anchor.SourceTestCaseID); err != nil {
+			return nil, err
+		}
+	}
+
+	commentID, err := r.queries.CreateSpecCommentThread(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := r.saveMentionsAndGetComment(ctx, commentID, mentions, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CommentThread{
+		Anchor:     anchor,
+		Comments:   []entity.Comment{*comment},
+		CreatedAt:  comment.CreatedAt,
+		DocumentID: &document.ID,
+		ID:         comment.ThreadID,
+	}, nil
+}
+
+func (r *PostgresRepository) AddComment(ctx context.Context, access entity.DocumentAccess, threadID, body string, mentions []string) (*entity.Comment, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return nil, err
+	}
+	threadUID, err := parseUUID(threadID)
+	if err != nil {
+		return nil, domain.ErrCommentThreadNotFound
+	}
+
+	commentID, err := r.queries.AddSpecComment(ctx, db.AddSpecCommentParams{
+		Body:        body,
+		ThreadID:    threadUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCommentThreadNotFound
+		}
+		return nil, err
+	}
+	return r.saveMentionsAndGetComment(ctx, commentID, mentions, false)
+}
+
+func (r *PostgresRepository) UpdateComment(ctx context.Context, access entity.DocumentAccess, commentID, body string, mentions []string) (*entity.Comment, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return nil, err
+	}
+	commentUID, err := parseUUID(commentID)
+	if err != nil {
+		return nil, domain.ErrCommentNotFound
+	}
+
+	updated, err := r.queries.UpdateSpecComment(ctx, db.UpdateSpecCommentParams{
+		Body:        body,
+		ID:          commentUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, domain.ErrCommentNotFound
+	}
+	return r.saveMentionsAndGetComment(ctx, commentUID, mentions, true)
+}
+
+func (r *PostgresRepository) DeleteComment(ctx context.Context, access entity.DocumentAccess, commentID string) (bool, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return false, err
+	}
+	commentUID, err := parseUUID(commentID)
+	if err != nil {
+		return false, nil
+	}
+
+	threadID, err := r.queries.DeleteSpecComment(ctx, db.DeleteSpecCommentParams{
+		ID:          commentUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := r.queries.DeleteEmptySpecCommentThread(ctx, threadID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *PostgresRepository) SetCommentThreadResolved(ctx context.Context, access entity.DocumentAccess, threadID string, resolved bool) (bool, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return false, err
+	}
+	threadUID, err := parseUUID(threadID)
+	if err != nil {
+		return false, nil
+	}
+
+	var updated int64
+	if resolved {
+		updated, err = r.queries.ResolveSpecCommentThread(ctx, db.ResolveSpecCommentThreadParams{
+			ID:          threadUID,
+			UserID:      userUID,
+			WorkspaceID: workspaceUID,
+		})
+	} else {
+		updated, err = r.queries.ReopenSpecCommentThread(ctx, db.ReopenSpecCommentThreadParams{
+			ID:          threadUID,
+			UserID:      userUID,
+			WorkspaceID: workspaceUID,
+		})
+	}
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// saveMentionsAndGetComment records the mentions of a written comment, replacing
+// earlier ones when the comment was edited, and reads the comment back.
+func (r *PostgresRepository) saveMentionsAndGetComment(ctx context.Context, commentID pgtype.UUID, mentions []string, replace bool) (*entity.Comment, error) {
+	if replace {
+		if err := r.queries.DeleteSpecCommentMentions(ctx, commentID); err != nil {
+			return nil, err
+		}
+	}
+	if len(mentions) > 0 {
+		if err := r.queries.InsertSpecCommentMentions(ctx, db.InsertSpecCommentMentionsParams{
+			CommentID: commentID,
+			Usernames: mentions,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := r.queries.GetSpecCommentByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	comments := []entity.Comment{mapComment(row)}
+	if err := r.attachMentions(ctx, comments, []pgtype.UUID{commentID}); err != nil {
+		return nil, err
+	}
+	return &comments[0], nil
+}
+
+func (r *PostgresRepository) attachMentions(ctx context.Context, comments []entity.Comment, commentIDs []pgtype.UUID) error {
+	if len(commentIDs) == 0 {
+		return nil
+	}
+
+	rows, err := r.queries.GetSpecCommentMentionsByCommentIDs(ctx, commentIDs)
+	if err != nil {
+		return err
+	}
+
+	byComment := make(map[string][]entity.CommentMention)
+	for _, row := range rows {
+		id := uuidToString(row.CommentID)
+		byComment[id] = append(byComment[id], entity.CommentMention{
+			UserID:   uuidToString(row.UserID),
+			Username: row.Username,
+		})
+	}
+	for i := range comments {
+		comments[i].Mentions = byComment[comments[i].ID]
+	}
+	return nil
+}
+
+func mapComment(row db.GetSpecCommentByIDRow) entity.Comment {
+	return entity.Comment{
+		AuthorID:       uuidToString(row.AuthorID),
+		AuthorUsername: row.AuthorUsername,
+		Body:           row.Body,
+		CreatedAt:      row.CreatedAt.Time,
+		ID:             uuidToString(row.ID),
+		ThreadID:       uuidToString(row.ThreadID),
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+}
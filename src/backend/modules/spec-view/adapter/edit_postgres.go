@@ -0,0 +1,310 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+var _ port.SpecEditRepository = (*PostgresRepository)(nil)
+
+func (r *PostgresRepository) GetSpecEdits(ctx context.Context, access entity.DocumentAccess, analysisID, language string) (*entity.SpecEdits, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return nil, err
+	}
+	analysisUID, err := parseUUID(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	codebaseID, err := r.queries.GetAnalysisCodebaseID(ctx, analysisUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAnalysisNotFound
+		}
+		return nil, err
+	}
+
+	behaviorRows, err := r.queries.GetSpecBehaviorEdits(ctx, db.GetSpecBehaviorEditsParams{
+		CodebaseID:  codebaseID,
+		Language:    language,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	featureRows, err := r.queries.GetSpecFeatureEdits(ctx, db.GetSpecFeatureEditsParams{
+		CodebaseID:  codebaseID,
+		Language:    language,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	edits := &entity.SpecEdits{
+		Behaviors: make([]entity.BehaviorEdit, len(behaviorRows)),
+		Features:  make([]entity.FeatureEdit, len(featureRows)),
+	}
+	for i, row := range behaviorRows {
+		edits.Behaviors[i] = entity.BehaviorEdit{
+			FilePath:         row.FilePath,
+			Hidden:           row.Hidden,
+			ID:               uuidToString(row.ID),
+			SourceTestCaseID: optionalUUID(row.SourceTestCaseID),
+			TestName:         row.TestName,
+			UpdatedAt:        row.UpdatedAt.Time,
+		}
+		if row.Description.Valid {
+			edits.Behaviors[i].Description = &row.Description.String
+		}
+	}
+	for i, row := range featureRows {
+		edits.Features[i] = entity.FeatureEdit{
+			DomainName:  row.DomainName,
+			FeatureName: row.FeatureName,
+			ID:          uuidToString(row.ID),
+			NewName:     row.NewName,
+			UpdatedAt:   row.UpdatedAt.Time,
+		}
+	}
+	return edits, nil
+}
+
+func (r *PostgresRepository) GetBehaviorEditTarget(ctx context.Context, access entity.DocumentAccess, behaviorID string) (*entity.BehaviorEditTarget, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return nil, err
+	}
+	behaviorUID, err := parseUUID(behaviorID)
+	if err != nil {
+		return nil, domain.ErrBehaviorNotFound
+	}
+
+	row, err := r.queries.GetSpecBehaviorEditTarget(ctx, db.GetSpecBehaviorEditTargetParams{
+		BehaviorID:  behaviorUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrBehaviorNotFound
+		}
+		return nil, err
+	}
+
+	return &entity.BehaviorEditTarget{
+		FilePath: row.FilePath,
+		Scope: entity.SpecEditScope{
+			CodebaseID:  uuidToString(row.CodebaseID),
+			Language:    row.Language,
+			UserID:      access.UserID,
+			WorkspaceID: access.WorkspaceID,
+		},
+		SourceTestCaseID: optionalUUID(row.SourceTestCaseID),
+		TestName:         row.OriginalName,
+	}, nil
+}
+
+func (r *PostgresRepository) GetFeatureEditTarget(ctx context.Context, access entity.DocumentAccess, featureID string) (*entity.FeatureEditTarget, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return nil, err
+	}
+	featureUID, err := parseUUID(featureID)
+	if err != nil {
+		return nil, domain.ErrFeatureNotFound
+	}
+
+	row, err := r.queries.GetSpecFeatureEditTarget(ctx, db.GetSpecFeatureEditTargetParams{
+		FeatureID:   featureUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrFeatureNotFound
+		}
+		return nil, err
+	}
+
+	return &entity.FeatureEditTarget{
+		DomainName:  row.DomainName,
+		FeatureName: row.FeatureName,
+		Scope: entity.SpecEditScope{
+			CodebaseID:  uuidToString(row.CodebaseID),
+			Language:    row.Language,
+			UserID:      access.UserID,
+			WorkspaceID: access.WorkspaceID,
+		},
+	}, nil
+}
+
+func (r *PostgresRepository) SaveBehaviorEdit(ctx context.Context, scope entity.SpecEditScope, edit entity.BehaviorEdit) (*entity.BehaviorEdit, error) {
+	userUID, codebaseUID, workspaceUID, err := parseSharedEditScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpsertSpecBehaviorEditParams{
+		CodebaseID: codebaseUID,
+		FilePath:   edit.FilePath,
+		Hidden:     edit.Hidden,
+		Language:   scope.Language,
+		TestName:   edit.TestName,
+		UserID:     userUID,
+	}
+	if edit.Description != nil {
+		params.Description = pgtype.Text{String: *edit.Description, Valid: true}
+	}
+	if edit.SourceTestCaseID != nil {
+		if params.SourceTestCaseID, err = parseUUID(*edit.SourceTestCaseID); err != nil {
+			return nil, err
+		}
+	}
+
+	if workspaceUID.Valid {
+		row, err := r.queries.UpsertWorkspaceSpecBehaviorEdit(ctx, db.UpsertWorkspaceSpecBehaviorEditParams{
+			CodebaseID:       params.CodebaseID,
+			Description:      params.Description,
+			FilePath:         params.FilePath,
+			Hidden:           params.Hidden,
+			Language:         params.Language,
+			SourceTestCaseID: params.SourceTestCaseID,
+			TestName:         params.TestName,
+			UserID:           params.UserID,
+			WorkspaceID:      workspaceUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		edit.ID = uuidToString(row.ID)
+		edit.UpdatedAt = row.UpdatedAt.Time
+		return &edit, nil
+	}
+
+	row, err := r.queries.UpsertSpecBehaviorEdit(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	edit.ID = uuidToString(row.ID)
+	edit.UpdatedAt = row.UpdatedAt.Time
+	return &edit, nil
+}
+
+func (r *PostgresRepository) SaveFeatureEdit(ctx context.Context, scope entity.SpecEditScope, edit entity.FeatureEdit) (*entity.FeatureEdit, error) {
+	userUID, codebaseUID, workspaceUID, err := parseSharedEditScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if workspaceUID.Valid {
+		row, err := r.queries.UpsertWorkspaceSpecFeatureEdit(ctx, db.UpsertWorkspaceSpecFeatureEditParams{
+			CodebaseID:  codebaseUID,
+			DomainName:  edit.DomainName,
+			FeatureName: edit.FeatureName,
+			Language:    scope.Language,
+			NewName:     edit.NewName,
+			UserID:      userUID,
+			WorkspaceID: workspaceUID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		edit.ID = uuidToString(row.ID)
+		edit.UpdatedAt = row.UpdatedAt.Time
+		return &edit, nil
+	}
+
+	row, err := r.queries.UpsertSpecFeatureEdit(ctx, db.UpsertSpecFeatureEditParams{
+		CodebaseID:  codebaseUID,
+		DomainName:  edit.DomainName,
+		FeatureName: edit.FeatureName,
+		Language:    scope.Language,
+		NewName:     edit.NewName,
+		UserID:      userUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	edit.ID = uuidToString(row.ID)
+	edit.UpdatedAt = row.UpdatedAt.Time
+	return &edit, nil
+}
+
+func (r *PostgresRepository) DeleteBehaviorEdit(ctx context.Context, target entity.BehaviorEditTarget) (bool, error) {
+	userUID, codebaseUID, workspaceUID, err := parseSharedEditScope(target.Scope)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := r.queries.DeleteSpecBehaviorEdit(ctx, db.DeleteSpecBehaviorEditParams{
+		CodebaseID:  codebaseUID,
+		FilePath:    target.FilePath,
+		Language:    target.Scope.Language,
+		TestName:    target.TestName,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+func (r *PostgresRepository) DeleteFeatureEdit(ctx context.Context, target entity.FeatureEditTarget) (bool, error) {
+	userUID, codebaseUID, workspaceUID, err := parseSharedEditScope(target.Scope)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := r.queries.DeleteSpecFeatureEdit(ctx, db.DeleteSpecFeatureEditParams{
+		CodebaseID:  codebaseUID,
+		DomainName:  target.DomainName,
+		FeatureName: target.FeatureName,
+		Language:    target.Scope.Language,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+func parseEditScope(scope entity.SpecEditScope) (userUID, codebaseUID pgtype.UUID, err error) {
+	if userUID, err = parseUUID(scope.UserID); err != nil {
+		return userUID, codebaseUID, err
+	}
+	codebaseUID, err = parseUUID(scope.CodebaseID)
+	return userUID, codebaseUID, err
+}
+
+// parseSharedEditScope leaves workspaceUID invalid for a user's personal edits
+func parseSharedEditScope(scope entity.SpecEditScope) (userUID, codebaseUID, workspaceUID pgtype.UUID, err error) {
+	if userUID, codebaseUID, err = parseEditScope(scope); err != nil {
+		return userUID, codebaseUID, workspaceUID, err
+	}
+	workspaceUID, err = parseOptionalUUID(scope.WorkspaceID)
+	return userUID, codebaseUID, workspaceUID, err
+}
+
+func optionalUUID(u pgtype.UUID) *string {
+	if !u.Valid {
+		return nil
+	}
+	s := uuidToString(u)
+	return &s
+}
@@ -0,0 +1,120 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// ToSpecCommentThreadsResponse converts comment threads to API response
+func ToSpecCommentThreadsResponse(threads []entity.CommentThread) (api.SpecCommentThreadsResponse, error) {
+	resp := api.SpecCommentThreadsResponse{
+		Data: make([]api.SpecCommentThread, 0, len(threads)),
+	}
+	for _, t := range threads {
+		thread, err := ToSpecCommentThreadResponse(&t)
+		if err != nil {
+			return api.SpecCommentThreadsResponse{}, err
+		}
+		resp.Data = append(resp.Data, thread)
+	}
+	return resp, nil
+}
+
+// ToSpecCommentThreadResponse converts a comment thread to API response
+func ToSpecCommentThreadResponse(thread *entity.CommentThread) (api.SpecCommentThread, error) {
+	threadUID, err := uuid.Parse(thread.ID)
+	if err != nil {
+		return api.SpecCommentThread{}, fmt.Errorf("invalid comment thread ID %q: %w", thread.ID, err)
+	}
+	targetUID, err := uuid.Parse(thread.TargetID)
+	if err != nil {
+		return api.SpecCommentThread{}, fmt.Errorf("invalid comment target ID %q: %w", thread.TargetID, err)
+	}
+
+	resp := api.SpecCommentThread{
+		Comments:   make([]api.SpecComment, 0, len(thread.Comments)),
+		CreatedAt:  thread.CreatedAt,
+		DomainName: thread.Anchor.DomainName,
+		ID:         threadUID,
+		Resolved:   thread.ResolvedAt != nil,
+		ResolvedAt: thread.ResolvedAt,
+		TargetID:   targetUID,
+		TargetType: api.SpecCommentTargetType(thread.Anchor.Type),
+	}
+	if thread.Anchor.FeatureName != "" {
+		resp.FeatureName = &thread.Anchor.FeatureName
+	}
+	if thread.Anchor.Type == entity.CommentTargetBehavior {
+		resp.TestName = &thread.Anchor.TestName
+	}
+	if thread.DocumentID != nil {
+		documentUID, err := uuid.Parse(*thread.DocumentID)
+		if err != nil {
+			return api.SpecCommentThread{}, fmt.Errorf("invalid document ID %q: %w", *thread.DocumentID, err)
+		}
+		resp.DocumentID = &documentUID
+	}
+	if thread.ResolvedBy != nil && thread.ResolvedByUsername != nil {
+		resolvedBy, err := toSpecCommentUser(*thread.ResolvedBy, *thread.ResolvedByUsername)
+		if err != nil {
+			return api.SpecCommentThread{}, err
+		}
+		resp.ResolvedBy = &resolvedBy
+	}
+
+	for _, c := range thread.Comments {
+		comment, err := ToSpecCommentResponse(&c)
+		if err != nil {
+			return api.SpecCommentThread{}, err
+		}
+		resp.Comments = append(resp.Comments, comment)
+	}
+	return resp, nil
+}
+
+// ToSpecCommentResponse converts a comment to API response
+func ToSpecCommentResponse(comment *entity.Comment) (api.SpecComment, error) {
+	commentUID, err := uuid.Parse(comment.ID)
+	if err != nil {
+		return api.SpecComment{}, fmt.Errorf("invalid comment ID %q: %w", comment.ID, err)
+	}
+	threadUID, err := uuid.Parse(comment.ThreadID)
+	if err != nil {
+		return api.SpecComment{}, fmt.Errorf("invalid comment thread ID %q: %w", comment.ThreadID, err)
+	}
+	author, err := toSpecCommentUser(comment.AuthorID, comment.AuthorUsername)
+	if err != nil {
+		return api.SpecComment{}, err
+	}
+
+	resp := api.SpecComment{
+		Author:    author,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		Edited:    comment.IsEdited(),
+		ID:        commentUID,
+		Mentions:  make([]api.SpecCommentUser, 0, len(comment.Mentions)),
+		ThreadID:  threadUID,
+		UpdatedAt: comment.UpdatedAt,
+	}
+	for _, m := range comment.Mentions {
+		user, err := toSpecCommentUser(m.UserID, m.Username)
+		if err != nil {
+			return api.SpecComment{}, err
+		}
+		resp.Mentions = append(resp.Mentions, user)
+	}
+	return resp, nil
+}
+
+func toSpecCommentUser(id, username string) (api.SpecCommentUser, error) {
+	userUID, err := uuid.Parse(id)
+	if err != nil {
+		return api.SpecCommentUser{}, fmt.Errorf("invalid user ID %q: %w", id, err)
+	}
+	return api.SpecCommentUser{ID: userUID, Username: username}, nil
+}
@@ -0,0 +1,111 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// ToSpecDiffResponse converts a spec diff and the compared versions to API response
+func ToSpecDiffResponse(from, to entity.DiffVersion, diff *entity.SpecDiff) (api.SpecDiffResponse, error) {
+	fromVersion, err := toAPIDiffVersion(from)
+	if err != nil {
+		return api.SpecDiffResponse{}, err
+	}
+	toVersion, err := toAPIDiffVersion(to)
+	if err != nil {
+		return api.SpecDiffResponse{}, err
+	}
+
+	domains := make([]api.SpecDomainDiff, len(diff.Domains))
+	for i, d := range diff.Domains {
+		features := make([]api.SpecFeatureDiff, len(d.Features))
+		for j, f := range d.Features {
+			behaviors := make([]api.SpecBehaviorDiff, len(f.Behaviors))
+			for k, b := range f.Behaviors {
+				behaviors[k] = api.SpecBehaviorDiff{
+					Change:               api.SpecChangeType(b.Change),
+					Description:          b.Description,
+					OriginalName:         b.OriginalName,
+					PreviousDescription:  optionalString(b.PreviousDescription),
+					PreviousOriginalName: optionalString(b.PreviousOriginalName),
+					SourceInfo:           toAPISourceInfo(b.SourceInfo),
+				}
+			}
+			features[j] = api.SpecFeatureDiff{
+				Behaviors:           behaviors,
+				Change:              api.SpecChangeType(f.Change),
+				Description:         f.Description,
+				Name:                f.Name,
+				PreviousDescription: f.PreviousDescription,
+				PreviousName:        optionalString(f.PreviousName),
+			}
+		}
+		domains[i] = api.SpecDomainDiff{
+			Change:              api.SpecChangeType(d.Change),
+			Description:         d.Description,
+			Features:            features,
+			Name:                d.Name,
+			PreviousDescription: d.PreviousDescription,
+			PreviousName:        optionalString(d.PreviousName),
+		}
+	}
+
+	resp := api.SpecDiffResponse{
+		Domains: domains,
+		From:    fromVersion,
+		Summary: api.SpecDiffSummary{
+			Behaviors: toAPIChangeCounts(diff.Summary.Behaviors),
+			Domains:   toAPIChangeCounts(diff.Summary.Domains),
+			Features:  toAPIChangeCounts(diff.Summary.Features),
+		},
+		To: toVersion,
+	}
+	if diff.ExecutiveSummary != nil {
+		resp.ExecutiveSummary = &api.SpecTextChange{
+			From: diff.ExecutiveSummary.From,
+			To:   diff.ExecutiveSummary.To,
+		}
+	}
+	return resp, nil
+}
+
+func toAPIDiffVersion(v entity.DiffVersion) (api.SpecDiffVersion, error) {
+	analysisUID, err := uuid.Parse(v.AnalysisID)
+	if err != nil {
+		return api.SpecDiffVersion{}, fmt.Errorf("invalid analysis ID %q: %w", v.AnalysisID, err)
+	}
+	docUID, err := uuid.Parse(v.ID)
+	if err != nil {
+		return api.SpecDiffVersion{}, fmt.Errorf("invalid document ID %q: %w", v.ID, err)
+	}
+
+	return api.SpecDiffVersion{
+		AnalysisID: analysisUID,
+		CommitSHA:  optionalString(v.CommitSHA),
+		CreatedAt:  v.CreatedAt,
+		ID:         docUID,
+		Language:   api.SpecLanguage(v.Language),
+		ModelID:    optionalString(v.ModelID),
+		Version:    v.Version,
+	}, nil
+}
+
+func toAPIChangeCounts(c entity.ChangeCounts) api.SpecChangeCounts {
+	return api.SpecChangeCounts{
+		Added:    c.Added,
+		Removed:  c.Removed,
+		Renamed:  c.Renamed,
+		Reworded: c.Reworded,
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
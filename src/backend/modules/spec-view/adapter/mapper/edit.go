@@ -0,0 +1,70 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// ToSpecEditsResponse converts spec edits to API response
+func ToSpecEditsResponse(edits *entity.SpecEdits) (api.SpecEditsResponse, error) {
+	resp := api.SpecEditsResponse{
+		Behaviors: make([]api.SpecBehaviorEdit, 0),
+		Features:  make([]api.SpecFeatureEdit, 0),
+	}
+	if edits == nil {
+		return resp, nil
+	}
+
+	for _, e := range edits.Behaviors {
+		b, err := ToSpecBehaviorEditResponse(&e)
+		if err != nil {
+			return api.SpecEditsResponse{}, err
+		}
+		resp.Behaviors = append(resp.Behaviors, b)
+	}
+	for _, e := range edits.Features {
+		f, err := ToSpecFeatureEditResponse(&e)
+		if err != nil {
+			return api.SpecEditsResponse{}, err
+		}
+		resp.Features = append(resp.Features, f)
+	}
+	return resp, nil
+}
+
+// ToSpecBehaviorEditResponse converts a behavior edit to API response
+func ToSpecBehaviorEditResponse(edit *entity.BehaviorEdit) (api.SpecBehaviorEdit, error) {
+	editUID, err := uuid.Parse(edit.ID)
+	if err != nil {
+		return api.SpecBehaviorEdit{}, fmt.Errorf("invalid behavior edit ID %q: %w", edit.ID, err)
+	}
+
+	return api.SpecBehaviorEdit{
+		Description: edit.Description,
+		FilePath:    edit.FilePath,
+		Hidden:      edit.Hidden,
+		ID:          editUID,
+		TestName:    edit.TestName,
+		UpdatedAt:   edit.UpdatedAt,
+	}, nil
+}
+
+// ToSpecFeatureEditResponse converts a feature edit to API response
+func ToSpecFeatureEditResponse(edit *entity.FeatureEdit) (api.SpecFeatureEdit, error) {
+	editUID, err := uuid.Parse(edit.ID)
+	if err != nil {
+		return api.SpecFeatureEdit{}, fmt.Errorf("invalid feature edit ID %q: %w", edit.ID, err)
+	}
+
+	return api.SpecFeatureEdit{
+		DomainName:  edit.DomainName,
+		FeatureName: edit.FeatureName,
+		ID:          editUID,
+		Name:        edit.NewName,
+		UpdatedAt:   edit.UpdatedAt,
+	}, nil
+}
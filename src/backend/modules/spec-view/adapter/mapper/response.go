@@ -152,6 +152,9 @@ func toAPIFeatures(features []entity.SpecFeature) ([]api.SpecFeature, error) {
 			Name:        f.Name,
 			SortOrder:   f.SortOrder,
 		}
+		if f.Edited {
+			result[i].Edited = &f.Edited
+		}
 	}
 	return result, nil
 }
@@ -176,18 +179,26 @@ func toAPIBehaviors(behaviors []entity.SpecBehavior) ([]api.SpecBehavior, error)
 			}
 			result[i].SourceTestCaseID = &tcUID
 		}
-		if b.SourceInfo != nil {
-			result[i].SourceInfo = &api.SpecBehaviorSourceInfo{
-				FilePath:   b.SourceInfo.FilePath,
-				Framework:  api.Framework(b.SourceInfo.Framework),
-				LineNumber: b.SourceInfo.LineNumber,
-				Status:     api.TestStatus(b.SourceInfo.Status),
-			}
+		result[i].SourceInfo = toAPISourceInfo(b.SourceInfo)
+		if b.Edited {
+			result[i].Edited = &b.Edited
 		}
 	}
 	return result, nil
 }
 
+func toAPISourceInfo(info *entity.BehaviorSourceInfo) *api.SpecBehaviorSourceInfo {
+	if info == nil {
+		return nil
+	}
+	return &api.SpecBehaviorSourceInfo{
+		FilePath:   info.FilePath,
+		Framework:  api.Framework(info.Framework),
+		LineNumber: info.LineNumber,
+		Status:     api.TestStatus(info.Status),
+	}
+}
+
 func toAPIStatusEnum(status entity.GenerationStatus) api.SpecGenerationStatusEnum {
 	switch status {
 	case entity.StatusPending:
@@ -198,6 +209,8 @@ func toAPIStatusEnum(status entity.GenerationStatus) api.SpecGenerationStatusEnu
 		return api.SpecGenerationStatusEnumCompleted
 	case entity.StatusFailed:
 		return api.SpecGenerationStatusEnumFailed
+	case entity.StatusCancelled:
+		return api.SpecGenerationStatusEnumCancelled
 	default:
 		return api.SpecGenerationStatusEnumNotFound
 	}
@@ -0,0 +1,76 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// ToSpecSearchResponse converts a page of search results to API response
+func ToSpecSearchResponse(results []entity.SearchResult, nextCursor string, hasNext bool) (api.SpecSearchResponse, error) {
+	data := make([]api.SpecSearchResult, len(results))
+	for i, r := range results {
+		result, err := toAPISearchResult(r)
+		if err != nil {
+			return api.SpecSearchResponse{}, err
+		}
+		data[i] = result
+	}
+
+	return api.SpecSearchResponse{
+		Data:       data,
+		HasNext:    hasNext,
+		NextCursor: optionalString(nextCursor),
+	}, nil
+}
+
+func toAPISearchResult(r entity.SearchResult) (api.SpecSearchResult, error) {
+	resultUID, err := uuid.Parse(r.ID)
+	if err != nil {
+		return api.SpecSearchResult{}, fmt.Errorf("invalid search result ID %q: %w", r.ID, err)
+	}
+	analysisUID, err := uuid.Parse(r.AnalysisID)
+	if err != nil {
+		return api.SpecSearchResult{}, fmt.Errorf("invalid analysis ID %q: %w", r.AnalysisID, err)
+	}
+	docUID, err := uuid.Parse(r.DocumentID)
+	if err != nil {
+		return api.SpecSearchResult{}, fmt.Errorf("invalid document ID %q: %w", r.DocumentID, err)
+	}
+	domainUID, err := uuid.Parse(r.DomainID)
+	if err != nil {
+		return api.SpecSearchResult{}, fmt.Errorf("invalid domain ID %q: %w", r.DomainID, err)
+	}
+
+	highlights := make([]api.SpecHighlightSegment, len(r.Highlights))
+	for i, h := range r.Highlights {
+		highlights[i] = api.SpecHighlightSegment{Matched: h.Matched, Text: h.Text}
+	}
+
+	result := api.SpecSearchResult{
+		AnalysisID:  analysisUID,
+		DocumentID:  docUID,
+		DomainID:    domainUID,
+		DomainName:  r.DomainName,
+		FeatureName: optionalString(r.FeatureName),
+		Highlights:  highlights,
+		ID:          resultUID,
+		Kind:        api.SpecSearchResultKind(r.Kind),
+		Language:    api.SpecLanguage(r.Language),
+		Owner:       r.Owner,
+		Rank:        float32(r.Rank),
+		Repo:        r.Repo,
+		Version:     r.Version,
+	}
+	if r.FeatureID != "" {
+		featureUID, err := uuid.Parse(r.FeatureID)
+		if err != nil {
+			return api.SpecSearchResult{}, fmt.Errorf("invalid feature ID %q: %w", r.FeatureID, err)
+		}
+		result.FeatureID = &featureUID
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// ToSpecShareLinkListResponse converts share links to API response
+func ToSpecShareLinkListResponse(links []entity.ShareLink) (api.SpecShareLinkListResponse, error) {
+	resp := api.SpecShareLinkListResponse{
+		Data: make([]api.SpecShareLink, 0, len(links)),
+	}
+	for _, l := range links {
+		link, err := ToSpecShareLink(&l)
+		if err != nil {
+			return api.SpecShareLinkListResponse{}, err
+		}
+		resp.Data = append(resp.Data, link)
+	}
+	return resp, nil
+}
+
+// ToSpecShareLink converts a share link to API response
+func ToSpecShareLink(link *entity.ShareLink) (api.SpecShareLink, error) {
+	linkUID, err := uuid.Parse(link.ID)
+	if err != nil {
+		return api.SpecShareLink{}, fmt.Errorf("invalid share link ID %q: %w", link.ID, err)
+	}
+
+	resp := api.SpecShareLink{
+		AccessCount:     link.AccessCount,
+		CreatedAt:       link.CreatedAt,
+		DocumentVersion: link.DocumentVersion,
+		ExpiresAt:       link.ExpiresAt,
+		ID:              linkUID,
+		Language:        api.SpecLanguage(link.Scope.Language),
+		LastAccessedAt:  link.LastAccessedAt,
+		Latest:          link.IsLatest(),
+		Owner:           link.Owner,
+		Repo:            link.Repo,
+		TokenPrefix:     link.TokenPrefix,
+	}
+	if link.DocumentID != nil {
+		documentUID, err := uuid.Parse(*link.DocumentID)
+		if err != nil {
+			return api.SpecShareLink{}, fmt.Errorf("invalid document ID %q: %w", *link.DocumentID, err)
+		}
+		resp.DocumentID = &documentUID
+	}
+	return resp, nil
+}
+
+// ToSharedSpecDocumentResponse converts a document read through a share link to API response.
+// Owner-specific data such as available languages is left out.
+func ToSharedSpecDocumentResponse(doc *entity.RepoSpecDocument, link *entity.ShareLink) (api.SharedSpecDocumentResponse, error) {
+	analysisUID, err := uuid.Parse(doc.AnalysisID)
+	if err != nil {
+		return api.SharedSpecDocumentResponse{}, fmt.Errorf("invalid analysis ID %q: %w", doc.AnalysisID, err)
+	}
+	docUID, err := uuid.Parse(doc.ID)
+	if err != nil {
+		return api.SharedSpecDocumentResponse{}, fmt.Errorf("invalid document ID %q: %w", doc.ID, err)
+	}
+
+	domains, err := toAPIDomains(doc.Domains)
+	if err != nil {
+		return api.SharedSpecDocumentResponse{}, err
+	}
+
+	return api.SharedSpecDocumentResponse{
+		Data: api.RepoSpecDocument{
+			AnalysisID:       analysisUID,
+			CommitSHA:        doc.CommitSHA,
+			CreatedAt:        doc.CreatedAt,
+			Domains:          domains,
+			ExecutiveSummary: doc.ExecutiveSummary,
+			ID:               docUID,
+			Language:         api.SpecLanguage(doc.Language),
+			ModelID:          &doc.ModelID,
+			Version:          doc.Version,
+		},
+		ExpiresAt: link.ExpiresAt,
+		Owner:     link.Owner,
+		Repo:      link.Repo,
+	}, nil
+}
@@ -2,13 +2,17 @@ package adapter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/rivertype"
+	"github.com/specvital/web/src/backend/common/metrics"
 	"github.com/specvital/web/src/backend/common/queue"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
 	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
 	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
 	subscription "github.com/specvital/web/src/backend/modules/subscription/domain/entity"
@@ -25,7 +29,7 @@ const (
 
 // SpecGenerationArgs represents the arguments for spec generation job.
 // This must match the worker's Args structure (JSON fields only; river tags are producer-only).
-// Unique key: (AnalysisID, Language) - allows different languages for same analysis,
+// Unique key: (AnalysisID, Language, SupersedesJobID) - allows different languages for same analysis,
 // but prevents duplicate jobs for the same analysis+language combination.
 type SpecGenerationArgs struct {
 	AnalysisID     string  `json:"analysis_id" river:"unique"`
@@ -35,10 +39,28 @@ type SpecGenerationArgs struct {
 	GenerationMode string  `json:"generation_mode,omitempty"`
 	// Backward compatibility: derived from GenerationMode for workers not yet updated.
 	ForceRegenerate bool `json:"force_regenerate,omitempty"`
+	// WorkspaceID is set when the document is generated for a workspace.
+	// The worker stores it in spec_documents.workspace_id; the document stays owned by UserID.
+	WorkspaceID *string `json:"workspace_id,omitempty"`
+	// BillingUserID is set together with WorkspaceID. The worker stores it in
+	// usage_events.billed_user_id so the charge does not follow later workspace changes.
+	BillingUserID *string `json:"billing_user_id,omitempty"`
+	// SupersedesJobID is set when the request replaces a cancelled job that is still
+	// running. It is part of the unique key so the new job does not collide with the
+	// cancelled one while its worker winds down. Producer-only; the worker ignores it.
+	SupersedesJobID *int64 `json:"supersedes_job_id,omitempty" river:"unique"`
 }
 
 func (SpecGenerationArgs) Kind() string { return TypeSpecGeneration }
 
+func (a *SpecGenerationArgs) setWorkspace(workspace *entity.WorkspaceGeneration) {
+	if workspace == nil {
+		return
+	}
+	a.WorkspaceID = &workspace.WorkspaceID
+	a.BillingUserID = &workspace.BillingUserID
+}
+
 type RiverQueueService struct {
 	client *river.Client[pgx.Tx]
 }
@@ -47,7 +69,7 @@ func NewRiverQueueService(client *river.Client[pgx.Tx]) *RiverQueueService {
 	return &RiverQueueService{client: client}
 }
 
-func (s *RiverQueueService) EnqueueSpecGeneration(ctx context.Context, analysisID string, language string, userID *string, tier subscription.PlanTier, mode entity.GenerationMode) error {
+func (s *RiverQueueService) EnqueueSpecGeneration(ctx context.Context, analysisID string, language string, userID *string, workspace *entity.WorkspaceGeneration, tier subscription.PlanTier, mode entity.GenerationMode) error {
 	ctx, cancel := context.WithTimeout(ctx, enqueueTimeout)
 	defer cancel()
 
@@ -59,31 +81,27 @@ func (s *RiverQueueService) EnqueueSpecGeneration(ctx context.Context, analysisI
 		GenerationMode:  string(mode),
 		ForceRegenerate: mode.IsRegeneration(),
 	}
+	args.setWorkspace(workspace)
 
 	targetQueue := queue.SelectQueueForSpecView(tier, false)
 
-	_, err := s.client.Insert(ctx, args, &river.InsertOpts{
-		MaxAttempts: maxRetries,
-		Queue:       targetQueue,
-		UniqueOpts: river.UniqueOpts{
-			ByArgs: true,
-			ByState: []rivertype.JobState{
-				rivertype.JobStateAvailable,
-				rivertype.JobStatePending,
-				rivertype.JobStateRunning,
-				rivertype.JobStateRetryable,
-				rivertype.JobStateScheduled,
-			},
-		},
-	})
+	opts := insertOpts(targetQueue)
+	result, err := s.client.Insert(ctx, args, opts)
+	for err == nil && result.UniqueSkippedAsDuplicate && isCancelAttempted(result.Job) {
+		args.SupersedesJobID = &result.Job.ID
+		result, err = s.client.Insert(ctx, args, opts)
+	}
 	if err != nil {
 		return fmt.Errorf("enqueue spec generation for analysis %s: %w", analysisID, err)
 	}
+	if !result.UniqueSkippedAsDuplicate {
+		metrics.RecordEnqueue(targetQueue, string(tier))
+	}
 
 	return nil
 }
 
-func (s *RiverQueueService) EnqueueSpecGenerationTx(ctx context.Context, tx pgx.Tx, analysisID string, language string, userID *string, tier subscription.PlanTier, mode entity.GenerationMode) (int64, error) {
+func (s *RiverQueueService) EnqueueSpecGenerationTx(ctx context.Context, tx pgx.Tx, analysisID string, language string, userID *string, workspace *entity.WorkspaceGeneration, tier subscription.PlanTier, mode entity.GenerationMode) (int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, enqueueTimeout)
 	defer cancel()
 
@@ -95,10 +113,46 @@ func (s *RiverQueueService) EnqueueSpecGenerationTx(ctx context.Context, tx pgx.
 		GenerationMode:  string(mode),
 		ForceRegenerate: mode.IsRegeneration(),
 	}
+	args.setWorkspace(workspace)
 
 	targetQueue := queue.SelectQueueForSpecView(tier, false)
 
-	result, err := s.client.InsertTx(ctx, tx, args, &river.InsertOpts{
+	opts := insertOpts(targetQueue)
+	result, err := s.client.InsertTx(ctx, tx, args, opts)
+	for err == nil && result.UniqueSkippedAsDuplicate && isCancelAttempted(result.Job) {
+		args.SupersedesJobID = &result.Job.ID
+		result, err = s.client.InsertTx(ctx, tx, args, opts)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("enqueue spec generation for analysis %s: %w", analysisID, err)
+	}
+	if !result.UniqueSkippedAsDuplicate {
+		metrics.RecordEnqueue(targetQueue, string(tier))
+	}
+
+	return result.Job.ID, nil
+}
+
+func (s *RiverQueueService) CancelSpecGenerationTx(ctx context.Context, tx pgx.Tx, jobID int64) error {
+	job, err := s.client.JobCancelTx(ctx, tx, jobID)
+	if err != nil {
+		if errors.Is(err, river.ErrNotFound) {
+			return domain.ErrNoActiveGeneration
+		}
+		return fmt.Errorf("cancel spec generation job %d: %w", jobID, err)
+	}
+
+	if job.State == rivertype.JobStateCompleted || job.State == rivertype.JobStateDiscarded {
+		return domain.ErrGenerationFinished
+	}
+
+	// River cancels a queued job at once and only flags a running one, which keeps
+	// running until its worker returns
+	return nil
+}
+
+func insertOpts(targetQueue string) *river.InsertOpts {
+	return &river.InsertOpts{
 		MaxAttempts: maxRetries,
 		Queue:       targetQueue,
 		UniqueOpts: river.UniqueOpts{
@@ -111,10 +165,20 @@ func (s *RiverQueueService) EnqueueSpecGenerationTx(ctx context.Context, tx pgx.
 				rivertype.JobStateScheduled,
 			},
 		},
-	})
-	if err != nil {
-		return 0, fmt.Errorf("enqueue spec generation for analysis %s: %w", analysisID, err)
 	}
+}
 
-	return result.Job.ID, nil
+// isCancelAttempted reports whether a job is still running after being cancelled.
+// Such a job keeps its unique slot until the worker returns, so a new request for
+// the same analysis and language is inserted as superseding it.
+func isCancelAttempted(job *rivertype.JobRow) bool {
+	if job == nil || job.State != rivertype.JobStateRunning {
+		return false
+	}
+	var metadata map[string]json.RawMessage
+	if err := json.Unmarshal(job.Metadata, &metadata); err != nil {
+		return false
+	}
+	_, ok := metadata["cancel_attempted_at"]
+	return ok
 }
@@ -0,0 +1,165 @@
+package render
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+// GherkinRenderer renders each feature as a .feature file with its behaviors
+// as scenarios, packaged as a zip per domain. The domain zips are bundled in a
+// single zip archive for download. Scenarios have no steps; they are a
+// starting point for BDD authoring.
+type GherkinRenderer struct{}
+
+var _ port.SpecRenderer = (*GherkinRenderer)(nil)
+
+func NewGherkinRenderer() *GherkinRenderer {
+	return &GherkinRenderer{}
+}
+
+func (r *GherkinRenderer) ContentType() string {
+	return "application/zip"
+}
+
+func (r *GherkinRenderer) FileExtension() string {
+	return "zip"
+}
+
+func (r *GherkinRenderer) Render(doc *entity.ExportDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	for d, domain := range doc.Domains {
+		domainZip, err := renderDomain(doc, domain)
+		if err != nil {
+			return nil, err
+		}
+		// Already compressed, so stored as is.
+		name := fmt.Sprintf("%02d-%s.zip", d+1, slug(domain.Name))
+		if err := writeZipEntry(archive, name, zip.Store, doc.CreatedAt, domainZip); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("close archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func renderDomain(doc *entity.ExportDocument, domain entity.SpecDomain) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	for f, feature := range domain.Features {
+		name := fmt.Sprintf("%02d-%s.feature", f+1, slug(feature.Name))
+		if err := writeZipEntry(archive, name, zip.Deflate, doc.CreatedAt, renderFeature(doc, domain, feature)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("close %s archive: %w", domain.Name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func writeZipEntry(archive *zip.Writer, name string, method uint16, modified time.Time, content []byte) error {
+	w, err := archive.CreateHeader(&zip.FileHeader{Method: method, Modified: modified, Name: name})
+	if err != nil {
+		return fmt.Errorf("create %s: %w", name, err)
+	}
+	if _, err := w.Write(content); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+	return nil
+}
+
+func renderFeature(doc *entity.ExportDocument, domain entity.SpecDomain, feature entity.SpecFeature) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "# Generated by Specvital from %s/%s", doc.Source.Owner, doc.Source.Name)
+	if sha := doc.Source.CommitSHA; sha != "" {
+		fmt.Fprintf(&buf, " at %s", shortSHA(sha))
+	}
+	fmt.Fprintf(&buf, " (spec v%d, %s)\n", doc.Version, doc.Language)
+	fmt.Fprintf(&buf, "@%s\n", tag(domain.Name))
+	fmt.Fprintf(&buf, "Feature: %s\n", inline(feature.Name))
+	if description := text(feature.Description); description != "" {
+		for _, line := range strings.Split(description, "\n") {
+			fmt.Fprintf(&buf, "  %s\n", descriptionLine(strings.TrimSpace(line)))
+		}
+	}
+
+	for _, behavior := range feature.Behaviors {
+		buf.WriteString("\n")
+		if name := inline(behavior.OriginalName); name != "" {
+			fmt.Fprintf(&buf, "  # Original test: %s\n", name)
+		}
+		if info := behavior.SourceInfo; info != nil && info.FilePath != "" {
+			fmt.Fprintf(&buf, "  @source:%s\n", tag(sourceLabel(info)))
+		}
+		fmt.Fprintf(&buf, "  Scenario: %s\n", inline(behavior.ConvertedDescription))
+	}
+
+	return buf.Bytes()
+}
+
+// gherkinLinePrefixes start lines the parser treats as something other than
+// description text. Files carry no "# language:" header, so only the English
+// keywords apply.
+var gherkinLinePrefixes = []string{
+	"#", "@", "|", `"""`, "```",
+	"Feature:", "Rule:", "Background:", "Scenario:", "Scenario Outline:", "Scenario Template:",
+	"Example:", "Examples:", "Scenarios:",
+	"Given ", "When ", "Then ", "And ", "But ", "* ",
+}
+
+// descriptionLine keeps generated text from being parsed as Gherkin syntax by
+// prefixing lines that would start a keyword, comment, tag, table or doc
+// string with a backslash. Indenting does not help, since the parser ignores
+// leading whitespace.
+func descriptionLine(line string) string {
+	for _, prefix := range gherkinLinePrefixes {
+		if strings.HasPrefix(line, prefix) {
+			return `\` + line
+		}
+	}
+	return line
+}
+
+// tag makes s usable as a Gherkin tag, which must not contain whitespace or
+// start another tag or a comment.
+func tag(s string) string {
+	s = strings.NewReplacer("@", "_", "#", "_").Replace(s)
+	return strings.Join(strings.Fields(s), "_")
+}
+
+// slug turns a name into a file name segment, keeping letters of any script.
+func slug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	s := strings.TrimSuffix(b.String(), "-")
+	if s == "" {
+		return "untitled"
+	}
+	return s
+}
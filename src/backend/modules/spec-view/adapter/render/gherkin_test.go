@@ -0,0 +1,113 @@
+package render
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+func TestGherkinRenderer_Render(t *testing.T) {
+	doc := newTestDocument(true)
+	doc.Domains[0].Features[0].Behaviors[0].OriginalName = "TestLogin/invalid password"
+	doc.Domains = append(doc.Domains, entity.SpecDomain{
+		Name:     "결제 처리",
+		Features: []entity.SpecFeature{{Name: "Refunds & Voids"}},
+	})
+
+	out, err := NewGherkinRenderer().Render(doc)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	domains := unzip(t, out)
+	if len(domains) != 2 {
+		t.Fatalf("expected a zip per domain, got %d entries", len(domains))
+	}
+	refunds := unzip(t, []byte(domains["02-결제-처리.zip"]))
+	if _, ok := refunds["01-refunds-voids.feature"]; !ok {
+		t.Errorf("expected feature file in domain zip, got %v", refunds)
+	}
+	files := unzip(t, []byte(domains["01-authentication.zip"]))
+
+	want := `# Generated by Specvital from octocat/hello at 0123456 (spec v3, English)
+@Authentication
+Feature: Login
+
+  # Original test: TestLogin/invalid password
+  @source:src/auth/login_test.go:42
+  Scenario: Rejects an invalid password
+
+  Scenario: Remembers the session
+`
+	if got := files["01-login.feature"]; got != want {
+		t.Errorf("feature file =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestGherkinRenderer_RenderHostileText(t *testing.T) {
+	doc := newTestDocument(false)
+	doc.Domains[0].Name = "Auth @admin #1"
+	doc.Domains[0].Features[0].Description = ptr(strings.Join([]string{
+		"Covers sign-in.",
+		"Scenario: injected",
+		"  Given a user",
+		"When they log in",
+		"# not a comment",
+		"@smoke",
+		`"""`,
+		"| a | b |",
+		"Whenever possible, stay signed in.",
+	}, "\n"))
+
+	out, err := NewGherkinRenderer().Render(doc)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	domains := unzip(t, out)
+	files := unzip(t, []byte(domains["01-auth-admin-1.zip"]))
+
+	want := `# Generated by Specvital from octocat/hello at 0123456 (spec v3, English)
+@Auth__admin__1
+Feature: Login
+  Covers sign-in.
+  \Scenario: injected
+  \Given a user
+  \When they log in
+  \# not a comment
+  \@smoke
+  \"""
+  \| a | b |
+  Whenever possible, stay signed in.
+
+  @source:src/auth/login_test.go:42
+  Scenario: Rejects an invalid password
+
+  Scenario: Remembers the session
+`
+	if got := files["01-login.feature"]; got != want {
+		t.Errorf("feature file =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func unzip(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	files := map[string]string{}
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(content)
+	}
+	return files
+}
@@ -0,0 +1,96 @@
+package render
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+//go:embed spec.html
+var specHTML string
+
+// languageTags maps supported spec languages to BCP 47 tags for the lang attribute.
+var languageTags = map[string]string{
+	"Arabic":     "ar",
+	"Chinese":    "zh",
+	"Czech":      "cs",
+	"Danish":     "da",
+	"Dutch":      "nl",
+	"English":    "en",
+	"Finnish":    "fi",
+	"French":     "fr",
+	"German":     "de",
+	"Greek":      "el",
+	"Hindi":      "hi",
+	"Indonesian": "id",
+	"Italian":    "it",
+	"Japanese":   "ja",
+	"Korean":     "ko",
+	"Polish":     "pl",
+	"Portuguese": "pt",
+	"Russian":    "ru",
+	"Spanish":    "es",
+	"Swedish":    "sv",
+	"Thai":       "th",
+	"Turkish":    "tr",
+	"Ukrainian":  "uk",
+	"Vietnamese": "vi",
+}
+
+var specTemplate = template.Must(template.New("spec").Funcs(template.FuncMap{
+	"date":        func(t time.Time) string { return t.UTC().Format("2006-01-02") },
+	"domainID":    func(d int) string { return fmt.Sprintf("domain-%d", d+1) },
+	"featureID":   func(d, f int) string { return fmt.Sprintf("feature-%d-%d", d+1, f+1) },
+	"languageTag": languageTag,
+	"shortSHA":    shortSHA,
+	"sourceLabel": sourceLabel,
+	"text":        text,
+}).Parse(specHTML))
+
+// HTMLRenderer renders spec documents as a single self-contained HTML page
+// with embedded styles, a table of contents and collapsible sections, so it
+// can be shared with readers who have no account.
+type HTMLRenderer struct{}
+
+var _ port.SpecRenderer = (*HTMLRenderer)(nil)
+
+func NewHTMLRenderer() *HTMLRenderer {
+	return &HTMLRenderer{}
+}
+
+func (r *HTMLRenderer) ContentType() string {
+	return "text/html; charset=utf-8"
+}
+
+func (r *HTMLRenderer) FileExtension() string {
+	return "html"
+}
+
+func (r *HTMLRenderer) Render(doc *entity.ExportDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := specTemplate.Execute(&buf, doc); err != nil {
+		return nil, fmt.Errorf("render spec html: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func languageTag(language string) string {
+	if tag, ok := languageTags[language]; ok {
+		return tag
+	}
+	return "en"
+}
+
+// text dereferences optional document text, returning "" for blank values.
+func text(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.TrimSpace(*s)
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHTMLRenderer_Render(t *testing.T) {
+	doc := newTestDocument(true)
+	doc.Language = "Korean"
+	doc.ModelID = "model-x"
+	doc.Domains[0].Features[0].Behaviors[1].ConvertedDescription = "Escapes <script>alert(1)</script>"
+
+	out, err := NewHTMLRenderer().Render(doc)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	html := string(out)
+
+	for _, want := range []string{
+		`<html lang="ko">`,
+		`<style>`,
+		`<a href="#domain-1">Authentication</a>`,
+		`<a href="#feature-1-1">Login</a>`,
+		`<details class="domain" id="domain-1" open>`,
+		`<details class="feature" id="feature-1-1" open>`,
+		`<code title="0123456789abcdef">0123456</code>`,
+		`<dd><code>model-x</code></dd>`,
+		`<dd>3</dd>`,
+		`<p class="summary">Handles user accounts.</p>`,
+		`href="https://github.com/octocat/hello/blob/0123456789abcdef/src/auth/login%20test.go#L42"`,
+		`Escapes &lt;script&gt;alert(1)&lt;/script&gt;`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Render() missing %q", want)
+		}
+	}
+	for _, unwanted := range []string{"<script>", "<link", " src="} {
+		if strings.Contains(html, unwanted) {
+			t.Errorf("Render() must be self-contained and escaped, found %q", unwanted)
+		}
+	}
+}
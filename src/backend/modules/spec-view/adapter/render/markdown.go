@@ -0,0 +1,120 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+const shortSHALength = 7
+
+// MarkdownRenderer renders spec documents as CommonMark: domains become
+// second-level headings, features third-level headings and behaviors bullets.
+type MarkdownRenderer struct{}
+
+var _ port.SpecRenderer = (*MarkdownRenderer)(nil)
+
+func NewMarkdownRenderer() *MarkdownRenderer {
+	return &MarkdownRenderer{}
+}
+
+func (r *MarkdownRenderer) ContentType() string {
+	return "text/markdown; charset=utf-8"
+}
+
+func (r *MarkdownRenderer) FileExtension() string {
+	return "md"
+}
+
+func (r *MarkdownRenderer) Render(doc *entity.ExportDocument) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "# %s/%s Specification\n\n", doc.Source.Owner, doc.Source.Name)
+	fmt.Fprintf(&buf, "- Language: %s\n", doc.Language)
+	fmt.Fprintf(&buf, "- Version: %d\n", doc.Version)
+	if sha := doc.Source.CommitSHA; sha != "" {
+		fmt.Fprintf(&buf, "- Commit: `%s`\n", shortSHA(sha))
+	}
+	fmt.Fprintf(&buf, "- Generated: %s\n", doc.CreatedAt.UTC().Format("2006-01-02"))
+
+	if doc.ExecutiveSummary != nil && strings.TrimSpace(*doc.ExecutiveSummary) != "" {
+		buf.WriteString("\n## Executive Summary\n\n")
+		buf.WriteString(strings.TrimSpace(*doc.ExecutiveSummary))
+		buf.WriteString("\n")
+	}
+
+	for _, domain := range doc.Domains {
+		fmt.Fprintf(&buf, "\n## %s\n", escapeMarkdown(inline(domain.Name)))
+		writeDescription(&buf, domain.Description)
+
+		for _, feature := range domain.Features {
+			fmt.Fprintf(&buf, "\n### %s\n", escapeMarkdown(inline(feature.Name)))
+			writeDescription(&buf, feature.Description)
+
+			if len(feature.Behaviors) == 0 {
+				continue
+			}
+			buf.WriteString("\n")
+			for _, behavior := range feature.Behaviors {
+				fmt.Fprintf(&buf, "- %s", escapeMarkdown(inline(behavior.ConvertedDescription)))
+				if link := doc.SourceURL(behavior); link != "" {
+					fmt.Fprintf(&buf, " ([`%s`](%s))", sourceLabel(behavior.SourceInfo), link)
+				}
+				buf.WriteString("\n")
+			}
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeDescription(buf *bytes.Buffer, description *string) {
+	if description == nil || strings.TrimSpace(*description) == "" {
+		return
+	}
+	buf.WriteString("\n")
+	buf.WriteString(strings.TrimSpace(*description))
+	buf.WriteString("\n")
+}
+
+// inline collapses line breaks so text stays within a heading or bullet.
+func inline(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`,
+)
+
+// escapeMarkdown keeps generated names from changing the document structure
+// when placed in a heading or list item, e.g. by emphasis, links, code spans
+// or a leading marker that starts a nested list.
+func escapeMarkdown(text string) string {
+	text = markdownEscaper.Replace(text)
+	if strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+") {
+		return `\` + text
+	}
+	digits := len(text) - len(strings.TrimLeft(text, "0123456789"))
+	if digits > 0 && digits < len(text) && (text[digits] == '.' || text[digits] == ')') {
+		return text[:digits] + `\` + text[digits:]
+	}
+	return text
+}
+
+func sourceLabel(info *entity.BehaviorSourceInfo) string {
+	if info.LineNumber > 0 {
+		return fmt.Sprintf("%s:%d", info.FilePath, info.LineNumber)
+	}
+	return info.FilePath
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > shortSHALength {
+		return sha[:shortSHALength]
+	}
+	return sha
+}
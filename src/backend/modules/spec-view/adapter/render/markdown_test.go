@@ -0,0 +1,123 @@
+package render
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+func ptr(s string) *string {
+	return &s
+}
+
+func newTestDocument(sourceLinks bool) *entity.ExportDocument {
+	return &entity.ExportDocument{
+		CreatedAt:        time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		ExecutiveSummary: ptr("Handles user accounts."),
+		Language:         "English",
+		Source: entity.AnalysisSource{
+			CommitSHA: "0123456789abcdef",
+			Host:      "github.com",
+			Name:      "hello",
+			Owner:     "octocat",
+		},
+		SourceLinks: sourceLinks,
+		Version:     3,
+		Domains: []entity.SpecDomain{
+			{
+				Name:        "Authentication",
+				Description: ptr("Sign-in flows."),
+				Features: []entity.SpecFeature{
+					{
+						Name: "Login",
+						Behaviors: []entity.SpecBehavior{
+							{
+								ConvertedDescription: "Rejects an invalid\npassword",
+								SourceInfo:           &entity.BehaviorSourceInfo{FilePath: "src/auth/login test.go", LineNumber: 42},
+							},
+							{ConvertedDescription: "Remembers the session"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestMarkdownRenderer_Render(t *testing.T) {
+	t.Run("renders document hierarchy with source links", func(t *testing.T) {
+		out, err := NewMarkdownRenderer().Render(newTestDocument(true))
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+
+		want := `# octocat/hello Specification
+
+- Language: English
+- Version: 3
+- Commit: ` + "`0123456`" + `
+- Generated: 2026-03-01
+
+## Executive Summary
+
+Handles user accounts.
+
+## Authentication
+
+Sign-in flows.
+
+### Login
+
+- Rejects an invalid password ([` + "`src/auth/login test.go:42`" + `](https://github.com/octocat/hello/blob/0123456789abcdef/src/auth/login%20test.go#L42))
+- Remembers the session
+`
+		if string(out) != want {
+			t.Errorf("Render() =\n%s\nwant\n%s", out, want)
+		}
+	})
+
+	t.Run("omits source links when disabled", func(t *testing.T) {
+		out, err := NewMarkdownRenderer().Render(newTestDocument(false))
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+		if strings.Contains(string(out), "https://") {
+			t.Errorf("Render() contains source link when disabled:\n%s", out)
+		}
+		if !strings.Contains(string(out), "- Rejects an invalid password\n") {
+			t.Errorf("Render() missing plain behavior bullet:\n%s", out)
+		}
+	})
+
+	t.Run("escapes markdown in names", func(t *testing.T) {
+		doc := newTestDocument(false)
+		doc.Domains[0].Name = "Auth # [admin](https://evil.example)"
+		doc.Domains[0].Features[0].Name = "*Login* _flow_ `code`"
+		doc.Domains[0].Features[0].Behaviors = []entity.SpecBehavior{
+			{ConvertedDescription: "- nested item"},
+			{ConvertedDescription: "1. ordered item"},
+			{ConvertedDescription: "2024 was a year"},
+			{ConvertedDescription: "<b>bold</b> \\ back"},
+		}
+
+		out, err := NewMarkdownRenderer().Render(doc)
+		if err != nil {
+			t.Fatalf("Render() error = %v", err)
+		}
+
+		for _, want := range []string{
+			"\n## Auth \\# \\[admin\\](https://evil.example)\n",
+			"\n### \\*Login\\* \\_flow\\_ \\`code\\`\n",
+			"\n- \\- nested item\n",
+			"\n- 1\\. ordered item\n",
+			"\n- 2024 was a year\n",
+			"\n- \\<b\\>bold\\</b\\> \\\\ back\n",
+		} {
+			if !strings.Contains(string(out), want) {
+				t.Errorf("Render() missing %q:\n%s", want, out)
+			}
+		}
+	})
+}
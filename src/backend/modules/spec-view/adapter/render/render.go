@@ -0,0 +1,16 @@
+// Package render turns spec documents into downloadable export formats.
+package render
+
+import (
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+// Renderers returns a renderer for every supported export format.
+func Renderers() map[entity.ExportFormat]port.SpecRenderer {
+	return map[entity.ExportFormat]port.SpecRenderer{
+		entity.ExportFormatGherkin:  NewGherkinRenderer(),
+		entity.ExportFormatHTML:     NewHTMLRenderer(),
+		entity.ExportFormatMarkdown: NewMarkdownRenderer(),
+	}
+}
@@ -18,7 +18,11 @@ type PostgresRepository struct {
 	queries *db.Queries
 }
 
-var _ port.SpecViewRepository = (*PostgresRepository)(nil)
+var (
+	_ port.AnalysisSourceReader = (*PostgresRepository)(nil)
+	_ port.SpecSearcher         = (*PostgresRepository)(nil)
+	_ port.SpecViewRepository   = (*PostgresRepository)(nil)
+)
 
 func NewPostgresRepository(queries *db.Queries) *PostgresRepository {
 	return &PostgresRepository{queries: queries}
@@ -50,6 +54,28 @@ func (r *PostgresRepository) GetAnalysisTestCount(ctx context.Context, analysisI
 	return int(count), nil
 }
 
+func (r *PostgresRepository) GetAnalysisSource(ctx context.Context, analysisID string) (*entity.AnalysisSource, error) {
+	uid, err := parseUUID(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.GetAnalysisSource(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAnalysisNotFound
+		}
+		return nil, err
+	}
+
+	return &entity.AnalysisSource{
+		CommitSHA: row.CommitSha,
+		Host:      row.Host,
+		Name:      row.Name,
+		Owner:     row.Owner,
+	}, nil
+}
+
 func (r *PostgresRepository) CheckSpecDocumentExistsByLanguage(ctx context.Context, analysisID string, language string) (bool, error) {
 	uid, err := parseUUID(analysisID)
 	if err != nil {
@@ -235,6 +261,28 @@ func (r *PostgresRepository) buildSpecDocument(ctx context.Context, docRow db.Ge
 	return doc, nil
 }
 
+func (r *PostgresRepository) GetActiveGenerationJobID(ctx context.Context, userID string, analysisID string, language string) (int64, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return 0, err
+	}
+	if _, err := uuid.Parse(analysisID); err != nil {
+		return 0, err
+	}
+
+	jobID, err := r.queries.GetActiveSpecGenerationJobID(ctx, db.GetActiveSpecGenerationJobIDParams{
+		AnalysisID: []byte(analysisID),
+		UserID:     []byte(userID),
+		Language:   []byte(language),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return jobID, nil
+}
+
 func (r *PostgresRepository) GetGenerationStatus(ctx context.Context, userID string, analysisID string) (*entity.SpecGenerationStatus, error) {
 	if _, err := uuid.Parse(userID); err != nil {
 		return nil, err
@@ -256,7 +304,7 @@ func (r *PostgresRepository) GetGenerationStatus(ctx context.Context, userID str
 
 	status := &entity.SpecGenerationStatus{
 		AnalysisID: analysisID,
-		Status:     mapRiverJobState(string(row.State)),
+		Status:     mapRiverJobState(string(row.State), row.CancelAttempted),
 	}
 
 	if row.CreatedAt.Valid {
@@ -291,7 +339,7 @@ func (r *PostgresRepository) GetGenerationStatusByLanguage(ctx context.Context,
 
 	status := &entity.SpecGenerationStatus{
 		AnalysisID: analysisID,
-		Status:     mapRiverJobState(string(row.State)),
+		Status:     mapRiverJobState(string(row.State), row.CancelAttempted),
 	}
 
 	if row.CreatedAt.Valid {
@@ -578,15 +626,23 @@ func (r *PostgresRepository) GetLanguagesWithPreviousSpec(ctx context.Context, u
 	})
 }
 
-func mapRiverJobState(state string) entity.GenerationStatus {
+// mapRiverJobState maps a job's River state to a generation status. A running job
+// marked for cancellation is reported as cancelled: its reservation is already
+// released and a new request for it can be made.
+func mapRiverJobState(state string, cancelAttempted bool) entity.GenerationStatus {
 	switch state {
 	case "available", "pending", "scheduled", "retryable":
 		return entity.StatusPending
 	case "running":
+		if cancelAttempted {
+			return entity.StatusCancelled
+		}
 		return entity.StatusRunning
 	case "completed":
 		return entity.StatusCompleted
-	case "discarded", "cancelled":
+	case "cancelled":
+		return entity.StatusCancelled
+	case "discarded":
 		return entity.StatusFailed
 	default:
 		return entity.StatusNotFound
@@ -694,6 +750,41 @@ func (r *PostgresRepository) GetSpecDocumentByRepositoryAndDocumentId(ctx contex
 	})
 }
 
+func (r *PostgresRepository) GetSpecDocumentRevisionByRepository(ctx context.Context, userID, owner, name, documentID string) (*entity.RepoSpecDocumentRevision, error) {
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	docID, err := parseUUID(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.GetSpecDocumentRevisionByRepository(ctx, db.GetSpecDocumentRevisionByRepositoryParams{
+		Owner:      owner,
+		Repo:       name,
+		UserID:     uid,
+		DocumentID: docID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &entity.RepoSpecDocumentRevision{
+		DocumentCount:   int(row.DocumentCount),
+		DocumentID:      documentID,
+		EditCount:       int(row.EditCount),
+		LatestCreatedAt: row.LatestCreatedAt.Time,
+		LatestEditAt:    row.LatestEditAt.Time,
+		UpdatedAt:       row.UpdatedAt.Time,
+		Version:         int(row.Version),
+	}, nil
+}
+
 func (r *PostgresRepository) buildRepoSpecDocument(ctx context.Context, row db.GetSpecDocumentByRepositoryRow) (*entity.RepoSpecDocument, error) {
 	domains, err := r.buildDomainHierarchy(ctx, row.ID)
 	if err != nil {
@@ -818,3 +909,49 @@ func (r *PostgresRepository) GetCurrentAnalysisTestCount(ctx context.Context, an
 
 	return int(count), nil
 }
+
+func (r *PostgresRepository) SearchSpecDocuments(ctx context.Context, params port.SearchParams) ([]entity.SearchResult, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(params.Access)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.SearchSpecDocuments(ctx, db.SearchSpecDocumentsParams{
+		Language:    optionalText(params.Language),
+		LimitCount:  int32(params.Limit),
+		OffsetCount: int32(params.Offset),
+		Owner:       optionalText(params.Owner),
+		Query:       params.Query,
+		Repo:        optionalText(params.Repo),
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]entity.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = entity.SearchResult{
+			AnalysisID:  uuidToString(row.AnalysisID),
+			DocumentID:  uuidToString(row.DocumentID),
+			DomainID:    uuidToString(row.DomainID),
+			DomainName:  row.DomainName,
+			FeatureID:   uuidToString(row.FeatureID),
+			FeatureName: row.FeatureName.String,
+			Highlights:  entity.ParseHighlight(row.Highlight),
+			ID:          uuidToString(row.ID),
+			Kind:        entity.SearchResultKind(row.Kind),
+			Language:    row.Language,
+			Owner:       row.Owner,
+			Rank:        float64(row.Rank),
+			Repo:        row.Repo,
+			Version:     int(row.Version),
+		}
+	}
+	return results, nil
+}
+
+func optionalText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
@@ -0,0 +1,199 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+var _ port.SpecShareLinkRepository = (*PostgresRepository)(nil)
+
+func (r *PostgresRepository) CreateShareLink(ctx context.Context, params port.CreateShareLinkParams) (*entity.ShareLink, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(params.Access)
+	if err != nil {
+		return nil, err
+	}
+	documentUID, err := parseUUID(params.DocumentID)
+	if err != nil {
+		return nil, domain.ErrDocumentNotFound
+	}
+
+	document, err := r.queries.GetSpecShareLinkDocument(ctx, db.GetSpecShareLinkDocumentParams{
+		DocumentID:  documentUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrDocumentNotFound
+		}
+		return nil, err
+	}
+
+	args := db.CreateSpecShareLinkParams{
+		CodebaseID:  document.CodebaseID,
+		Language:    document.Language,
+		TokenHash:   params.Token.Hash,
+		TokenPrefix: params.Token.Prefix,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	}
+	if !params.Latest {
+		args.DocumentID = document.ID
+	}
+	if params.ExpiresAt != nil {
+		args.ExpiresAt = pgtype.Timestamptz{Time: *params.ExpiresAt, Valid: true}
+	}
+
+	id, err := r.queries.CreateSpecShareLink(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.GetSpecShareLinkByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return mapShareLink(row), nil
+}
+
+func (r *PostgresRepository) GetShareLinkByToken(ctx context.Context, token string) (*entity.ShareLink, error) {
+	row, err := r.queries.GetSpecShareLinkByHash(ctx, entity.HashShareToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrShareLinkNotFound
+		}
+		return nil, err
+	}
+	return mapShareLink(db.GetSpecShareLinkByIDRow(row)), nil
+}
+
+func (r *PostgresRepository) GetSharedDocument(ctx context.Context, link *entity.ShareLink) (*entity.RepoSpecDocument, error) {
+	userUID, codebaseUID, err := parseEditScope(link.Scope)
+	if err != nil {
+		return nil, err
+	}
+	workspaceUID, err := parseOptionalUUID(link.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.GetSharedSpecDocumentParams{
+		CodebaseID:  codebaseUID,
+		Language:    link.Scope.Language,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	}
+	if link.DocumentID != nil {
+		if params.DocumentID, err = parseUUID(*link.DocumentID); err != nil {
+			return nil, err
+		}
+	}
+
+	row, err := r.queries.GetSharedSpecDocument(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.buildRepoSpecDocument(ctx, db.GetSpecDocumentByRepositoryRow(row))
+}
+
+func (r *PostgresRepository) ListShareLinks(ctx context.Context, access entity.DocumentAccess) ([]entity.ShareLink, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.ListSpecShareLinks(ctx, db.ListSpecShareLinksParams{
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]entity.ShareLink, len(rows))
+	for i, row := range rows {
+		links[i] = *mapShareLink(db.GetSpecShareLinkByIDRow(row))
+	}
+	return links, nil
+}
+
+func (r *PostgresRepository) RecordShareLinkAccess(ctx context.Context, linkID, clientIP string) error {
+	linkUID, err := parseUUID(linkID)
+	if err != nil {
+		return err
+	}
+
+	return r.queries.RecordSpecShareLinkAccess(ctx, db.RecordSpecShareLinkAccessParams{
+		IpAddress:   optionalText(clientIP),
+		ShareLinkID: linkUID,
+	})
+}
+
+func (r *PostgresRepository) RevokeShareLink(ctx context.Context, access entity.DocumentAccess, linkID string) (bool, error) {
+	userUID, workspaceUID, err := parseDocumentAccess(access)
+	if err != nil {
+		return false, err
+	}
+	linkUID, err := parseUUID(linkID)
+	if err != nil {
+		return false, nil
+	}
+
+	revoked, err := r.queries.RevokeSpecShareLink(ctx, db.RevokeSpecShareLinkParams{
+		ID:          linkUID,
+		UserID:      userUID,
+		WorkspaceID: workspaceUID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return revoked > 0, nil
+}
+
+func mapShareLink(row db.GetSpecShareLinkByIDRow) *entity.ShareLink {
+	link := &entity.ShareLink{
+		AccessCount:    row.AccessCount,
+		CreatedAt:      row.CreatedAt.Time,
+		DocumentID:     optionalUUID(row.DocumentID),
+		ExpiresAt:      optionalTime(row.ExpiresAt),
+		ID:             uuidToString(row.ID),
+		LastAccessedAt: optionalTime(row.LastAccessedAt),
+		Owner:          row.Owner,
+		Repo:           row.Repo,
+		RevokedAt:      optionalTime(row.RevokedAt),
+		Scope: entity.SpecEditScope{
+			CodebaseID: uuidToString(row.CodebaseID),
+			Language:   row.Language,
+			UserID:     uuidToString(row.UserID),
+		},
+		TokenPrefix: row.TokenPrefix,
+	}
+	if row.WorkspaceID.Valid {
+		link.WorkspaceID = uuidToString(row.WorkspaceID)
+	}
+	if row.DocumentVersion.Valid {
+		version := int(row.DocumentVersion.Int32)
+		link.DocumentVersion = &version
+	}
+	return link
+}
+
+func optionalTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
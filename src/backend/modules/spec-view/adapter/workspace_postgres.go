@@ -0,0 +1,199 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+var _ port.WorkspaceSpecRepository = (*PostgresRepository)(nil)
+
+// parseDocumentAccess returns a NULL workspace UUID for the user's own documents.
+func parseDocumentAccess(access entity.DocumentAccess) (userUID, workspaceUID pgtype.UUID, err error) {
+	if userUID, err = parseUUID(access.UserID); err != nil {
+		return userUID, workspaceUID, err
+	}
+	workspaceUID, err = parseOptionalUUID(access.WorkspaceID)
+	return userUID, workspaceUID, err
+}
+
+func parseOptionalUUID(s string) (pgtype.UUID, error) {
+	if s == "" {
+		return pgtype.UUID{}, nil
+	}
+	return parseUUID(s)
+}
+
+func (r *PostgresRepository) GetActiveGenerationJobIDByWorkspace(ctx context.Context, workspaceID, analysisID, language string) (int64, error) {
+	if _, err := uuid.Parse(workspaceID); err != nil {
+		return 0, err
+	}
+	if _, err := uuid.Parse(analysisID); err != nil {
+		return 0, err
+	}
+
+	jobID, err := r.queries.GetActiveWorkspaceSpecGenerationJobID(ctx, db.GetActiveWorkspaceSpecGenerationJobIDParams{
+		AnalysisID:  []byte(analysisID),
+		WorkspaceID: []byte(workspaceID),
+		Language:    []byte(language),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return jobID, nil
+}
+
+func (r *PostgresRepository) GetAvailableLanguagesByWorkspace(ctx context.Context, workspaceID, analysisID string) ([]entity.AvailableLanguageInfo, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	aid, err := parseUUID(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.GetAvailableLanguagesByWorkspaceAndAnalysis(ctx, db.GetAvailableLanguagesByWorkspaceAndAnalysisParams{
+		WorkspaceID: wid,
+		AnalysisID:  aid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]entity.AvailableLanguageInfo, len(rows))
+	for i, row := range rows {
+		result[i] = entity.AvailableLanguageInfo{
+			CreatedAt:     row.CreatedAt.Time,
+			Language:      row.Language,
+			LatestVersion: int(row.LatestVersion),
+		}
+	}
+	return result, nil
+}
+
+func (r *PostgresRepository) GetGenerationStatusByWorkspace(ctx context.Context, workspaceID, analysisID, language string) (*entity.SpecGenerationStatus, error) {
+	if _, err := uuid.Parse(workspaceID); err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(analysisID); err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.GetWorkspaceSpecGenerationStatus(ctx, db.GetWorkspaceSpecGenerationStatusParams{
+		AnalysisID:  []byte(analysisID),
+		WorkspaceID: []byte(workspaceID),
+		Language:    optionalText(language),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	status := &entity.SpecGenerationStatus{
+		AnalysisID: analysisID,
+		Status:     mapRiverJobState(string(row.State), row.CancelAttempted),
+	}
+
+	if row.CreatedAt.Valid {
+		status.StartedAt = &row.CreatedAt.Time
+	}
+	if row.FinalizedAt.Valid {
+		status.CompletedAt = &row.FinalizedAt.Time
+	}
+
+	return status, nil
+}
+
+func (r *PostgresRepository) GetSpecDocumentByWorkspace(ctx context.Context, workspaceID, analysisID, language string) (*entity.SpecDocument, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	aid, err := parseUUID(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.GetWorkspaceSpecDocument(ctx, db.GetWorkspaceSpecDocumentParams{
+		WorkspaceID: wid,
+		AnalysisID:  aid,
+		Language:    optionalText(language),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.buildSpecDocument(ctx, db.GetSpecDocumentByAnalysisIDRow(row))
+}
+
+func (r *PostgresRepository) GetSpecDocumentByWorkspaceAndVersion(ctx context.Context, workspaceID, analysisID, language string, version int) (*entity.SpecDocument, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	aid, err := parseUUID(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.GetWorkspaceSpecDocumentByVersion(ctx, db.GetWorkspaceSpecDocumentByVersionParams{
+		WorkspaceID: wid,
+		AnalysisID:  aid,
+		Language:    language,
+		Version:     int32(version),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.buildSpecDocument(ctx, db.GetSpecDocumentByAnalysisIDRow(row))
+}
+
+func (r *PostgresRepository) GetVersionsByWorkspace(ctx context.Context, workspaceID, analysisID, language string) ([]entity.VersionInfo, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	aid, err := parseUUID(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.GetVersionsByWorkspaceAndLanguage(ctx, db.GetVersionsByWorkspaceAndLanguageParams{
+		WorkspaceID: wid,
+		AnalysisID:  aid,
+		Language:    language,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]entity.VersionInfo, len(rows))
+	for i, row := range rows {
+		result[i] = entity.VersionInfo{
+			CreatedAt: row.CreatedAt.Time,
+			ModelID:   row.ModelID,
+			Version:   int(row.Version),
+		}
+	}
+	return result, nil
+}
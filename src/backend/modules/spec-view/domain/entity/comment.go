@@ -0,0 +1,188 @@
+package entity
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxCommentLength bounds a comment body in characters
+const MaxCommentLength = 10000
+
+// CommentTargetType is the spec hierarchy level a comment thread is attached to
+type CommentTargetType string
+
+const (
+	CommentTargetBehavior CommentTargetType = "behavior"
+	CommentTargetDomain   CommentTargetType = "domain"
+	CommentTargetFeature  CommentTargetType = "feature"
+)
+
+// IsValidCommentTargetType checks if the given target type is supported
+func IsValidCommentTargetType(t CommentTargetType) bool {
+	switch t {
+	case CommentTargetBehavior, CommentTargetDomain, CommentTargetFeature:
+		return true
+	}
+	return false
+}
+
+// CommentAnchor identifies what a thread is attached to independently of a
+// spec version: domains and features by name, behaviors by test file and test
+// name like edits. This lets open threads follow the target into new versions.
+type CommentAnchor struct {
+	DomainName string
+	// FeatureName is empty for domain threads
+	FeatureName string
+	// FilePath and TestName are empty unless Type is CommentTargetBehavior
+	FilePath         string
+	SourceTestCaseID *string
+	TestName         string
+	Type             CommentTargetType
+}
+
+// CommentDocument is a spec document comments are read from or written to
+type CommentDocument struct {
+	CreatedAt time.Time
+	Domains   []SpecDomain
+	ID        string
+	// Scope.UserID is the user reading or writing the comments
+	Scope SpecEditScope
+	// WorkspaceID is empty for the user's own documents, whose threads only the user sees
+	WorkspaceID string
+}
+
+type CommentThread struct {
+	Anchor   CommentAnchor
+	Comments []Comment
+	// DocumentID is the document the thread was opened on; nil once that version is deleted
+	DocumentID         *string
+	CreatedAt          time.Time
+	ID                 string
+	ResolvedAt         *time.Time
+	ResolvedBy         *string
+	ResolvedByUsername *string
+	// TargetID is the domain, feature or behavior ID in the document the thread is listed for
+	TargetID string
+}
+
+type Comment struct {
+	AuthorID       string
+	AuthorUsername string
+	Body           string
+	CreatedAt      time.Time
+	ID             string
+	Mentions       []CommentMention
+	ThreadID       string
+	UpdatedAt      time.Time
+}
+
+// IsEdited reports whether the comment was changed after it was posted
+func (c *Comment) IsEdited() bool {
+	return c.UpdatedAt.After(c.CreatedAt)
+}
+
+type CommentMention struct {
+	UserID   string
+	Username string
+}
+
+// mentionPattern matches @login mentions using GitHub's username rules
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@./-])@([A-Za-z0-9](?:[A-Za-z0-9-]{0,38}))\b`)
+
+// ParseMentions returns the distinct lowercase usernames mentioned in body
+func ParseMentions(body string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		username := strings.ToLower(match[1])
+		if !seen[username] {
+			seen[username] = true
+			usernames = append(usernames, username)
+		}
+	}
+	return usernames
+}
+
+// FindCommentAnchor returns the anchor of the domain, feature or behavior
+// with the given ID in domains.
+func FindCommentAnchor(domains []SpecDomain, targetType CommentTargetType, targetID string) (CommentAnchor, bool) {
+	for _, d := range domains {
+		if targetType == CommentTargetDomain && d.ID == targetID {
+			return CommentAnchor{DomainName: d.Name, Type: targetType}, true
+		}
+		for _, f := range d.Features {
+			if targetType == CommentTargetFeature && f.ID == targetID {
+				return CommentAnchor{DomainName: d.Name, FeatureName: f.Name, Type: targetType}, true
+			}
+			if targetType != CommentTargetBehavior {
+				continue
+			}
+			for _, b := range f.Behaviors {
+				if b.ID != targetID {
+					continue
+				}
+				anchor := CommentAnchor{
+					DomainName:       d.Name,
+					FeatureName:      f.Name,
+					SourceTestCaseID: b.SourceTestCaseID,
+					TestName:         b.OriginalName,
+					Type:             targetType,
+				}
+				if b.SourceInfo != nil {
+					anchor.FilePath = b.SourceInfo.FilePath
+				}
+				return anchor, true
+			}
+		}
+	}
+	return CommentAnchor{}, false
+}
+
+// PlaceCommentThreads sets TargetID of each thread whose target exists in
+// domains and drops the others. Domains and features are matched by
+// normalized name; behaviors by test case ID, then by test file and name
+// anywhere in the document, since regeneration may reclassify them.
+func PlaceCommentThreads(threads []CommentThread, domains []SpecDomain) []CommentThread {
+	domainIDs := make(map[string]string)
+	featureIDs := make(map[string]string)
+	behaviorIDs := make(map[string]string)
+	testCaseIDs := make(map[string]string)
+	for _, d := range domains {
+		domainIDs[normalizeName(d.Name)] = d.ID
+		for _, f := range d.Features {
+			featureIDs[normalizeName(d.Name)+"\x00"+normalizeName(f.Name)] = f.ID
+			for _, b := range f.Behaviors {
+				behaviorIDs[behaviorKey(b)] = b.ID
+				if b.SourceTestCaseID != nil {
+					testCaseIDs[*b.SourceTestCaseID] = b.ID
+				}
+			}
+		}
+	}
+
+	placed := make([]CommentThread, 0, len(threads))
+	for _, t := range threads {
+		var id string
+		var ok bool
+		switch t.Anchor.Type {
+		case CommentTargetDomain:
+			id, ok = domainIDs[normalizeName(t.Anchor.DomainName)]
+		case CommentTargetFeature:
+			id, ok = featureIDs[normalizeName(t.Anchor.DomainName)+"\x00"+normalizeName(t.Anchor.FeatureName)]
+		case CommentTargetBehavior:
+			if t.Anchor.SourceTestCaseID != nil {
+				id, ok = testCaseIDs[*t.Anchor.SourceTestCaseID]
+			}
+			if !ok {
+				id, ok = behaviorIDs[t.Anchor.FilePath+"\x00"+t.Anchor.TestName]
+			}
+		}
+		if !ok || len(t.Comments) == 0 {
+			continue
+		}
+		t.TargetID = id
+		placed = append(placed, t)
+	}
+	return placed
+}
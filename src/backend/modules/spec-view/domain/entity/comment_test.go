@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"no mentions", "Is this really what we want?", nil},
+		{"mentions", "@octocat is this right? cc @Hubot-2", []string{"octocat", "hubot-2"}},
+		{"deduplicates case-insensitively", "@octocat and @OctoCat", []string{"octocat"}},
+		{"adjacent punctuation", "(@octocat), @hubot.", []string{"octocat", "hubot"}},
+		{"ignores email addresses", "mail me at dev@example.com", nil},
+		{"ignores names longer than 39 characters", "@a123456789012345678901234567890123456789", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseMentions(tt.body); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMentions(%q) = %v, want %v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindCommentAnchor(t *testing.T) {
+	domains := editTestDomains()
+
+	t.Run("behavior", func(t *testing.T) {
+		anchor, ok := FindCommentAnchor(domains, CommentTargetBehavior, "b-1")
+		if !ok {
+			t.Fatal("FindCommentAnchor() ok = false, want true")
+		}
+		if anchor.FilePath != "refund_test.go" || anchor.TestName != "refunds order" || anchor.FeatureName != "Refunds" {
+			t.Errorf("anchor = %+v, want refund_test.go/refunds order in Refunds", anchor)
+		}
+		if anchor.SourceTestCaseID == nil || *anchor.SourceTestCaseID != "tc-1" {
+			t.Errorf("SourceTestCaseID = %v, want tc-1", anchor.SourceTestCaseID)
+		}
+	})
+
+	t.Run("feature", func(t *testing.T) {
+		anchor, ok := FindCommentAnchor(domains, CommentTargetFeature, "f-2")
+		if !ok || anchor.DomainName != "Payments" || anchor.FeatureName != "Chargebacks" || anchor.TestName != "" {
+			t.Errorf("FindCommentAnchor() = %+v, %v, want Payments/Chargebacks", anchor, ok)
+		}
+	})
+
+	t.Run("type must match the target", func(t *testing.T) {
+		if _, ok := FindCommentAnchor(domains, CommentTargetDomain, "f-1"); ok {
+			t.Error("FindCommentAnchor() ok = true for feature ID as domain target")
+		}
+	})
+}
+
+func TestPlaceCommentThreads(t *testing.T) {
+	comments := []Comment{{ID: "c-1"}}
+	oldTestCase := "tc-old"
+	threads := []CommentThread{
+		{ID: "t-domain", Comments: comments, Anchor: CommentAnchor{Type: CommentTargetDomain, DomainName: "payments"}},
+		{ID: "t-feature", Comments: comments, Anchor: CommentAnchor{Type: CommentTargetFeature, DomainName: "Payments", FeatureName: "Chargebacks"}},
+		{ID: "t-behavior", Comments: comments, Anchor: CommentAnchor{Type: CommentTargetBehavior, FilePath: "dispute_test.go", SourceTestCaseID: &oldTestCase, TestName: "opens dispute"}},
+		{ID: "t-by-test-case", Comments: comments, Anchor: CommentAnchor{Type: CommentTargetBehavior, FilePath: "moved_test.go", SourceTestCaseID: strPtr("tc-1"), TestName: "renamed"}},
+		{ID: "t-removed", Comments: comments, Anchor: CommentAnchor{Type: CommentTargetBehavior, FilePath: "refund_test.go", TestName: "deleted test"}},
+		{ID: "t-empty", Anchor: CommentAnchor{Type: CommentTargetDomain, DomainName: "Payments"}},
+	}
+
+	got := PlaceCommentThreads(threads, editTestDomains())
+
+	want := map[string]string{
+		"t-domain":       "d-1",
+		"t-feature":      "f-2",
+		"t-behavior":     "b-3",
+		"t-by-test-case": "b-1",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("PlaceCommentThreads() returned %d threads, want %d: %+v", len(got), len(want), got)
+	}
+	for _, thread := range got {
+		if thread.TargetID != want[thread.ID] {
+			t.Errorf("thread %s TargetID = %q, want %q", thread.ID, thread.TargetID, want[thread.ID])
+		}
+	}
+}
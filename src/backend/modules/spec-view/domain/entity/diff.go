@@ -0,0 +1,399 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// ChangeType classifies how an item differs between two spec versions
+type ChangeType string
+
+const (
+	ChangeAdded   ChangeType = "added"
+	ChangeRemoved ChangeType = "removed"
+	// ChangeRenamed means the name changed; the description may have changed as well
+	ChangeRenamed ChangeType = "renamed"
+	// ChangeReworded means only the description changed
+	ChangeReworded ChangeType = "reworded"
+	// ChangeModified means the item itself is unchanged but some of its children changed
+	ChangeModified ChangeType = "modified"
+)
+
+// renameThreshold is the minimum share of behaviors two differently named
+// domains or features must have in common to be treated as a rename
+const renameThreshold = 0.5
+
+// SpecDiff describes the changes from one spec document version to another.
+// Unchanged domains, features and behaviors are omitted.
+type SpecDiff struct {
+	Domains []DomainDiff
+	// ExecutiveSummary is nil when the summary is unchanged
+	ExecutiveSummary *TextChange
+	Summary          DiffSummary
+}
+
+// DiffVersion identifies one side of a spec diff
+type DiffVersion struct {
+	AnalysisID string
+	// CommitSHA is only known for repository-based diffs
+	CommitSHA string
+	CreatedAt time.Time
+	ID        string
+	Language  string
+	ModelID   string
+	Version   int
+}
+
+type TextChange struct {
+	From *string
+	To   *string
+}
+
+// DiffSummary counts changes per hierarchy level. A renamed item whose
+// description also changed counts as both renamed and reworded.
+type DiffSummary struct {
+	Behaviors ChangeCounts
+	Domains   ChangeCounts
+	Features  ChangeCounts
+}
+
+type ChangeCounts struct {
+	Added    int
+	Removed  int
+	Renamed  int
+	Reworded int
+}
+
+type DomainDiff struct {
+	Change      ChangeType
+	Description *string
+	Features    []FeatureDiff
+	Name        string
+	// PreviousDescription is set when the description changed
+	PreviousDescription *string
+	// PreviousName is set when the domain was renamed
+	PreviousName string
+}
+
+type FeatureDiff struct {
+	Behaviors   []BehaviorDiff
+	Change      ChangeType
+	Description *string
+	Name        string
+	// PreviousDescription is set when the description changed
+	PreviousDescription *string
+	// PreviousName is set when the feature was renamed
+	PreviousName string
+}
+
+type BehaviorDiff struct {
+	Change       ChangeType
+	Description  string
+	OriginalName string
+	// PreviousDescription is set when the converted description changed
+	PreviousDescription string
+	// PreviousOriginalName is set when the source test was renamed
+	PreviousOriginalName string
+	SourceInfo           *BehaviorSourceInfo
+}
+
+// DiffSpecDocuments compares two versions of a spec document.
+//
+// Domains and features are aligned by name; differently named ones that share
+// most of their behaviors are reported as renamed. Behaviors are aligned by
+// source test case, falling back to test name and file since test cases are
+// recreated for every analysis. Behaviors that moved to another feature are
+// reported as removed and added.
+func DiffSpecDocuments(fromDomains, toDomains []SpecDomain, fromSummary, toSummary *string) *SpecDiff {
+	diff := &SpecDiff{}
+	if !sameText(fromSummary, toSummary) {
+		diff.ExecutiveSummary = &TextChange{From: fromSummary, To: toSummary}
+	}
+
+	for _, p := range align(fromDomains, toDomains, func(d SpecDomain) string { return d.Name }, domainBehaviors) {
+		if d, ok := diffDomain(p, &diff.Summary); ok {
+			diff.Domains = append(diff.Domains, d)
+		}
+	}
+	return diff
+}
+
+func diffDomain(p pair[SpecDomain], summary *DiffSummary) (DomainDiff, bool) {
+	var from, to []SpecFeature
+	var d DomainDiff
+	switch {
+	case p.to == nil:
+		d = DomainDiff{Change: ChangeRemoved, Description: p.from.Description, Name: p.from.Name}
+		from = p.from.Features
+		summary.Domains.Removed++
+	case p.from == nil:
+		d = DomainDiff{Change: ChangeAdded, Description: p.to.Description, Name: p.to.Name}
+		to = p.to.Features
+		summary.Domains.Added++
+	default:
+		d = DomainDiff{Description: p.to.Description, Name: p.to.Name}
+		from, to = p.from.Features, p.to.Features
+		if !sameText(p.from.Description, p.to.Description) {
+			d.Change = ChangeReworded
+			d.PreviousDescription = p.from.Description
+			summary.Domains.Reworded++
+		}
+		if normalizeName(p.from.Name) != normalizeName(p.to.Name) {
+			d.Change = ChangeRenamed
+			d.PreviousName = p.from.Name
+			summary.Domains.Renamed++
+		}
+	}
+
+	for _, fp := range align(from, to, func(f SpecFeature) string { return f.Name }, featureBehaviors) {
+		if f, ok := diffFeature(fp, summary); ok {
+			d.Features = append(d.Features, f)
+		}
+	}
+
+	if d.Change == "" {
+		if len(d.Features) == 0 {
+			return d, false
+		}
+		d.Change = ChangeModified
+	}
+	return d, true
+}
+
+func diffFeature(p pair[SpecFeature], summary *DiffSummary) (FeatureDiff, bool) {
+	var from, to []SpecBehavior
+	var f FeatureDiff
+	switch {
+	case p.to == nil:
+		f = FeatureDiff{Change: ChangeRemoved, Description: p.from.Description, Name: p.from.Name}
+		from = p.from.Behaviors
+		summary.Features.Removed++
+	case p.from == nil:
+		f = FeatureDiff{Change: ChangeAdded, Description: p.to.Description, Name: p.to.Name}
+		to = p.to.Behaviors
+		summary.Features.Added++
+	default:
+		f = FeatureDiff{Description: p.to.Description, Name: p.to.Name}
+		from, to = p.from.Behaviors, p.to.Behaviors
+		if !sameText(p.from.Description, p.to.Description) {
+			f.Change = ChangeReworded
+			f.PreviousDescription = p.from.Description
+			summary.Features.Reworded++
+		}
+		if normalizeName(p.from.Name) != normalizeName(p.to.Name) {
+			f.Change = ChangeRenamed
+			f.PreviousName = p.from.Name
+			summary.Features.Renamed++
+		}
+	}
+
+	for _, bp := range alignBehaviors(from, to) {
+		if b, ok := diffBehavior(bp, summary); ok {
+			f.Behaviors = append(f.Behaviors, b)
+		}
+	}
+
+	if f.Change == "" {
+		if len(f.Behaviors) == 0 {
+			return f, false
+		}
+		f.Change = ChangeModified
+	}
+	return f, true
+}
+
+func diffBehavior(p pair[SpecBehavior], summary *DiffSummary) (BehaviorDiff, bool) {
+	switch {
+	case p.to == nil:
+		summary.Behaviors.Removed++
+		return newBehaviorDiff(ChangeRemoved, *p.from), true
+	case p.from == nil:
+		summary.Behaviors.Added++
+		return newBehaviorDiff(ChangeAdded, *p.to), true
+	}
+
+	b := newBehaviorDiff("", *p.to)
+	if strings.TrimSpace(p.from.ConvertedDescription) != strings.TrimSpace(p.to.ConvertedDescription) {
+		b.Change = ChangeReworded
+		b.PreviousDescription = p.from.ConvertedDescription
+		summary.Behaviors.Reworded++
+	}
+	if p.from.OriginalName != p.to.OriginalName {
+		b.Change = ChangeRenamed
+		b.PreviousOriginalName = p.from.OriginalName
+		summary.Behaviors.Renamed++
+	}
+	return b, b.Change != ""
+}
+
+func newBehaviorDiff(change ChangeType, b SpecBehavior) BehaviorDiff {
+	return BehaviorDiff{
+		Change:       change,
+		Description:  b.ConvertedDescription,
+		OriginalName: b.OriginalName,
+		SourceInfo:   b.SourceInfo,
+	}
+}
+
+// pair holds aligned items; from is nil for added items and to for removed ones
+type pair[T any] struct {
+	from *T
+	to   *T
+}
+
+// align pairs items by name, then pairs the remaining ones by behavior overlap.
+// Pairs follow the order of to, with removed items appended in the order of from.
+func align[T any](from, to []T, name func(T) string, behaviors func(T) []SpecBehavior) []pair[T] {
+	matched := make([]int, len(to))
+	used := make([]bool, len(from))
+
+	byName := make(map[string]int, len(from))
+	for i := range from {
+		if _, ok := byName[normalizeName(name(from[i]))]; !ok {
+			byName[normalizeName(name(from[i]))] = i
+		}
+	}
+	for j := range to {
+		matched[j] = -1
+		if i, ok := byName[normalizeName(name(to[j]))]; ok && !used[i] {
+			matched[j] = i
+			used[i] = true
+		}
+	}
+
+	for j := range to {
+		if matched[j] >= 0 {
+			continue
+		}
+		toBehaviors := behaviors(to[j])
+		best, bestScore := -1, renameThreshold
+		for i := range from {
+			if used[i] {
+				continue
+			}
+			if score := behaviorOverlap(behaviors(from[i]), toBehaviors); score >= bestScore {
+				best, bestScore = i, score
+			}
+		}
+		if best >= 0 {
+			matched[j] = best
+			used[best] = true
+		}
+	}
+
+	pairs := make([]pair[T], 0, len(to)+len(from))
+	for j := range to {
+		p := pair[T]{to: &to[j]}
+		if matched[j] >= 0 {
+			p.from = &from[matched[j]]
+		}
+		pairs = append(pairs, p)
+	}
+	for i := range from {
+		if !used[i] {
+			pairs = append(pairs, pair[T]{from: &from[i]})
+		}
+	}
+	return pairs
+}
+
+// alignBehaviors pairs behaviors by source test case, then by test name and file.
+func alignBehaviors(from, to []SpecBehavior) []pair[SpecBehavior] {
+	matched := make([]int, len(to))
+	used := make([]bool, len(from))
+
+	byTestCase := make(map[string]int, len(from))
+	byKey := make(map[string]int, len(from))
+	for i := range from {
+		if id := from[i].SourceTestCaseID; id != nil {
+			byTestCase[*id] = i
+		}
+		if _, ok := byKey[behaviorKey(from[i])]; !ok {
+			byKey[behaviorKey(from[i])] = i
+		}
+	}
+
+	for j := range to {
+		matched[j] = -1
+		if id := to[j].SourceTestCaseID; id != nil {
+			if i, ok := byTestCase[*id]; ok && !used[i] {
+				matched[j] = i
+				used[i] = true
+			}
+		}
+	}
+	for j := range to {
+		if matched[j] >= 0 {
+			continue
+		}
+		if i, ok := byKey[behaviorKey(to[j])]; ok && !used[i] {
+			matched[j] = i
+			used[i] = true
+		}
+	}
+
+	pairs := make([]pair[SpecBehavior], 0, len(to)+len(from))
+	for j := range to {
+		p := pair[SpecBehavior]{to: &to[j]}
+		if matched[j] >= 0 {
+			p.from = &from[matched[j]]
+		}
+		pairs = append(pairs, p)
+	}
+	for i := range from {
+		if !used[i] {
+			pairs = append(pairs, pair[SpecBehavior]{from: &from[i]})
+		}
+	}
+	return pairs
+}
+
+// behaviorKey identifies a behavior across analyses by its test name and file
+func behaviorKey(b SpecBehavior) string {
+	file := ""
+	if b.SourceInfo != nil {
+		file = b.SourceInfo.FilePath
+	}
+	return file + "\x00" + b.OriginalName
+}
+
+func featureBehaviors(f SpecFeature) []SpecBehavior {
+	return f.Behaviors
+}
+
+func domainBehaviors(d SpecDomain) []SpecBehavior {
+	var behaviors []SpecBehavior
+	for _, f := range d.Features {
+		behaviors = append(behaviors, f.Behaviors...)
+	}
+	return behaviors
+}
+
+// behaviorOverlap returns the share of behaviors alignBehaviors pairs between
+// a and b (Jaccard index), 0 when both are empty
+func behaviorOverlap(a, b []SpecBehavior) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for _, p := range alignBehaviors(a, b) {
+		if p.from != nil && p.to != nil {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+func sameText(a, b *string) bool {
+	text := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return strings.TrimSpace(*s)
+	}
+	return text(a) == text(b)
+}
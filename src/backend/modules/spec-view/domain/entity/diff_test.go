@@ -0,0 +1,146 @@
+package entity
+
+import "testing"
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func behavior(testCaseID, file, name, description string) SpecBehavior {
+	b := SpecBehavior{ConvertedDescription: description, OriginalName: name}
+	if testCaseID != "" {
+		b.SourceTestCaseID = strPtr(testCaseID)
+	}
+	if file != "" {
+		b.SourceInfo = &BehaviorSourceInfo{FilePath: file}
+	}
+	return b
+}
+
+func TestDiffSpecDocuments(t *testing.T) {
+	from := []SpecDomain{
+		{
+			Name: "Authentication",
+			Features: []SpecFeature{
+				{
+					Name: "Login",
+					Behaviors: []SpecBehavior{
+						behavior("tc-1", "auth_test.go", "TestLogin", "Logs in a user"),
+						behavior("tc-2", "auth_test.go", "TestLogout", "Logs out a user"),
+						behavior("tc-3", "auth_test.go", "TestLockout", "Locks the account"),
+					},
+				},
+			},
+		},
+		{
+			Name:        "Billing",
+			Description: strPtr("Payments"),
+			Features: []SpecFeature{
+				{Name: "Invoices", Behaviors: []SpecBehavior{behavior("tc-4", "billing_test.go", "TestInvoice", "Creates invoices")}},
+			},
+		},
+		{
+			Name:     "Legacy",
+			Features: []SpecFeature{{Name: "Old", Behaviors: []SpecBehavior{behavior("tc-5", "old_test.go", "TestOld", "Does old things")}}},
+		},
+	}
+	to := []SpecDomain{
+		{
+			Name: "Authentication",
+			Features: []SpecFeature{
+				{
+					// Renamed feature: shares all behaviors with "Login"
+					Name: "Sign-in",
+					Behaviors: []SpecBehavior{
+						behavior("tc-1", "auth_test.go", "TestLogin", "Signs a user in"),
+						behavior("tc-2", "auth_test.go", "TestSignOut", "Logs out a user"),
+						behavior("tc-3", "auth_test.go", "TestLockout", "Locks the account"),
+						behavior("tc-6", "auth_test.go", "TestMFA", "Requires a second factor"),
+					},
+				},
+			},
+		},
+		{
+			Name:        "Billing",
+			Description: strPtr("Payments and refunds"),
+			Features: []SpecFeature{
+				{Name: "Invoices", Behaviors: []SpecBehavior{behavior("tc-4", "billing_test.go", "TestInvoice", "Creates invoices")}},
+			},
+		},
+	}
+
+	diff := DiffSpecDocuments(from, to, strPtr("Summary"), strPtr("Summary "))
+
+	if diff.ExecutiveSummary != nil {
+		t.Error("expected whitespace-only summary change to be ignored")
+	}
+	if len(diff.Domains) != 3 {
+		t.Fatalf("expected 3 changed domains, got %+v", diff.Domains)
+	}
+
+	auth := diff.Domains[0]
+	if auth.Change != ChangeModified || len(auth.Features) != 1 {
+		t.Fatalf("unexpected authentication diff: %+v", auth)
+	}
+	login := auth.Features[0]
+	if login.Change != ChangeRenamed || login.PreviousName != "Login" || login.Name != "Sign-in" {
+		t.Errorf("expected Login to be renamed to Sign-in, got %+v", login)
+	}
+	wantBehaviors := []struct {
+		change   ChangeType
+		previous string
+	}{
+		{ChangeReworded, "Logs in a user"},
+		{ChangeRenamed, ""},
+		{ChangeAdded, ""},
+	}
+	if len(login.Behaviors) != len(wantBehaviors) {
+		t.Fatalf("expected %d behavior changes, got %+v", len(wantBehaviors), login.Behaviors)
+	}
+	for i, want := range wantBehaviors {
+		if got := login.Behaviors[i]; got.Change != want.change || got.PreviousDescription != want.previous {
+			t.Errorf("behavior %d = %+v, want change %s", i, got, want.change)
+		}
+	}
+	if login.Behaviors[1].PreviousOriginalName != "TestLogout" {
+		t.Errorf("expected renamed test to keep previous name, got %+v", login.Behaviors[1])
+	}
+
+	billing := diff.Domains[1]
+	if billing.Change != ChangeReworded || *billing.PreviousDescription != "Payments" || len(billing.Features) != 0 {
+		t.Errorf("expected reworded billing domain without feature changes, got %+v", billing)
+	}
+
+	legacy := diff.Domains[2]
+	if legacy.Change != ChangeRemoved || len(legacy.Features) != 1 || legacy.Features[0].Behaviors[0].Change != ChangeRemoved {
+		t.Errorf("expected removed legacy domain listing its content, got %+v", legacy)
+	}
+
+	want := DiffSummary{
+		Behaviors: ChangeCounts{Added: 1, Removed: 1, Renamed: 1, Reworded: 1},
+		Domains:   ChangeCounts{Removed: 1, Reworded: 1},
+		Features:  ChangeCounts{Removed: 1, Renamed: 1},
+	}
+	if diff.Summary != want {
+		t.Errorf("Summary = %+v, want %+v", diff.Summary, want)
+	}
+}
+
+func TestDiffSpecDocuments_AcrossAnalyses(t *testing.T) {
+	// Test case IDs differ between analyses; behaviors align by test name and file.
+	from := []SpecDomain{{Name: "Search", Features: []SpecFeature{{Name: "Query", Behaviors: []SpecBehavior{
+		behavior("old-1", "search_test.go", "TestQuery", "Finds documents"),
+	}}}}}
+	to := []SpecDomain{{Name: "search", Features: []SpecFeature{{Name: "Query", Behaviors: []SpecBehavior{
+		behavior("new-1", "search_test.go", "TestQuery", "Finds documents"),
+	}}}}}
+
+	diff := DiffSpecDocuments(from, to, nil, strPtr("New summary"))
+
+	if len(diff.Domains) != 0 {
+		t.Errorf("expected no domain changes, got %+v", diff.Domains)
+	}
+	if diff.ExecutiveSummary == nil || diff.ExecutiveSummary.From != nil || *diff.ExecutiveSummary.To != "New summary" {
+		t.Errorf("expected added executive summary, got %+v", diff.ExecutiveSummary)
+	}
+}
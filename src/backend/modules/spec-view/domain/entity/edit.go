@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// MaxEditedDescriptionLength bounds a user-written behavior description in characters
+	MaxEditedDescriptionLength = 2000
+	// MaxFeatureNameLength matches the spec_features.name column
+	MaxFeatureNameLength = 255
+)
+
+// SpecEditScope identifies the spec documents a set of edits applies to:
+// every version a user or workspace generates for a repository in one language.
+type SpecEditScope struct {
+	CodebaseID string
+	Language   string
+	UserID     string
+	// WorkspaceID is set for edits shared by a workspace's members; UserID then records who edited
+	WorkspaceID string
+}
+
+// SpecEdits are user corrections layered over generated spec documents.
+// They are stored apart from generated rows so regeneration never loses them.
+type SpecEdits struct {
+	Behaviors []BehaviorEdit
+	Features  []FeatureEdit
+}
+
+// BehaviorEdit overrides a generated behavior. It is keyed by test file and
+// test name because test cases are recreated for every analysis;
+// SourceTestCaseID is matched first when the edited analysis is displayed.
+type BehaviorEdit struct {
+	// Description replaces the generated description; nil keeps it
+	Description      *string
+	FilePath         string
+	Hidden           bool
+	ID               string
+	SourceTestCaseID *string
+	TestName         string
+	UpdatedAt        time.Time
+}
+
+// FeatureEdit renames a generated feature. Renaming a feature to the name of
+// another feature in the same domain merges the two.
+type FeatureEdit struct {
+	DomainName  string
+	FeatureName string
+	ID          string
+	NewName     string
+	UpdatedAt   time.Time
+}
+
+// BehaviorEditTarget locates the behavior an edit request refers to
+type BehaviorEditTarget struct {
+	FilePath         string
+	Scope            SpecEditScope
+	SourceTestCaseID *string
+	TestName         string
+}
+
+// FeatureEditTarget locates the feature an edit request refers to
+type FeatureEditTarget struct {
+	DomainName  string
+	FeatureName string
+	Scope       SpecEditScope
+}
+
+// IsEmpty reports whether there are no edits to apply
+func (e *SpecEdits) IsEmpty() bool {
+	return e == nil || (len(e.Behaviors) == 0 && len(e.Features) == 0)
+}
+
+// Apply returns domains with the edits layered on top. Edited features and
+// behaviors are marked; hidden behaviors are dropped along with features and
+// domains left without behaviors. The input is not modified.
+func (e *SpecEdits) Apply(domains []SpecDomain) []SpecDomain {
+	if e.IsEmpty() {
+		return domains
+	}
+
+	byTestCase := make(map[string]*BehaviorEdit, len(e.Behaviors))
+	byKey := make(map[string]*BehaviorEdit, len(e.Behaviors))
+	for i := range e.Behaviors {
+		edit := &e.Behaviors[i]
+		if edit.SourceTestCaseID != nil {
+			byTestCase[*edit.SourceTestCaseID] = edit
+		}
+		byKey[edit.FilePath+"\x00"+edit.TestName] = edit
+	}
+	renames := make(map[string]string, len(e.Features))
+	for _, edit := range e.Features {
+		renames[edit.DomainName+"\x00"+edit.FeatureName] = edit.NewName
+	}
+
+	result := make([]SpecDomain, 0, len(domains))
+	for _, d := range domains {
+		features := make([]SpecFeature, 0, len(d.Features))
+		byName := make(map[string]int, len(d.Features))
+		hadBehaviors := false
+		for _, f := range d.Features {
+			hadBehaviors = hadBehaviors || len(f.Behaviors) > 0
+			behaviors := applyBehaviorEdits(f.Behaviors, byTestCase, byKey)
+			if len(behaviors) == 0 && len(f.Behaviors) > 0 {
+				continue
+			}
+			f.Behaviors = behaviors
+
+			newName, renamed := renames[d.Name+"\x00"+f.Name]
+			if renamed {
+				f.Name = newName
+				f.Edited = true
+			}
+
+			i, ok := byName[normalizeName(f.Name)]
+			if !ok {
+				byName[normalizeName(f.Name)] = len(features)
+				features = append(features, f)
+				continue
+			}
+
+			// Merge, keeping the ID and description of the feature that kept its generated name
+			merged := features[i]
+			behaviors = append(append(make([]SpecBehavior, 0, len(merged.Behaviors)+len(f.Behaviors)), merged.Behaviors...), f.Behaviors...)
+			if !renamed {
+				merged = f
+			}
+			merged.Behaviors = behaviors
+			merged.Edited = true
+			features[i] = merged
+		}
+
+		if len(features) == 0 && hadBehaviors {
+			continue
+		}
+		d.Features = features
+		result = append(result, d)
+	}
+	return result
+}
+
+func applyBehaviorEdits(behaviors []SpecBehavior, byTestCase, byKey map[string]*BehaviorEdit) []SpecBehavior {
+	result := make([]SpecBehavior, 0, len(behaviors))
+	for _, b := range behaviors {
+		edit := findBehaviorEdit(b, byTestCase, byKey)
+		if edit == nil {
+			result = append(result, b)
+			continue
+		}
+		if edit.Hidden {
+			continue
+		}
+		if edit.Description != nil {
+			b.ConvertedDescription = *edit.Description
+			b.Edited = true
+		}
+		result = append(result, b)
+	}
+	return result
+}
+
+func findBehaviorEdit(b SpecBehavior, byTestCase, byKey map[string]*BehaviorEdit) *BehaviorEdit {
+	if b.SourceTestCaseID != nil {
+		if edit, ok := byTestCase[*b.SourceTestCaseID]; ok {
+			return edit
+		}
+	}
+	return byKey[behaviorKey(b)]
+}
+
+// NormalizeEditText trims text and reports whether it is non-empty and at most maxLen characters
+func NormalizeEditText(text string, maxLen int) (string, bool) {
+	text = strings.TrimSpace(text)
+	return text, text != "" && len([]rune(text)) <= maxLen
+}
@@ -0,0 +1,185 @@
+package entity
+
+import (
+	"testing"
+)
+
+func editTestDomains() []SpecDomain {
+	tc1 := "tc-1"
+	generated := "Generated payments feature"
+	return []SpecDomain{
+		{
+			ID:   "d-1",
+			Name: "Payments",
+			Features: []SpecFeature{
+				{
+					ID:          "f-1",
+					Name:        "Refunds",
+					Description: &generated,
+					Behaviors: []SpecBehavior{
+						{ID: "b-1", OriginalName: "refunds order", ConvertedDescription: "Refunds an order", SourceTestCaseID: &tc1, SourceInfo: &BehaviorSourceInfo{FilePath: "refund_test.go"}},
+						{ID: "b-2", OriginalName: "rejects refund", ConvertedDescription: "Rejects a refund", SourceInfo: &BehaviorSourceInfo{FilePath: "refund_test.go"}},
+					},
+				},
+				{
+					ID:   "f-2",
+					Name: "Chargebacks",
+					Behaviors: []SpecBehavior{
+						{ID: "b-3", OriginalName: "opens dispute", ConvertedDescription: "Opens a dispute", SourceInfo: &BehaviorSourceInfo{FilePath: "dispute_test.go"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSpecEdits_Apply(t *testing.T) {
+	strPtr := func(s string) *string { return &s }
+
+	t.Run("returns domains unchanged when there are no edits", func(t *testing.T) {
+		var edits *SpecEdits
+		got := edits.Apply(editTestDomains())
+		if len(got) != 1 || len(got[0].Features) != 2 {
+			t.Fatalf("Apply() = %+v, want unchanged domains", got)
+		}
+	})
+
+	t.Run("replaces description matched by test case ID", func(t *testing.T) {
+		edits := &SpecEdits{Behaviors: []BehaviorEdit{
+			{Description: strPtr("Issues a full refund"), FilePath: "moved_test.go", SourceTestCaseID: strPtr("tc-1"), TestName: "renamed"},
+		}}
+		domains := editTestDomains()
+
+		got := edits.Apply(domains)
+
+		b := got[0].Features[0].Behaviors[0]
+		if b.ConvertedDescription != "Issues a full refund" || !b.Edited {
+			t.Errorf("behavior = %+v, want edited description", b)
+		}
+		if domains[0].Features[0].Behaviors[0].Edited {
+			t.Error("Apply() modified its input")
+		}
+	})
+
+	t.Run("matches behaviors of other analyses by file and test name", func(t *testing.T) {
+		edits := &SpecEdits{Behaviors: []BehaviorEdit{
+			{Description: strPtr("Declines a refund"), FilePath: "refund_test.go", SourceTestCaseID: strPtr("tc-old"), TestName: "rejects refund"},
+		}}
+
+		got := edits.Apply(editTestDomains())
+
+		b := got[0].Features[0].Behaviors[1]
+		if b.ConvertedDescription != "Declines a refund" || !b.Edited {
+			t.Errorf("behavior = %+v, want edited description", b)
+		}
+		if got[0].Features[0].Behaviors[0].Edited {
+			t.Error("unedited behavior marked as edited")
+		}
+	})
+
+	t.Run("drops hidden behaviors and features left empty", func(t *testing.T) {
+		edits := &SpecEdits{Behaviors: []BehaviorEdit{
+			{FilePath: "refund_test.go", Hidden: true, TestName: "rejects refund"},
+			{FilePath: "dispute_test.go", Hidden: true, TestName: "opens dispute"},
+		}}
+
+		got := edits.Apply(editTestDomains())
+
+		if len(got[0].Features) != 1 {
+			t.Fatalf("features = %d, want 1", len(got[0].Features))
+		}
+		if behaviors := got[0].Features[0].Behaviors; len(behaviors) != 1 || behaviors[0].ID != "b-1" {
+			t.Errorf("behaviors = %+v, want only b-1", behaviors)
+		}
+	})
+
+	t.Run("drops domains whose behaviors are all hidden", func(t *testing.T) {
+		edits := &SpecEdits{Behaviors: []BehaviorEdit{
+			{FilePath: "refund_test.go", Hidden: true, TestName: "refunds order"},
+			{FilePath: "refund_test.go", Hidden: true, TestName: "rejects refund"},
+			{FilePath: "dispute_test.go", Hidden: true, TestName: "opens dispute"},
+		}}
+
+		if got := edits.Apply(editTestDomains()); len(got) != 0 {
+			t.Errorf("Apply() = %+v, want no domains", got)
+		}
+	})
+
+	t.Run("renames feature", func(t *testing.T) {
+		edits := &SpecEdits{Features: []FeatureEdit{
+			{DomainName: "Payments", FeatureName: "Chargebacks", NewName: "Disputes"},
+		}}
+
+		got := edits.Apply(editTestDomains())
+
+		f := got[0].Features[1]
+		if f.Name != "Disputes" || !f.Edited || f.ID != "f-2" {
+			t.Errorf("feature = %+v, want renamed f-2", f)
+		}
+		if got[0].Features[0].Edited {
+			t.Error("unrenamed feature marked as edited")
+		}
+	})
+
+	t.Run("merges feature renamed to a sibling's name", func(t *testing.T) {
+		edits := &SpecEdits{Features: []FeatureEdit{
+			{DomainName: "Payments", FeatureName: "Chargebacks", NewName: " refunds "},
+		}}
+
+		got := edits.Apply(editTestDomains())
+
+		if len(got[0].Features) != 1 {
+			t.Fatalf("features = %d, want 1", len(got[0].Features))
+		}
+		f := got[0].Features[0]
+		if f.ID != "f-1" || f.Name != "Refunds" || f.Description == nil || !f.Edited {
+			t.Errorf("feature = %+v, want f-1 keeping its generated name and description", f)
+		}
+		if len(f.Behaviors) != 3 || f.Behaviors[2].ID != "b-3" {
+			t.Errorf("behaviors = %+v, want b-1, b-2, b-3", f.Behaviors)
+		}
+	})
+
+	t.Run("merge keeps the feature that was not renamed when it comes later", func(t *testing.T) {
+		edits := &SpecEdits{Features: []FeatureEdit{
+			{DomainName: "Payments", FeatureName: "Refunds", NewName: "Chargebacks"},
+		}}
+
+		got := edits.Apply(editTestDomains())
+
+		if len(got[0].Features) != 1 {
+			t.Fatalf("features = %d, want 1", len(got[0].Features))
+		}
+		f := got[0].Features[0]
+		if f.ID != "f-2" || f.Name != "Chargebacks" || !f.Edited {
+			t.Errorf("feature = %+v, want f-2", f)
+		}
+		if len(f.Behaviors) != 3 || f.Behaviors[0].ID != "b-1" {
+			t.Errorf("behaviors = %+v, want b-1, b-2, b-3", f.Behaviors)
+		}
+	})
+}
+
+func TestNormalizeEditText(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		maxLen int
+		want   string
+		wantOK bool
+	}{
+		{"trims whitespace", "  Refunds  ", 7, "Refunds", true},
+		{"rejects blank", "   ", 7, "", false},
+		{"counts characters not bytes", "환불환불", 4, "환불환불", true},
+		{"rejects too long", "Refunds!", 7, "Refunds!", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeEditText(tt.text, tt.maxLen)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("NormalizeEditText(%q, %d) = %q, %v, want %q, %v", tt.text, tt.maxLen, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ExportFormat is a file format spec documents can be exported to (synced with OpenAPI SpecExportFormat enum)
+type ExportFormat string
+
+const (
+	ExportFormatGherkin  ExportFormat = "gherkin"
+	ExportFormatHTML     ExportFormat = "html"
+	ExportFormatMarkdown ExportFormat = "markdown"
+)
+
+// DefaultExportFormat is used when no export format is requested
+const DefaultExportFormat = ExportFormatMarkdown
+
+// AnalysisSource identifies the repository revision an analysis was run against
+type AnalysisSource struct {
+	CommitSHA string
+	Host      string
+	Name      string
+	Owner     string
+}
+
+// FileURL returns a link to filePath at the analyzed commit, anchored to line when it is positive
+func (s AnalysisSource) FileURL(filePath string, line int) string {
+	segments := strings.Split(strings.TrimPrefix(filePath, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	link := fmt.Sprintf("https://%s/%s/%s/blob/%s/%s", s.Host, s.Owner, s.Name, s.CommitSHA, strings.Join(segments, "/"))
+	if line > 0 {
+		link += fmt.Sprintf("#L%d", line)
+	}
+	return link
+}
+
+// ExportDocument is a spec document together with the repository context needed to render it
+type ExportDocument struct {
+	CreatedAt        time.Time
+	Domains          []SpecDomain
+	ExecutiveSummary *string
+	Language         string
+	ModelID          string
+	Source           AnalysisSource
+	// SourceLinks controls whether behaviors link to their test source
+	SourceLinks bool
+	Version     int
+}
+
+// SourceURL returns the link to a behavior's test source, or "" when source links are disabled or unknown
+func (d *ExportDocument) SourceURL(behavior SpecBehavior) string {
+	if !d.SourceLinks || behavior.SourceInfo == nil || behavior.SourceInfo.FilePath == "" {
+		return ""
+	}
+	return d.Source.FileURL(behavior.SourceInfo.FilePath, behavior.SourceInfo.LineNumber)
+}
+
+// Filename returns the suggested download filename for the document, e.g. "octocat-hello-spec-english-v2.md"
+func (d *ExportDocument) Filename(extension string) string {
+	return fmt.Sprintf("%s-%s-spec-%s-v%d.%s",
+		d.Source.Owner, d.Source.Name, strings.ToLower(d.Language), d.Version, extension)
+}
@@ -9,6 +9,7 @@ const (
 	StatusRunning   GenerationStatus = "running"
 	StatusCompleted GenerationStatus = "completed"
 	StatusFailed    GenerationStatus = "failed"
+	StatusCancelled GenerationStatus = "cancelled"
 	StatusNotFound  GenerationStatus = "not_found"
 )
 
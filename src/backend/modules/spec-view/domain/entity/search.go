@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+	// MaxSearchQueryLength bounds the query in characters
+	MaxSearchQueryLength = 200
+)
+
+// Highlight markers wrap matched terms in highlights returned by the search
+// adapter. Control characters are used so markers cannot be confused with
+// HTML or Markdown in spec text.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// SearchResultKind is the spec hierarchy level a search result matched at
+type SearchResultKind string
+
+const (
+	SearchResultBehavior SearchResultKind = "behavior"
+	SearchResultDomain   SearchResultKind = "domain"
+	SearchResultFeature  SearchResultKind = "feature"
+)
+
+// SearchResult is a domain, feature or behavior matching a search query,
+// located within the latest spec document of its repository and language.
+type SearchResult struct {
+	AnalysisID string
+	DocumentID string
+	DomainID   string
+	DomainName string
+	// FeatureID and FeatureName are empty for domain results
+	FeatureID   string
+	FeatureName string
+	Highlights  []HighlightSegment
+	// ID identifies the matched domain, feature or behavior
+	ID       string
+	Kind     SearchResultKind
+	Language string
+	Owner    string
+	Rank     float64
+	Repo     string
+	Version  int
+}
+
+// HighlightSegment is a piece of a search highlight; Matched segments contain
+// query terms.
+type HighlightSegment struct {
+	Matched bool
+	Text    string
+}
+
+// ParseHighlight splits text marked with HighlightStart and HighlightStop into
+// segments. Unbalanced markers are tolerated.
+func ParseHighlight(marked string) []HighlightSegment {
+	var segments []HighlightSegment
+	add := func(text string, matched bool) {
+		if text != "" {
+			segments = append(segments, HighlightSegment{Matched: matched, Text: text})
+		}
+	}
+
+	for marked != "" {
+		start := strings.Index(marked, HighlightStart)
+		if start < 0 {
+			break
+		}
+		add(strings.ReplaceAll(marked[:start], HighlightStop, ""), false)
+		marked = marked[start+len(HighlightStart):]
+
+		stop := strings.Index(marked, HighlightStop)
+		if stop < 0 {
+			stop = len(marked)
+		}
+		add(strings.ReplaceAll(marked[:stop], HighlightStart, ""), true)
+		marked = strings.TrimPrefix(marked[stop:], HighlightStop)
+	}
+	add(strings.ReplaceAll(marked, HighlightStop, ""), false)
+	return segments
+}
+
+// SearchCursor is the pagination position of a search. Results are ordered by
+// relevance, so the cursor carries an offset bound to the query it was issued for.
+type SearchCursor struct {
+	Offset int
+	Query  string
+}
+
+type searchCursorPayload struct {
+	Offset int    `json:"o"`
+	Query  string `json:"q"`
+}
+
+func EncodeSearchCursor(c SearchCursor) string {
+	b, _ := json.Marshal(searchCursorPayload{Offset: c.Offset, Query: c.Query})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeSearchCursor returns ok=false for malformed cursors and cursors
+// issued for a different query.
+func DecodeSearchCursor(encoded, query string) (SearchCursor, bool) {
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return SearchCursor{}, false
+	}
+
+	var payload searchCursorPayload
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return SearchCursor{}, false
+	}
+	if payload.Offset < 0 || payload.Query != query {
+		return SearchCursor{}, false
+	}
+	return SearchCursor{Offset: payload.Offset, Query: payload.Query}, true
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseHighlight(t *testing.T) {
+	tests := []struct {
+		name   string
+		marked string
+		want   []HighlightSegment
+	}{
+		{"empty", "", nil},
+		{"no match", "plain text", []HighlightSegment{{Text: "plain text"}}},
+		{
+			"matches in text",
+			"issues a \x02refund\x03 for <b>\x02refunded\x03</b> orders",
+			[]HighlightSegment{
+				{Text: "issues a "},
+				{Matched: true, Text: "refund"},
+				{Text: " for <b>"},
+				{Matched: true, Text: "refunded"},
+				{Text: "</b> orders"},
+			},
+		},
+		{
+			"match at start and end",
+			"\x02Refund\x03 flow \x02refund\x03",
+			[]HighlightSegment{
+				{Matched: true, Text: "Refund"},
+				{Text: " flow "},
+				{Matched: true, Text: "refund"},
+			},
+		},
+		{
+			"unbalanced markers",
+			"stray\x03 stop and \x02open",
+			[]HighlightSegment{
+				{Text: "stray stop and "},
+				{Matched: true, Text: "open"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseHighlight(tt.marked); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseHighlight(%q) = %+v, want %+v", tt.marked, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchCursor(t *testing.T) {
+	encoded := EncodeSearchCursor(SearchCursor{Offset: 40, Query: "refund"})
+
+	got, ok := DecodeSearchCursor(encoded, "refund")
+	if !ok || got.Offset != 40 {
+		t.Errorf("DecodeSearchCursor() = %+v, %v, want offset 40", got, ok)
+	}
+
+	if _, ok := DecodeSearchCursor(encoded, "payment"); ok {
+		t.Error("DecodeSearchCursor() accepted a cursor issued for another query")
+	}
+	if _, ok := DecodeSearchCursor("%%%", "refund"); ok {
+		t.Error("DecodeSearchCursor() accepted a malformed cursor")
+	}
+	negative := EncodeSearchCursor(SearchCursor{Offset: -1, Query: "refund"})
+	if _, ok := DecodeSearchCursor(negative, "refund"); ok {
+		t.Error("DecodeSearchCursor() accepted a negative offset")
+	}
+}
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	MaxShareLinkExpiry = 365 * 24 * time.Hour
+	// ShareTokenPrefix marks share link tokens so they are recognizable in
+	// URLs, logs and secret scanners.
+	ShareTokenPrefix = "svs_"
+	// shareTokenBytes is the amount of randomness in a share token
+	shareTokenBytes = 32
+	// shareTokenPrefixLength is how much of the raw token is stored in clear
+	// text so owners can tell their links apart.
+	shareTokenPrefixLength = 12
+)
+
+// ShareLink grants read access to a spec document to anyone holding its token
+type ShareLink struct {
+	AccessCount int64
+	CreatedAt   time.Time
+	// DocumentID is the shared version; nil for links that follow the
+	// latest document of the repository and language.
+	DocumentID      *string
+	DocumentVersion *int
+	// ExpiresAt is nil for links that never expire
+	ExpiresAt      *time.Time
+	ID             string
+	LastAccessedAt *time.Time
+	Owner          string
+	Repo           string
+	RevokedAt      *time.Time
+	// Scope.UserID is the user who created the link
+	Scope       SpecEditScope
+	TokenPrefix string
+	// WorkspaceID is empty for links to the creator's own documents
+	WorkspaceID string
+}
+
+// IsLatest reports whether the link follows the newest document instead of a fixed version
+func (l *ShareLink) IsLatest() bool {
+	return l.DocumentID == nil
+}
+
+// IsValidAt reports whether the link can be used at the given time
+func (l *ShareLink) IsValidAt(now time.Time) bool {
+	if l.RevokedAt != nil {
+		return false
+	}
+	return l.ExpiresAt == nil || now.Before(*l.ExpiresAt)
+}
+
+// ShareToken is a newly generated share link secret
+type ShareToken struct {
+	Hash   string
+	Prefix string
+	// Token is the raw secret. It is only available at creation time.
+	Token string
+}
+
+// NewShareToken generates a random, unguessable share link token
+func NewShareToken() (*ShareToken, error) {
+	secret := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("generate share token: %w", err)
+	}
+
+	token := ShareTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return &ShareToken{
+		Hash:   HashShareToken(token),
+		Prefix: token[:shareTokenPrefixLength],
+		Token:  token,
+	}, nil
+}
+
+// HashShareToken returns the hash a share token is stored and looked up by
+func HashShareToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// IsShareTokenFormat reports whether token looks like a share link token
+func IsShareTokenFormat(token string) bool {
+	return strings.HasPrefix(token, ShareTokenPrefix) && len(token) > shareTokenPrefixLength
+}
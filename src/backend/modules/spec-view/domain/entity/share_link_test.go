@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestShareLink_IsValidAt(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Minute)
+	after := now.Add(time.Minute)
+
+	tests := []struct {
+		name string
+		link ShareLink
+		want bool
+	}{
+		{name: "no expiry", link: ShareLink{}, want: true},
+		{name: "expires later", link: ShareLink{ExpiresAt: &after}, want: true},
+		{name: "expired", link: ShareLink{ExpiresAt: &before}, want: false},
+		{name: "expires now", link: ShareLink{ExpiresAt: &now}, want: false},
+		{name: "revoked", link: ShareLink{ExpiresAt: &after, RevokedAt: &before}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.link.IsValidAt(now); got != tt.want {
+				t.Errorf("IsValidAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewShareToken(t *testing.T) {
+	first, err := NewShareToken()
+	if err != nil {
+		t.Fatalf("NewShareToken() error = %v", err)
+	}
+	second, err := NewShareToken()
+	if err != nil {
+		t.Fatalf("NewShareToken() error = %v", err)
+	}
+
+	if first.Token == second.Token {
+		t.Error("NewShareToken() returned the same token twice")
+	}
+	if !IsShareTokenFormat(first.Token) {
+		t.Errorf("IsShareTokenFormat(%q) = false", first.Token)
+	}
+	if !strings.HasPrefix(first.Token, first.Prefix) || len(first.Prefix) != shareTokenPrefixLength {
+		t.Errorf("Prefix = %q, want the first %d characters of %q", first.Prefix, shareTokenPrefixLength, first.Token)
+	}
+	if first.Hash != HashShareToken(first.Token) || first.Hash == first.Token {
+		t.Errorf("Hash = %q, want the token hash", first.Hash)
+	}
+}
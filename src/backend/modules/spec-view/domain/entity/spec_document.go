@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"regexp"
 	"time"
 
@@ -100,18 +101,22 @@ type SpecDomain struct {
 type SpecFeature struct {
 	Behaviors   []SpecBehavior
 	Description *string
-	ID          string
-	Name        string
-	SortOrder   int
+	// Edited is set when the user renamed the feature or merged another into it
+	Edited    bool
+	ID        string
+	Name      string
+	SortOrder int
 }
 
 type SpecBehavior struct {
 	ConvertedDescription string
-	ID                   string
-	OriginalName         string
-	SortOrder            int
-	SourceInfo           *BehaviorSourceInfo
-	SourceTestCaseID     *string
+	// Edited is set when ConvertedDescription was written by the user
+	Edited           bool
+	ID               string
+	OriginalName     string
+	SortOrder        int
+	SourceInfo       *BehaviorSourceInfo
+	SourceTestCaseID *string
 }
 
 type BehaviorSourceInfo struct {
@@ -136,6 +141,25 @@ type RepoSpecDocument struct {
 	Version            int
 }
 
+// RepoSpecDocumentRevision summarizes everything a RepoSpecDocument is built
+// from, so a cached copy can be revalidated without loading the document.
+type RepoSpecDocumentRevision struct {
+	DocumentCount   int
+	DocumentID      string
+	EditCount       int
+	LatestCreatedAt time.Time
+	LatestEditAt    time.Time
+	UpdatedAt       time.Time
+	Version         int
+}
+
+// Validator changes whenever the document, its edits or the repository's
+// available languages change.
+func (r *RepoSpecDocumentRevision) Validator() string {
+	return fmt.Sprintf("%s:%d:%d:%d:%d:%d:%d", r.DocumentID, r.Version, r.UpdatedAt.UnixNano(),
+		r.DocumentCount, r.LatestCreatedAt.UnixNano(), r.EditCount, r.LatestEditAt.UnixNano())
+}
+
 // RepoVersionInfo extends VersionInfo with commit SHA for repository-based queries
 type RepoVersionInfo struct {
 	AnalysisID string
@@ -0,0 +1,29 @@
+package entity
+
+// WorkspaceAccess describes what a workspace member may do with the
+// workspace's spec documents.
+type WorkspaceAccess struct {
+	// BillingUserID is the workspace owner. Generations requested for the
+	// workspace are charged to the owner's quota, not the requesting member's.
+	BillingUserID string
+	// CanGenerate is true for editors and owners. Besides generating, they may
+	// edit, comment on, share and cancel the workspace's documents; viewers only read.
+	CanGenerate bool
+}
+
+// DocumentAccess identifies the spec documents a request reaches: the user's
+// own, or every document generated for a workspace the user belongs to.
+type DocumentAccess struct {
+	UserID string
+	// WorkspaceID is empty for the user's own documents. It is only set once
+	// the user's membership has been checked.
+	WorkspaceID string
+}
+
+// WorkspaceGeneration identifies the workspace a spec generation is requested for.
+type WorkspaceGeneration struct {
+	// BillingUserID is recorded on the job so usage is charged to whoever owned
+	// the workspace when the generation was requested, even if ownership changes later.
+	BillingUserID string
+	WorkspaceID   string
+}
@@ -3,18 +3,35 @@ package domain
 import "errors"
 
 var (
-	ErrAlreadyExists        = errors.New("spec document already exists")
-	ErrAnalysisNotCompleted = errors.New("analysis not completed")
-	ErrAnalysisNotFound     = errors.New("analysis not found")
-	ErrCodebaseNotFound     = errors.New("codebase not found")
-	ErrDocumentNotFound     = errors.New("spec document not found")
-	ErrForbidden            = errors.New("access denied to this resource")
-	ErrGenerationPending    = errors.New("generation already pending")
-	ErrGenerationRunning    = errors.New("generation already running")
-	ErrInvalidAnalysisID    = errors.New("invalid analysis ID")
-	ErrInvalidDocumentID    = errors.New("invalid document ID format")
-	ErrInvalidLanguage      = errors.New("invalid language")
-	ErrInvalidRepository    = errors.New("invalid repository (owner or name empty)")
-	ErrQuotaExceeded        = errors.New("quota exceeded")
-	ErrUnauthorized         = errors.New("authentication required")
+	ErrAlreadyExists          = errors.New("spec document already exists")
+	ErrAnalysisNotCompleted   = errors.New("analysis not completed")
+	ErrAnalysisNotFound       = errors.New("analysis not found")
+	ErrBehaviorNotFound       = errors.New("spec behavior not found")
+	ErrCodebaseNotFound       = errors.New("codebase not found")
+	ErrCommentNotFound        = errors.New("spec comment not found")
+	ErrCommentTargetNotFound  = errors.New("comment target not found in spec document")
+	ErrCommentThreadNotFound  = errors.New("spec comment thread not found")
+	ErrDocumentNotFound       = errors.New("spec document not found")
+	ErrEditNotFound           = errors.New("spec edit not found")
+	ErrFeatureNotFound        = errors.New("spec feature not found")
+	ErrForbidden              = errors.New("access denied to this resource")
+	ErrGenerationFinished     = errors.New("generation already finished")
+	ErrGenerationPending      = errors.New("generation already pending")
+	ErrGenerationRunning      = errors.New("generation already running")
+	ErrInvalidAnalysisID      = errors.New("invalid analysis ID")
+	ErrInvalidComment         = errors.New("invalid spec comment")
+	ErrInvalidCursor          = errors.New("invalid cursor")
+	ErrInvalidDocumentID      = errors.New("invalid document ID format")
+	ErrInvalidExportFormat    = errors.New("unsupported export format")
+	ErrInvalidLanguage        = errors.New("invalid language")
+	ErrInvalidRepository      = errors.New("invalid repository (owner or name empty)")
+	ErrInvalidSearchQuery     = errors.New("invalid search query")
+	ErrInvalidShareLinkExpiry = errors.New("invalid share link expiry")
+	ErrInvalidSpecEdit        = errors.New("invalid spec edit")
+	ErrInvalidVersion         = errors.New("invalid version")
+	ErrLanguageMismatch       = errors.New("spec documents are in different languages")
+	ErrNoActiveGeneration     = errors.New("no pending or running generation")
+	ErrQuotaExceeded          = errors.New("quota exceeded")
+	ErrShareLinkNotFound      = errors.New("spec share link not found")
+	ErrUnauthorized           = errors.New("authentication required")
 )
@@ -0,0 +1,31 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// SpecCommentRepository stores comment threads on spec documents.
+type SpecCommentRepository interface {
+	// AddComment returns domain.ErrCommentThreadNotFound unless the thread is on
+	// the spec documents the access reaches.
+	AddComment(ctx context.Context, access entity.DocumentAccess, threadID, body string, mentions []string) (*entity.Comment, error)
+	// CreateCommentThread opens a thread on the document with its first comment,
+	// written by the user of the document's scope.
+	CreateCommentThread(ctx context.Context, document *entity.CommentDocument, anchor entity.CommentAnchor, body string, mentions []string) (*entity.CommentThread, error)
+	// DeleteComment deletes the thread along with its last comment. Returns false
+	// if the user has no such comment on the documents the access reaches.
+	DeleteComment(ctx context.Context, access entity.DocumentAccess, commentID string) (bool, error)
+	// GetCommentDocument returns domain.ErrDocumentNotFound unless the access
+	// reaches the document.
+	GetCommentDocument(ctx context.Context, access entity.DocumentAccess, documentID string) (*entity.CommentDocument, error)
+	// GetCommentThreads returns the threads of the document's scope opened no
+	// later than the document and unresolved when it was generated, with comments.
+	GetCommentThreads(ctx context.Context, document *entity.CommentDocument) ([]entity.CommentThread, error)
+	// SetCommentThreadResolved returns false if the thread is not on the spec documents the access reaches.
+	SetCommentThreadResolved(ctx context.Context, access entity.DocumentAccess, threadID string, resolved bool) (bool, error)
+	// UpdateComment returns domain.ErrCommentNotFound unless the user wrote the
+	// comment on the spec documents the access reaches.
+	UpdateComment(ctx context.Context, access entity.DocumentAccess, commentID, body string, mentions []string) (*entity.Comment, error)
+}
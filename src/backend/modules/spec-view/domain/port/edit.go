@@ -0,0 +1,27 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// SpecEditRepository stores user edits layered over generated spec documents.
+type SpecEditRepository interface {
+	// DeleteBehaviorEdit returns false if no edit existed for the target.
+	DeleteBehaviorEdit(ctx context.Context, target entity.BehaviorEditTarget) (bool, error)
+	// DeleteFeatureEdit returns false if no edit existed for the target.
+	DeleteFeatureEdit(ctx context.Context, target entity.FeatureEditTarget) (bool, error)
+	// GetBehaviorEditTarget returns domain.ErrBehaviorNotFound unless the behavior
+	// belongs to a spec document the access reaches. Edits to a workspace's
+	// documents are shared by its members.
+	GetBehaviorEditTarget(ctx context.Context, access entity.DocumentAccess, behaviorID string) (*entity.BehaviorEditTarget, error)
+	// GetFeatureEditTarget returns domain.ErrFeatureNotFound unless the feature
+	// belongs to a spec document the access reaches. Edits to a workspace's
+	// documents are shared by its members.
+	GetFeatureEditTarget(ctx context.Context, access entity.DocumentAccess, featureID string) (*entity.FeatureEditTarget, error)
+	// SaveBehaviorEdit creates or replaces the edit for the behavior's test.
+	SaveBehaviorEdit(ctx context.Context, scope entity.SpecEditScope, edit entity.BehaviorEdit) (*entity.BehaviorEdit, error)
+	// SaveFeatureEdit creates or replaces the edit for the feature.
+	SaveFeatureEdit(ctx context.Context, scope entity.SpecEditScope, edit entity.FeatureEdit) (*entity.FeatureEdit, error)
+}
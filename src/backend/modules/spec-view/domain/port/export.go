@@ -0,0 +1,22 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// AnalysisSourceReader resolves the repository revision behind an analysis.
+type AnalysisSourceReader interface {
+	// GetAnalysisSource returns domain.ErrAnalysisNotFound if the analysis does not exist.
+	GetAnalysisSource(ctx context.Context, analysisID string) (*entity.AnalysisSource, error)
+}
+
+// SpecRenderer renders spec documents in a single export format.
+type SpecRenderer interface {
+	// ContentType is the MIME type of the rendered output.
+	ContentType() string
+	// FileExtension is the extension used for download filenames, without the dot.
+	FileExtension() string
+	Render(doc *entity.ExportDocument) ([]byte, error)
+}
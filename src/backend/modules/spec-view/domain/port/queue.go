@@ -9,9 +9,17 @@ import (
 	subscription "github.com/specvital/web/src/backend/modules/subscription/domain/entity"
 )
 
+// QueueService enqueues and cancels spec generation jobs. workspace is nil for
+// personal generations; otherwise the generated document belongs to that workspace.
 type QueueService interface {
-	EnqueueSpecGeneration(ctx context.Context, analysisID string, language string, userID *string, tier subscription.PlanTier, mode entity.GenerationMode) error
+	// CancelSpecGenerationTx cancels a spec generation job within a transaction.
+	// A queued job is cancelled immediately. A running job is marked for cancellation
+	// and stops when its worker returns; it no longer blocks a new request for the
+	// same analysis and language. A job that already completed or failed returns
+	// domain.ErrGenerationFinished.
+	CancelSpecGenerationTx(ctx context.Context, tx pgx.Tx, jobID int64) error
+	EnqueueSpecGeneration(ctx context.Context, analysisID string, language string, userID *string, workspace *entity.WorkspaceGeneration, tier subscription.PlanTier, mode entity.GenerationMode) error
 	// EnqueueSpecGenerationTx enqueues a spec generation job within a transaction.
 	// Returns the job ID for quota reservation tracking.
-	EnqueueSpecGenerationTx(ctx context.Context, tx pgx.Tx, analysisID string, language string, userID *string, tier subscription.PlanTier, mode entity.GenerationMode) (int64, error)
+	EnqueueSpecGenerationTx(ctx context.Context, tx pgx.Tx, analysisID string, language string, userID *string, workspace *entity.WorkspaceGeneration, tier subscription.PlanTier, mode entity.GenerationMode) (int64, error)
 }
@@ -24,6 +24,9 @@ type SpecViewRepository interface {
 	GetAvailableLanguages(ctx context.Context, analysisID string) ([]entity.AvailableLanguageInfo, error)
 	// GetAvailableLanguagesByUser returns available languages for documents owned by the user for the given analysis.
 	GetAvailableLanguagesByUser(ctx context.Context, userID string, analysisID string) ([]entity.AvailableLanguageInfo, error)
+	// GetActiveGenerationJobID returns the pending or running generation job for a user, analysis,
+	// and language, or 0 if there is none.
+	GetActiveGenerationJobID(ctx context.Context, userID string, analysisID string, language string) (int64, error)
 	// GetGenerationStatus returns the latest generation status for a user and analysis (any language).
 	GetGenerationStatus(ctx context.Context, userID string, analysisID string) (*entity.SpecGenerationStatus, error)
 	// GetGenerationStatusByLanguage returns status for a specific user, analysis, and language combination.
@@ -45,6 +48,9 @@ type SpecViewRepository interface {
 	GetVersionsByLanguage(ctx context.Context, analysisID string, language string) ([]entity.VersionInfo, error)
 	// GetVersionsByUser returns all versions for documents owned by the user for the given analysis and language.
 	GetVersionsByUser(ctx context.Context, userID string, analysisID string, language string) ([]entity.VersionInfo, error)
+	// GetSpecEdits returns the edits for the repository of the analysis in the given language:
+	// the workspace's shared edits if the access is through a workspace, otherwise the user's own.
+	GetSpecEdits(ctx context.Context, access entity.DocumentAccess, analysisID, language string) (*entity.SpecEdits, error)
 
 	// Repository-based queries (cross-analysis access)
 
@@ -57,6 +63,8 @@ type SpecViewRepository interface {
 	GetSpecDocumentByRepositoryAndVersion(ctx context.Context, userID, owner, name, language string, version int) (*entity.RepoSpecDocument, error)
 	// GetSpecDocumentByRepositoryAndDocumentId retrieves a specific spec document by its ID for a repository.
 	GetSpecDocumentByRepositoryAndDocumentId(ctx context.Context, userID, owner, name, documentID string) (*entity.RepoSpecDocument, error)
+	// GetSpecDocumentRevisionByRepository returns nil if the user has no such document for the repository.
+	GetSpecDocumentRevisionByRepository(ctx context.Context, userID, owner, name, documentID string) (*entity.RepoSpecDocumentRevision, error)
 	// GetVersionHistoryByRepository returns all spec versions for a repository across all analyses.
 	// Each version includes the commit SHA at generation time.
 	GetVersionHistoryByRepository(ctx context.Context, userID, owner, name, language string) ([]entity.RepoVersionInfo, error)
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+type SearchParams struct {
+	// Access selects the user's own documents or the workspace's
+	Access entity.DocumentAccess
+	// Language, Owner and Repo are optional filters
+	Language string
+	Limit    int
+	Offset   int
+	Owner    string
+	Query    string
+	Repo     string
+}
+
+// SpecSearcher runs full-text searches over the spec documents a user owns
+// or a workspace shares.
+type SpecSearcher interface {
+	// SearchSpecDocuments returns results ordered by relevance, most relevant first.
+	SearchSpecDocuments(ctx context.Context, params SearchParams) ([]entity.SearchResult, error)
+}
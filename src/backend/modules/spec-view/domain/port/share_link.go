@@ -0,0 +1,41 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+type CreateShareLinkParams struct {
+	// Access selects the user's own documents or the workspace's
+	Access     entity.DocumentAccess
+	DocumentID string
+	// ExpiresAt is nil for links that never expire
+	ExpiresAt *time.Time
+	// Latest makes the link follow the newest document of the repository and
+	// language instead of the given document.
+	Latest bool
+	Token  *entity.ShareToken
+}
+
+// SpecShareLinkRepository stores share links that grant read access to spec
+// documents without signing in.
+type SpecShareLinkRepository interface {
+	// CreateShareLink returns domain.ErrDocumentNotFound unless the access
+	// reaches the document.
+	CreateShareLink(ctx context.Context, params CreateShareLinkParams) (*entity.ShareLink, error)
+	// GetShareLinkByToken returns domain.ErrShareLinkNotFound if no link has the
+	// token, regardless of whether the link is still valid.
+	GetShareLinkByToken(ctx context.Context, token string) (*entity.ShareLink, error)
+	// GetSharedDocument returns the document the link points to, or nil if the
+	// owner or workspace has no document left for it.
+	GetSharedDocument(ctx context.Context, link *entity.ShareLink) (*entity.RepoSpecDocument, error)
+	// ListShareLinks returns the links of the documents the access reaches that
+	// were not revoked, newest first.
+	ListShareLinks(ctx context.Context, access entity.DocumentAccess) ([]entity.ShareLink, error)
+	// RecordShareLinkAccess logs a read through the link.
+	RecordShareLinkAccess(ctx context.Context, linkID, clientIP string) error
+	// RevokeShareLink returns false if the access reaches no such active link.
+	RevokeShareLink(ctx context.Context, access entity.DocumentAccess, linkID string) (bool, error)
+}
@@ -0,0 +1,34 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// WorkspaceAccess resolves a user's membership in a workspace.
+type WorkspaceAccess interface {
+	// GetWorkspaceAccess returns domain.ErrForbidden if the user is not a
+	// member of the workspace.
+	GetWorkspaceAccess(ctx context.Context, workspaceID, userID string) (*entity.WorkspaceAccess, error)
+}
+
+// WorkspaceSpecRepository reads spec documents generated for a workspace.
+// Any member of the workspace may read them, whoever requested the generation.
+type WorkspaceSpecRepository interface {
+	// GetActiveGenerationJobIDByWorkspace returns the pending or running generation job for the workspace,
+	// analysis, and language, whichever member requested it, or 0 if there is none.
+	GetActiveGenerationJobIDByWorkspace(ctx context.Context, workspaceID, analysisID, language string) (int64, error)
+	// GetAvailableLanguagesByWorkspace returns the languages the workspace has documents in for the analysis.
+	GetAvailableLanguagesByWorkspace(ctx context.Context, workspaceID, analysisID string) ([]entity.AvailableLanguageInfo, error)
+	// GetGenerationStatusByWorkspace returns the latest generation status for the workspace and analysis.
+	// If language is empty, jobs in any language match.
+	GetGenerationStatusByWorkspace(ctx context.Context, workspaceID, analysisID, language string) (*entity.SpecGenerationStatus, error)
+	// GetSpecDocumentByWorkspace returns the workspace's most recent document for the analysis, or nil if none.
+	// If language is empty, returns the most recent document regardless of language.
+	GetSpecDocumentByWorkspace(ctx context.Context, workspaceID, analysisID, language string) (*entity.SpecDocument, error)
+	// GetSpecDocumentByWorkspaceAndVersion returns a specific version of the workspace's document, or nil if none.
+	GetSpecDocumentByWorkspaceAndVersion(ctx context.Context, workspaceID, analysisID, language string, version int) (*entity.SpecDocument, error)
+	// GetVersionsByWorkspace returns the versions of the workspace's documents for the analysis and language, newest first.
+	GetVersionsByWorkspace(ctx context.Context, workspaceID, analysisID, language string) ([]entity.VersionInfo, error)
+}
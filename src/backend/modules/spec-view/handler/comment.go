@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/usecase"
+)
+
+func (h *Handler) GetSpecComments(ctx context.Context, request api.GetSpecCommentsRequestObject) (api.GetSpecCommentsResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	threads, err := h.getSpecComments.Execute(ctx, usecase.GetSpecCommentsInput{
+		DocumentID:  request.DocumentID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.GetSpecComments401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.GetSpecComments403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidDocumentID):
+			return api.GetSpecComments400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid document ID"),
+			}, nil
+		case errors.Is(err, domain.ErrDocumentNotFound):
+			return api.GetSpecComments404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("spec document not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to get spec comments", "error", err)
+		return api.GetSpecComments500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get spec comments"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecCommentThreadsResponse(threads)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map spec comments response", "error", err)
+		return api.GetSpecComments500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.GetSpecComments200JSONResponse(resp), nil
+}
+
+func (h *Handler) CreateSpecCommentThread(ctx context.Context, request api.CreateSpecCommentThreadRequestObject) (api.CreateSpecCommentThreadResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	if request.Body == nil {
+		return api.CreateSpecCommentThread400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	thread, err := h.createCommentThread.Execute(ctx, usecase.CreateCommentThreadInput{
+		Body:        request.Body.Body,
+		DocumentID:  request.DocumentID.String(),
+		TargetID:    request.Body.TargetID.String(),
+		TargetType:  entity.CommentTargetType(request.Body.TargetType),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.CreateSpecCommentThread401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.CreateSpecCommentThread403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidDocumentID):
+			return api.CreateSpecCommentThread400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid document ID"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidComment):
+			return api.CreateSpecCommentThread400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("provide a comment of 1-10000 characters on a domain, feature or behavior"),
+			}, nil
+		case errors.Is(err, domain.ErrDocumentNotFound):
+			return api.CreateSpecCommentThread404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("spec document not found"),
+			}, nil
+		case errors.Is(err, domain.ErrCommentTargetNotFound):
+			return api.CreateSpecCommentThread404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("comment target not found in spec document"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to create spec comment thread", "error", err)
+		return api.CreateSpecCommentThread500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to create comment thread"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecCommentThreadResponse(thread)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map comment thread response", "error", err)
+		return api.CreateSpecCommentThread500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.CreateSpecCommentThread201JSONResponse(resp), nil
+}
+
+func (h *Handler) AddSpecComment(ctx context.Context, request api.AddSpecCommentRequestObject) (api.AddSpecCommentResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	if request.Body == nil {
+		return api.AddSpecComment400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	comment, err := h.addComment.Execute(ctx, usecase.AddCommentInput{
+		Body:        request.Body.Body,
+		ThreadID:    request.ThreadID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.AddSpecComment401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.AddSpecComment403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidComment):
+			return api.AddSpecComment400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("provide a comment of 1-10000 characters"),
+			}, nil
+		case errors.Is(err, domain.ErrCommentThreadNotFound):
+			return api.AddSpecComment404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("comment thread not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to add spec comment", "error", err)
+		return api.AddSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to add comment"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecCommentResponse(comment)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map comment response", "error", err)
+		return api.AddSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.AddSpecComment201JSONResponse(resp), nil
+}
+
+func (h *Handler) UpdateSpecComment(ctx context.Context, request api.UpdateSpecCommentRequestObject) (api.UpdateSpecCommentResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	if request.Body == nil {
+		return api.UpdateSpecComment400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	comment, err := h.updateComment.Execute(ctx, usecase.UpdateCommentInput{
+		Body:        request.Body.Body,
+		CommentID:   request.CommentID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.UpdateSpecComment401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.UpdateSpecComment403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidComment):
+			return api.UpdateSpecComment400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("provide a comment of 1-10000 characters"),
+			}, nil
+		case errors.Is(err, domain.ErrCommentNotFound):
+			return api.UpdateSpecComment404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("comment not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to update spec comment", "error", err)
+		return api.UpdateSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to update comment"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecCommentResponse(comment)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map comment response", "error", err)
+		return api.UpdateSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.UpdateSpecComment200JSONResponse(resp), nil
+}
+
+func (h *Handler) DeleteSpecComment(ctx context.Context, request api.DeleteSpecCommentRequestObject) (api.DeleteSpecCommentResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	err := h.deleteComment.Execute(ctx, usecase.DeleteCommentInput{
+		CommentID:   request.CommentID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.DeleteSpecComment401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.DeleteSpecComment403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrCommentNotFound):
+			return api.DeleteSpecComment404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("comment not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to delete spec comment", "error", err)
+		return api.DeleteSpecComment500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to delete comment"),
+		}, nil
+	}
+
+	return api.DeleteSpecComment204Response{}, nil
+}
+
+func (h *Handler) ResolveSpecCommentThread(ctx context.Context, request api.ResolveSpecCommentThreadRequestObject) (api.ResolveSpecCommentThreadResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	err := h.resolveCommentThread.Execute(ctx, usecase.ResolveCommentThreadInput{
+		Resolved:    true,
+		ThreadID:    request.ThreadID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.ResolveSpecCommentThread401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.ResolveSpecCommentThread403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrCommentThreadNotFound):
+			return api.ResolveSpecCommentThread404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("comment thread not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to resolve spec comment thread", "error", err)
+		return api.ResolveSpecCommentThread500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to resolve comment thread"),
+		}, nil
+	}
+
+	return api.ResolveSpecCommentThread204Response{}, nil
+}
+
+func (h *Handler) ReopenSpecCommentThread(ctx context.Context, request api.ReopenSpecCommentThreadRequestObject) (api.ReopenSpecCommentThreadResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	err := h.resolveCommentThread.Execute(ctx, usecase.ResolveCommentThreadInput{
+		Resolved:    false,
+		ThreadID:    request.ThreadID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.ReopenSpecCommentThread401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.ReopenSpecCommentThread403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrCommentThreadNotFound):
+			return api.ReopenSpecCommentThread404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("comment thread not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to reopen spec comment thread", "error", err)
+		return api.ReopenSpecCommentThread500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to reopen comment thread"),
+		}, nil
+	}
+
+	return api.ReopenSpecCommentThread204Response{}, nil
+}
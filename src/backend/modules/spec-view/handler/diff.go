@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/usecase"
+)
+
+func (h *Handler) GetSpecDiff(ctx context.Context, request api.GetSpecDiffRequestObject) (api.GetSpecDiffResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	result, err := h.getSpecDiff.Execute(ctx, usecase.GetSpecDiffInput{
+		AnalysisID:  request.AnalysisID.String(),
+		FromVersion: request.Params.From,
+		Language:    string(request.Params.Language),
+		ToVersion:   request.Params.To,
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.GetSpecDiff401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.GetSpecDiff403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrDocumentNotFound):
+			return api.GetSpecDiff404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("spec document version not found"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidAnalysisID):
+			return api.GetSpecDiff404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("invalid analysis ID"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidLanguage):
+			return api.GetSpecDiff400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid language"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidVersion):
+			return api.GetSpecDiff400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid version"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to diff spec versions", "error", err)
+		return api.GetSpecDiff500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to diff spec versions"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecDiffResponse(result.From, result.To, result.Diff)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map spec diff response", "error", err)
+		return api.GetSpecDiff500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.GetSpecDiff200JSONResponse(resp), nil
+}
+
+func (h *Handler) GetSpecDiffByRepository(ctx context.Context, request api.GetSpecDiffByRepositoryRequestObject) (api.GetSpecDiffByRepositoryResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	result, err := h.getSpecDiffByRepository.Execute(ctx, usecase.GetSpecDiffByRepositoryInput{
+		FromDocumentID: request.Params.From.String(),
+		Name:           request.Repo,
+		Owner:          request.Owner,
+		ToDocumentID:   request.Params.To.String(),
+		UserID:         userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.GetSpecDiffByRepository401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.GetSpecDiffByRepository403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrDocumentNotFound), errors.Is(err, domain.ErrCodebaseNotFound):
+			return api.GetSpecDiffByRepository404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("spec document not found"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidRepository):
+			return api.GetSpecDiffByRepository400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid repository"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidDocumentID):
+			return api.GetSpecDiffByRepository400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid document ID"),
+			}, nil
+		case errors.Is(err, domain.ErrLanguageMismatch):
+			return api.GetSpecDiffByRepository400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("spec documents are in different languages"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to diff spec documents by repository", "error", err)
+		return api.GetSpecDiffByRepository500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to diff spec documents"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecDiffResponse(result.From, result.To, result.Diff)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map spec diff response", "error", err)
+		return api.GetSpecDiffByRepository500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.GetSpecDiffByRepository200JSONResponse(resp), nil
+}
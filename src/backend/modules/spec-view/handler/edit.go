@@ -0,0 +1,242 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/usecase"
+)
+
+func (h *Handler) GetSpecEdits(ctx context.Context, request api.GetSpecEditsRequestObject) (api.GetSpecEditsResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	edits, err := h.getSpecEdits.Execute(ctx, usecase.GetSpecEditsInput{
+		AnalysisID:  request.AnalysisID.String(),
+		Language:    string(request.Params.Language),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.GetSpecEdits401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.GetSpecEdits403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrAnalysisNotFound), errors.Is(err, domain.ErrInvalidAnalysisID):
+			return api.GetSpecEdits404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("analysis not found"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidLanguage):
+			return api.GetSpecEdits400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid language"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to get spec edits", "error", err)
+		return api.GetSpecEdits500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get spec edits"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecEditsResponse(edits)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map spec edits response", "error", err)
+		return api.GetSpecEdits500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.GetSpecEdits200JSONResponse(resp), nil
+}
+
+func (h *Handler) EditSpecBehavior(ctx context.Context, request api.EditSpecBehaviorRequestObject) (api.EditSpecBehaviorResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	if request.Body == nil {
+		return api.EditSpecBehavior400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	input := usecase.EditBehaviorInput{
+		BehaviorID:  request.BehaviorID.String(),
+		Description: request.Body.Description,
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	}
+	if request.Body.Hidden != nil {
+		input.Hidden = *request.Body.Hidden
+	}
+
+	edit, err := h.editBehavior.Execute(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.EditSpecBehavior401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.EditSpecBehavior403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrBehaviorNotFound):
+			return api.EditSpecBehavior404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("behavior not found"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidSpecEdit):
+			return api.EditSpecBehavior400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("provide a description of 1-2000 characters or hide the behavior"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to edit spec behavior", "error", err)
+		return api.EditSpecBehavior500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to edit spec behavior"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecBehaviorEditResponse(edit)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map behavior edit response", "error", err)
+		return api.EditSpecBehavior500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.EditSpecBehavior200JSONResponse(resp), nil
+}
+
+func (h *Handler) RevertSpecBehaviorEdit(ctx context.Context, request api.RevertSpecBehaviorEditRequestObject) (api.RevertSpecBehaviorEditResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	err := h.revertBehaviorEdit.Execute(ctx, usecase.RevertBehaviorEditInput{
+		BehaviorID:  request.BehaviorID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.RevertSpecBehaviorEdit401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.RevertSpecBehaviorEdit403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrBehaviorNotFound):
+			return api.RevertSpecBehaviorEdit404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("behavior not found"),
+			}, nil
+		case errors.Is(err, domain.ErrEditNotFound):
+			return api.RevertSpecBehaviorEdit404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("behavior has no edit"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to revert spec behavior edit", "error", err)
+		return api.RevertSpecBehaviorEdit500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to revert spec behavior edit"),
+		}, nil
+	}
+
+	return api.RevertSpecBehaviorEdit204Response{}, nil
+}
+
+func (h *Handler) EditSpecFeature(ctx context.Context, request api.EditSpecFeatureRequestObject) (api.EditSpecFeatureResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	if request.Body == nil {
+		return api.EditSpecFeature400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	edit, err := h.editFeature.Execute(ctx, usecase.EditFeatureInput{
+		FeatureID:   request.FeatureID.String(),
+		Name:        request.Body.Name,
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.EditSpecFeature401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.EditSpecFeature403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrFeatureNotFound):
+			return api.EditSpecFeature404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("feature not found"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidSpecEdit):
+			return api.EditSpecFeature400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("feature name must be 1-255 characters"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to edit spec feature", "error", err)
+		return api.EditSpecFeature500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to edit spec feature"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecFeatureEditResponse(edit)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map feature edit response", "error", err)
+		return api.EditSpecFeature500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.EditSpecFeature200JSONResponse(resp), nil
+}
+
+func (h *Handler) RevertSpecFeatureEdit(ctx context.Context, request api.RevertSpecFeatureEditRequestObject) (api.RevertSpecFeatureEditResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	err := h.revertFeatureEdit.Execute(ctx, usecase.RevertFeatureEditInput{
+		FeatureID:   request.FeatureID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.RevertSpecFeatureEdit401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.RevertSpecFeatureEdit403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrFeatureNotFound):
+			return api.RevertSpecFeatureEdit404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("feature not found"),
+			}, nil
+		case errors.Is(err, domain.ErrEditNotFound):
+			return api.RevertSpecFeatureEdit404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("feature has no edit"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to revert spec feature edit", "error", err)
+		return api.RevertSpecFeatureEdit500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to revert spec feature edit"),
+		}, nil
+	}
+
+	return api.RevertSpecFeatureEdit204Response{}, nil
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"mime"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/usecase"
+)
+
+func (h *Handler) ExportSpecDocument(ctx context.Context, request api.ExportSpecDocumentRequestObject) (api.ExportSpecDocumentResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	language := ""
+	if request.Params.Language != nil {
+		language = string(*request.Params.Language)
+	}
+
+	version := 0
+	if request.Params.Version != nil {
+		version = *request.Params.Version
+	}
+
+	result, err := h.exportSpecDocument.Execute(ctx, usecase.ExportSpecDocumentInput{
+		AnalysisID:  request.AnalysisID.String(),
+		Format:      exportFormat(request.Params.Format),
+		Language:    language,
+		SourceLinks: sourceLinks(request.Params.SourceLinks),
+		UserID:      userID,
+		Version:     version,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.ExportSpecDocument401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.ExportSpecDocument403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrDocumentNotFound), errors.Is(err, domain.ErrAnalysisNotFound):
+			return api.ExportSpecDocument404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("spec document not found"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidAnalysisID):
+			return api.ExportSpecDocument404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("invalid analysis ID"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidLanguage):
+			return api.ExportSpecDocument400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid language"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidExportFormat):
+			return api.ExportSpecDocument400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("unsupported export format"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to export spec document", "error", err)
+		return api.ExportSpecDocument500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to export spec document"),
+		}, nil
+	}
+
+	return exportResponse{result}, nil
+}
+
+func (h *Handler) ExportSpecDocumentByRepository(ctx context.Context, request api.ExportSpecDocumentByRepositoryRequestObject) (api.ExportSpecDocumentByRepositoryResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	language := ""
+	if request.Params.Language != nil {
+		language = string(*request.Params.Language)
+	}
+
+	version := 0
+	if request.Params.Version != nil {
+		version = *request.Params.Version
+	}
+
+	documentID := ""
+	if request.Params.DocumentID != nil {
+		documentID = request.Params.DocumentID.String()
+	}
+
+	result, err := h.exportSpecByRepository.Execute(ctx, usecase.ExportSpecByRepositoryInput{
+		DocumentID:  documentID,
+		Format:      exportFormat(request.Params.Format),
+		Language:    language,
+		Name:        request.Repo,
+		Owner:       request.Owner,
+		SourceLinks: sourceLinks(request.Params.SourceLinks),
+		UserID:      userID,
+		Version:     version,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.ExportSpecDocumentByRepository401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.ExportSpecDocumentByRepository403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrDocumentNotFound), errors.Is(err, domain.ErrCodebaseNotFound), errors.Is(err, domain.ErrAnalysisNotFound):
+			return api.ExportSpecDocumentByRepository404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("spec document not found"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidRepository):
+			return api.ExportSpecDocumentByRepository400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid repository"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidLanguage):
+			return api.ExportSpecDocumentByRepository400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid language"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidDocumentID):
+			return api.ExportSpecDocumentByRepository400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid document ID"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidExportFormat):
+			return api.ExportSpecDocumentByRepository400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("unsupported export format"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to export spec document by repository", "error", err)
+		return api.ExportSpecDocumentByRepository500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to export spec document"),
+		}, nil
+	}
+
+	return exportResponse{result}, nil
+}
+
+func exportFormat(format *api.SpecExportFormat) entity.ExportFormat {
+	if format == nil {
+		return ""
+	}
+	return entity.ExportFormat(*format)
+}
+
+// sourceLinks defaults to true, matching the OpenAPI parameter default.
+func sourceLinks(param *bool) bool {
+	return param == nil || *param
+}
+
+// exportResponse serves a rendered document with the renderer's content type.
+// The generated responses fix the Content-Type per format, which does not
+// allow adding formats without changing every endpoint.
+type exportResponse struct {
+	*usecase.ExportSpecOutput
+}
+
+func (r exportResponse) VisitExportSpecDocumentResponse(w http.ResponseWriter) error {
+	return r.write(w)
+}
+
+func (r exportResponse) VisitExportSpecDocumentByRepositoryResponse(w http.ResponseWriter) error {
+	return r.write(w)
+}
+
+func (r exportResponse) write(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", r.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": r.Filename}))
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(r.Content)
+	return err
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cockroachdb/errors"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/specvital/web/src/backend/common/logger"
 	"github.com/specvital/web/src/backend/common/middleware"
@@ -19,32 +20,77 @@ import (
 )
 
 type Handler struct {
+	addComment              *usecase.AddCommentUseCase
+	cancelGeneration        *usecase.CancelGenerationUseCase
+	createCommentThread     *usecase.CreateCommentThreadUseCase
+	createShareLink         *usecase.CreateShareLinkUseCase
+	deleteComment           *usecase.DeleteCommentUseCase
+	editBehavior            *usecase.EditBehaviorUseCase
+	editFeature             *usecase.EditFeatureUseCase
+	exportSpecByRepository  *usecase.ExportSpecByRepositoryUseCase
+	exportSpecDocument      *usecase.ExportSpecDocumentUseCase
 	getCacheAvailability    *usecase.GetCacheAvailabilityUseCase
 	getCachePrediction      *usecase.GetCachePredictionUseCase
 	getGenerationStatus     *usecase.GetGenerationStatusUseCase
+	getSharedSpec           *usecase.GetSharedSpecUseCase
 	getSpecByRepository     *usecase.GetSpecByRepositoryUseCase
+	getSpecComments         *usecase.GetSpecCommentsUseCase
+	getSpecDiff             *usecase.GetSpecDiffUseCase
+	getSpecDiffByRepository *usecase.GetSpecDiffByRepositoryUseCase
 	getSpecDocument         *usecase.GetSpecDocumentUseCase
+	getSpecEdits            *usecase.GetSpecEditsUseCase
 	getVersionHistoryByRepo *usecase.GetVersionHistoryByRepositoryUseCase
 	getVersions             *usecase.GetVersionsUseCase
+	getWorkspaceSpec        *usecase.GetWorkspaceSpecDocumentUseCase
+	listShareLinks          *usecase.ListShareLinksUseCase
 	logger                  *logger.Logger
 	requestGeneration       *usecase.RequestGenerationUseCase
+	resolveCommentThread    *usecase.ResolveCommentThreadUseCase
+	revertBehaviorEdit      *usecase.RevertBehaviorEditUseCase
+	revertFeatureEdit       *usecase.RevertFeatureEditUseCase
+	revokeShareLink         *usecase.RevokeShareLinkUseCase
+	searchSpecs             *usecase.SearchSpecsUseCase
 	tierLookup              port.TierLookup
+	updateComment           *usecase.UpdateCommentUseCase
 }
 
 var _ api.SpecViewHandlers = (*Handler)(nil)
 
 type HandlerConfig struct {
+	AddComment              *usecase.AddCommentUseCase
+	CancelGeneration        *usecase.CancelGenerationUseCase
+	CreateCommentThread     *usecase.CreateCommentThreadUseCase
+	CreateShareLink         *usecase.CreateShareLinkUseCase
+	DeleteComment           *usecase.DeleteCommentUseCase
+	EditBehavior            *usecase.EditBehaviorUseCase
+	EditFeature             *usecase.EditFeatureUseCase
+	ExportSpecByRepository  *usecase.ExportSpecByRepositoryUseCase
+	ExportSpecDocument      *usecase.ExportSpecDocumentUseCase
 	GetCacheAvailability    *usecase.GetCacheAvailabilityUseCase
 	GetCachePrediction      *usecase.GetCachePredictionUseCase
 	GetGenerationStatus     *usecase.GetGenerationStatusUseCase
+	GetSharedSpec           *usecase.GetSharedSpecUseCase
 	GetSpecByRepository     *usecase.GetSpecByRepositoryUseCase
+	GetSpecComments         *usecase.GetSpecCommentsUseCase
+	GetSpecDiff             *usecase.GetSpecDiffUseCase
+	GetSpecDiffByRepository *usecase.GetSpecDiffByRepositoryUseCase
 	GetSpecDocument         *usecase.GetSpecDocumentUseCase
+	GetSpecEdits            *usecase.GetSpecEditsUseCase
 	GetVersionHistoryByRepo *usecase.GetVersionHistoryByRepositoryUseCase
 	GetVersions             *usecase.GetVersionsUseCase
-	Logger                  *logger.Logger
-	RequestGeneration       *usecase.RequestGenerationUseCase
+	// GetWorkspaceSpec is optional. If nil, the workspaceId parameter is rejected.
+	GetWorkspaceSpec     *usecase.GetWorkspaceSpecDocumentUseCase
+	ListShareLinks       *usecase.ListShareLinksUseCase
+	Logger               *logger.Logger
+	RequestGeneration    *usecase.RequestGenerationUseCase
+	ResolveCommentThread *usecase.ResolveCommentThreadUseCase
+	RevertBehaviorEdit   *usecase.RevertBehaviorEditUseCase
+	RevertFeatureEdit    *usecase.RevertFeatureEditUseCase
+	RevokeShareLink      *usecase.RevokeShareLinkUseCase
+	SearchSpecs          *usecase.SearchSpecsUseCase
 	// TierLookup is optional. If nil, all requests use default queue.
-	TierLookup port.TierLookup
+	TierLookup    port.TierLookup
+	UpdateComment *usecase.UpdateCommentUseCase
 }
 
 func NewHandler(cfg *HandlerConfig) (*Handler, error) {
@@ -57,6 +103,9 @@ func NewHandler(cfg *HandlerConfig) (*Handler, error) {
 	if cfg.GetGenerationStatus == nil {
 		return nil, errors.New("GetGenerationStatus usecase is required")
 	}
+	if cfg.CancelGeneration == nil {
+		return nil, errors.New("CancelGeneration usecase is required")
+	}
 	if cfg.GetVersions == nil {
 		return nil, errors.New("GetVersions usecase is required")
 	}
@@ -72,21 +121,103 @@ func NewHandler(cfg *HandlerConfig) (*Handler, error) {
 	if cfg.GetVersionHistoryByRepo == nil {
 		return nil, errors.New("GetVersionHistoryByRepo usecase is required")
 	}
+	if cfg.GetSpecDiff == nil {
+		return nil, errors.New("GetSpecDiff usecase is required")
+	}
+	if cfg.GetSpecDiffByRepository == nil {
+		return nil, errors.New("GetSpecDiffByRepository usecase is required")
+	}
+	if cfg.ExportSpecDocument == nil {
+		return nil, errors.New("ExportSpecDocument usecase is required")
+	}
+	if cfg.ExportSpecByRepository == nil {
+		return nil, errors.New("ExportSpecByRepository usecase is required")
+	}
+	if cfg.GetSpecEdits == nil {
+		return nil, errors.New("GetSpecEdits usecase is required")
+	}
+	if cfg.EditBehavior == nil {
+		return nil, errors.New("EditBehavior usecase is required")
+	}
+	if cfg.RevertBehaviorEdit == nil {
+		return nil, errors.New("RevertBehaviorEdit usecase is required")
+	}
+	if cfg.EditFeature == nil {
+		return nil, errors.New("EditFeature usecase is required")
+	}
+	if cfg.RevertFeatureEdit == nil {
+		return nil, errors.New("RevertFeatureEdit usecase is required")
+	}
+	if cfg.SearchSpecs == nil {
+		return nil, errors.New("SearchSpecs usecase is required")
+	}
+	if cfg.GetSpecComments == nil {
+		return nil, errors.New("GetSpecComments usecase is required")
+	}
+	if cfg.CreateCommentThread == nil {
+		return nil, errors.New("CreateCommentThread usecase is required")
+	}
+	if cfg.AddComment == nil {
+		return nil, errors.New("AddComment usecase is required")
+	}
+	if cfg.UpdateComment == nil {
+		return nil, errors.New("UpdateComment usecase is required")
+	}
+	if cfg.DeleteComment == nil {
+		return nil, errors.New("DeleteComment usecase is required")
+	}
+	if cfg.ResolveCommentThread == nil {
+		return nil, errors.New("ResolveCommentThread usecase is required")
+	}
+	if cfg.CreateShareLink == nil {
+		return nil, errors.New("CreateShareLink usecase is required")
+	}
+	if cfg.ListShareLinks == nil {
+		return nil, errors.New("ListShareLinks usecase is required")
+	}
+	if cfg.RevokeShareLink == nil {
+		return nil, errors.New("RevokeShareLink usecase is required")
+	}
+	if cfg.GetSharedSpec == nil {
+		return nil, errors.New("GetSharedSpec usecase is required")
+	}
 	if cfg.Logger == nil {
 		return nil, errors.New("Logger is required")
 	}
 
 	return &Handler{
+		addComment:              cfg.AddComment,
+		cancelGeneration:        cfg.CancelGeneration,
+		createCommentThread:     cfg.CreateCommentThread,
+		createShareLink:         cfg.CreateShareLink,
+		deleteComment:           cfg.DeleteComment,
+		editBehavior:            cfg.EditBehavior,
+		editFeature:             cfg.EditFeature,
+		exportSpecByRepository:  cfg.ExportSpecByRepository,
+		exportSpecDocument:      cfg.ExportSpecDocument,
 		getCacheAvailability:    cfg.GetCacheAvailability,
 		getCachePrediction:      cfg.GetCachePrediction,
 		getGenerationStatus:     cfg.GetGenerationStatus,
+		getSharedSpec:           cfg.GetSharedSpec,
 		getSpecByRepository:     cfg.GetSpecByRepository,
+		getSpecComments:         cfg.GetSpecComments,
+		getSpecDiff:             cfg.GetSpecDiff,
+		getSpecDiffByRepository: cfg.GetSpecDiffByRepository,
 		getSpecDocument:         cfg.GetSpecDocument,
+		getSpecEdits:            cfg.GetSpecEdits,
 		getVersionHistoryByRepo: cfg.GetVersionHistoryByRepo,
 		getVersions:             cfg.GetVersions,
+		getWorkspaceSpec:        cfg.GetWorkspaceSpec,
+		listShareLinks:          cfg.ListShareLinks,
 		logger:                  cfg.Logger,
 		requestGeneration:       cfg.RequestGeneration,
+		resolveCommentThread:    cfg.ResolveCommentThread,
+		revertBehaviorEdit:      cfg.RevertBehaviorEdit,
+		revertFeatureEdit:       cfg.RevertFeatureEdit,
+		revokeShareLink:         cfg.RevokeShareLink,
+		searchSpecs:             cfg.SearchSpecs,
 		tierLookup:              cfg.TierLookup,
+		updateComment:           cfg.UpdateComment,
 	}, nil
 }
 
@@ -104,14 +235,35 @@ func (h *Handler) GetSpecDocument(ctx context.Context, request api.GetSpecDocume
 		version = *request.Params.Version
 	}
 
-	result, err := h.getSpecDocument.Execute(ctx, usecase.GetSpecDocumentInput{
-		AnalysisID: analysisID,
-		Language:   language,
-		UserID:     userID,
-		Version:    version,
-	})
+	var result *usecase.GetSpecDocumentOutput
+	var err error
+	if request.Params.WorkspaceID != nil {
+		if h.getWorkspaceSpec == nil {
+			return api.GetSpecDocument400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("workspaces are not available"),
+			}, nil
+		}
+		result, err = h.getWorkspaceSpec.Execute(ctx, usecase.GetWorkspaceSpecDocumentInput{
+			AnalysisID:  analysisID,
+			Language:    language,
+			UserID:      userID,
+			Version:     version,
+			WorkspaceID: request.Params.WorkspaceID.String(),
+		})
+	} else {
+		result, err = h.getSpecDocument.Execute(ctx, usecase.GetSpecDocumentInput{
+			AnalysisID: analysisID,
+			Language:   language,
+			UserID:     userID,
+			Version:    version,
+		})
+	}
 	if err != nil {
 		switch {
+		case errors.Is(err, domain.ErrInvalidLanguage):
+			return api.GetSpecDocument400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid language"),
+			}, nil
 		case errors.Is(err, domain.ErrUnauthorized):
 			return api.GetSpecDocument401ApplicationProblemPlusJSONResponse{
 				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
@@ -144,7 +296,7 @@ func (h *Handler) GetSpecDocument(ctx context.Context, request api.GetSpecDocume
 				InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
 			}, nil
 		}
-		return newSpecDocument200Response(data), nil
+		return newSpecDocument200Response(data, true), nil
 	}
 
 	data, err := mapper.ToGeneratingResponse(result.GenerationStatus)
@@ -154,7 +306,7 @@ func (h *Handler) GetSpecDocument(ctx context.Context, request api.GetSpecDocume
 			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
 		}, nil
 	}
-	return newSpecDocument200Response(data), nil
+	return newSpecDocument200Response(data, false), nil
 }
 
 func (h *Handler) RequestSpecGeneration(ctx context.Context, request api.RequestSpecGenerationRequestObject) (api.RequestSpecGenerationResponseObject, error) {
@@ -181,14 +333,20 @@ func (h *Handler) RequestSpecGeneration(ctx context.Context, request api.Request
 		mode = entity.GenerationMode(*request.Body.GenerationMode)
 	}
 
+	workspaceID := ""
+	if request.Body.WorkspaceID != nil {
+		workspaceID = request.Body.WorkspaceID.String()
+	}
+
 	tier := h.lookupUserTier(ctx, userID)
 
 	result, err := h.requestGeneration.Execute(ctx, usecase.RequestGenerationInput{
-		AnalysisID: request.Body.AnalysisID.String(),
-		Language:   language,
-		Mode:       mode,
-		Tier:       tier,
-		UserID:     userID,
+		AnalysisID:  request.Body.AnalysisID.String(),
+		Language:    language,
+		Mode:        mode,
+		Tier:        tier,
+		UserID:      userID,
+		WorkspaceID: workspaceID,
 	})
 	if err != nil {
 		switch {
@@ -229,6 +387,10 @@ func (h *Handler) RequestSpecGeneration(ctx context.Context, request api.Request
 			return api.RequestSpecGeneration403ApplicationProblemPlusJSONResponse{
 				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("Active subscription required for spec generation. Please subscribe to a plan."),
 			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.RequestSpecGeneration403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("editor or owner role required to generate for this workspace"),
+			}, nil
 		}
 
 		h.logger.Error(ctx, "failed to request spec generation", "error", err)
@@ -256,8 +418,9 @@ func (h *Handler) GetSpecGenerationStatus(ctx context.Context, request api.GetSp
 	analysisID := request.AnalysisID.String()
 
 	input := usecase.GetGenerationStatusInput{
-		AnalysisID: analysisID,
-		UserID:     userID,
+		AnalysisID:  analysisID,
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
 	}
 	if request.Params.Language != nil {
 		input.Language = *request.Params.Language
@@ -301,15 +464,72 @@ func (h *Handler) GetSpecGenerationStatus(ctx context.Context, request api.GetSp
 	return api.GetSpecGenerationStatus200JSONResponse(resp), nil
 }
 
+func (h *Handler) CancelSpecGeneration(ctx context.Context, request api.CancelSpecGenerationRequestObject) (api.CancelSpecGenerationResponseObject, error) {
+	input := usecase.CancelGenerationInput{
+		AnalysisID:  request.AnalysisID.String(),
+		UserID:      middleware.GetUserID(ctx),
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	}
+	if request.Params.Language != nil {
+		input.Language = *request.Params.Language
+	}
+
+	result, err := h.cancelGeneration.Execute(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.CancelSpecGeneration401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.CancelSpecGeneration403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidAnalysisID):
+			return api.CancelSpecGeneration404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("invalid analysis ID"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidLanguage):
+			return api.CancelSpecGeneration400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid language"),
+			}, nil
+		case errors.Is(err, domain.ErrNoActiveGeneration):
+			return api.CancelSpecGeneration404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("no pending or running generation"),
+			}, nil
+		case errors.Is(err, domain.ErrGenerationFinished):
+			return api.CancelSpecGeneration409ApplicationProblemPlusJSONResponse{
+				ConflictApplicationProblemPlusJSONResponse: api.NewConflict("generation already finished"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to cancel spec generation", "error", err)
+		return api.CancelSpecGeneration500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to cancel spec generation"),
+		}, nil
+	}
+
+	resp, err := mapper.ToGenerationStatusResponse(result.Status)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map generation status response", "error", err)
+		return api.CancelSpecGeneration500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.CancelSpecGeneration200JSONResponse(resp), nil
+}
+
 func (h *Handler) GetSpecVersions(ctx context.Context, request api.GetSpecVersionsRequestObject) (api.GetSpecVersionsResponseObject, error) {
 	userID := middleware.GetUserID(ctx)
 	analysisID := request.AnalysisID.String()
 	language := string(request.Params.Language)
 
 	result, err := h.getVersions.Execute(ctx, usecase.GetVersionsInput{
-		AnalysisID: analysisID,
-		Language:   language,
-		UserID:     userID,
+		AnalysisID:  analysisID,
+		Language:    language,
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
 	})
 	if err != nil {
 		switch {
@@ -350,11 +570,18 @@ func (h *Handler) GetSpecVersions(ctx context.Context, request api.GetSpecVersio
 }
 
 type specDocument200Response struct {
-	data []byte
+	completed bool
+	data      []byte
+}
+
+var _ api.CacheableResponse = specDocument200Response{}
+
+func newSpecDocument200Response(data []byte, completed bool) api.GetSpecDocumentResponseObject {
+	return specDocument200Response{completed: completed, data: data}
 }
 
-func newSpecDocument200Response(data []byte) api.GetSpecDocumentResponseObject {
-	return specDocument200Response{data: data}
+func (r specDocument200Response) CacheableBody() ([]byte, bool, error) {
+	return r.data, r.completed, nil
 }
 
 func (r specDocument200Response) VisitGetSpecDocumentResponse(w http.ResponseWriter) error {
@@ -368,6 +595,14 @@ func ptr(s string) *string {
 	return &s
 }
 
+// workspaceID returns an empty string when the request targets the user's own documents.
+func workspaceID(id *openapi_types.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
 func (h *Handler) GetSpecCacheAvailability(ctx context.Context, request api.GetSpecCacheAvailabilityRequestObject) (api.GetSpecCacheAvailabilityResponseObject, error) {
 	userID := middleware.GetUserID(ctx)
 	analysisID := request.AnalysisID.String()
@@ -451,6 +686,22 @@ func (h *Handler) lookupUserTier(ctx context.Context, userID string) subscriptio
 	return subscription.PlanTier(tierStr)
 }
 
+// GetSpecDocumentByRepositoryValidator lets a cached document requested by ID
+// be revalidated without loading it. See api.ValidatorFunc.
+func (h *Handler) GetSpecDocumentByRepositoryValidator(ctx context.Context, request any) (string, bool, error) {
+	req, ok := request.(api.GetSpecDocumentByRepositoryRequestObject)
+	if !ok || req.Params.DocumentID == nil {
+		return "", false, nil
+	}
+
+	return h.getSpecByRepository.Validator(ctx, usecase.GetSpecByRepositoryInput{
+		DocumentID: req.Params.DocumentID.String(),
+		Name:       req.Repo,
+		Owner:      req.Owner,
+		UserID:     middleware.GetUserID(ctx),
+	})
+}
+
 func (h *Handler) GetSpecDocumentByRepository(ctx context.Context, request api.GetSpecDocumentByRepositoryRequestObject) (api.GetSpecDocumentByRepositoryResponseObject, error) {
 	userID := middleware.GetUserID(ctx)
 
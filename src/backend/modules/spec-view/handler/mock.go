@@ -21,6 +21,12 @@ func (m *MockHandler) GetSpecDocument(_ context.Context, _ api.GetSpecDocumentRe
 	}, nil
 }
 
+func (m *MockHandler) CancelSpecGeneration(_ context.Context, _ api.CancelSpecGenerationRequestObject) (api.CancelSpecGenerationResponseObject, error) {
+	return api.CancelSpecGeneration401ApplicationProblemPlusJSONResponse{
+		UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("unauthorized"),
+	}, nil
+}
+
 func (m *MockHandler) GetSpecGenerationStatus(_ context.Context, _ api.GetSpecGenerationStatusRequestObject) (api.GetSpecGenerationStatusResponseObject, error) {
 	return api.GetSpecGenerationStatus404ApplicationProblemPlusJSONResponse{
 		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
@@ -65,3 +71,125 @@ func (m *MockHandler) GetVersionHistoryByRepository(_ context.Context, _ api.Get
 		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
 	}, nil
 }
+
+func (m *MockHandler) ExportSpecDocument(_ context.Context, _ api.ExportSpecDocumentRequestObject) (api.ExportSpecDocumentResponseObject, error) {
+	return api.ExportSpecDocument404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) ExportSpecDocumentByRepository(_ context.Context, _ api.ExportSpecDocumentByRepositoryRequestObject) (api.ExportSpecDocumentByRepositoryResponseObject, error) {
+	return api.ExportSpecDocumentByRepository404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) GetSpecDiff(_ context.Context, _ api.GetSpecDiffRequestObject) (api.GetSpecDiffResponseObject, error) {
+	return api.GetSpecDiff404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) GetSpecDiffByRepository(_ context.Context, _ api.GetSpecDiffByRepositoryRequestObject) (api.GetSpecDiffByRepositoryResponseObject, error) {
+	return api.GetSpecDiffByRepository404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) SearchSpecs(_ context.Context, _ api.SearchSpecsRequestObject) (api.SearchSpecsResponseObject, error) {
+	return api.SearchSpecs200JSONResponse{Data: []api.SpecSearchResult{}}, nil
+}
+
+func (m *MockHandler) EditSpecBehavior(_ context.Context, _ api.EditSpecBehaviorRequestObject) (api.EditSpecBehaviorResponseObject, error) {
+	return api.EditSpecBehavior404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) EditSpecFeature(_ context.Context, _ api.EditSpecFeatureRequestObject) (api.EditSpecFeatureResponseObject, error) {
+	return api.EditSpecFeature404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) GetSpecEdits(_ context.Context, _ api.GetSpecEditsRequestObject) (api.GetSpecEditsResponseObject, error) {
+	return api.GetSpecEdits404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) RevertSpecBehaviorEdit(_ context.Context, _ api.RevertSpecBehaviorEditRequestObject) (api.RevertSpecBehaviorEditResponseObject, error) {
+	return api.RevertSpecBehaviorEdit404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) RevertSpecFeatureEdit(_ context.Context, _ api.RevertSpecFeatureEditRequestObject) (api.RevertSpecFeatureEditResponseObject, error) {
+	return api.RevertSpecFeatureEdit404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) AddSpecComment(_ context.Context, _ api.AddSpecCommentRequestObject) (api.AddSpecCommentResponseObject, error) {
+	return api.AddSpecComment404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) CreateSpecCommentThread(_ context.Context, _ api.CreateSpecCommentThreadRequestObject) (api.CreateSpecCommentThreadResponseObject, error) {
+	return api.CreateSpecCommentThread404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) DeleteSpecComment(_ context.Context, _ api.DeleteSpecCommentRequestObject) (api.DeleteSpecCommentResponseObject, error) {
+	return api.DeleteSpecComment404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) GetSpecComments(_ context.Context, _ api.GetSpecCommentsRequestObject) (api.GetSpecCommentsResponseObject, error) {
+	return api.GetSpecComments404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) ReopenSpecCommentThread(_ context.Context, _ api.ReopenSpecCommentThreadRequestObject) (api.ReopenSpecCommentThreadResponseObject, error) {
+	return api.ReopenSpecCommentThread404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) ResolveSpecCommentThread(_ context.Context, _ api.ResolveSpecCommentThreadRequestObject) (api.ResolveSpecCommentThreadResponseObject, error) {
+	return api.ResolveSpecCommentThread404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) UpdateSpecComment(_ context.Context, _ api.UpdateSpecCommentRequestObject) (api.UpdateSpecCommentResponseObject, error) {
+	return api.UpdateSpecComment404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) CreateSpecShareLink(_ context.Context, _ api.CreateSpecShareLinkRequestObject) (api.CreateSpecShareLinkResponseObject, error) {
+	return api.CreateSpecShareLink404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) ListSpecShareLinks(_ context.Context, _ api.ListSpecShareLinksRequestObject) (api.ListSpecShareLinksResponseObject, error) {
+	return api.ListSpecShareLinks200JSONResponse{Data: []api.SpecShareLink{}}, nil
+}
+
+func (m *MockHandler) RevokeSpecShareLink(_ context.Context, _ api.RevokeSpecShareLinkRequestObject) (api.RevokeSpecShareLinkResponseObject, error) {
+	return api.RevokeSpecShareLink404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
+
+func (m *MockHandler) GetSharedSpec(_ context.Context, _ api.GetSharedSpecRequestObject) (api.GetSharedSpecResponseObject, error) {
+	return api.GetSharedSpec404ApplicationProblemPlusJSONResponse{
+		NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("not found"),
+	}, nil
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/usecase"
+)
+
+func (h *Handler) SearchSpecs(ctx context.Context, request api.SearchSpecsRequestObject) (api.SearchSpecsResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	input := usecase.SearchSpecsInput{
+		Query:       request.Params.Q,
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	}
+	if request.Params.Cursor != nil {
+		input.Cursor = *request.Params.Cursor
+	}
+	if request.Params.Language != nil {
+		input.Language = string(*request.Params.Language)
+	}
+	if request.Params.Limit != nil {
+		input.Limit = *request.Params.Limit
+	}
+	if request.Params.Owner != nil {
+		input.Owner = *request.Params.Owner
+	}
+	if request.Params.Repo != nil {
+		input.Repo = *request.Params.Repo
+	}
+
+	result, err := h.searchSpecs.Execute(ctx, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.SearchSpecs401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.SearchSpecs403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidSearchQuery):
+			return api.SearchSpecs400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("search query must be 1-200 characters"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidLanguage):
+			return api.SearchSpecs400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid language"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidRepository):
+			return api.SearchSpecs400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid owner or repository name"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidCursor):
+			return api.SearchSpecs400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid cursor"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to search spec documents", "error", err)
+		return api.SearchSpecs500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to search spec documents"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecSearchResponse(result.Results, result.NextCursor, result.HasNext)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map search response", "error", err)
+		return api.SearchSpecs500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.SearchSpecs200JSONResponse(resp), nil
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/spec-view/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/usecase"
+)
+
+func (h *Handler) CreateSpecShareLink(ctx context.Context, request api.CreateSpecShareLinkRequestObject) (api.CreateSpecShareLinkResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	if request.Body == nil {
+		return api.CreateSpecShareLink400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	var expiresIn time.Duration
+	if request.Body.ExpiresInDays != nil {
+		if *request.Body.ExpiresInDays <= 0 {
+			return api.CreateSpecShareLink400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("expiresInDays must be between 1 and 365"),
+			}, nil
+		}
+		expiresIn = time.Duration(*request.Body.ExpiresInDays) * 24 * time.Hour
+	}
+
+	output, err := h.createShareLink.Execute(ctx, usecase.CreateShareLinkInput{
+		DocumentID:  request.Body.DocumentID.String(),
+		ExpiresIn:   expiresIn,
+		Latest:      request.Body.Latest != nil && *request.Body.Latest,
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.CreateSpecShareLink401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.CreateSpecShareLink403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidDocumentID):
+			return api.CreateSpecShareLink400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("invalid document ID"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidShareLinkExpiry):
+			return api.CreateSpecShareLink400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("expiresInDays must be between 1 and 365"),
+			}, nil
+		case errors.Is(err, domain.ErrDocumentNotFound):
+			return api.CreateSpecShareLink404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("spec document not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to create spec share link", "error", err)
+		return api.CreateSpecShareLink500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to create share link"),
+		}, nil
+	}
+
+	link, err := mapper.ToSpecShareLink(output.ShareLink)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map spec share link response", "error", err)
+		return api.CreateSpecShareLink500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.CreateSpecShareLink201JSONResponse{
+		Data:  link,
+		Token: output.Token,
+	}, nil
+}
+
+func (h *Handler) ListSpecShareLinks(ctx context.Context, request api.ListSpecShareLinksRequestObject) (api.ListSpecShareLinksResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	links, err := h.listShareLinks.Execute(ctx, usecase.ListShareLinksInput{
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.ListSpecShareLinks401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.ListSpecShareLinks403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to list spec share links", "error", err)
+		return api.ListSpecShareLinks500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list share links"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSpecShareLinkListResponse(links)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map spec share links response", "error", err)
+		return api.ListSpecShareLinks500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.ListSpecShareLinks200JSONResponse(resp), nil
+}
+
+func (h *Handler) RevokeSpecShareLink(ctx context.Context, request api.RevokeSpecShareLinkRequestObject) (api.RevokeSpecShareLinkResponseObject, error) {
+	userID := middleware.GetUserID(ctx)
+
+	err := h.revokeShareLink.Execute(ctx, usecase.RevokeShareLinkInput{
+		ShareLinkID: request.ShareLinkID.String(),
+		UserID:      userID,
+		WorkspaceID: workspaceID(request.Params.WorkspaceID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.RevokeSpecShareLink401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.RevokeSpecShareLink403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("access denied to this resource"),
+			}, nil
+		case errors.Is(err, domain.ErrShareLinkNotFound):
+			return api.RevokeSpecShareLink404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("share link not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to revoke spec share link", "error", err)
+		return api.RevokeSpecShareLink500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to revoke share link"),
+		}, nil
+	}
+
+	return api.RevokeSpecShareLink204Response{}, nil
+}
+
+func (h *Handler) GetSharedSpec(ctx context.Context, request api.GetSharedSpecRequestObject) (api.GetSharedSpecResponseObject, error) {
+	output, err := h.getSharedSpec.Execute(ctx, usecase.GetSharedSpecInput{
+		ClientIP: middleware.GetClientIP(ctx),
+		Token:    request.Token,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrShareLinkNotFound) || errors.Is(err, domain.ErrDocumentNotFound) {
+			return api.GetSharedSpec404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("shared spec document not found"),
+			}, nil
+		}
+
+		h.logger.Error(ctx, "failed to get shared spec document", "error", err)
+		return api.GetSharedSpec500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to get shared spec document"),
+		}, nil
+	}
+
+	resp, err := mapper.ToSharedSpecDocumentResponse(output.Document, output.ShareLink)
+	if err != nil {
+		h.logger.Error(ctx, "failed to map shared spec document response", "error", err)
+		return api.GetSharedSpec500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to process response"),
+		}, nil
+	}
+
+	return api.GetSharedSpec200JSONResponse(resp), nil
+}
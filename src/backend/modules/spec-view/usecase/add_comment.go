@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type AddCommentInput struct {
+	Body     string
+	ThreadID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the thread must be the workspace's and the user an editor or owner.
+	WorkspaceID string
+}
+
+// AddCommentUseCase replies to a comment thread. Resolved threads accept
+// replies without being reopened.
+type AddCommentUseCase struct {
+	comments   port.SpecCommentRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewAddCommentUseCase(comments port.SpecCommentRepository, workspaces port.WorkspaceAccess) *AddCommentUseCase {
+	return &AddCommentUseCase{comments: comments, workspaces: workspaces}
+}
+
+func (uc *AddCommentUseCase) Execute(ctx context.Context, input AddCommentInput) (*entity.Comment, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.ThreadID == "" {
+		return nil, domain.ErrCommentThreadNotFound
+	}
+
+	body, ok := entity.NormalizeEditText(input.Body, entity.MaxCommentLength)
+	if !ok {
+		return nil, domain.ErrInvalidComment
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.comments.AddComment(ctx, access, input.ThreadID, body, entity.ParseMentions(body))
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+	usageport "github.com/specvital/web/src/backend/modules/usage/domain/port"
+)
+
+type CancelGenerationInput struct {
+	AnalysisID string
+	// Language is optional. Defaults to English, matching RequestGenerationInput.
+	Language string
+	UserID   string
+	// WorkspaceID is optional. If set, cancels the workspace's generation, whichever
+	// member requested it; the user must be an editor or owner.
+	WorkspaceID string
+}
+
+type CancelGenerationOutput struct {
+	Status *entity.SpecGenerationStatus
+}
+
+// TxBeginner starts the transaction that cancels a job and releases its reservation.
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// CancelGenerationUseCase cancels the user's or the workspace's pending or running
+// generation and releases its quota reservation, so it can be requested again at
+// once. A running generation is marked for cancellation; its worker finds no
+// reservation for it and records no usage.
+type CancelGenerationUseCase struct {
+	dbPool          TxBeginner
+	documents       specDocumentReader
+	queue           port.QueueService
+	reservationRepo usageport.QuotaReservationRepository
+	workspaces      port.WorkspaceAccess
+}
+
+func NewCancelGenerationUseCase(
+	repo port.SpecViewRepository,
+	queue port.QueueService,
+	dbPool TxBeginner,
+	reservationRepo usageport.QuotaReservationRepository,
+	workspaces port.WorkspaceAccess,
+	workspaceSpecs port.WorkspaceSpecRepository,
+) *CancelGenerationUseCase {
+	return &CancelGenerationUseCase{
+		dbPool:          dbPool,
+		documents:       specDocumentReader{repo: repo, workspaceSpecs: workspaceSpecs},
+		queue:           queue,
+		reservationRepo: reservationRepo,
+		workspaces:      workspaces,
+	}
+}
+
+func (uc *CancelGenerationUseCase) Execute(ctx context.Context, input CancelGenerationInput) (*CancelGenerationOutput, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.AnalysisID == "" {
+		return nil, domain.ErrInvalidAnalysisID
+	}
+
+	language := input.Language
+	if language == "" {
+		language = "English"
+	}
+	if !entity.IsValidLanguage(language) {
+		return nil, domain.ErrInvalidLanguage
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := uc.documents.activeJobID(ctx, access, input.AnalysisID, language)
+	if err != nil {
+		return nil, fmt.Errorf("get active generation job: %w", err)
+	}
+	if jobID == 0 {
+		return nil, domain.ErrNoActiveGeneration
+	}
+
+	if err := uc.cancelWithReservation(ctx, jobID); err != nil {
+		return nil, err
+	}
+
+	status, err := uc.documents.generationStatus(ctx, access, input.AnalysisID, language)
+	if err != nil {
+		return nil, fmt.Errorf("get generation status: %w", err)
+	}
+	if status == nil {
+		status = &entity.SpecGenerationStatus{
+			AnalysisID: input.AnalysisID,
+			Status:     entity.StatusNotFound,
+		}
+	}
+
+	return &CancelGenerationOutput{Status: status}, nil
+}
+
+// cancelWithReservation cancels the job and deletes its quota reservation in the
+// same transaction.
+func (uc *CancelGenerationUseCase) cancelWithReservation(ctx context.Context, jobID int64) error {
+	tx, err := uc.dbPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := uc.queue.CancelSpecGenerationTx(ctx, tx, jobID); err != nil {
+		return err
+	}
+
+	qtx := db.New(tx)
+	if err := uc.reservationRepo.DeleteReservationByJobIDTx(ctx, qtx, jobID); err != nil {
+		return fmt.Errorf("release quota reservation: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+// fakeTx records the statements run in it and whether it was committed.
+type fakeTx struct {
+	pgx.Tx
+	committed  bool
+	statements []string
+	// execAfterCommit is set if a statement ran after the transaction committed.
+	execAfterCommit bool
+}
+
+func (tx *fakeTx) Commit(_ context.Context) error {
+	tx.committed = true
+	return nil
+}
+
+func (tx *fakeTx) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	if tx.committed {
+		tx.execAfterCommit = true
+	}
+	tx.statements = append(tx.statements, sql)
+	return pgconn.CommandTag{}, nil
+}
+
+func (tx *fakeTx) Rollback(_ context.Context) error {
+	return nil
+}
+
+func (tx *fakeTx) reservationDeletes() int {
+	count := 0
+	for _, sql := range tx.statements {
+		if strings.Contains(sql, "DELETE FROM quota_reservations") {
+			count++
+		}
+	}
+	return count
+}
+
+type fakeTxBeginner struct {
+	tx *fakeTx
+}
+
+func (b *fakeTxBeginner) Begin(_ context.Context) (pgx.Tx, error) {
+	return b.tx, nil
+}
+
+// txReservationRepository deletes reservations through the transaction it is given.
+type txReservationRepository struct {
+	mockReservationRepository
+	deletedJobID int64
+}
+
+func (r *txReservationRepository) DeleteReservationByJobIDTx(ctx context.Context, qtx *db.Queries, jobID int64) error {
+	r.deletedJobID = jobID
+	return qtx.DeleteQuotaReservationByJobID(ctx, jobID)
+}
+
+func TestCancelGenerationUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CancelGenerationInput
+		repo    *mockSpecViewRepository
+		wantErr error
+	}{
+		{
+			name:    "should reject missing user",
+			input:   CancelGenerationInput{AnalysisID: "test-analysis-id"},
+			repo:    &mockSpecViewRepository{},
+			wantErr: domain.ErrUnauthorized,
+		},
+		{
+			name:    "should reject missing analysis ID",
+			input:   CancelGenerationInput{UserID: "test-user-id"},
+			repo:    &mockSpecViewRepository{},
+			wantErr: domain.ErrInvalidAnalysisID,
+		},
+		{
+			name:    "should reject unsupported language",
+			input:   CancelGenerationInput{AnalysisID: "test-analysis-id", Language: "Klingon", UserID: "test-user-id"},
+			repo:    &mockSpecViewRepository{},
+			wantErr: domain.ErrInvalidLanguage,
+		},
+		{
+			name:    "should return no active generation when nothing is queued or running",
+			input:   CancelGenerationInput{AnalysisID: "test-analysis-id", UserID: "test-user-id"},
+			repo:    &mockSpecViewRepository{},
+			wantErr: domain.ErrNoActiveGeneration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewCancelGenerationUseCase(tt.repo, &mockQueueService{}, nil, &mockReservationRepository{}, nil, nil)
+
+			_, err := uc.Execute(context.Background(), tt.input)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCancelGenerationUseCase_Execute_Job(t *testing.T) {
+	input := CancelGenerationInput{AnalysisID: "test-analysis-id", UserID: "test-user-id"}
+
+	tests := []struct {
+		name        string
+		queue       *mockQueueService
+		status      *entity.SpecGenerationStatus
+		wantErr     error
+		wantRelease bool
+		wantStatus  entity.GenerationStatus
+	}{
+		{
+			name:        "should cancel a pending or running job and release its reservation",
+			queue:       &mockQueueService{},
+			status:      &entity.SpecGenerationStatus{Status: entity.StatusCancelled},
+			wantRelease: true,
+			wantStatus:  entity.StatusCancelled,
+		},
+		{
+			name:    "should return conflict for a job that already finished",
+			queue:   &mockQueueService{cancelErr: domain.ErrGenerationFinished},
+			wantErr: domain.ErrGenerationFinished,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTx{}
+			reservations := &txReservationRepository{}
+			repo := &mockSpecViewRepository{activeJobID: 42, status: tt.status}
+			uc := NewCancelGenerationUseCase(repo, tt.queue, &fakeTxBeginner{tx: tx}, reservations, nil, nil)
+
+			result, err := uc.Execute(context.Background(), input)
+
+			if tt.queue.cancelledJobID != 42 {
+				t.Errorf("expected job 42 to be cancelled, got %d", tt.queue.cancelledJobID)
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if tx.committed {
+					t.Error("expected transaction to roll back")
+				}
+				if reservations.deletedJobID != 0 {
+					t.Errorf("expected reservation to be kept, deleted for job %d", reservations.deletedJobID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tx.committed {
+				t.Error("expected transaction to commit")
+			}
+			if released := tx.reservationDeletes() == 1; released != tt.wantRelease {
+				t.Errorf("reservation released = %v, want %v (statements %v)", released, tt.wantRelease, tx.statements)
+			}
+			if result.Status.Status != tt.wantStatus {
+				t.Errorf("expected status %s, got %s", tt.wantStatus, result.Status.Status)
+			}
+		})
+	}
+}
+
+func TestCancelGenerationUseCase_Execute_ReleasesReservationInCancelTransaction(t *testing.T) {
+	tx := &fakeTx{}
+	queue := &mockQueueService{}
+	reservations := &txReservationRepository{}
+	repo := &mockSpecViewRepository{activeJobID: 42}
+	uc := NewCancelGenerationUseCase(repo, queue, &fakeTxBeginner{tx: tx}, reservations, nil, nil)
+
+	if _, err := uc.Execute(context.Background(), CancelGenerationInput{AnalysisID: "test-analysis-id", UserID: "test-user-id"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if queue.cancelTx != pgx.Tx(tx) {
+		t.Error("expected the job to be cancelled in the transaction")
+	}
+	if reservations.deletedJobID != 42 {
+		t.Errorf("expected reservation of job 42 to be released, got %d", reservations.deletedJobID)
+	}
+	if tx.reservationDeletes() != 1 {
+		t.Errorf("expected the reservation to be deleted in the cancel transaction, got statements %v", tx.statements)
+	}
+	if tx.execAfterCommit {
+		t.Error("expected the reservation to be deleted before the transaction committed")
+	}
+}
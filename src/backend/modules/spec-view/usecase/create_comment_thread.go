@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type CreateCommentThreadInput struct {
+	Body       string
+	DocumentID string
+	// TargetID is the ID of the domain, feature or behavior in the document
+	TargetID   string
+	TargetType entity.CommentTargetType
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the document must be the workspace's and the user an editor or owner.
+	WorkspaceID string
+}
+
+// CreateCommentThreadUseCase opens a comment thread on a domain, feature or
+// behavior of a spec document. @username mentions of existing users are recorded.
+type CreateCommentThreadUseCase struct {
+	comments   port.SpecCommentRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewCreateCommentThreadUseCase(comments port.SpecCommentRepository, workspaces port.WorkspaceAccess) *CreateCommentThreadUseCase {
+	return &CreateCommentThreadUseCase{comments: comments, workspaces: workspaces}
+}
+
+func (uc *CreateCommentThreadUseCase) Execute(ctx context.Context, input CreateCommentThreadInput) (*entity.CommentThread, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if !entity.IsValidDocumentID(input.DocumentID) {
+		return nil, domain.ErrInvalidDocumentID
+	}
+
+	if !entity.IsValidCommentTargetType(input.TargetType) {
+		return nil, domain.ErrInvalidComment
+	}
+
+	body, ok := entity.NormalizeEditText(input.Body, entity.MaxCommentLength)
+	if !ok {
+		return nil, domain.ErrInvalidComment
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := uc.comments.GetCommentDocument(ctx, access, input.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+
+	anchor, ok := entity.FindCommentAnchor(document.Domains, input.TargetType, input.TargetID)
+	if !ok {
+		return nil, domain.ErrCommentTargetNotFound
+	}
+
+	thread, err := uc.comments.CreateCommentThread(ctx, document, anchor, body, entity.ParseMentions(body))
+	if err != nil {
+		return nil, err
+	}
+	thread.TargetID = input.TargetID
+	return thread, nil
+}
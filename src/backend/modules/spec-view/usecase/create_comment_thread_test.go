@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+const testDocumentID = "550e8400-e29b-41d4-a716-446655440000"
+
+type mockCommentRepository struct {
+	document *entity.CommentDocument
+	threads  []entity.CommentThread
+	updated  bool
+	err      error
+
+	access          entity.DocumentAccess
+	createdAnchor   entity.CommentAnchor
+	createdBody     string
+	createdMentions []string
+	resolved        *bool
+}
+
+func (m *mockCommentRepository) AddComment(_ context.Context, access entity.DocumentAccess, threadID, body string, _ []string) (*entity.Comment, error) {
+	m.access = access
+	return &entity.Comment{Body: body, ThreadID: threadID}, m.err
+}
+
+func (m *mockCommentRepository) CreateCommentThread(_ context.Context, _ *entity.CommentDocument, anchor entity.CommentAnchor, body string, mentions []string) (*entity.CommentThread, error) {
+	m.createdAnchor = anchor
+	m.createdBody = body
+	m.createdMentions = mentions
+	return &entity.CommentThread{Anchor: anchor, ID: "thread-1"}, m.err
+}
+
+func (m *mockCommentRepository) DeleteComment(_ context.Context, access entity.DocumentAccess, _ string) (bool, error) {
+	m.access = access
+	return m.updated, m.err
+}
+
+func (m *mockCommentRepository) GetCommentDocument(_ context.Context, access entity.DocumentAccess, _ string) (*entity.CommentDocument, error) {
+	m.access = access
+	if m.document == nil {
+		return nil, domain.ErrDocumentNotFound
+	}
+	return m.document, nil
+}
+
+func (m *mockCommentRepository) GetCommentThreads(_ context.Context, _ *entity.CommentDocument) ([]entity.CommentThread, error) {
+	return m.threads, m.err
+}
+
+func (m *mockCommentRepository) SetCommentThreadResolved(_ context.Context, access entity.DocumentAccess, _ string, resolved bool) (bool, error) {
+	m.access = access
+	m.resolved = &resolved
+	return m.updated, m.err
+}
+
+func (m *mockCommentRepository) UpdateComment(_ context.Context, access entity.DocumentAccess, commentID, body string, _ []string) (*entity.Comment, error) {
+	m.access = access
+	if !m.updated {
+		return nil, domain.ErrCommentNotFound
+	}
+	return &entity.Comment{Body: body, ID: commentID}, m.err
+}
+
+func newCommentDocument() *entity.CommentDocument {
+	testCaseID := "tc-1"
+	return &entity.CommentDocument{
+		Domains: []entity.SpecDomain{
+			{
+				ID:   "domain-1",
+				Name: "Payments",
+				Features: []entity.SpecFeature{
+					{
+						ID:   "feature-1",
+						Name: "Refunds",
+						Behaviors: []entity.SpecBehavior{
+							{
+								ID:               "behavior-1",
+								OriginalName:     "refunds order",
+								SourceInfo:       &entity.BehaviorSourceInfo{FilePath: "refund_test.go"},
+								SourceTestCaseID: &testCaseID,
+							},
+						},
+					},
+				},
+			},
+		},
+		ID:    testDocumentID,
+		Scope: entity.SpecEditScope{CodebaseID: "codebase-1", Language: "English", UserID: "user-1"},
+	}
+}
+
+func TestCreateCommentThreadUseCase_Execute(t *testing.T) {
+	validInput := func() CreateCommentThreadInput {
+		return CreateCommentThreadInput{
+			Body:       "  Is this really what we want? @octocat  ",
+			DocumentID: testDocumentID,
+			TargetID:   "behavior-1",
+			TargetType: entity.CommentTargetBehavior,
+			UserID:     "user-1",
+		}
+	}
+
+	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
+		input := validInput()
+		input.UserID = ""
+		_, err := NewCreateCommentThreadUseCase(&mockCommentRepository{}, nil).Execute(context.Background(), input)
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrUnauthorized)
+		}
+	})
+
+	t.Run("returns ErrInvalidComment for unknown target type", func(t *testing.T) {
+		input := validInput()
+		input.TargetType = "document"
+		_, err := NewCreateCommentThreadUseCase(&mockCommentRepository{document: newCommentDocument()}, nil).Execute(context.Background(), input)
+		if !errors.Is(err, domain.ErrInvalidComment) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidComment)
+		}
+	})
+
+	t.Run("returns ErrInvalidComment for too long body", func(t *testing.T) {
+		input := validInput()
+		input.Body = strings.Repeat("a", entity.MaxCommentLength+1)
+		_, err := NewCreateCommentThreadUseCase(&mockCommentRepository{document: newCommentDocument()}, nil).Execute(context.Background(), input)
+		if !errors.Is(err, domain.ErrInvalidComment) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidComment)
+		}
+	})
+
+	t.Run("returns ErrDocumentNotFound for document of another user", func(t *testing.T) {
+		_, err := NewCreateCommentThreadUseCase(&mockCommentRepository{}, nil).Execute(context.Background(), validInput())
+		if !errors.Is(err, domain.ErrDocumentNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrDocumentNotFound)
+		}
+	})
+
+	t.Run("returns ErrCommentTargetNotFound when target is not in document", func(t *testing.T) {
+		input := validInput()
+		input.TargetID = "behavior-2"
+		_, err := NewCreateCommentThreadUseCase(&mockCommentRepository{document: newCommentDocument()}, nil).Execute(context.Background(), input)
+		if !errors.Is(err, domain.ErrCommentTargetNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrCommentTargetNotFound)
+		}
+	})
+
+	t.Run("creates thread anchored to the behavior's test", func(t *testing.T) {
+		repo := &mockCommentRepository{document: newCommentDocument()}
+
+		thread, err := NewCreateCommentThreadUseCase(repo, nil).Execute(context.Background(), validInput())
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		if thread.TargetID != "behavior-1" {
+			t.Errorf("TargetID = %q, want behavior-1", thread.TargetID)
+		}
+		if repo.createdAnchor.FilePath != "refund_test.go" || repo.createdAnchor.TestName != "refunds order" {
+			t.Errorf("anchor = %+v, want refund_test.go/refunds order", repo.createdAnchor)
+		}
+		if repo.createdBody != "Is this really what we want? @octocat" {
+			t.Errorf("body = %q, want trimmed body", repo.createdBody)
+		}
+		if !reflect.DeepEqual(repo.createdMentions, []string{"octocat"}) {
+			t.Errorf("mentions = %v, want [octocat]", repo.createdMentions)
+		}
+	})
+}
+
+func TestGetSpecCommentsUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrInvalidDocumentID for malformed ID", func(t *testing.T) {
+		_, err := NewGetSpecCommentsUseCase(&mockCommentRepository{}, nil).Execute(context.Background(), GetSpecCommentsInput{DocumentID: "doc", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrInvalidDocumentID) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidDocumentID)
+		}
+	})
+
+	t.Run("lists threads whose target exists in the document", func(t *testing.T) {
+		comments := []entity.Comment{{ID: "comment-1"}}
+		repo := &mockCommentRepository{
+			document: newCommentDocument(),
+			threads: []entity.CommentThread{
+				{ID: "carried", Comments: comments, Anchor: entity.CommentAnchor{Type: entity.CommentTargetBehavior, FilePath: "refund_test.go", TestName: "refunds order"}},
+				{ID: "removed", Comments: comments, Anchor: entity.CommentAnchor{Type: entity.CommentTargetFeature, DomainName: "Payments", FeatureName: "Chargebacks"}},
+			},
+		}
+
+		threads, err := NewGetSpecCommentsUseCase(repo, nil).Execute(context.Background(), GetSpecCommentsInput{DocumentID: testDocumentID, UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if len(threads) != 1 || threads[0].ID != "carried" || threads[0].TargetID != "behavior-1" {
+			t.Errorf("threads = %+v, want only carried on behavior-1", threads)
+		}
+	})
+}
+
+func TestResolveCommentThreadUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrCommentThreadNotFound for thread of another user", func(t *testing.T) {
+		err := NewResolveCommentThreadUseCase(&mockCommentRepository{}, nil).Execute(context.Background(), ResolveCommentThreadInput{Resolved: true, ThreadID: "thread-1", UserID: "user-2"})
+		if !errors.Is(err, domain.ErrCommentThreadNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrCommentThreadNotFound)
+		}
+	})
+
+	t.Run("reopens thread", func(t *testing.T) {
+		repo := &mockCommentRepository{updated: true}
+		err := NewResolveCommentThreadUseCase(repo, nil).Execute(context.Background(), ResolveCommentThreadInput{ThreadID: "thread-1", UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if repo.resolved == nil || *repo.resolved {
+			t.Errorf("resolved = %v, want false", repo.resolved)
+		}
+	})
+}
+
+func TestDeleteCommentUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrCommentNotFound when nothing was deleted", func(t *testing.T) {
+		err := NewDeleteCommentUseCase(&mockCommentRepository{}, nil).Execute(context.Background(), DeleteCommentInput{CommentID: "comment-1", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrCommentNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrCommentNotFound)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type CreateShareLinkInput struct {
+	DocumentID string
+	// ExpiresIn of zero creates a link that never expires.
+	ExpiresIn time.Duration
+	// Latest shares the newest document of the repository and language
+	// instead of the given version.
+	Latest bool
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the document must be the workspace's and the user an editor or owner.
+	WorkspaceID string
+}
+
+type CreateShareLinkOutput struct {
+	ShareLink *entity.ShareLink
+	// Token is the raw secret. It is only available at creation time.
+	Token string
+}
+
+// CreateShareLinkUseCase creates a link through which anyone holding its token
+// can read one of the user's spec documents without signing in.
+type CreateShareLinkUseCase struct {
+	shareLinks port.SpecShareLinkRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewCreateShareLinkUseCase(shareLinks port.SpecShareLinkRepository, workspaces port.WorkspaceAccess) *CreateShareLinkUseCase {
+	return &CreateShareLinkUseCase{shareLinks: shareLinks, workspaces: workspaces}
+}
+
+func (uc *CreateShareLinkUseCase) Execute(ctx context.Context, input CreateShareLinkInput) (*CreateShareLinkOutput, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if !entity.IsValidDocumentID(input.DocumentID) {
+		return nil, domain.ErrInvalidDocumentID
+	}
+
+	if input.ExpiresIn < 0 || input.ExpiresIn > entity.MaxShareLinkExpiry {
+		return nil, domain.ErrInvalidShareLinkExpiry
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := entity.NewShareToken()
+	if err != nil {
+		return nil, err
+	}
+
+	params := port.CreateShareLinkParams{
+		Access:     access,
+		DocumentID: input.DocumentID,
+		Latest:     input.Latest,
+		Token:      token,
+	}
+	if input.ExpiresIn > 0 {
+		expiresAt := time.Now().Add(input.ExpiresIn)
+		params.ExpiresAt = &expiresAt
+	}
+
+	link, err := uc.shareLinks.CreateShareLink(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateShareLinkOutput{ShareLink: link, Token: token.Token}, nil
+}
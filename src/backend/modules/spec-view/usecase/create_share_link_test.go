@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+func TestCreateShareLinkUseCase_Execute(t *testing.T) {
+	t.Run("creates a link with a fresh token", func(t *testing.T) {
+		shares := &mockShareLinkRepository{}
+
+		output, err := NewCreateShareLinkUseCase(shares, nil).Execute(context.Background(), CreateShareLinkInput{
+			DocumentID: testDocumentID,
+			ExpiresIn:  7 * 24 * time.Hour,
+			Latest:     true,
+			UserID:     "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		params := shares.createdParams
+		if !strings.HasPrefix(output.Token, entity.ShareTokenPrefix) {
+			t.Errorf("token %q lacks prefix %q", output.Token, entity.ShareTokenPrefix)
+		}
+		if params.Token.Hash != entity.HashShareToken(output.Token) {
+			t.Error("stored hash does not match the returned token")
+		}
+		if !params.Latest || params.DocumentID != testDocumentID || params.Access.UserID != "user-1" {
+			t.Errorf("params = %+v", params)
+		}
+		if params.ExpiresAt == nil || time.Until(*params.ExpiresAt) < 6*24*time.Hour {
+			t.Errorf("ExpiresAt = %v, want about a week from now", params.ExpiresAt)
+		}
+	})
+
+	t.Run("zero expiry creates a link that never expires", func(t *testing.T) {
+		shares := &mockShareLinkRepository{}
+
+		if _, err := NewCreateShareLinkUseCase(shares, nil).Execute(context.Background(), CreateShareLinkInput{
+			DocumentID: testDocumentID,
+			UserID:     "user-1",
+		}); err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if shares.createdParams.ExpiresAt != nil {
+			t.Errorf("ExpiresAt = %v, want nil", shares.createdParams.ExpiresAt)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  CreateShareLinkInput
+		target error
+	}{
+		{
+			name:   "anonymous user",
+			input:  CreateShareLinkInput{DocumentID: testDocumentID},
+			target: domain.ErrUnauthorized,
+		},
+		{
+			name:   "invalid document ID",
+			input:  CreateShareLinkInput{DocumentID: "doc-1", UserID: "user-1"},
+			target: domain.ErrInvalidDocumentID,
+		},
+		{
+			name:   "expiry beyond the maximum",
+			input:  CreateShareLinkInput{DocumentID: testDocumentID, ExpiresIn: entity.MaxShareLinkExpiry + time.Hour, UserID: "user-1"},
+			target: domain.ErrInvalidShareLinkExpiry,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares := &mockShareLinkRepository{}
+
+			_, err := NewCreateShareLinkUseCase(shares, nil).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.target)
+			}
+			if shares.createdParams != nil {
+				t.Error("link created for rejected request")
+			}
+		})
+	}
+}
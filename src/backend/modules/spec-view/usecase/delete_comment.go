@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type DeleteCommentInput struct {
+	CommentID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the comment must be on a workspace thread and the user an editor or owner.
+	WorkspaceID string
+}
+
+// DeleteCommentUseCase deletes a comment written by the user. Deleting the
+// last comment of a thread deletes the thread.
+type DeleteCommentUseCase struct {
+	comments   port.SpecCommentRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewDeleteCommentUseCase(comments port.SpecCommentRepository, workspaces port.WorkspaceAccess) *DeleteCommentUseCase {
+	return &DeleteCommentUseCase{comments: comments, workspaces: workspaces}
+}
+
+func (uc *DeleteCommentUseCase) Execute(ctx context.Context, input DeleteCommentInput) error {
+	if input.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	if input.CommentID == "" {
+		return domain.ErrCommentNotFound
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := uc.comments.DeleteComment(ctx, access, input.CommentID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrCommentNotFound
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type EditBehaviorInput struct {
+	BehaviorID string
+	// Description replaces the generated description. Optional if Hidden is set.
+	Description *string
+	Hidden      bool
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the behavior must be in the workspace's documents
+	// and the user must be an editor or owner of the workspace.
+	WorkspaceID string
+}
+
+// EditBehaviorUseCase saves a correction for a generated behavior. The edit
+// applies to every version of the repository's spec in the document language.
+type EditBehaviorUseCase struct {
+	edits      port.SpecEditRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewEditBehaviorUseCase(edits port.SpecEditRepository, workspaces port.WorkspaceAccess) *EditBehaviorUseCase {
+	return &EditBehaviorUseCase{edits: edits, workspaces: workspaces}
+}
+
+func (uc *EditBehaviorUseCase) Execute(ctx context.Context, input EditBehaviorInput) (*entity.BehaviorEdit, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.BehaviorID == "" {
+		return nil, domain.ErrBehaviorNotFound
+	}
+
+	var description *string
+	if input.Description != nil {
+		text, ok := entity.NormalizeEditText(*input.Description, entity.MaxEditedDescriptionLength)
+		if !ok {
+			return nil, domain.ErrInvalidSpecEdit
+		}
+		description = &text
+	}
+	if description == nil && !input.Hidden {
+		return nil, domain.ErrInvalidSpecEdit
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := uc.edits.GetBehaviorEditTarget(ctx, access, input.BehaviorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.edits.SaveBehaviorEdit(ctx, target.Scope, entity.BehaviorEdit{
+		Description:      description,
+		FilePath:         target.FilePath,
+		Hidden:           input.Hidden,
+		SourceTestCaseID: target.SourceTestCaseID,
+		TestName:         target.TestName,
+	})
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+type mockEditRepository struct {
+	behaviorTarget *entity.BehaviorEditTarget
+	featureTarget  *entity.FeatureEditTarget
+	deleted        bool
+	err            error
+
+	access        entity.DocumentAccess
+	savedBehavior *entity.BehaviorEdit
+	savedFeature  *entity.FeatureEdit
+	savedScope    entity.SpecEditScope
+}
+
+func (m *mockEditRepository) DeleteBehaviorEdit(_ context.Context, _ entity.BehaviorEditTarget) (bool, error) {
+	return m.deleted, m.err
+}
+
+func (m *mockEditRepository) DeleteFeatureEdit(_ context.Context, _ entity.FeatureEditTarget) (bool, error) {
+	return m.deleted, m.err
+}
+
+func (m *mockEditRepository) GetBehaviorEditTarget(_ context.Context, access entity.DocumentAccess, _ string) (*entity.BehaviorEditTarget, error) {
+	m.access = access
+	if m.behaviorTarget == nil {
+		return nil, domain.ErrBehaviorNotFound
+	}
+	return m.behaviorTarget, m.err
+}
+
+func (m *mockEditRepository) GetFeatureEditTarget(_ context.Context, access entity.DocumentAccess, _ string) (*entity.FeatureEditTarget, error) {
+	m.access = access
+	if m.featureTarget == nil {
+		return nil, domain.ErrFeatureNotFound
+	}
+	return m.featureTarget, m.err
+}
+
+func (m *mockEditRepository) SaveBehaviorEdit(_ context.Context, scope entity.SpecEditScope, edit entity.BehaviorEdit) (*entity.BehaviorEdit, error) {
+	m.savedScope = scope
+	m.savedBehavior = &edit
+	return &edit, m.err
+}
+
+func (m *mockEditRepository) SaveFeatureEdit(_ context.Context, scope entity.SpecEditScope, edit entity.FeatureEdit) (*entity.FeatureEdit, error) {
+	m.savedScope = scope
+	m.savedFeature = &edit
+	return &edit, m.err
+}
+
+func newBehaviorEditTarget() *entity.BehaviorEditTarget {
+	return &entity.BehaviorEditTarget{
+		FilePath: "refund_test.go",
+		Scope:    entity.SpecEditScope{CodebaseID: "codebase-1", Language: "English", UserID: "user-1"},
+		TestName: "refunds order",
+	}
+}
+
+func TestEditBehaviorUseCase_Execute(t *testing.T) {
+	description := "  Issues a full refund  "
+
+	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
+		uc := NewEditBehaviorUseCase(&mockEditRepository{}, nil)
+		_, err := uc.Execute(context.Background(), EditBehaviorInput{BehaviorID: "b-1", Hidden: true})
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrUnauthorized)
+		}
+	})
+
+	t.Run("returns ErrInvalidSpecEdit without description or hidden", func(t *testing.T) {
+		uc := NewEditBehaviorUseCase(&mockEditRepository{behaviorTarget: newBehaviorEditTarget()}, nil)
+		_, err := uc.Execute(context.Background(), EditBehaviorInput{BehaviorID: "b-1", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrInvalidSpecEdit) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidSpecEdit)
+		}
+	})
+
+	t.Run("returns ErrInvalidSpecEdit for blank description", func(t *testing.T) {
+		blank := "  "
+		uc := NewEditBehaviorUseCase(&mockEditRepository{behaviorTarget: newBehaviorEditTarget()}, nil)
+		_, err := uc.Execute(context.Background(), EditBehaviorInput{BehaviorID: "b-1", Description: &blank, UserID: "user-1"})
+		if !errors.Is(err, domain.ErrInvalidSpecEdit) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidSpecEdit)
+		}
+	})
+
+	t.Run("returns ErrBehaviorNotFound for behavior of another user", func(t *testing.T) {
+		uc := NewEditBehaviorUseCase(&mockEditRepository{}, nil)
+		_, err := uc.Execute(context.Background(), EditBehaviorInput{BehaviorID: "b-1", Hidden: true, UserID: "user-2"})
+		if !errors.Is(err, domain.ErrBehaviorNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrBehaviorNotFound)
+		}
+	})
+
+	t.Run("saves trimmed description keyed by test", func(t *testing.T) {
+		repo := &mockEditRepository{behaviorTarget: newBehaviorEditTarget()}
+		uc := NewEditBehaviorUseCase(repo, nil)
+
+		_, err := uc.Execute(context.Background(), EditBehaviorInput{BehaviorID: "b-1", Description: &description, UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		saved := repo.savedBehavior
+		if saved == nil || saved.Description == nil || *saved.Description != "Issues a full refund" {
+			t.Fatalf("saved = %+v, want trimmed description", saved)
+		}
+		if saved.FilePath != "refund_test.go" || saved.TestName != "refunds order" {
+			t.Errorf("saved key = %q/%q, want refund_test.go/refunds order", saved.FilePath, saved.TestName)
+		}
+		if repo.savedScope.CodebaseID != "codebase-1" || repo.savedScope.Language != "English" {
+			t.Errorf("scope = %+v, want codebase-1/English", repo.savedScope)
+		}
+	})
+}
+
+func TestRevertBehaviorEditUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrEditNotFound when behavior has no edit", func(t *testing.T) {
+		uc := NewRevertBehaviorEditUseCase(&mockEditRepository{behaviorTarget: newBehaviorEditTarget()}, nil)
+		err := uc.Execute(context.Background(), RevertBehaviorEditInput{BehaviorID: "b-1", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrEditNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrEditNotFound)
+		}
+	})
+
+	t.Run("deletes edit", func(t *testing.T) {
+		uc := NewRevertBehaviorEditUseCase(&mockEditRepository{behaviorTarget: newBehaviorEditTarget(), deleted: true}, nil)
+		if err := uc.Execute(context.Background(), RevertBehaviorEditInput{BehaviorID: "b-1", UserID: "user-1"}); err != nil {
+			t.Errorf("Execute() error = %v", err)
+		}
+	})
+}
+
+// editStore keeps saved behavior edits per scope the way the repository does:
+// a workspace's edits are shared, a user's own edits are not.
+type editStore struct {
+	mockRepository
+	edits map[string][]entity.BehaviorEdit
+}
+
+func editStoreKey(userID, workspaceID string) string {
+	if workspaceID != "" {
+		return "workspace:" + workspaceID
+	}
+	return "user:" + userID
+}
+
+func (s *editStore) GetSpecEdits(_ context.Context, access entity.DocumentAccess, _, _ string) (*entity.SpecEdits, error) {
+	return &entity.SpecEdits{Behaviors: s.edits[editStoreKey(access.UserID, access.WorkspaceID)]}, nil
+}
+
+func (s *editStore) DeleteBehaviorEdit(_ context.Context, _ entity.BehaviorEditTarget) (bool, error) {
+	return false, nil
+}
+
+func (s *editStore) DeleteFeatureEdit(_ context.Context, _ entity.FeatureEditTarget) (bool, error) {
+	return false, nil
+}
+
+func (s *editStore) GetBehaviorEditTarget(_ context.Context, access entity.DocumentAccess, _ string) (*entity.BehaviorEditTarget, error) {
+	target := newBehaviorEditTarget()
+	target.Scope.UserID = access.UserID
+	target.Scope.WorkspaceID = access.WorkspaceID
+	return target, nil
+}
+
+func (s *editStore) GetFeatureEditTarget(_ context.Context, _ entity.DocumentAccess, _ string) (*entity.FeatureEditTarget, error) {
+	return nil, domain.ErrFeatureNotFound
+}
+
+func (s *editStore) SaveBehaviorEdit(_ context.Context, scope entity.SpecEditScope, edit entity.BehaviorEdit) (*entity.BehaviorEdit, error) {
+	key := editStoreKey(scope.UserID, scope.WorkspaceID)
+	s.edits[key] = append(s.edits[key], edit)
+	return &edit, nil
+}
+
+func (s *editStore) SaveFeatureEdit(_ context.Context, _ entity.SpecEditScope, edit entity.FeatureEdit) (*entity.FeatureEdit, error) {
+	return &edit, nil
+}
+
+func TestWorkspaceSpecEdits_SharedByMembers(t *testing.T) {
+	description := "Issues a full refund"
+	store := &editStore{edits: map[string][]entity.BehaviorEdit{}}
+	editor := &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-id", CanGenerate: true}}
+	viewer := &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-id"}}
+
+	_, err := NewEditBehaviorUseCase(store, editor).Execute(context.Background(), EditBehaviorInput{
+		BehaviorID: "b-1", Description: &description, UserID: "editor-id", WorkspaceID: testWorkspaceID,
+	})
+	if err != nil {
+		t.Fatalf("edit error = %v", err)
+	}
+
+	t.Run("another member reads the edit", func(t *testing.T) {
+		edits, err := NewGetSpecEditsUseCase(store, viewer).Execute(context.Background(), GetSpecEditsInput{
+			AnalysisID: "analysis-1", Language: "English", UserID: "viewer-id", WorkspaceID: testWorkspaceID,
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if len(edits.Behaviors) != 1 || *edits.Behaviors[0].Description != description {
+			t.Errorf("behaviors = %+v, want the editor's edit", edits.Behaviors)
+		}
+	})
+
+	t.Run("the edit stays out of the member's own documents", func(t *testing.T) {
+		edits, err := NewGetSpecEditsUseCase(store, nil).Execute(context.Background(), GetSpecEditsInput{
+			AnalysisID: "analysis-1", Language: "English", UserID: "editor-id",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !edits.IsEmpty() {
+			t.Errorf("behaviors = %+v, want none", edits.Behaviors)
+		}
+	})
+
+	t.Run("viewers cannot edit", func(t *testing.T) {
+		_, err := NewEditBehaviorUseCase(store, viewer).Execute(context.Background(), EditBehaviorInput{
+			BehaviorID: "b-1", Hidden: true, UserID: "viewer-id", WorkspaceID: testWorkspaceID,
+		})
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrForbidden)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type EditFeatureInput struct {
+	FeatureID string
+	// Name is the new feature name. Using the name of another feature in the
+	// same domain merges the two.
+	Name string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the feature must be in the workspace's documents
+	// and the user must be an editor or owner of the workspace.
+	WorkspaceID string
+}
+
+// EditFeatureUseCase renames or merges a generated feature. The edit applies
+// to every version of the repository's spec that has a feature with the same
+// domain and feature name.
+type EditFeatureUseCase struct {
+	edits      port.SpecEditRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewEditFeatureUseCase(edits port.SpecEditRepository, workspaces port.WorkspaceAccess) *EditFeatureUseCase {
+	return &EditFeatureUseCase{edits: edits, workspaces: workspaces}
+}
+
+func (uc *EditFeatureUseCase) Execute(ctx context.Context, input EditFeatureInput) (*entity.FeatureEdit, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.FeatureID == "" {
+		return nil, domain.ErrFeatureNotFound
+	}
+
+	name, ok := entity.NormalizeEditText(input.Name, entity.MaxFeatureNameLength)
+	if !ok {
+		return nil, domain.ErrInvalidSpecEdit
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := uc.edits.GetFeatureEditTarget(ctx, access, input.FeatureID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.edits.SaveFeatureEdit(ctx, target.Scope, entity.FeatureEdit{
+		DomainName:  target.DomainName,
+		FeatureName: target.FeatureName,
+		NewName:     name,
+	})
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+func newFeatureEditTarget() *entity.FeatureEditTarget {
+	return &entity.FeatureEditTarget{
+		DomainName:  "Payments",
+		FeatureName: "Chargebacks",
+		Scope:       entity.SpecEditScope{CodebaseID: "codebase-1", Language: "English", UserID: "user-1"},
+	}
+}
+
+func TestEditFeatureUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
+		uc := NewEditFeatureUseCase(&mockEditRepository{}, nil)
+		_, err := uc.Execute(context.Background(), EditFeatureInput{FeatureID: "f-1", Name: "Disputes"})
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrUnauthorized)
+		}
+	})
+
+	t.Run("returns ErrInvalidSpecEdit for too long name", func(t *testing.T) {
+		uc := NewEditFeatureUseCase(&mockEditRepository{featureTarget: newFeatureEditTarget()}, nil)
+		name := strings.Repeat("a", entity.MaxFeatureNameLength+1)
+		_, err := uc.Execute(context.Background(), EditFeatureInput{FeatureID: "f-1", Name: name, UserID: "user-1"})
+		if !errors.Is(err, domain.ErrInvalidSpecEdit) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidSpecEdit)
+		}
+	})
+
+	t.Run("returns ErrFeatureNotFound for feature of another user", func(t *testing.T) {
+		uc := NewEditFeatureUseCase(&mockEditRepository{}, nil)
+		_, err := uc.Execute(context.Background(), EditFeatureInput{FeatureID: "f-1", Name: "Disputes", UserID: "user-2"})
+		if !errors.Is(err, domain.ErrFeatureNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrFeatureNotFound)
+		}
+	})
+
+	t.Run("saves rename keyed by generated name", func(t *testing.T) {
+		repo := &mockEditRepository{featureTarget: newFeatureEditTarget()}
+		uc := NewEditFeatureUseCase(repo, nil)
+
+		_, err := uc.Execute(context.Background(), EditFeatureInput{FeatureID: "f-1", Name: " Disputes ", UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		saved := repo.savedFeature
+		if saved == nil || saved.NewName != "Disputes" || saved.DomainName != "Payments" || saved.FeatureName != "Chargebacks" {
+			t.Errorf("saved = %+v, want Payments/Chargebacks renamed to Disputes", saved)
+		}
+	})
+}
+
+func TestRevertFeatureEditUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrEditNotFound when feature has no edit", func(t *testing.T) {
+		uc := NewRevertFeatureEditUseCase(&mockEditRepository{featureTarget: newFeatureEditTarget()}, nil)
+		err := uc.Execute(context.Background(), RevertFeatureEditInput{FeatureID: "f-1", UserID: "user-1"})
+		if !errors.Is(err, domain.ErrEditNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrEditNotFound)
+		}
+	})
+}
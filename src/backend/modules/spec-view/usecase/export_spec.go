@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+// ExportSpecOutput is a rendered spec document ready to be served as a download.
+type ExportSpecOutput struct {
+	Content     []byte
+	ContentType string
+	Filename    string
+}
+
+// specExporter renders documents shared by the analysis- and repository-based export use cases.
+type specExporter struct {
+	renderers map[entity.ExportFormat]port.SpecRenderer
+	sources   port.AnalysisSourceReader
+}
+
+// renderer resolves format before any document is loaded so invalid requests fail fast.
+func (e specExporter) renderer(format entity.ExportFormat) (port.SpecRenderer, error) {
+	if format == "" {
+		format = entity.DefaultExportFormat
+	}
+	renderer, ok := e.renderers[format]
+	if !ok {
+		return nil, domain.ErrInvalidExportFormat
+	}
+	return renderer, nil
+}
+
+func (e specExporter) render(ctx context.Context, renderer port.SpecRenderer, analysisID string, doc *entity.ExportDocument) (*ExportSpecOutput, error) {
+	source, err := e.sources.GetAnalysisSource(ctx, analysisID)
+	if err != nil {
+		return nil, err
+	}
+	doc.Source = *source
+
+	content, err := renderer.Render(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportSpecOutput{
+		Content:     content,
+		ContentType: renderer.ContentType(),
+		Filename:    doc.Filename(renderer.FileExtension()),
+	}, nil
+}
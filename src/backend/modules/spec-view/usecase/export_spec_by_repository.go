@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type ExportSpecByRepositoryInput struct {
+	// DocumentID is optional. If set, exports the specific document (takes precedence over Version).
+	DocumentID string
+	// Format is optional. If empty, uses entity.DefaultExportFormat.
+	Format entity.ExportFormat
+	// Language is optional. If empty, falls back to any available language.
+	Language string
+	// Name is the repository name. Required.
+	Name string
+	// Owner is the repository owner. Required.
+	Owner       string
+	SourceLinks bool
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// Version is optional. If 0, exports the latest version.
+	Version int
+}
+
+type ExportSpecByRepositoryUseCase struct {
+	exporter            specExporter
+	getSpecByRepository *GetSpecByRepositoryUseCase
+}
+
+func NewExportSpecByRepositoryUseCase(
+	getSpecByRepository *GetSpecByRepositoryUseCase,
+	sources port.AnalysisSourceReader,
+	renderers map[entity.ExportFormat]port.SpecRenderer,
+) *ExportSpecByRepositoryUseCase {
+	return &ExportSpecByRepositoryUseCase{
+		exporter:            specExporter{renderers: renderers, sources: sources},
+		getSpecByRepository: getSpecByRepository,
+	}
+}
+
+func (uc *ExportSpecByRepositoryUseCase) Execute(ctx context.Context, input ExportSpecByRepositoryInput) (*ExportSpecOutput, error) {
+	renderer, err := uc.exporter.renderer(input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := uc.getSpecByRepository.Execute(ctx, GetSpecByRepositoryInput{
+		DocumentID: input.DocumentID,
+		Language:   input.Language,
+		Name:       input.Name,
+		Owner:      input.Owner,
+		UserID:     input.UserID,
+		Version:    input.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	doc := result.Document
+
+	return uc.exporter.render(ctx, renderer, doc.AnalysisID, &entity.ExportDocument{
+		CreatedAt:        doc.CreatedAt,
+		Domains:          doc.Domains,
+		ExecutiveSummary: doc.ExecutiveSummary,
+		Language:         doc.Language,
+		ModelID:          doc.ModelID,
+		SourceLinks:      input.SourceLinks,
+		Version:          doc.Version,
+	})
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type ExportSpecDocumentInput struct {
+	AnalysisID string
+	// Format is optional. If empty, uses entity.DefaultExportFormat.
+	Format entity.ExportFormat
+	// Language is optional. If empty, exports the most recent document.
+	Language    string
+	SourceLinks bool
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// Version is optional. If 0, exports the latest version. Requires Language to be set.
+	Version int
+	// WorkspaceID is optional. If set, exports the workspace's document; the user must be a member.
+	WorkspaceID string
+}
+
+type ExportSpecDocumentUseCase struct {
+	documents  specDocumentReader
+	exporter   specExporter
+	repo       port.SpecViewRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewExportSpecDocumentUseCase(
+	repo port.SpecViewRepository,
+	sources port.AnalysisSourceReader,
+	renderers map[entity.ExportFormat]port.SpecRenderer,
+	workspaces port.WorkspaceAccess,
+	workspaceSpecs port.WorkspaceSpecRepository,
+) *ExportSpecDocumentUseCase {
+	return &ExportSpecDocumentUseCase{
+		documents:  specDocumentReader{repo: repo, workspaceSpecs: workspaceSpecs},
+		exporter:   specExporter{renderers: renderers, sources: sources},
+		repo:       repo,
+		workspaces: workspaces,
+	}
+}
+
+// Execute exports the stored document even while a regeneration is running,
+// unlike GetSpecDocumentUseCase which reports the generation status instead.
+func (uc *ExportSpecDocumentUseCase) Execute(ctx context.Context, input ExportSpecDocumentInput) (*ExportSpecOutput, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.AnalysisID == "" {
+		return nil, domain.ErrInvalidAnalysisID
+	}
+
+	if input.Language != "" || input.Version > 0 {
+		if !entity.IsValidLanguage(input.Language) {
+			return nil, domain.ErrInvalidLanguage
+		}
+	}
+
+	renderer, err := uc.exporter.renderer(input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc *entity.SpecDocument
+	if input.Version > 0 {
+		doc, err = uc.documents.byVersion(ctx, access, input.AnalysisID, input.Language, input.Version)
+	} else {
+		doc, err = uc.documents.latest(ctx, access, input.AnalysisID, input.Language)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, domain.ErrDocumentNotFound
+	}
+
+	doc.Domains, err = applySpecEdits(ctx, uc.repo, access, doc.AnalysisID, doc.Language, doc.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.exporter.render(ctx, renderer, doc.AnalysisID, &entity.ExportDocument{
+		CreatedAt:        doc.CreatedAt,
+		Domains:          doc.Domains,
+		ExecutiveSummary: doc.ExecutiveSummary,
+		Language:         doc.Language,
+		ModelID:          doc.ModelID,
+		SourceLinks:      input.SourceLinks,
+		Version:          doc.Version,
+	})
+}
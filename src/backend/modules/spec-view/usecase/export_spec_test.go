@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type mockSourceReader struct {
+	source *entity.AnalysisSource
+	err    error
+
+	calledAnalysisID string
+}
+
+func (m *mockSourceReader) GetAnalysisSource(_ context.Context, analysisID string) (*entity.AnalysisSource, error) {
+	m.calledAnalysisID = analysisID
+	return m.source, m.err
+}
+
+type mockRenderer struct {
+	rendered *entity.ExportDocument
+}
+
+func (m *mockRenderer) ContentType() string   { return "text/plain" }
+func (m *mockRenderer) FileExtension() string { return "txt" }
+
+func (m *mockRenderer) Render(doc *entity.ExportDocument) ([]byte, error) {
+	m.rendered = doc
+	return []byte("rendered"), nil
+}
+
+func newTestSource() *entity.AnalysisSource {
+	return &entity.AnalysisSource{CommitSHA: "abc123", Host: "github.com", Name: "hello", Owner: "octocat"}
+}
+
+func TestExportSpecDocumentUseCase_Execute(t *testing.T) {
+	doc := &entity.SpecDocument{
+		AnalysisID: "analysis-1",
+		CreatedAt:  time.Now(),
+		ID:         "doc-1",
+		Language:   "English",
+		Version:    2,
+	}
+
+	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
+		uc := NewExportSpecDocumentUseCase(&mockRepository{}, &mockSourceReader{}, nil, nil, nil)
+		_, err := uc.Execute(context.Background(), ExportSpecDocumentInput{AnalysisID: "analysis-1"})
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrUnauthorized)
+		}
+	})
+
+	t.Run("returns ErrInvalidExportFormat for unknown format", func(t *testing.T) {
+		uc := NewExportSpecDocumentUseCase(&mockRepository{document: doc}, &mockSourceReader{source: newTestSource()},
+			map[entity.ExportFormat]port.SpecRenderer{entity.ExportFormatMarkdown: &mockRenderer{}}, nil, nil)
+		_, err := uc.Execute(context.Background(), ExportSpecDocumentInput{
+			AnalysisID: "analysis-1",
+			Format:     "pdf",
+			UserID:     "user-1",
+		})
+		if !errors.Is(err, domain.ErrInvalidExportFormat) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidExportFormat)
+		}
+	})
+
+	t.Run("returns ErrInvalidLanguage for unsupported language", func(t *testing.T) {
+		uc := NewExportSpecDocumentUseCase(&mockRepository{document: doc}, &mockSourceReader{}, nil, nil, nil)
+		_, err := uc.Execute(context.Background(), ExportSpecDocumentInput{
+			AnalysisID: "analysis-1",
+			Language:   "Klingon",
+			UserID:     "user-1",
+		})
+		if !errors.Is(err, domain.ErrInvalidLanguage) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidLanguage)
+		}
+	})
+
+	t.Run("returns ErrDocumentNotFound when no document exists", func(t *testing.T) {
+		uc := NewExportSpecDocumentUseCase(&mockRepository{}, &mockSourceReader{},
+			map[entity.ExportFormat]port.SpecRenderer{entity.ExportFormatMarkdown: &mockRenderer{}}, nil, nil)
+		_, err := uc.Execute(context.Background(), ExportSpecDocumentInput{
+			AnalysisID: "analysis-1",
+			UserID:     "user-1",
+		})
+		if !errors.Is(err, domain.ErrDocumentNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrDocumentNotFound)
+		}
+	})
+
+	t.Run("exports document even while generation is running", func(t *testing.T) {
+		renderer := &mockRenderer{}
+		sources := &mockSourceReader{source: newTestSource()}
+		repo := &mockRepository{
+			document: doc,
+			status:   &entity.SpecGenerationStatus{Status: entity.StatusRunning},
+		}
+		uc := NewExportSpecDocumentUseCase(repo, sources,
+			map[entity.ExportFormat]port.SpecRenderer{entity.ExportFormatMarkdown: renderer}, nil, nil)
+
+		result, err := uc.Execute(context.Background(), ExportSpecDocumentInput{
+			AnalysisID:  "analysis-1",
+			SourceLinks: true,
+			UserID:      "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if string(result.Content) != "rendered" || result.ContentType != "text/plain" {
+			t.Errorf("Execute() = %q (%s), want renderer output", result.Content, result.ContentType)
+		}
+		if result.Filename != "octocat-hello-spec-english-v2.txt" {
+			t.Errorf("Execute() Filename = %q", result.Filename)
+		}
+		if sources.calledAnalysisID != "analysis-1" {
+			t.Errorf("GetAnalysisSource called with %q, want %q", sources.calledAnalysisID, "analysis-1")
+		}
+		if !renderer.rendered.SourceLinks || renderer.rendered.Source.CommitSHA != "abc123" {
+			t.Errorf("renderer received %+v, want source links at the analysis commit", renderer.rendered)
+		}
+	})
+
+	t.Run("propagates source lookup errors", func(t *testing.T) {
+		uc := NewExportSpecDocumentUseCase(&mockRepository{document: doc}, &mockSourceReader{err: domain.ErrAnalysisNotFound},
+			map[entity.ExportFormat]port.SpecRenderer{entity.ExportFormatMarkdown: &mockRenderer{}}, nil, nil)
+		_, err := uc.Execute(context.Background(), ExportSpecDocumentInput{
+			AnalysisID: "analysis-1",
+			UserID:     "user-1",
+		})
+		if !errors.Is(err, domain.ErrAnalysisNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrAnalysisNotFound)
+		}
+	})
+}
+
+func TestExportSpecByRepositoryUseCase_Execute(t *testing.T) {
+	t.Run("exports the document of the resolved analysis", func(t *testing.T) {
+		repo := &repoMockRepository{
+			codebaseExists: true,
+			repoDocument: &entity.RepoSpecDocument{
+				AnalysisID: "analysis-7",
+				CommitSHA:  "abc123",
+				ID:         "doc-1",
+				Language:   "Korean",
+				Version:    1,
+			},
+		}
+		sources := &mockSourceReader{source: newTestSource()}
+		uc := NewExportSpecByRepositoryUseCase(NewGetSpecByRepositoryUseCase(repo), sources,
+			map[entity.ExportFormat]port.SpecRenderer{entity.ExportFormatMarkdown: &mockRenderer{}})
+
+		result, err := uc.Execute(context.Background(), ExportSpecByRepositoryInput{
+			Language: "Korean",
+			Name:     "hello",
+			Owner:    "octocat",
+			UserID:   "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if sources.calledAnalysisID != "analysis-7" {
+			t.Errorf("GetAnalysisSource called with %q, want %q", sources.calledAnalysisID, "analysis-7")
+		}
+		if result.Filename != "octocat-hello-spec-korean-v1.txt" {
+			t.Errorf("Execute() Filename = %q", result.Filename)
+		}
+	})
+
+	t.Run("returns ErrCodebaseNotFound for unknown repository", func(t *testing.T) {
+		uc := NewExportSpecByRepositoryUseCase(NewGetSpecByRepositoryUseCase(&repoMockRepository{}), &mockSourceReader{},
+			map[entity.ExportFormat]port.SpecRenderer{entity.ExportFormatMarkdown: &mockRenderer{}})
+		_, err := uc.Execute(context.Background(), ExportSpecByRepositoryInput{
+			Name:   "hello",
+			Owner:  "octocat",
+			UserID: "user-1",
+		})
+		if !errors.Is(err, domain.ErrCodebaseNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrCodebaseNotFound)
+		}
+	})
+}
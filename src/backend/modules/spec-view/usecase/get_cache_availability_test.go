@@ -44,6 +44,10 @@ func (m *mockCacheAvailabilityRepository) GetSpecDocumentByUser(_ context.Contex
 	return nil, nil
 }
 
+func (m *mockCacheAvailabilityRepository) GetActiveGenerationJobID(_ context.Context, _, _, _ string) (int64, error) {
+	return 0, nil
+}
+
 func (m *mockCacheAvailabilityRepository) GetGenerationStatus(_ context.Context, _ string, _ string) (*entity.SpecGenerationStatus, error) {
 	return nil, nil
 }
@@ -88,6 +92,9 @@ func (m *mockCacheAvailabilityRepository) GetSpecDocumentByRepositoryAndVersion(
 func (m *mockCacheAvailabilityRepository) GetSpecDocumentByRepositoryAndDocumentId(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocument, error) {
 	return nil, nil
 }
+func (m *mockCacheAvailabilityRepository) GetSpecDocumentRevisionByRepository(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocumentRevision, error) {
+	return nil, nil
+}
 func (m *mockCacheAvailabilityRepository) GetVersionHistoryByRepository(_ context.Context, _, _, _, _ string) ([]entity.RepoVersionInfo, error) {
 	return nil, nil
 }
@@ -101,6 +108,10 @@ func (m *mockCacheAvailabilityRepository) GetCurrentAnalysisTestCount(_ context.
 	return 0, nil
 }
 
+func (m *mockCacheAvailabilityRepository) GetSpecEdits(_ context.Context, _ entity.DocumentAccess, _, _ string) (*entity.SpecEdits, error) {
+	return nil, nil
+}
+
 func TestGetCacheAvailabilityUseCase_Execute(t *testing.T) {
 	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
 		uc := NewGetCacheAvailabilityUseCase(&mockCacheAvailabilityRepository{})
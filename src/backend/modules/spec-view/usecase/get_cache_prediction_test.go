@@ -46,6 +46,10 @@ func (m *mockCachePredictionRepository) GetSpecDocumentByUser(_ context.Context,
 	return nil, nil
 }
 
+func (m *mockCachePredictionRepository) GetActiveGenerationJobID(_ context.Context, _, _, _ string) (int64, error) {
+	return 0, nil
+}
+
 func (m *mockCachePredictionRepository) GetGenerationStatus(_ context.Context, _, _ string) (*entity.SpecGenerationStatus, error) {
 	return nil, nil
 }
@@ -93,6 +97,9 @@ func (m *mockCachePredictionRepository) GetSpecDocumentByRepositoryAndVersion(_
 func (m *mockCachePredictionRepository) GetSpecDocumentByRepositoryAndDocumentId(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocument, error) {
 	return nil, nil
 }
+func (m *mockCachePredictionRepository) GetSpecDocumentRevisionByRepository(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocumentRevision, error) {
+	return nil, nil
+}
 
 func (m *mockCachePredictionRepository) GetVersionHistoryByRepository(_ context.Context, _, _, _, _ string) ([]entity.RepoVersionInfo, error) {
 	return nil, nil
@@ -110,6 +117,10 @@ func (m *mockCachePredictionRepository) GetCurrentAnalysisTestCount(_ context.Co
 	return m.currentTestCount, m.currentTestCountErr
 }
 
+func (m *mockCachePredictionRepository) GetSpecEdits(_ context.Context, _ entity.DocumentAccess, _, _ string) (*entity.SpecEdits, error) {
+	return nil, nil
+}
+
 func TestGetCachePredictionUseCase_Execute(t *testing.T) {
 	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
 		uc := NewGetCachePredictionUseCase(&mockCachePredictionRepository{})
@@ -9,9 +9,10 @@ import (
 )
 
 type GetGenerationStatusInput struct {
-	AnalysisID string
-	Language   string // Optional: if specified, returns status for that specific language
-	UserID     string // Required: user must be authenticated
+	AnalysisID  string
+	Language    string // Optional: if specified, returns status for that specific language
+	UserID      string // Required: user must be authenticated
+	WorkspaceID string // Optional: if specified, returns the workspace's status; user must be a member
 }
 
 type GetGenerationStatusOutput struct {
@@ -19,11 +20,19 @@ type GetGenerationStatusOutput struct {
 }
 
 type GetGenerationStatusUseCase struct {
-	repo port.SpecViewRepository
+	documents  specDocumentReader
+	workspaces port.WorkspaceAccess
 }
 
-func NewGetGenerationStatusUseCase(repo port.SpecViewRepository) *GetGenerationStatusUseCase {
-	return &GetGenerationStatusUseCase{repo: repo}
+func NewGetGenerationStatusUseCase(
+	repo port.SpecViewRepository,
+	workspaces port.WorkspaceAccess,
+	workspaceSpecs port.WorkspaceSpecRepository,
+) *GetGenerationStatusUseCase {
+	return &GetGenerationStatusUseCase{
+		documents:  specDocumentReader{repo: repo, workspaceSpecs: workspaceSpecs},
+		workspaces: workspaces,
+	}
 }
 
 func (uc *GetGenerationStatusUseCase) Execute(ctx context.Context, input GetGenerationStatusInput) (*GetGenerationStatusOutput, error) {
@@ -39,14 +48,12 @@ func (uc *GetGenerationStatusUseCase) Execute(ctx context.Context, input GetGene
 		return nil, domain.ErrInvalidLanguage
 	}
 
-	var status *entity.SpecGenerationStatus
-	var err error
-
-	if input.Language != "" {
-		status, err = uc.repo.GetGenerationStatusByLanguage(ctx, input.UserID, input.AnalysisID, input.Language)
-	} else {
-		status, err = uc.repo.GetGenerationStatus(ctx, input.UserID, input.AnalysisID)
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
 	}
+
+	status, err := uc.documents.generationStatus(ctx, access, input.AnalysisID, input.Language)
 	if err != nil {
 		return nil, err
 	}
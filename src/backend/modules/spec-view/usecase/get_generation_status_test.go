@@ -48,6 +48,10 @@ func (m *mockStatusRepository) GetSpecDocumentByUser(_ context.Context, _ string
 	return nil, nil
 }
 
+func (m *mockStatusRepository) GetActiveGenerationJobID(_ context.Context, _, _, _ string) (int64, error) {
+	return 0, nil
+}
+
 func (m *mockStatusRepository) GetGenerationStatus(_ context.Context, userID string, analysisID string) (*entity.SpecGenerationStatus, error) {
 	m.calledUserID = userID
 	m.calledAnalysisID = analysisID
@@ -97,6 +101,9 @@ func (m *mockStatusRepository) GetSpecDocumentByRepositoryAndVersion(_ context.C
 func (m *mockStatusRepository) GetSpecDocumentByRepositoryAndDocumentId(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocument, error) {
 	return nil, nil
 }
+func (m *mockStatusRepository) GetSpecDocumentRevisionByRepository(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocumentRevision, error) {
+	return nil, nil
+}
 func (m *mockStatusRepository) GetVersionHistoryByRepository(_ context.Context, _, _, _, _ string) ([]entity.RepoVersionInfo, error) {
 	return nil, nil
 }
@@ -110,9 +117,13 @@ func (m *mockStatusRepository) GetCurrentAnalysisTestCount(_ context.Context, _
 	return 0, nil
 }
 
+func (m *mockStatusRepository) GetSpecEdits(_ context.Context, _ entity.DocumentAccess, _, _ string) (*entity.SpecEdits, error) {
+	return nil, nil
+}
+
 func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 	t.Run("returns unauthorized error when userID is empty", func(t *testing.T) {
-		uc := NewGetGenerationStatusUseCase(&mockStatusRepository{})
+		uc := NewGetGenerationStatusUseCase(&mockStatusRepository{}, nil, nil)
 		_, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 		})
@@ -122,7 +133,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("returns error when analysisID is empty", func(t *testing.T) {
-		uc := NewGetGenerationStatusUseCase(&mockStatusRepository{})
+		uc := NewGetGenerationStatusUseCase(&mockStatusRepository{}, nil, nil)
 		_, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			UserID: "user-1",
 		})
@@ -137,7 +148,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 			Status:     entity.StatusRunning,
 		}
 		mock := &mockStatusRepository{status: status}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		result, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			UserID:     "user-1",
@@ -159,7 +170,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 			Status:     entity.StatusPending,
 		}
 		mock := &mockStatusRepository{statusByLanguage: status}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		result, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			Language:   "Korean",
@@ -187,7 +198,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 
 	t.Run("returns not_found status when no status exists without language", func(t *testing.T) {
 		mock := &mockStatusRepository{status: nil}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		result, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			UserID:     "user-1",
@@ -205,7 +216,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 
 	t.Run("returns not_found status when no status exists with language", func(t *testing.T) {
 		mock := &mockStatusRepository{statusByLanguage: nil}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		result, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			Language:   "English",
@@ -231,7 +242,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 	t.Run("propagates repository error without language", func(t *testing.T) {
 		dbErr := errors.New("database error")
 		mock := &mockStatusRepository{statusErr: dbErr}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		_, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			UserID:     "user-1",
@@ -244,7 +255,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 	t.Run("propagates repository error with language", func(t *testing.T) {
 		dbErr := errors.New("database error")
 		mock := &mockStatusRepository{statusByLanguageErr: dbErr}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		_, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			Language:   "Korean",
@@ -257,7 +268,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 
 	t.Run("returns error when language is invalid", func(t *testing.T) {
 		mock := &mockStatusRepository{}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		_, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			Language:   "InvalidLanguage",
@@ -273,7 +284,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 		mock := &mockStatusRepository{
 			status: nil, // No generation exists for this user
 		}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		result, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			UserID:     "user-b",
@@ -293,7 +304,7 @@ func TestGetGenerationStatusUseCase_Execute(t *testing.T) {
 	t.Run("passes userID to repository for user-scoped status query with language", func(t *testing.T) {
 		// Ensures generation status query is scoped to the requesting user
 		mock := &mockStatusRepository{statusByLanguage: nil}
-		uc := NewGetGenerationStatusUseCase(mock)
+		uc := NewGetGenerationStatusUseCase(mock, nil, nil)
 		_, err := uc.Execute(context.Background(), GetGenerationStatusInput{
 			AnalysisID: "analysis-1",
 			Language:   "Korean",
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type GetSharedSpecInput struct {
+	// ClientIP is recorded in the link's access log. Optional.
+	ClientIP string
+	Token    string
+}
+
+type GetSharedSpecOutput struct {
+	Document  *entity.RepoSpecDocument
+	ShareLink *entity.ShareLink
+}
+
+// GetSharedSpecUseCase serves a spec document to anyone holding a valid share
+// link token, with the owner's edits applied. Every read is logged on the link.
+// Unknown, expired and revoked links are all reported as ErrShareLinkNotFound.
+type GetSharedSpecUseCase struct {
+	repo       port.SpecViewRepository
+	shareLinks port.SpecShareLinkRepository
+}
+
+func NewGetSharedSpecUseCase(repo port.SpecViewRepository, shareLinks port.SpecShareLinkRepository) *GetSharedSpecUseCase {
+	return &GetSharedSpecUseCase{repo: repo, shareLinks: shareLinks}
+}
+
+func (uc *GetSharedSpecUseCase) Execute(ctx context.Context, input GetSharedSpecInput) (*GetSharedSpecOutput, error) {
+	if !entity.IsShareTokenFormat(input.Token) {
+		return nil, domain.ErrShareLinkNotFound
+	}
+
+	link, err := uc.shareLinks.GetShareLinkByToken(ctx, input.Token)
+	if err != nil {
+		return nil, err
+	}
+	if !link.IsValidAt(time.Now()) {
+		return nil, domain.ErrShareLinkNotFound
+	}
+
+	doc, err := uc.shareLinks.GetSharedDocument(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, domain.ErrDocumentNotFound
+	}
+
+	doc.Domains, err = applySpecEdits(ctx, uc.repo, entity.DocumentAccess{UserID: link.Scope.UserID, WorkspaceID: link.WorkspaceID}, doc.AnalysisID, doc.Language, doc.Domains)
+	if err != nil {
+		return nil, err
+	}
+
+	// The access log is best effort and must not block reading the document.
+	if err := uc.shareLinks.RecordShareLinkAccess(ctx, link.ID, input.ClientIP); err != nil {
+		slog.WarnContext(ctx, "failed to record share link access", "share_link_id", link.ID, "error", err)
+	}
+
+	return &GetSharedSpecOutput{Document: doc, ShareLink: link}, nil
+}
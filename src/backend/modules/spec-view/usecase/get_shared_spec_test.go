@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type mockShareLinkRepository struct {
+	link     *entity.ShareLink
+	document *entity.RepoSpecDocument
+	revoked  bool
+	err      error
+	// accessErr fails RecordShareLinkAccess only.
+	accessErr error
+
+	access        entity.DocumentAccess
+	createdParams *port.CreateShareLinkParams
+	accessedLink  string
+	accessedIP    string
+}
+
+func (m *mockShareLinkRepository) CreateShareLink(_ context.Context, params port.CreateShareLinkParams) (*entity.ShareLink, error) {
+	m.createdParams = &params
+	return &entity.ShareLink{ID: "link-1", TokenPrefix: params.Token.Prefix}, m.err
+}
+
+func (m *mockShareLinkRepository) GetShareLinkByToken(_ context.Context, _ string) (*entity.ShareLink, error) {
+	if m.link == nil {
+		return nil, domain.ErrShareLinkNotFound
+	}
+	return m.link, nil
+}
+
+func (m *mockShareLinkRepository) GetSharedDocument(_ context.Context, _ *entity.ShareLink) (*entity.RepoSpecDocument, error) {
+	return m.document, m.err
+}
+
+func (m *mockShareLinkRepository) ListShareLinks(_ context.Context, access entity.DocumentAccess) ([]entity.ShareLink, error) {
+	m.access = access
+	return nil, m.err
+}
+
+func (m *mockShareLinkRepository) RecordShareLinkAccess(_ context.Context, linkID, clientIP string) error {
+	m.accessedLink = linkID
+	m.accessedIP = clientIP
+	if m.accessErr != nil {
+		return m.accessErr
+	}
+	return m.err
+}
+
+func (m *mockShareLinkRepository) RevokeShareLink(_ context.Context, access entity.DocumentAccess, _ string) (bool, error) {
+	m.access = access
+	return m.revoked, m.err
+}
+
+func newSharedDocument() *entity.RepoSpecDocument {
+	return &entity.RepoSpecDocument{
+		AnalysisID: "analysis-1",
+		Domains: []entity.SpecDomain{
+			{
+				Name: "Payments",
+				Features: []entity.SpecFeature{
+					{Name: "Refunds", Behaviors: []entity.SpecBehavior{{ID: "behavior-1", OriginalName: "refunds order"}}},
+				},
+			},
+		},
+		ID:       testDocumentID,
+		Language: "English",
+	}
+}
+
+func TestGetSharedSpecUseCase_Execute(t *testing.T) {
+	token := entity.ShareTokenPrefix + "abcdefghijklmnopqrstuvwxyz"
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	t.Run("serves the document with the owner's edits and logs the access", func(t *testing.T) {
+		repo := &mockRepository{edits: &entity.SpecEdits{
+			Features: []entity.FeatureEdit{{DomainName: "Payments", FeatureName: "Refunds", NewName: "Returns"}},
+		}}
+		shares := &mockShareLinkRepository{
+			document: newSharedDocument(),
+			link:     &entity.ShareLink{ExpiresAt: &future, ID: "link-1", Scope: entity.SpecEditScope{UserID: "owner-1"}},
+		}
+
+		output, err := NewGetSharedSpecUseCase(repo, shares).Execute(context.Background(), GetSharedSpecInput{
+			ClientIP: "203.0.113.7",
+			Token:    token,
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if got := output.Document.Domains[0].Features[0].Name; got != "Returns" {
+			t.Errorf("feature name = %q, want edited name %q", got, "Returns")
+		}
+		if shares.accessedLink != "link-1" || shares.accessedIP != "203.0.113.7" {
+			t.Errorf("access logged for (%q, %q), want (link-1, 203.0.113.7)", shares.accessedLink, shares.accessedIP)
+		}
+	})
+
+	t.Run("serves the document when the access log fails", func(t *testing.T) {
+		shares := &mockShareLinkRepository{
+			accessErr: errors.New("insert failed"),
+			document:  newSharedDocument(),
+			link:      &entity.ShareLink{ID: "link-1", Scope: entity.SpecEditScope{UserID: "owner-1"}},
+		}
+
+		output, err := NewGetSharedSpecUseCase(&mockRepository{}, shares).Execute(context.Background(), GetSharedSpecInput{Token: token})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if output.Document == nil {
+			t.Fatal("expected document to be served")
+		}
+	})
+
+	tests := []struct {
+		name   string
+		token  string
+		link   *entity.ShareLink
+		doc    *entity.RepoSpecDocument
+		target error
+	}{
+		{
+			name:   "malformed token",
+			token:  "not-a-share-token",
+			link:   &entity.ShareLink{ID: "link-1"},
+			target: domain.ErrShareLinkNotFound,
+		},
+		{
+			name:   "unknown token",
+			token:  token,
+			target: domain.ErrShareLinkNotFound,
+		},
+		{
+			name:   "expired link",
+			token:  token,
+			link:   &entity.ShareLink{ExpiresAt: &past, ID: "link-1"},
+			target: domain.ErrShareLinkNotFound,
+		},
+		{
+			name:   "revoked link",
+			token:  token,
+			link:   &entity.ShareLink{ID: "link-1", RevokedAt: &past},
+			target: domain.ErrShareLinkNotFound,
+		},
+		{
+			name:   "owner has no document left",
+			token:  token,
+			link:   &entity.ShareLink{ID: "link-1"},
+			target: domain.ErrDocumentNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares := &mockShareLinkRepository{document: tt.doc, link: tt.link}
+
+			_, err := NewGetSharedSpecUseCase(&mockRepository{}, shares).Execute(context.Background(), GetSharedSpecInput{Token: tt.token})
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.target)
+			}
+			if shares.accessedLink != "" {
+				t.Errorf("access logged for rejected request")
+			}
+		})
+	}
+}
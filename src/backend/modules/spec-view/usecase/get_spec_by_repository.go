@@ -101,6 +101,11 @@ func (uc *GetSpecByRepositoryUseCase) Execute(ctx context.Context, input GetSpec
 		return nil, domain.ErrDocumentNotFound
 	}
 
+	doc.Domains, err = applySpecEdits(ctx, uc.repo, entity.DocumentAccess{UserID: input.UserID}, doc.AnalysisID, doc.Language, doc.Domains)
+	if err != nil {
+		return nil, err
+	}
+
 	availableLanguages, err := uc.repo.GetAvailableLanguagesByRepository(ctx, input.UserID, input.Owner, input.Name)
 	if err != nil {
 		return nil, err
@@ -109,3 +114,21 @@ func (uc *GetSpecByRepositoryUseCase) Execute(ctx context.Context, input GetSpec
 
 	return &GetSpecByRepositoryOutput{Document: doc}, nil
 }
+
+// Validator returns a value that changes whenever Execute's document would,
+// without loading it. ok is false unless the input pins a document by ID,
+// since resolving the latest version costs as much as loading it.
+func (uc *GetSpecByRepositoryUseCase) Validator(ctx context.Context, input GetSpecByRepositoryInput) (validator string, ok bool, err error) {
+	if input.UserID == "" || input.DocumentID == "" {
+		return "", false, nil
+	}
+	if !entity.IsValidRepositoryName(input.Owner) || !entity.IsValidRepositoryName(input.Name) || !entity.IsValidDocumentID(input.DocumentID) {
+		return "", false, nil
+	}
+
+	revision, err := uc.repo.GetSpecDocumentRevisionByRepository(ctx, input.UserID, input.Owner, input.Name, input.DocumentID)
+	if err != nil || revision == nil {
+		return "", false, err
+	}
+	return revision.Validator(), true, nil
+}
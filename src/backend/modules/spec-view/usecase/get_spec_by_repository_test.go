@@ -19,6 +19,8 @@ type repoMockRepository struct {
 	repoVersionsErr    error
 	availableLanguages []entity.AvailableLanguageInfo
 	availableLangsErr  error
+	edits              *entity.SpecEdits
+	revision           *entity.RepoSpecDocumentRevision
 
 	calledDocumentID string
 	calledLanguage   string
@@ -55,6 +57,11 @@ func (m *repoMockRepository) GetSpecDocumentByRepositoryAndDocumentId(_ context.
 	return m.repoDocument, m.repoDocumentErr
 }
 
+func (m *repoMockRepository) GetSpecDocumentRevisionByRepository(_ context.Context, _, _, _, documentID string) (*entity.RepoSpecDocumentRevision, error) {
+	m.calledDocumentID = documentID
+	return m.revision, nil
+}
+
 func (m *repoMockRepository) GetVersionHistoryByRepository(_ context.Context, _, owner, name, language string) ([]entity.RepoVersionInfo, error) {
 	m.calledOwner = owner
 	m.calledName = name
@@ -90,6 +97,10 @@ func (m *repoMockRepository) GetAvailableLanguages(_ context.Context, _ string)
 func (m *repoMockRepository) GetAvailableLanguagesByUser(_ context.Context, _, _ string) ([]entity.AvailableLanguageInfo, error) {
 	return nil, nil
 }
+func (m *repoMockRepository) GetActiveGenerationJobID(_ context.Context, _, _, _ string) (int64, error) {
+	return 0, nil
+}
+
 func (m *repoMockRepository) GetGenerationStatus(_ context.Context, _, _ string) (*entity.SpecGenerationStatus, error) {
 	return nil, nil
 }
@@ -121,6 +132,10 @@ func (m *repoMockRepository) GetCurrentAnalysisTestCount(_ context.Context, _ st
 	return 0, nil
 }
 
+func (m *repoMockRepository) GetSpecEdits(_ context.Context, _ entity.DocumentAccess, _, _ string) (*entity.SpecEdits, error) {
+	return m.edits, nil
+}
+
 func TestGetSpecByRepositoryUseCase_Execute(t *testing.T) {
 	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
 		uc := NewGetSpecByRepositoryUseCase(&repoMockRepository{})
@@ -446,3 +461,54 @@ func TestGetSpecByRepositoryUseCase_Execute(t *testing.T) {
 		}
 	})
 }
+
+func TestGetSpecByRepositoryUseCase_Validator(t *testing.T) {
+	documentID := "550e8400-e29b-41d4-a716-446655440000"
+	revision := func() *entity.RepoSpecDocumentRevision {
+		return &entity.RepoSpecDocumentRevision{
+			DocumentCount: 2,
+			DocumentID:    documentID,
+			UpdatedAt:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Version:       3,
+		}
+	}
+	input := GetSpecByRepositoryInput{DocumentID: documentID, Name: "react", Owner: "facebook", UserID: "user-1"}
+
+	t.Run("has no validator without a document ID", func(t *testing.T) {
+		mock := &repoMockRepository{revision: revision()}
+		latest := input
+		latest.DocumentID = ""
+
+		_, ok, err := NewGetSpecByRepositoryUseCase(mock).Validator(context.Background(), latest)
+		if err != nil || ok {
+			t.Errorf("Validator() = ok %v, error %v; want no validator", ok, err)
+		}
+		if mock.calledDocumentID != "" {
+			t.Errorf("repository called for %q, want no call", mock.calledDocumentID)
+		}
+	})
+
+	t.Run("has no validator for a missing document", func(t *testing.T) {
+		_, ok, err := NewGetSpecByRepositoryUseCase(&repoMockRepository{}).Validator(context.Background(), input)
+		if err != nil || ok {
+			t.Errorf("Validator() = ok %v, error %v; want no validator", ok, err)
+		}
+	})
+
+	t.Run("changes when an edit changes", func(t *testing.T) {
+		mock := &repoMockRepository{revision: revision()}
+		uc := NewGetSpecByRepositoryUseCase(mock)
+
+		before, ok, err := uc.Validator(context.Background(), input)
+		if err != nil || !ok {
+			t.Fatalf("Validator() = ok %v, error %v", ok, err)
+		}
+		mock.revision.EditCount = 1
+		mock.revision.LatestEditAt = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		after, _, _ := uc.Validator(context.Background(), input)
+
+		if before == after {
+			t.Errorf("validator %q did not change after an edit", before)
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type GetSpecCommentsInput struct {
+	DocumentID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the document must be the workspace's and the user a member.
+	WorkspaceID string
+}
+
+// GetSpecCommentsUseCase lists the comment threads of a spec document. Threads
+// opened on earlier versions are included while unresolved and their target
+// still exists in the document.
+type GetSpecCommentsUseCase struct {
+	comments   port.SpecCommentRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewGetSpecCommentsUseCase(comments port.SpecCommentRepository, workspaces port.WorkspaceAccess) *GetSpecCommentsUseCase {
+	return &GetSpecCommentsUseCase{comments: comments, workspaces: workspaces}
+}
+
+func (uc *GetSpecCommentsUseCase) Execute(ctx context.Context, input GetSpecCommentsInput) ([]entity.CommentThread, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if !entity.IsValidDocumentID(input.DocumentID) {
+		return nil, domain.ErrInvalidDocumentID
+	}
+
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := uc.comments.GetCommentDocument(ctx, access, input.DocumentID)
+	if err != nil {
+		return nil, err
+	}
+
+	threads, err := uc.comments.GetCommentThreads(ctx, document)
+	if err != nil {
+		return nil, err
+	}
+	return entity.PlaceCommentThreads(threads, document.Domains), nil
+}
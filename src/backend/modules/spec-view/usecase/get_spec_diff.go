@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type GetSpecDiffInput struct {
+	AnalysisID string
+	// FromVersion is the base version. Required.
+	FromVersion int
+	// Language is required; versions are numbered per language.
+	Language string
+	// ToVersion is the target version. Required.
+	ToVersion int
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, compares the workspace's versions; the user must be a member.
+	WorkspaceID string
+}
+
+type GetSpecDiffOutput struct {
+	Diff *entity.SpecDiff
+	From entity.DiffVersion
+	To   entity.DiffVersion
+}
+
+type GetSpecDiffUseCase struct {
+	documents  specDocumentReader
+	workspaces port.WorkspaceAccess
+}
+
+func NewGetSpecDiffUseCase(
+	repo port.SpecViewRepository,
+	workspaces port.WorkspaceAccess,
+	workspaceSpecs port.WorkspaceSpecRepository,
+) *GetSpecDiffUseCase {
+	return &GetSpecDiffUseCase{
+		documents:  specDocumentReader{repo: repo, workspaceSpecs: workspaceSpecs},
+		workspaces: workspaces,
+	}
+}
+
+func (uc *GetSpecDiffUseCase) Execute(ctx context.Context, input GetSpecDiffInput) (*GetSpecDiffOutput, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.AnalysisID == "" {
+		return nil, domain.ErrInvalidAnalysisID
+	}
+
+	if !entity.IsValidLanguage(input.Language) {
+		return nil, domain.ErrInvalidLanguage
+	}
+
+	if input.FromVersion < 1 || input.ToVersion < 1 {
+		return nil, domain.ErrInvalidVersion
+	}
+
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := uc.documents.byVersion(ctx, access, input.AnalysisID, input.Language, input.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.documents.byVersion(ctx, access, input.AnalysisID, input.Language, input.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+	if from == nil || to == nil {
+		return nil, domain.ErrDocumentNotFound
+	}
+
+	return &GetSpecDiffOutput{
+		Diff: entity.DiffSpecDocuments(from.Domains, to.Domains, from.ExecutiveSummary, to.ExecutiveSummary),
+		From: specDiffVersion(from),
+		To:   specDiffVersion(to),
+	}, nil
+}
+
+func specDiffVersion(doc *entity.SpecDocument) entity.DiffVersion {
+	return entity.DiffVersion{
+		AnalysisID: doc.AnalysisID,
+		CreatedAt:  doc.CreatedAt,
+		ID:         doc.ID,
+		Language:   doc.Language,
+		ModelID:    doc.ModelID,
+		Version:    doc.Version,
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type GetSpecDiffByRepositoryInput struct {
+	// FromDocumentID is the base document, as listed by the repository version history. Required.
+	FromDocumentID string
+	// Name is the repository name. Required.
+	Name string
+	// Owner is the repository owner. Required.
+	Owner string
+	// ToDocumentID is the target document. Required.
+	ToDocumentID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+}
+
+type GetSpecDiffByRepositoryUseCase struct {
+	repo port.SpecViewRepository
+}
+
+func NewGetSpecDiffByRepositoryUseCase(repo port.SpecViewRepository) *GetSpecDiffByRepositoryUseCase {
+	return &GetSpecDiffByRepositoryUseCase{repo: repo}
+}
+
+// Execute compares two documents of a repository, which may belong to
+// different analyses.
+func (uc *GetSpecDiffByRepositoryUseCase) Execute(ctx context.Context, input GetSpecDiffByRepositoryInput) (*GetSpecDiffOutput, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if !entity.IsValidRepositoryName(input.Owner) || !entity.IsValidRepositoryName(input.Name) {
+		return nil, domain.ErrInvalidRepository
+	}
+
+	if !entity.IsValidDocumentID(input.FromDocumentID) || !entity.IsValidDocumentID(input.ToDocumentID) {
+		return nil, domain.ErrInvalidDocumentID
+	}
+
+	exists, err := uc.repo.CheckCodebaseExists(ctx, input.Owner, input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrCodebaseNotFound
+	}
+
+	from, err := uc.repo.GetSpecDocumentByRepositoryAndDocumentId(ctx, input.UserID, input.Owner, input.Name, input.FromDocumentID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.repo.GetSpecDocumentByRepositoryAndDocumentId(ctx, input.UserID, input.Owner, input.Name, input.ToDocumentID)
+	if err != nil {
+		return nil, err
+	}
+	if from == nil || to == nil {
+		return nil, domain.ErrDocumentNotFound
+	}
+
+	if from.Language != to.Language {
+		return nil, domain.ErrLanguageMismatch
+	}
+
+	return &GetSpecDiffOutput{
+		Diff: entity.DiffSpecDocuments(from.Domains, to.Domains, from.ExecutiveSummary, to.ExecutiveSummary),
+		From: repoSpecDiffVersion(from),
+		To:   repoSpecDiffVersion(to),
+	}, nil
+}
+
+func repoSpecDiffVersion(doc *entity.RepoSpecDocument) entity.DiffVersion {
+	return entity.DiffVersion{
+		AnalysisID: doc.AnalysisID,
+		CommitSHA:  doc.CommitSHA,
+		CreatedAt:  doc.CreatedAt,
+		ID:         doc.ID,
+		Language:   doc.Language,
+		ModelID:    doc.ModelID,
+		Version:    doc.Version,
+	}
+}
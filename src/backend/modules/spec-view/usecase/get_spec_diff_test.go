@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+const (
+	diffFromDocumentID = "550e8400-e29b-41d4-a716-446655440000"
+	diffToDocumentID   = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+)
+
+// diffRepoMockRepository returns a different document per document ID.
+type diffRepoMockRepository struct {
+	repoMockRepository
+	documents map[string]*entity.RepoSpecDocument
+}
+
+func (m *diffRepoMockRepository) GetSpecDocumentByRepositoryAndDocumentId(_ context.Context, _, _, _, documentID string) (*entity.RepoSpecDocument, error) {
+	return m.documents[documentID], nil
+}
+
+func TestGetSpecDiffUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
+		uc := NewGetSpecDiffUseCase(&mockRepository{}, nil, nil)
+		_, err := uc.Execute(context.Background(), GetSpecDiffInput{AnalysisID: "analysis-1", Language: "English", FromVersion: 1, ToVersion: 2})
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrUnauthorized)
+		}
+	})
+
+	t.Run("returns ErrInvalidLanguage when language is missing", func(t *testing.T) {
+		uc := NewGetSpecDiffUseCase(&mockRepository{}, nil, nil)
+		_, err := uc.Execute(context.Background(), GetSpecDiffInput{AnalysisID: "analysis-1", FromVersion: 1, ToVersion: 2, UserID: "user-1"})
+		if !errors.Is(err, domain.ErrInvalidLanguage) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidLanguage)
+		}
+	})
+
+	t.Run("returns ErrInvalidVersion for non-positive versions", func(t *testing.T) {
+		uc := NewGetSpecDiffUseCase(&mockRepository{}, nil, nil)
+		_, err := uc.Execute(context.Background(), GetSpecDiffInput{AnalysisID: "analysis-1", Language: "English", ToVersion: 2, UserID: "user-1"})
+		if !errors.Is(err, domain.ErrInvalidVersion) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidVersion)
+		}
+	})
+
+	t.Run("returns ErrDocumentNotFound when a version does not exist", func(t *testing.T) {
+		uc := NewGetSpecDiffUseCase(&mockRepository{}, nil, nil)
+		_, err := uc.Execute(context.Background(), GetSpecDiffInput{AnalysisID: "analysis-1", Language: "English", FromVersion: 1, ToVersion: 9, UserID: "user-1"})
+		if !errors.Is(err, domain.ErrDocumentNotFound) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrDocumentNotFound)
+		}
+	})
+
+	t.Run("returns versions and diff", func(t *testing.T) {
+		doc := &entity.SpecDocument{AnalysisID: "analysis-1", ID: "doc-1", Language: "English", Version: 2}
+		mock := &mockRepository{documentByVersion: doc}
+		uc := NewGetSpecDiffUseCase(mock, nil, nil)
+		result, err := uc.Execute(context.Background(), GetSpecDiffInput{AnalysisID: "analysis-1", Language: "English", FromVersion: 2, ToVersion: 2, UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if result.From.ID != "doc-1" || result.To.Version != 2 {
+			t.Errorf("Execute() versions = %+v / %+v", result.From, result.To)
+		}
+		if len(result.Diff.Domains) != 0 || result.Diff.ExecutiveSummary != nil {
+			t.Errorf("expected empty diff for identical versions, got %+v", result.Diff)
+		}
+	})
+}
+
+func TestGetSpecDiffByRepositoryUseCase_Execute(t *testing.T) {
+	newRepo := func(toLanguage string) *diffRepoMockRepository {
+		return &diffRepoMockRepository{
+			repoMockRepository: repoMockRepository{codebaseExists: true},
+			documents: map[string]*entity.RepoSpecDocument{
+				diffFromDocumentID: {AnalysisID: "analysis-1", CommitSHA: "aaa", ID: diffFromDocumentID, Language: "English", Version: 1},
+				diffToDocumentID:   {AnalysisID: "analysis-2", CommitSHA: "bbb", ID: diffToDocumentID, Language: toLanguage, Version: 1},
+			},
+		}
+	}
+
+	t.Run("compares documents across analyses", func(t *testing.T) {
+		uc := NewGetSpecDiffByRepositoryUseCase(newRepo("English"))
+		result, err := uc.Execute(context.Background(), GetSpecDiffByRepositoryInput{
+			FromDocumentID: diffFromDocumentID,
+			Name:           "hello",
+			Owner:          "octocat",
+			ToDocumentID:   diffToDocumentID,
+			UserID:         "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if result.From.CommitSHA != "aaa" || result.To.CommitSHA != "bbb" {
+			t.Errorf("Execute() commits = %q -> %q", result.From.CommitSHA, result.To.CommitSHA)
+		}
+	})
+
+	t.Run("returns ErrLanguageMismatch for documents in different languages", func(t *testing.T) {
+		uc := NewGetSpecDiffByRepositoryUseCase(newRepo("Korean"))
+		_, err := uc.Execute(context.Background(), GetSpecDiffByRepositoryInput{
+			FromDocumentID: diffFromDocumentID,
+			Name:           "hello",
+			Owner:          "octocat",
+			ToDocumentID:   diffToDocumentID,
+			UserID:         "user-1",
+		})
+		if !errors.Is(err, domain.ErrLanguageMismatch) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrLanguageMismatch)
+		}
+	})
+
+	t.Run("returns ErrInvalidDocumentID for malformed IDs", func(t *testing.T) {
+		uc := NewGetSpecDiffByRepositoryUseCase(newRepo("English"))
+		_, err := uc.Execute(context.Background(), GetSpecDiffByRepositoryInput{
+			FromDocumentID: "v3",
+			Name:           "hello",
+			Owner:          "octocat",
+			ToDocumentID:   diffToDocumentID,
+			UserID:         "user-1",
+		})
+		if !errors.Is(err, domain.ErrInvalidDocumentID) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrInvalidDocumentID)
+		}
+	})
+}
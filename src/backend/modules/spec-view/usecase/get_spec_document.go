@@ -78,6 +78,11 @@ func (uc *GetSpecDocumentUseCase) Execute(ctx context.Context, input GetSpecDocu
 	}
 
 	if doc != nil {
+		doc.Domains, err = applySpecEdits(ctx, uc.repo, entity.DocumentAccess{UserID: input.UserID}, doc.AnalysisID, doc.Language, doc.Domains)
+		if err != nil {
+			return nil, err
+		}
+
 		availableLanguages, err := uc.repo.GetAvailableLanguagesByUser(ctx, input.UserID, input.AnalysisID)
 		if err != nil {
 			return nil, err
@@ -25,10 +25,12 @@ type mockRepository struct {
 	availableLangsErr    error
 	versions             []entity.VersionInfo
 	versionsErr          error
+	edits                *entity.SpecEdits
 
 	// Captured parameters for verification
-	calledLanguage string
-	calledVersion  int
+	calledEditsAccess entity.DocumentAccess
+	calledLanguage    string
+	calledVersion     int
 }
 
 func (m *mockRepository) CheckAnalysisExists(_ context.Context, _ string) (bool, error) {
@@ -61,6 +63,10 @@ func (m *mockRepository) GetSpecDocumentByUser(_ context.Context, _ string, _ st
 	return m.document, m.documentErr
 }
 
+func (m *mockRepository) GetActiveGenerationJobID(_ context.Context, _, _, _ string) (int64, error) {
+	return 0, nil
+}
+
 func (m *mockRepository) GetGenerationStatus(_ context.Context, _ string, _ string) (*entity.SpecGenerationStatus, error) {
 	return m.status, m.statusErr
 }
@@ -111,6 +117,9 @@ func (m *mockRepository) GetSpecDocumentByRepositoryAndVersion(_ context.Context
 func (m *mockRepository) GetSpecDocumentByRepositoryAndDocumentId(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocument, error) {
 	return nil, nil
 }
+func (m *mockRepository) GetSpecDocumentRevisionByRepository(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocumentRevision, error) {
+	return nil, nil
+}
 func (m *mockRepository) GetVersionHistoryByRepository(_ context.Context, _, _, _, _ string) ([]entity.RepoVersionInfo, error) {
 	return nil, nil
 }
@@ -124,6 +133,11 @@ func (m *mockRepository) GetCurrentAnalysisTestCount(_ context.Context, _ string
 	return 0, nil
 }
 
+func (m *mockRepository) GetSpecEdits(_ context.Context, access entity.DocumentAccess, _, _ string) (*entity.SpecEdits, error) {
+	m.calledEditsAccess = access
+	return m.edits, nil
+}
+
 func TestGetSpecDocumentUseCase_Execute(t *testing.T) {
 	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
 		uc := NewGetSpecDocumentUseCase(&mockRepository{})
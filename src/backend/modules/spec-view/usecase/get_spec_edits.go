@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type GetSpecEditsInput struct {
+	AnalysisID string
+	// Language is required; edits are kept per language.
+	Language string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, returns the edits shared by the workspace's members;
+	// the user must be a member.
+	WorkspaceID string
+}
+
+// GetSpecEditsUseCase lists the edits applied to a repository's specs,
+// including hidden behaviors that no longer appear in the documents.
+type GetSpecEditsUseCase struct {
+	repo       port.SpecViewRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewGetSpecEditsUseCase(repo port.SpecViewRepository, workspaces port.WorkspaceAccess) *GetSpecEditsUseCase {
+	return &GetSpecEditsUseCase{repo: repo, workspaces: workspaces}
+}
+
+func (uc *GetSpecEditsUseCase) Execute(ctx context.Context, input GetSpecEditsInput) (*entity.SpecEdits, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.AnalysisID == "" {
+		return nil, domain.ErrInvalidAnalysisID
+	}
+
+	if !entity.IsValidLanguage(input.Language) {
+		return nil, domain.ErrInvalidLanguage
+	}
+
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetSpecEdits(ctx, access, input.AnalysisID, input.Language)
+}
+
+// applySpecEdits layers the edits the access reaches over generated domains
+func applySpecEdits(ctx context.Context, repo port.SpecViewRepository, access entity.DocumentAccess, analysisID, language string, domains []entity.SpecDomain) ([]entity.SpecDomain, error) {
+	edits, err := repo.GetSpecEdits(ctx, access, analysisID, language)
+	if err != nil {
+		return nil, err
+	}
+	return edits.Apply(domains), nil
+}
@@ -13,6 +13,8 @@ type GetVersionsInput struct {
 	Language   string
 	// UserID is required. Empty userID returns ErrUnauthorized.
 	UserID string
+	// WorkspaceID is optional. If set, lists the workspace's versions; the user must be a member.
+	WorkspaceID string
 }
 
 type GetVersionsOutput struct {
@@ -22,11 +24,19 @@ type GetVersionsOutput struct {
 }
 
 type GetVersionsUseCase struct {
-	repo port.SpecViewRepository
+	documents  specDocumentReader
+	workspaces port.WorkspaceAccess
 }
 
-func NewGetVersionsUseCase(repo port.SpecViewRepository) *GetVersionsUseCase {
-	return &GetVersionsUseCase{repo: repo}
+func NewGetVersionsUseCase(
+	repo port.SpecViewRepository,
+	workspaces port.WorkspaceAccess,
+	workspaceSpecs port.WorkspaceSpecRepository,
+) *GetVersionsUseCase {
+	return &GetVersionsUseCase{
+		documents:  specDocumentReader{repo: repo, workspaceSpecs: workspaceSpecs},
+		workspaces: workspaces,
+	}
 }
 
 func (uc *GetVersionsUseCase) Execute(ctx context.Context, input GetVersionsInput) (*GetVersionsOutput, error) {
@@ -44,7 +54,12 @@ func (uc *GetVersionsUseCase) Execute(ctx context.Context, input GetVersionsInpu
 		return nil, domain.ErrInvalidLanguage
 	}
 
-	versions, err := uc.repo.GetVersionsByUser(ctx, input.UserID, input.AnalysisID, input.Language)
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := uc.documents.versions(ctx, access, input.AnalysisID, input.Language)
 	if err != nil {
 		return nil, err
 	}
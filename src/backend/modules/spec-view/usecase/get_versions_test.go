@@ -12,7 +12,7 @@ import (
 
 func TestGetVersionsUseCase_Execute(t *testing.T) {
 	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
-		uc := NewGetVersionsUseCase(&mockRepository{})
+		uc := NewGetVersionsUseCase(&mockRepository{}, nil, nil)
 		_, err := uc.Execute(context.Background(), GetVersionsInput{
 			AnalysisID: "analysis-1",
 			Language:   "Korean",
@@ -23,7 +23,7 @@ func TestGetVersionsUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("returns error when analysisID is empty", func(t *testing.T) {
-		uc := NewGetVersionsUseCase(&mockRepository{})
+		uc := NewGetVersionsUseCase(&mockRepository{}, nil, nil)
 		_, err := uc.Execute(context.Background(), GetVersionsInput{
 			Language: "Korean",
 			UserID:   "user-1",
@@ -34,7 +34,7 @@ func TestGetVersionsUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("returns error when language is empty", func(t *testing.T) {
-		uc := NewGetVersionsUseCase(&mockRepository{})
+		uc := NewGetVersionsUseCase(&mockRepository{}, nil, nil)
 		_, err := uc.Execute(context.Background(), GetVersionsInput{
 			AnalysisID: "analysis-1",
 			UserID:     "user-1",
@@ -45,7 +45,7 @@ func TestGetVersionsUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("returns error when language is invalid", func(t *testing.T) {
-		uc := NewGetVersionsUseCase(&mockRepository{})
+		uc := NewGetVersionsUseCase(&mockRepository{}, nil, nil)
 		_, err := uc.Execute(context.Background(), GetVersionsInput{
 			AnalysisID: "analysis-1",
 			Language:   "InvalidLang",
@@ -63,7 +63,7 @@ func TestGetVersionsUseCase_Execute(t *testing.T) {
 			{Version: 1, CreatedAt: time.Now().Add(-2 * time.Hour), ModelID: "model-1"},
 		}
 		mock := &mockRepository{versions: versions}
-		uc := NewGetVersionsUseCase(mock)
+		uc := NewGetVersionsUseCase(mock, nil, nil)
 		result, err := uc.Execute(context.Background(), GetVersionsInput{
 			AnalysisID: "analysis-1",
 			Language:   "Korean",
@@ -88,7 +88,7 @@ func TestGetVersionsUseCase_Execute(t *testing.T) {
 
 	t.Run("returns ErrDocumentNotFound when no versions found", func(t *testing.T) {
 		mock := &mockRepository{versions: []entity.VersionInfo{}}
-		uc := NewGetVersionsUseCase(mock)
+		uc := NewGetVersionsUseCase(mock, nil, nil)
 		_, err := uc.Execute(context.Background(), GetVersionsInput{
 			AnalysisID: "analysis-1",
 			Language:   "English",
@@ -102,7 +102,7 @@ func TestGetVersionsUseCase_Execute(t *testing.T) {
 	t.Run("propagates repository error", func(t *testing.T) {
 		dbErr := errors.New("database error")
 		mock := &mockRepository{versionsErr: dbErr}
-		uc := NewGetVersionsUseCase(mock)
+		uc := NewGetVersionsUseCase(mock, nil, nil)
 		_, err := uc.Execute(context.Background(), GetVersionsInput{
 			AnalysisID: "analysis-1",
 			Language:   "Korean",
@@ -118,7 +118,7 @@ func TestGetVersionsUseCase_Execute(t *testing.T) {
 		mock := &mockRepository{
 			versions: []entity.VersionInfo{}, // No versions for this user
 		}
-		uc := NewGetVersionsUseCase(mock)
+		uc := NewGetVersionsUseCase(mock, nil, nil)
 		_, err := uc.Execute(context.Background(), GetVersionsInput{
 			AnalysisID: "analysis-1",
 			Language:   "Korean",
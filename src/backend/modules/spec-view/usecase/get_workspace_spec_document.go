@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type GetWorkspaceSpecDocumentInput struct {
+	AnalysisID string
+	// Language is optional. If empty, returns the most recent document.
+	Language string
+	// UserID is required. The user must be a member of the workspace.
+	UserID string
+	// Version is optional. Requires Language. If 0, returns the latest version.
+	Version     int
+	WorkspaceID string
+}
+
+// GetWorkspaceSpecDocumentUseCase returns the latest spec document generated for a
+// workspace, visible to every member regardless of which member requested it.
+type GetWorkspaceSpecDocumentUseCase struct {
+	repo           port.SpecViewRepository
+	workspaceSpecs port.WorkspaceSpecRepository
+	workspaces     port.WorkspaceAccess
+}
+
+func NewGetWorkspaceSpecDocumentUseCase(
+	repo port.SpecViewRepository,
+	workspaces port.WorkspaceAccess,
+	workspaceSpecs port.WorkspaceSpecRepository,
+) *GetWorkspaceSpecDocumentUseCase {
+	return &GetWorkspaceSpecDocumentUseCase{
+		repo:           repo,
+		workspaceSpecs: workspaceSpecs,
+		workspaces:     workspaces,
+	}
+}
+
+func (uc *GetWorkspaceSpecDocumentUseCase) Execute(ctx context.Context, input GetWorkspaceSpecDocumentInput) (*GetSpecDocumentOutput, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.AnalysisID == "" {
+		return nil, domain.ErrInvalidAnalysisID
+	}
+
+	if input.Language != "" && !entity.IsValidLanguage(input.Language) {
+		return nil, domain.ErrInvalidLanguage
+	}
+
+	// Version requires valid language
+	if input.Version > 0 && input.Language == "" {
+		return nil, domain.ErrInvalidLanguage
+	}
+
+	if _, err := uc.workspaces.GetWorkspaceAccess(ctx, input.WorkspaceID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	// Skip generation check when requesting specific version (historical data)
+	var status *entity.SpecGenerationStatus
+	var err error
+	if input.Version == 0 {
+		status, err = uc.workspaceSpecs.GetGenerationStatusByWorkspace(ctx, input.WorkspaceID, input.AnalysisID, input.Language)
+		if err != nil {
+			return nil, err
+		}
+		if status != nil && (status.Status == entity.StatusPending || status.Status == entity.StatusRunning) {
+			return &GetSpecDocumentOutput{GenerationStatus: status}, nil
+		}
+	}
+
+	var doc *entity.SpecDocument
+	if input.Version > 0 {
+		doc, err = uc.workspaceSpecs.GetSpecDocumentByWorkspaceAndVersion(ctx, input.WorkspaceID, input.AnalysisID, input.Language, input.Version)
+	} else {
+		doc, err = uc.workspaceSpecs.GetSpecDocumentByWorkspace(ctx, input.WorkspaceID, input.AnalysisID, input.Language)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if doc != nil {
+		access := entity.DocumentAccess{UserID: input.UserID, WorkspaceID: input.WorkspaceID}
+		doc.Domains, err = applySpecEdits(ctx, uc.repo, access, doc.AnalysisID, doc.Language, doc.Domains)
+		if err != nil {
+			return nil, err
+		}
+
+		availableLanguages, err := uc.workspaceSpecs.GetAvailableLanguagesByWorkspace(ctx, input.WorkspaceID, input.AnalysisID)
+		if err != nil {
+			return nil, err
+		}
+		doc.AvailableLanguages = availableLanguages
+		return &GetSpecDocumentOutput{Document: doc}, nil
+	}
+
+	if status != nil && status.Status != entity.StatusNotFound {
+		return &GetSpecDocumentOutput{GenerationStatus: status}, nil
+	}
+
+	return nil, domain.ErrDocumentNotFound
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+)
+
+func TestGetWorkspaceSpecDocumentUseCase_Execute(t *testing.T) {
+	memberAccess := &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-id"}}
+
+	tests := []struct {
+		name           string
+		input          GetWorkspaceSpecDocumentInput
+		access         *mockWorkspaceAccess
+		workspaceSpecs *mockWorkspaceSpecRepository
+		wantErr        error
+		wantDocument   bool
+		wantStatus     entity.GenerationStatus
+		wantVersion    int
+	}{
+		{
+			name:    "should reject missing user",
+			input:   GetWorkspaceSpecDocumentInput{AnalysisID: "analysis-id", WorkspaceID: "workspace-id"},
+			access:  memberAccess,
+			wantErr: domain.ErrUnauthorized,
+		},
+		{
+			name:    "should reject version without language",
+			input:   GetWorkspaceSpecDocumentInput{AnalysisID: "analysis-id", UserID: "member-id", Version: 2, WorkspaceID: "workspace-id"},
+			access:  memberAccess,
+			wantErr: domain.ErrInvalidLanguage,
+		},
+		{
+			name:   "should return requested version while generating",
+			input:  GetWorkspaceSpecDocumentInput{AnalysisID: "analysis-id", Language: "English", UserID: "member-id", Version: 2, WorkspaceID: "workspace-id"},
+			access: memberAccess,
+			workspaceSpecs: &mockWorkspaceSpecRepository{
+				document:  &entity.SpecDocument{AnalysisID: "analysis-id", Language: "English", Version: 2},
+				languages: []entity.AvailableLanguageInfo{{Language: "English", LatestVersion: 3}},
+				status:    &entity.SpecGenerationStatus{Status: entity.StatusRunning},
+			},
+			wantDocument: true,
+			wantVersion:  2,
+		},
+		{
+			name:           "should reject non-member",
+			input:          GetWorkspaceSpecDocumentInput{AnalysisID: "analysis-id", UserID: "outsider-id", WorkspaceID: "workspace-id"},
+			access:         &mockWorkspaceAccess{err: domain.ErrForbidden},
+			workspaceSpecs: &mockWorkspaceSpecRepository{},
+			wantErr:        domain.ErrForbidden,
+		},
+		{
+			name:   "should return document with workspace languages",
+			input:  GetWorkspaceSpecDocumentInput{AnalysisID: "analysis-id", UserID: "member-id", WorkspaceID: "workspace-id"},
+			access: memberAccess,
+			workspaceSpecs: &mockWorkspaceSpecRepository{
+				document:  &entity.SpecDocument{AnalysisID: "analysis-id", Language: "English"},
+				languages: []entity.AvailableLanguageInfo{{Language: "English", LatestVersion: 1}},
+			},
+			wantDocument: true,
+		},
+		{
+			name:   "should return running status while generating",
+			input:  GetWorkspaceSpecDocumentInput{AnalysisID: "analysis-id", UserID: "member-id", WorkspaceID: "workspace-id"},
+			access: memberAccess,
+			workspaceSpecs: &mockWorkspaceSpecRepository{
+				document: &entity.SpecDocument{AnalysisID: "analysis-id", Language: "English"},
+				status:   &entity.SpecGenerationStatus{Status: entity.StatusRunning},
+			},
+			wantStatus: entity.StatusRunning,
+		},
+		{
+			name:           "should return not found when workspace has no document",
+			input:          GetWorkspaceSpecDocumentInput{AnalysisID: "analysis-id", UserID: "member-id", WorkspaceID: "workspace-id"},
+			access:         memberAccess,
+			workspaceSpecs: &mockWorkspaceSpecRepository{},
+			wantErr:        domain.ErrDocumentNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewGetWorkspaceSpecDocumentUseCase(&mockRepository{}, tt.access, tt.workspaceSpecs)
+
+			result, err := uc.Execute(context.Background(), tt.input)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantDocument {
+				if result.Document == nil {
+					t.Fatal("expected document, got nil")
+				}
+				if len(result.Document.AvailableLanguages) != 1 {
+					t.Errorf("expected 1 available language, got %d", len(result.Document.AvailableLanguages))
+				}
+				if tt.wantVersion > 0 && tt.workspaceSpecs.requestedVersion != tt.wantVersion {
+					t.Errorf("expected version %d to be requested, got %d", tt.wantVersion, tt.workspaceSpecs.requestedVersion)
+				}
+			}
+			if tt.wantStatus != "" {
+				if result.GenerationStatus == nil || result.GenerationStatus.Status != tt.wantStatus {
+					t.Errorf("expected status %s, got %+v", tt.wantStatus, result.GenerationStatus)
+				}
+			}
+		})
+	}
+}
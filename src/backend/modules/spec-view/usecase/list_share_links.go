@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type ListShareLinksInput struct {
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, lists the workspace's links; the user must be a member.
+	WorkspaceID string
+}
+
+// ListShareLinksUseCase lists the user's or the workspace's share links that
+// were not revoked, including expired ones.
+type ListShareLinksUseCase struct {
+	shareLinks port.SpecShareLinkRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewListShareLinksUseCase(shareLinks port.SpecShareLinkRepository, workspaces port.WorkspaceAccess) *ListShareLinksUseCase {
+	return &ListShareLinksUseCase{shareLinks: shareLinks, workspaces: workspaces}
+}
+
+func (uc *ListShareLinksUseCase) Execute(ctx context.Context, input ListShareLinksInput) ([]entity.ShareLink, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.shareLinks.ListShareLinks(ctx, access)
+}
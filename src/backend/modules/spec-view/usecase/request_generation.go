@@ -22,6 +22,9 @@ type RequestGenerationInput struct {
 	Mode       entity.GenerationMode
 	Tier       subscription.PlanTier
 	UserID     string
+	// WorkspaceID is optional. If set, the document is generated for the workspace
+	// and charged to the workspace owner's quota. Requires the editor or owner role.
+	WorkspaceID string
 }
 
 type RequestGenerationOutput struct {
@@ -36,6 +39,8 @@ type RequestGenerationUseCase struct {
 	queue           port.QueueService
 	repo            port.SpecViewRepository
 	reservationRepo usageport.QuotaReservationRepository
+	workspaceSpecs  port.WorkspaceSpecRepository
+	workspaces      port.WorkspaceAccess
 }
 
 func NewRequestGenerationUseCase(
@@ -44,6 +49,8 @@ func NewRequestGenerationUseCase(
 	checkQuota *usageusecase.CheckQuotaUseCase,
 	dbPool *pgxpool.Pool,
 	reservationRepo usageport.QuotaReservationRepository,
+	workspaces port.WorkspaceAccess,
+	workspaceSpecs port.WorkspaceSpecRepository,
 ) *RequestGenerationUseCase {
 	return &RequestGenerationUseCase{
 		checkQuota:      checkQuota,
@@ -51,6 +58,8 @@ func NewRequestGenerationUseCase(
 		queue:           queue,
 		repo:            repo,
 		reservationRepo: reservationRepo,
+		workspaceSpecs:  workspaceSpecs,
+		workspaces:      workspaces,
 	}
 }
 
@@ -63,6 +72,24 @@ func (uc *RequestGenerationUseCase) Execute(ctx context.Context, input RequestGe
 		return nil, domain.ErrInvalidAnalysisID
 	}
 
+	// Workspace generations are charged to the workspace owner
+	billingUserID := input.UserID
+	var workspace *entity.WorkspaceGeneration
+	if input.WorkspaceID != "" {
+		if uc.workspaces == nil || uc.workspaceSpecs == nil {
+			return nil, domain.ErrForbidden
+		}
+		access, err := uc.workspaces.GetWorkspaceAccess(ctx, input.WorkspaceID, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !access.CanGenerate {
+			return nil, domain.ErrForbidden
+		}
+		billingUserID = access.BillingUserID
+		workspace = &entity.WorkspaceGeneration{BillingUserID: billingUserID, WorkspaceID: input.WorkspaceID}
+	}
+
 	exists, err := uc.repo.CheckAnalysisExists(ctx, input.AnalysisID)
 	if err != nil {
 		return nil, err
@@ -77,8 +104,14 @@ func (uc *RequestGenerationUseCase) Execute(ctx context.Context, input RequestGe
 	}
 
 	// Always check for in-progress generation (by language) - regardless of force regenerate
-	// Only check the user's own generation status (per-user personalization)
-	status, err := uc.repo.GetGenerationStatusByLanguage(ctx, input.UserID, input.AnalysisID, language)
+	// Only check the user's own generation status (per-user personalization),
+	// or the workspace's when generating for a workspace
+	var status *entity.SpecGenerationStatus
+	if workspace != nil {
+		status, err = uc.workspaceSpecs.GetGenerationStatusByWorkspace(ctx, input.WorkspaceID, input.AnalysisID, language)
+	} else {
+		status, err = uc.repo.GetGenerationStatusByLanguage(ctx, input.UserID, input.AnalysisID, language)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +127,7 @@ func (uc *RequestGenerationUseCase) Execute(ctx context.Context, input RequestGe
 	// Regeneration: Worker will create a new version (no delete needed)
 	// Initial generation: Check if any version already exists
 	if !input.Mode.IsRegeneration() {
-		docExists, err := uc.repo.CheckSpecDocumentExistsByLanguage(ctx, input.AnalysisID, language)
+		docExists, err := uc.checkDocumentExists(ctx, input.WorkspaceID, input.AnalysisID, language)
 		if err != nil {
 			return nil, err
 		}
@@ -112,12 +145,12 @@ func (uc *RequestGenerationUseCase) Execute(ctx context.Context, input RequestGe
 	// Quota check: validates user has remaining quota before enqueueing.
 	if input.UserID != "" && uc.checkQuota != nil {
 		quotaResult, err := uc.checkQuota.Execute(ctx, usageusecase.CheckQuotaInput{
-			UserID:    input.UserID,
+			UserID:    billingUserID,
 			EventType: usageentity.EventTypeSpecview,
 			Amount:    testCount,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("check quota for user %s: %w", input.UserID, err)
+			return nil, fmt.Errorf("check quota for user %s: %w", billingUserID, err)
 		}
 		if !quotaResult.IsAllowed {
 			return nil, domain.ErrQuotaExceeded
@@ -132,12 +165,12 @@ func (uc *RequestGenerationUseCase) Execute(ctx context.Context, input RequestGe
 	// Enqueue with reservation if transaction support is available.
 	// Reservation prevents race conditions by tracking pending usage.
 	if uc.dbPool != nil && uc.reservationRepo != nil {
-		if err := uc.enqueueWithReservation(ctx, input.AnalysisID, language, input.UserID, input.Tier, input.Mode, testCount); err != nil {
+		if err := uc.enqueueWithReservation(ctx, input.AnalysisID, language, input.UserID, workspace, input.Tier, input.Mode, testCount); err != nil {
 			return nil, err
 		}
 	} else {
 		// Fallback: enqueue without reservation (for tests or configurations without quota tracking)
-		if err := uc.queue.EnqueueSpecGeneration(ctx, input.AnalysisID, language, userIDPtr, input.Tier, input.Mode); err != nil {
+		if err := uc.queue.EnqueueSpecGeneration(ctx, input.AnalysisID, language, userIDPtr, workspace, input.Tier, input.Mode); err != nil {
 			return nil, err
 		}
 	}
@@ -148,13 +181,27 @@ func (uc *RequestGenerationUseCase) Execute(ctx context.Context, input RequestGe
 	}, nil
 }
 
+// checkDocumentExists checks the workspace's documents when workspaceID is set.
+func (uc *RequestGenerationUseCase) checkDocumentExists(ctx context.Context, workspaceID, analysisID, language string) (bool, error) {
+	if workspaceID == "" {
+		return uc.repo.CheckSpecDocumentExistsByLanguage(ctx, analysisID, language)
+	}
+	doc, err := uc.workspaceSpecs.GetSpecDocumentByWorkspace(ctx, workspaceID, analysisID, language)
+	if err != nil {
+		return false, err
+	}
+	return doc != nil, nil
+}
+
 // enqueueWithReservation creates a quota reservation and enqueues the job atomically.
 // If enqueue fails, the transaction is rolled back and the reservation is not created.
+// For workspace generations the reservation is held against the workspace's billing user, not userID.
 func (uc *RequestGenerationUseCase) enqueueWithReservation(
 	ctx context.Context,
 	analysisID string,
 	language string,
 	userID string,
+	workspace *entity.WorkspaceGeneration,
 	tier subscription.PlanTier,
 	mode entity.GenerationMode,
 	testCount int,
@@ -166,14 +213,19 @@ func (uc *RequestGenerationUseCase) enqueueWithReservation(
 	defer func() { _ = tx.Rollback(ctx) }()
 
 	// Enqueue job within transaction - get job ID
-	jobID, err := uc.queue.EnqueueSpecGenerationTx(ctx, tx, analysisID, language, &userID, tier, mode)
+	jobID, err := uc.queue.EnqueueSpecGenerationTx(ctx, tx, analysisID, language, &userID, workspace, tier, mode)
 	if err != nil {
 		return err
 	}
 
+	billingUserID := userID
+	if workspace != nil {
+		billingUserID = workspace.BillingUserID
+	}
+
 	// Create reservation with actual test count
 	qtx := db.New(tx)
-	if err := uc.reservationRepo.CreateReservationTx(ctx, qtx, userID, usageentity.EventTypeSpecview, int32(testCount), jobID); err != nil {
+	if err := uc.reservationRepo.CreateReservationTx(ctx, qtx, billingUserID, usageentity.EventTypeSpecview, int32(testCount), jobID); err != nil {
 		return fmt.Errorf("create quota reservation: %w", err)
 	}
 
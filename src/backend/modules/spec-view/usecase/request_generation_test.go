@@ -18,6 +18,7 @@ import (
 )
 
 type mockSpecViewRepository struct {
+	activeJobID    int64
 	analysisExists bool
 	documentExists bool
 	status         *entity.SpecGenerationStatus
@@ -54,6 +55,10 @@ func (m *mockSpecViewRepository) GetSpecDocumentByUser(_ context.Context, _ stri
 	return nil, nil
 }
 
+func (m *mockSpecViewRepository) GetActiveGenerationJobID(_ context.Context, _, _, _ string) (int64, error) {
+	return m.activeJobID, nil
+}
+
 func (m *mockSpecViewRepository) GetGenerationStatus(_ context.Context, _ string, _ string) (*entity.SpecGenerationStatus, error) {
 	return m.status, m.statusErr
 }
@@ -98,6 +103,9 @@ func (m *mockSpecViewRepository) GetSpecDocumentByRepositoryAndVersion(_ context
 func (m *mockSpecViewRepository) GetSpecDocumentByRepositoryAndDocumentId(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocument, error) {
 	return nil, nil
 }
+func (m *mockSpecViewRepository) GetSpecDocumentRevisionByRepository(_ context.Context, _, _, _, _ string) (*entity.RepoSpecDocumentRevision, error) {
+	return nil, nil
+}
 func (m *mockSpecViewRepository) GetVersionHistoryByRepository(_ context.Context, _, _, _, _ string) ([]entity.RepoVersionInfo, error) {
 	return nil, nil
 }
@@ -111,16 +119,33 @@ func (m *mockSpecViewRepository) GetCurrentAnalysisTestCount(_ context.Context,
 	return 0, nil
 }
 
+func (m *mockSpecViewRepository) GetSpecEdits(_ context.Context, _ entity.DocumentAccess, _, _ string) (*entity.SpecEdits, error) {
+	return nil, nil
+}
+
 type mockQueueService struct {
+	cancelErr  error
 	enqueueErr error
 	jobID      int64
+	workspace  *entity.WorkspaceGeneration
+
+	cancelledJobID int64
+	cancelTx       pgx.Tx
+}
+
+func (m *mockQueueService) CancelSpecGenerationTx(_ context.Context, tx pgx.Tx, jobID int64) error {
+	m.cancelledJobID = jobID
+	m.cancelTx = tx
+	return m.cancelErr
 }
 
-func (m *mockQueueService) EnqueueSpecGeneration(_ context.Context, _ string, _ string, _ *string, _ subscriptionentity.PlanTier, _ entity.GenerationMode) error {
+func (m *mockQueueService) EnqueueSpecGeneration(_ context.Context, _ string, _ string, _ *string, workspace *entity.WorkspaceGeneration, _ subscriptionentity.PlanTier, _ entity.GenerationMode) error {
+	m.workspace = workspace
 	return m.enqueueErr
 }
 
-func (m *mockQueueService) EnqueueSpecGenerationTx(_ context.Context, _ pgx.Tx, _ string, _ string, _ *string, _ subscriptionentity.PlanTier, _ entity.GenerationMode) (int64, error) {
+func (m *mockQueueService) EnqueueSpecGenerationTx(_ context.Context, _ pgx.Tx, _ string, _ string, _ *string, workspace *entity.WorkspaceGeneration, _ subscriptionentity.PlanTier, _ entity.GenerationMode) (int64, error) {
+	m.workspace = workspace
 	if m.enqueueErr != nil {
 		return 0, m.enqueueErr
 	}
@@ -193,6 +218,10 @@ func (m *mockReservationRepository) DeleteReservationByJobID(_ context.Context,
 	return m.err
 }
 
+func (m *mockReservationRepository) DeleteReservationByJobIDTx(_ context.Context, _ *db.Queries, _ int64) error {
+	return m.err
+}
+
 func TestRequestGenerationUseCase_Execute_QuotaCheck(t *testing.T) {
 	now := time.Now()
 	periodEnd := now.AddDate(0, 1, 0)
@@ -382,7 +411,7 @@ func TestRequestGenerationUseCase_Execute_QuotaCheck(t *testing.T) {
 			}
 
 			// Pass nil for dbPool and reservationRepo to use fallback path without transaction
-			uc := NewRequestGenerationUseCase(tt.mockRepo, tt.mockQueue, checkQuotaUC, nil, nil)
+			uc := NewRequestGenerationUseCase(tt.mockRepo, tt.mockQueue, checkQuotaUC, nil, nil, nil, nil)
 
 			result, err := uc.Execute(context.Background(), tt.input)
 
@@ -522,11 +551,26 @@ func TestRequestGenerationUseCase_Execute_ForceRegenerate(t *testing.T) {
 			wantErr:         true,
 			wantErrContains: domain.ErrGenerationRunning.Error(),
 		},
+		{
+			name: "should enqueue force regenerate when the previous generation was cancelled",
+			input: RequestGenerationInput{
+				AnalysisID: "test-analysis-id",
+				Mode:       entity.GenerationModeRegenerateFresh,
+				Language:   "English",
+				UserID:     "test-user-id",
+			},
+			mockRepo: &mockSpecViewRepository{
+				analysisExists: true,
+				status:         &entity.SpecGenerationStatus{Status: entity.StatusCancelled},
+			},
+			mockQueue: &mockQueueService{},
+			wantErr:   false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			uc := NewRequestGenerationUseCase(tt.mockRepo, tt.mockQueue, nil, nil, nil)
+			uc := NewRequestGenerationUseCase(tt.mockRepo, tt.mockQueue, nil, nil, nil, nil, nil)
 
 			_, err := uc.Execute(context.Background(), tt.input)
 
@@ -542,3 +586,122 @@ func TestRequestGenerationUseCase_Execute_ForceRegenerate(t *testing.T) {
 		})
 	}
 }
+
+type mockWorkspaceAccess struct {
+	access *entity.WorkspaceAccess
+	err    error
+}
+
+func (m *mockWorkspaceAccess) GetWorkspaceAccess(_ context.Context, _, _ string) (*entity.WorkspaceAccess, error) {
+	return m.access, m.err
+}
+
+type mockWorkspaceSpecRepository struct {
+	activeJobID      int64
+	document         *entity.SpecDocument
+	languages        []entity.AvailableLanguageInfo
+	requestedVersion int
+	status           *entity.SpecGenerationStatus
+	versions         []entity.VersionInfo
+	workspaceID      string
+}
+
+func (m *mockWorkspaceSpecRepository) GetActiveGenerationJobIDByWorkspace(_ context.Context, workspaceID, _, _ string) (int64, error) {
+	m.workspaceID = workspaceID
+	return m.activeJobID, nil
+}
+
+func (m *mockWorkspaceSpecRepository) GetAvailableLanguagesByWorkspace(_ context.Context, workspaceID, _ string) ([]entity.AvailableLanguageInfo, error) {
+	m.workspaceID = workspaceID
+	return m.languages, nil
+}
+
+func (m *mockWorkspaceSpecRepository) GetGenerationStatusByWorkspace(_ context.Context, workspaceID, _, _ string) (*entity.SpecGenerationStatus, error) {
+	m.workspaceID = workspaceID
+	return m.status, nil
+}
+
+func (m *mockWorkspaceSpecRepository) GetSpecDocumentByWorkspace(_ context.Context, workspaceID, _, _ string) (*entity.SpecDocument, error) {
+	m.workspaceID = workspaceID
+	return m.document, nil
+}
+
+func (m *mockWorkspaceSpecRepository) GetSpecDocumentByWorkspaceAndVersion(_ context.Context, workspaceID, _, _ string, version int) (*entity.SpecDocument, error) {
+	m.workspaceID = workspaceID
+	m.requestedVersion = version
+	return m.document, nil
+}
+
+func (m *mockWorkspaceSpecRepository) GetVersionsByWorkspace(_ context.Context, workspaceID, _, _ string) ([]entity.VersionInfo, error) {
+	m.workspaceID = workspaceID
+	return m.versions, nil
+}
+
+func TestRequestGenerationUseCase_Execute_Workspace(t *testing.T) {
+	tests := []struct {
+		name            string
+		access          *mockWorkspaceAccess
+		workspaceSpecs  *mockWorkspaceSpecRepository
+		wantErr         error
+		wantWorkspaceID bool
+	}{
+		{
+			name:            "should enqueue with workspace and billing user for editor",
+			access:          &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-id", CanGenerate: true}},
+			workspaceSpecs:  &mockWorkspaceSpecRepository{},
+			wantWorkspaceID: true,
+		},
+		{
+			name:           "should reject viewer",
+			access:         &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-id"}},
+			workspaceSpecs: &mockWorkspaceSpecRepository{},
+			wantErr:        domain.ErrForbidden,
+		},
+		{
+			name:           "should reject non-member",
+			access:         &mockWorkspaceAccess{err: domain.ErrForbidden},
+			workspaceSpecs: &mockWorkspaceSpecRepository{},
+			wantErr:        domain.ErrForbidden,
+		},
+		{
+			name:   "should reject when workspace generation is running",
+			access: &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-id", CanGenerate: true}},
+			workspaceSpecs: &mockWorkspaceSpecRepository{
+				status: &entity.SpecGenerationStatus{Status: entity.StatusRunning},
+			},
+			wantErr: domain.ErrGenerationRunning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := &mockQueueService{}
+			uc := NewRequestGenerationUseCase(&mockSpecViewRepository{analysisExists: true}, queue, nil, nil, nil, tt.access, tt.workspaceSpecs)
+
+			_, err := uc.Execute(context.Background(), RequestGenerationInput{
+				AnalysisID:  "test-analysis-id",
+				Mode:        entity.GenerationModeInitial,
+				UserID:      "member-id",
+				WorkspaceID: "workspace-id",
+			})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantWorkspaceID {
+				if queue.workspace == nil || queue.workspace.WorkspaceID != "workspace-id" {
+					t.Fatalf("expected workspace ID to be passed to queue, got %+v", queue.workspace)
+				}
+				if queue.workspace.BillingUserID != "owner-id" {
+					t.Errorf("expected owner to be recorded as billing user, got %q", queue.workspace.BillingUserID)
+				}
+			}
+		})
+	}
+}
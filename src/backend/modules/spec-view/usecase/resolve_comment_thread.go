@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type ResolveCommentThreadInput struct {
+	// Resolved false reopens the thread
+	Resolved bool
+	ThreadID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the thread must be the workspace's and the user an editor or owner.
+	WorkspaceID string
+}
+
+// ResolveCommentThreadUseCase resolves or reopens a comment thread. Resolved
+// threads are no longer carried forward to spec versions generated afterwards.
+type ResolveCommentThreadUseCase struct {
+	comments   port.SpecCommentRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewResolveCommentThreadUseCase(comments port.SpecCommentRepository, workspaces port.WorkspaceAccess) *ResolveCommentThreadUseCase {
+	return &ResolveCommentThreadUseCase{comments: comments, workspaces: workspaces}
+}
+
+func (uc *ResolveCommentThreadUseCase) Execute(ctx context.Context, input ResolveCommentThreadInput) error {
+	if input.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	if input.ThreadID == "" {
+		return domain.ErrCommentThreadNotFound
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := uc.comments.SetCommentThreadResolved(ctx, access, input.ThreadID, input.Resolved)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return domain.ErrCommentThreadNotFound
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type RevertBehaviorEditInput struct {
+	BehaviorID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the behavior must be in the workspace's documents
+	// and the user must be an editor or owner of the workspace.
+	WorkspaceID string
+}
+
+// RevertBehaviorEditUseCase removes the edit of a behavior, restoring the
+// generated description and visibility.
+type RevertBehaviorEditUseCase struct {
+	edits      port.SpecEditRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewRevertBehaviorEditUseCase(edits port.SpecEditRepository, workspaces port.WorkspaceAccess) *RevertBehaviorEditUseCase {
+	return &RevertBehaviorEditUseCase{edits: edits, workspaces: workspaces}
+}
+
+func (uc *RevertBehaviorEditUseCase) Execute(ctx context.Context, input RevertBehaviorEditInput) error {
+	if input.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	if input.BehaviorID == "" {
+		return domain.ErrBehaviorNotFound
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	target, err := uc.edits.GetBehaviorEditTarget(ctx, access, input.BehaviorID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := uc.edits.DeleteBehaviorEdit(ctx, *target)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrEditNotFound
+	}
+	return nil
+}
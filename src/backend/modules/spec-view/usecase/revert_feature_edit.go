@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type RevertFeatureEditInput struct {
+	FeatureID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the feature must be in the workspace's documents
+	// and the user must be an editor or owner of the workspace.
+	WorkspaceID string
+}
+
+// RevertFeatureEditUseCase removes the rename of a feature, splitting it from
+// any feature it was merged into.
+type RevertFeatureEditUseCase struct {
+	edits      port.SpecEditRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewRevertFeatureEditUseCase(edits port.SpecEditRepository, workspaces port.WorkspaceAccess) *RevertFeatureEditUseCase {
+	return &RevertFeatureEditUseCase{edits: edits, workspaces: workspaces}
+}
+
+func (uc *RevertFeatureEditUseCase) Execute(ctx context.Context, input RevertFeatureEditInput) error {
+	if input.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	if input.FeatureID == "" {
+		return domain.ErrFeatureNotFound
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	target, err := uc.edits.GetFeatureEditTarget(ctx, access, input.FeatureID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := uc.edits.DeleteFeatureEdit(ctx, *target)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrEditNotFound
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type RevokeShareLinkInput struct {
+	ShareLinkID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the link must be the workspace's and the user an editor or owner.
+	WorkspaceID string
+}
+
+// RevokeShareLinkUseCase permanently disables a share link. Revoked links
+// are kept so their access log stays available.
+type RevokeShareLinkUseCase struct {
+	shareLinks port.SpecShareLinkRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewRevokeShareLinkUseCase(shareLinks port.SpecShareLinkRepository, workspaces port.WorkspaceAccess) *RevokeShareLinkUseCase {
+	return &RevokeShareLinkUseCase{shareLinks: shareLinks, workspaces: workspaces}
+}
+
+func (uc *RevokeShareLinkUseCase) Execute(ctx context.Context, input RevokeShareLinkInput) error {
+	if input.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	if input.ShareLinkID == "" {
+		return domain.ErrShareLinkNotFound
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := uc.shareLinks.RevokeShareLink(ctx, access, input.ShareLinkID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return domain.ErrShareLinkNotFound
+	}
+	return nil
+}
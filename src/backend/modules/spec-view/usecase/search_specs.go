@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type SearchSpecsInput struct {
+	// Cursor is the opaque position returned by a previous page. Optional.
+	Cursor string
+	// Language, Owner and Repo are optional filters.
+	Language string
+	// Limit defaults to entity.DefaultSearchLimit and is capped at entity.MaxSearchLimit.
+	Limit int
+	Owner string
+	// Query uses web search syntax: quoted phrases, OR and -negation.
+	Query string
+	Repo  string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, searches the workspace's documents; the user must be a member.
+	WorkspaceID string
+}
+
+type SearchSpecsOutput struct {
+	HasNext bool
+	// NextCursor is empty when HasNext is false
+	NextCursor string
+	Results    []entity.SearchResult
+}
+
+type SearchSpecsUseCase struct {
+	searcher   port.SpecSearcher
+	workspaces port.WorkspaceAccess
+}
+
+func NewSearchSpecsUseCase(searcher port.SpecSearcher, workspaces port.WorkspaceAccess) *SearchSpecsUseCase {
+	return &SearchSpecsUseCase{searcher: searcher, workspaces: workspaces}
+}
+
+func (uc *SearchSpecsUseCase) Execute(ctx context.Context, input SearchSpecsInput) (*SearchSpecsOutput, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	query := strings.TrimSpace(input.Query)
+	if query == "" || utf8.RuneCountInString(query) > entity.MaxSearchQueryLength {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+
+	if input.Language != "" && !entity.IsValidLanguage(input.Language) {
+		return nil, domain.ErrInvalidLanguage
+	}
+
+	if (input.Owner != "" && !entity.IsValidRepositoryName(input.Owner)) ||
+		(input.Repo != "" && !entity.IsValidRepositoryName(input.Repo)) {
+		return nil, domain.ErrInvalidRepository
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = entity.DefaultSearchLimit
+	}
+	limit = min(limit, entity.MaxSearchLimit)
+
+	offset := 0
+	if input.Cursor != "" {
+		cursor, ok := entity.DecodeSearchCursor(input.Cursor, query)
+		if !ok {
+			return nil, domain.ErrInvalidCursor
+		}
+		offset = cursor.Offset
+	}
+
+	access, err := readAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch one extra result to detect whether another page exists
+	results, err := uc.searcher.SearchSpecDocuments(ctx, port.SearchParams{
+		Access:   access,
+		Language: input.Language,
+		Limit:    limit + 1,
+		Offset:   offset,
+		Owner:    input.Owner,
+		Query:    query,
+		Repo:     input.Repo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SearchSpecsOutput{Results: results}
+	if len(results) > limit {
+		output.HasNext = true
+		output.NextCursor = entity.EncodeSearchCursor(entity.SearchCursor{Offset: offset + limit, Query: query})
+		output.Results = results[:limit]
+	}
+	return output, nil
+}
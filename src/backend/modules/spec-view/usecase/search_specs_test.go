@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type mockSearcher struct {
+	results []entity.SearchResult
+	err     error
+
+	calledParams port.SearchParams
+}
+
+func (m *mockSearcher) SearchSpecDocuments(_ context.Context, params port.SearchParams) ([]entity.SearchResult, error) {
+	m.calledParams = params
+	return m.results, m.err
+}
+
+func newSearchResults(n int) []entity.SearchResult {
+	results := make([]entity.SearchResult, n)
+	for i := range results {
+		results[i] = entity.SearchResult{Kind: entity.SearchResultBehavior, Owner: "octocat", Repo: "hello"}
+	}
+	return results
+}
+
+func TestSearchSpecsUseCase_Execute(t *testing.T) {
+	t.Run("returns ErrUnauthorized when userID is empty", func(t *testing.T) {
+		uc := NewSearchSpecsUseCase(&mockSearcher{}, nil)
+		_, err := uc.Execute(context.Background(), SearchSpecsInput{Query: "refund"})
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrUnauthorized)
+		}
+	})
+
+	validationTests := []struct {
+		name    string
+		input   SearchSpecsInput
+		wantErr error
+	}{
+		{"blank query", SearchSpecsInput{Query: "   "}, domain.ErrInvalidSearchQuery},
+		{"query too long", SearchSpecsInput{Query: strings.Repeat("a", entity.MaxSearchQueryLength+1)}, domain.ErrInvalidSearchQuery},
+		{"invalid language", SearchSpecsInput{Language: "Klingon", Query: "refund"}, domain.ErrInvalidLanguage},
+		{"invalid owner", SearchSpecsInput{Owner: "bad/owner", Query: "refund"}, domain.ErrInvalidRepository},
+		{"invalid repo", SearchSpecsInput{Query: "refund", Repo: "-repo"}, domain.ErrInvalidRepository},
+		{"malformed cursor", SearchSpecsInput{Cursor: "not a cursor", Query: "refund"}, domain.ErrInvalidCursor},
+		{
+			"cursor from another query",
+			SearchSpecsInput{Cursor: entity.EncodeSearchCursor(entity.SearchCursor{Offset: 20, Query: "payment"}), Query: "refund"},
+			domain.ErrInvalidCursor,
+		},
+	}
+	for _, tt := range validationTests {
+		t.Run("returns error for "+tt.name, func(t *testing.T) {
+			searcher := &mockSearcher{}
+			tt.input.UserID = "user-1"
+			_, err := NewSearchSpecsUseCase(searcher, nil).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("passes trimmed query, filters and default limit", func(t *testing.T) {
+		searcher := &mockSearcher{}
+		uc := NewSearchSpecsUseCase(searcher, nil)
+		_, err := uc.Execute(context.Background(), SearchSpecsInput{
+			Language: "Korean",
+			Owner:    "octocat",
+			Query:    "  refund  ",
+			Repo:     "hello",
+			UserID:   "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		want := port.SearchParams{
+			Access:   entity.DocumentAccess{UserID: "user-1"},
+			Language: "Korean",
+			Limit:    entity.DefaultSearchLimit + 1,
+			Owner:    "octocat",
+			Query:    "refund",
+			Repo:     "hello",
+		}
+		if searcher.calledParams != want {
+			t.Errorf("SearchSpecDocuments() params = %+v, want %+v", searcher.calledParams, want)
+		}
+	})
+
+	t.Run("caps limit", func(t *testing.T) {
+		searcher := &mockSearcher{}
+		_, err := NewSearchSpecsUseCase(searcher, nil).Execute(context.Background(), SearchSpecsInput{
+			Limit:  1000,
+			Query:  "refund",
+			UserID: "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if searcher.calledParams.Limit != entity.MaxSearchLimit+1 {
+			t.Errorf("Limit = %d, want %d", searcher.calledParams.Limit, entity.MaxSearchLimit+1)
+		}
+	})
+
+	t.Run("returns next cursor when more results exist", func(t *testing.T) {
+		searcher := &mockSearcher{results: newSearchResults(3)}
+		uc := NewSearchSpecsUseCase(searcher, nil)
+		output, err := uc.Execute(context.Background(), SearchSpecsInput{Limit: 2, Query: "refund", UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !output.HasNext || len(output.Results) != 2 {
+			t.Fatalf("HasNext = %v, results = %d, want true and 2", output.HasNext, len(output.Results))
+		}
+
+		searcher.results = newSearchResults(1)
+		output, err = uc.Execute(context.Background(), SearchSpecsInput{
+			Cursor: output.NextCursor,
+			Limit:  2,
+			Query:  "refund",
+			UserID: "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() with cursor error = %v", err)
+		}
+		if searcher.calledParams.Offset != 2 {
+			t.Errorf("Offset = %d, want 2", searcher.calledParams.Offset)
+		}
+		if output.HasNext || output.NextCursor != "" || len(output.Results) != 1 {
+			t.Errorf("last page: HasNext = %v, NextCursor = %q, results = %d", output.HasNext, output.NextCursor, len(output.Results))
+		}
+	})
+
+	t.Run("propagates searcher error", func(t *testing.T) {
+		searchErr := errors.New("db down")
+		_, err := NewSearchSpecsUseCase(&mockSearcher{err: searchErr}, nil).Execute(context.Background(), SearchSpecsInput{
+			Query:  "refund",
+			UserID: "user-1",
+		})
+		if !errors.Is(err, searchErr) {
+			t.Errorf("Execute() error = %v, want %v", err, searchErr)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+type UpdateCommentInput struct {
+	Body      string
+	CommentID string
+	// UserID is required. Empty userID returns ErrUnauthorized.
+	UserID string
+	// WorkspaceID is optional. If set, the comment must be on a workspace thread and the user an editor or owner.
+	WorkspaceID string
+}
+
+// UpdateCommentUseCase edits a comment written by the user. Mentions are
+// re-parsed from the new body.
+type UpdateCommentUseCase struct {
+	comments   port.SpecCommentRepository
+	workspaces port.WorkspaceAccess
+}
+
+func NewUpdateCommentUseCase(comments port.SpecCommentRepository, workspaces port.WorkspaceAccess) *UpdateCommentUseCase {
+	return &UpdateCommentUseCase{comments: comments, workspaces: workspaces}
+}
+
+func (uc *UpdateCommentUseCase) Execute(ctx context.Context, input UpdateCommentInput) (*entity.Comment, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if input.CommentID == "" {
+		return nil, domain.ErrCommentNotFound
+	}
+
+	body, ok := entity.NormalizeEditText(input.Body, entity.MaxCommentLength)
+	if !ok {
+		return nil, domain.ErrInvalidComment
+	}
+
+	access, err := writeAccess(ctx, uc.workspaces, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.comments.UpdateComment(ctx, access, input.CommentID, body, entity.ParseMentions(body))
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+// readAccess resolves the documents a read request reaches. Any workspace member may read.
+func readAccess(ctx context.Context, workspaces port.WorkspaceAccess, userID, workspaceID string) (entity.DocumentAccess, error) {
+	return resolveDocumentAccess(ctx, workspaces, userID, workspaceID, false)
+}
+
+// writeAccess resolves the documents a request that changes them reaches.
+// In a workspace, only editors and owners may write.
+func writeAccess(ctx context.Context, workspaces port.WorkspaceAccess, userID, workspaceID string) (entity.DocumentAccess, error) {
+	return resolveDocumentAccess(ctx, workspaces, userID, workspaceID, true)
+}
+
+func resolveDocumentAccess(ctx context.Context, workspaces port.WorkspaceAccess, userID, workspaceID string, write bool) (entity.DocumentAccess, error) {
+	if workspaceID == "" {
+		return entity.DocumentAccess{UserID: userID}, nil
+	}
+	if workspaces == nil {
+		return entity.DocumentAccess{}, domain.ErrForbidden
+	}
+
+	access, err := workspaces.GetWorkspaceAccess(ctx, workspaceID, userID)
+	if err != nil {
+		return entity.DocumentAccess{}, err
+	}
+	if write && !access.CanGenerate {
+		return entity.DocumentAccess{}, domain.ErrForbidden
+	}
+
+	return entity.DocumentAccess{UserID: userID, WorkspaceID: workspaceID}, nil
+}
+
+// specDocumentReader reads the documents a DocumentAccess reaches: the user's
+// own, or the workspace's whichever member requested them.
+type specDocumentReader struct {
+	repo           port.SpecViewRepository
+	workspaceSpecs port.WorkspaceSpecRepository
+}
+
+func (r specDocumentReader) latest(ctx context.Context, access entity.DocumentAccess, analysisID, language string) (*entity.SpecDocument, error) {
+	if access.WorkspaceID != "" {
+		return r.workspaceSpecs.GetSpecDocumentByWorkspace(ctx, access.WorkspaceID, analysisID, language)
+	}
+	return r.repo.GetSpecDocumentByUser(ctx, access.UserID, analysisID, language)
+}
+
+func (r specDocumentReader) byVersion(ctx context.Context, access entity.DocumentAccess, analysisID, language string, version int) (*entity.SpecDocument, error) {
+	if access.WorkspaceID != "" {
+		return r.workspaceSpecs.GetSpecDocumentByWorkspaceAndVersion(ctx, access.WorkspaceID, analysisID, language, version)
+	}
+	return r.repo.GetSpecDocumentByUserAndVersion(ctx, access.UserID, analysisID, language, version)
+}
+
+func (r specDocumentReader) versions(ctx context.Context, access entity.DocumentAccess, analysisID, language string) ([]entity.VersionInfo, error) {
+	if access.WorkspaceID != "" {
+		return r.workspaceSpecs.GetVersionsByWorkspace(ctx, access.WorkspaceID, analysisID, language)
+	}
+	return r.repo.GetVersionsByUser(ctx, access.UserID, analysisID, language)
+}
+
+func (r specDocumentReader) activeJobID(ctx context.Context, access entity.DocumentAccess, analysisID, language string) (int64, error) {
+	if access.WorkspaceID != "" {
+		return r.workspaceSpecs.GetActiveGenerationJobIDByWorkspace(ctx, access.WorkspaceID, analysisID, language)
+	}
+	return r.repo.GetActiveGenerationJobID(ctx, access.UserID, analysisID, language)
+}
+
+// generationStatus returns the status in any language if language is empty.
+func (r specDocumentReader) generationStatus(ctx context.Context, access entity.DocumentAccess, analysisID, language string) (*entity.SpecGenerationStatus, error) {
+	if access.WorkspaceID != "" {
+		return r.workspaceSpecs.GetGenerationStatusByWorkspace(ctx, access.WorkspaceID, analysisID, language)
+	}
+	if language == "" {
+		return r.repo.GetGenerationStatus(ctx, access.UserID, analysisID)
+	}
+	return r.repo.GetGenerationStatusByLanguage(ctx, access.UserID, analysisID, language)
+}
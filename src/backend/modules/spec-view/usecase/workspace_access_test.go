@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	"github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+)
+
+const testWorkspaceID = "workspace-1"
+
+// workspaceEndpoint runs one usecase against a workspace and returns the
+// workspace the repository was asked for.
+type workspaceEndpoint struct {
+	name  string
+	write bool
+	// allowedErr is the error an allowed request still returns with empty mocks.
+	allowedErr error
+	run        func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error)
+}
+
+func workspaceEndpoints() []workspaceEndpoint {
+	return []workspaceEndpoint{
+		{
+			name: "GetVersions",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				specs := &mockWorkspaceSpecRepository{versions: []entity.VersionInfo{{Version: 1}}}
+				_, err := NewGetVersionsUseCase(&mockRepository{}, workspaces, specs).Execute(ctx, GetVersionsInput{
+					AnalysisID: "analysis-1", Language: "English", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return specs.workspaceID, err
+			},
+		},
+		{
+			name: "GetSpecDiff",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				specs := &mockWorkspaceSpecRepository{document: &entity.SpecDocument{Version: 1}}
+				_, err := NewGetSpecDiffUseCase(&mockRepository{}, workspaces, specs).Execute(ctx, GetSpecDiffInput{
+					AnalysisID: "analysis-1", FromVersion: 1, Language: "English", ToVersion: 2, UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return specs.workspaceID, err
+			},
+		},
+		{
+			name: "ExportSpecDocument",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				specs := &mockWorkspaceSpecRepository{document: &entity.SpecDocument{AnalysisID: "analysis-1", Language: "English"}}
+				renderers := map[entity.ExportFormat]port.SpecRenderer{entity.DefaultExportFormat: &mockRenderer{}}
+				uc := NewExportSpecDocumentUseCase(&mockRepository{}, &mockSourceReader{source: newTestSource()}, renderers, workspaces, specs)
+				_, err := uc.Execute(ctx, ExportSpecDocumentInput{AnalysisID: "analysis-1", UserID: "user-1", WorkspaceID: testWorkspaceID})
+				return specs.workspaceID, err
+			},
+		},
+		{
+			name: "GetGenerationStatus",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				specs := &mockWorkspaceSpecRepository{}
+				_, err := NewGetGenerationStatusUseCase(&mockRepository{}, workspaces, specs).Execute(ctx, GetGenerationStatusInput{
+					AnalysisID: "analysis-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return specs.workspaceID, err
+			},
+		},
+		{
+			name:       "CancelGeneration",
+			write:      true,
+			allowedErr: domain.ErrNoActiveGeneration,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				specs := &mockWorkspaceSpecRepository{}
+				uc := NewCancelGenerationUseCase(&mockRepository{}, &mockQueueService{}, nil, nil, workspaces, specs)
+				_, err := uc.Execute(ctx, CancelGenerationInput{AnalysisID: "analysis-1", UserID: "user-1", WorkspaceID: testWorkspaceID})
+				return specs.workspaceID, err
+			},
+		},
+		{
+			name: "GetSpecEdits",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				repo := &mockRepository{}
+				_, err := NewGetSpecEditsUseCase(repo, workspaces).Execute(ctx, GetSpecEditsInput{
+					AnalysisID: "analysis-1", Language: "English", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return repo.calledEditsAccess.WorkspaceID, err
+			},
+		},
+		{
+			name: "SearchSpecs",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				searcher := &mockSearcher{}
+				_, err := NewSearchSpecsUseCase(searcher, workspaces).Execute(ctx, SearchSpecsInput{
+					Query: "refund", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return searcher.calledParams.Access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "EditBehavior",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				edits := &mockEditRepository{behaviorTarget: newBehaviorEditTarget()}
+				_, err := NewEditBehaviorUseCase(edits, workspaces).Execute(ctx, EditBehaviorInput{
+					BehaviorID: "behavior-1", Hidden: true, UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return edits.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "RevertBehaviorEdit",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				edits := &mockEditRepository{behaviorTarget: newBehaviorEditTarget(), deleted: true}
+				err := NewRevertBehaviorEditUseCase(edits, workspaces).Execute(ctx, RevertBehaviorEditInput{
+					BehaviorID: "behavior-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return edits.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "EditFeature",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				edits := &mockEditRepository{featureTarget: &entity.FeatureEditTarget{}}
+				_, err := NewEditFeatureUseCase(edits, workspaces).Execute(ctx, EditFeatureInput{
+					FeatureID: "feature-1", Name: "Returns", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return edits.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "RevertFeatureEdit",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				edits := &mockEditRepository{deleted: true, featureTarget: &entity.FeatureEditTarget{}}
+				err := NewRevertFeatureEditUseCase(edits, workspaces).Execute(ctx, RevertFeatureEditInput{
+					FeatureID: "feature-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return edits.access.WorkspaceID, err
+			},
+		},
+		{
+			name: "GetSpecComments",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				comments := &mockCommentRepository{document: newCommentDocument()}
+				_, err := NewGetSpecCommentsUseCase(comments, workspaces).Execute(ctx, GetSpecCommentsInput{
+					DocumentID: testDocumentID, UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return comments.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "CreateCommentThread",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				comments := &mockCommentRepository{document: newCommentDocument()}
+				_, err := NewCreateCommentThreadUseCase(comments, workspaces).Execute(ctx, CreateCommentThreadInput{
+					Body:        "Is this right?",
+					DocumentID:  testDocumentID,
+					TargetID:    "behavior-1",
+					TargetType:  entity.CommentTargetBehavior,
+					UserID:      "user-1",
+					WorkspaceID: testWorkspaceID,
+				})
+				return comments.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "AddComment",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				comments := &mockCommentRepository{}
+				_, err := NewAddCommentUseCase(comments, workspaces).Execute(ctx, AddCommentInput{
+					Body: "Agreed", ThreadID: "thread-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return comments.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "UpdateComment",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				comments := &mockCommentRepository{updated: true}
+				_, err := NewUpdateCommentUseCase(comments, workspaces).Execute(ctx, UpdateCommentInput{
+					Body: "Agreed", CommentID: "comment-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return comments.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "DeleteComment",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				comments := &mockCommentRepository{updated: true}
+				err := NewDeleteCommentUseCase(comments, workspaces).Execute(ctx, DeleteCommentInput{
+					CommentID: "comment-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return comments.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "ResolveCommentThread",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				comments := &mockCommentRepository{updated: true}
+				err := NewResolveCommentThreadUseCase(comments, workspaces).Execute(ctx, ResolveCommentThreadInput{
+					Resolved: true, ThreadID: "thread-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return comments.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "CreateShareLink",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				shareLinks := &mockShareLinkRepository{}
+				_, err := NewCreateShareLinkUseCase(shareLinks, workspaces).Execute(ctx, CreateShareLinkInput{
+					DocumentID: testDocumentID, UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				if shareLinks.createdParams == nil {
+					return "", err
+				}
+				return shareLinks.createdParams.Access.WorkspaceID, err
+			},
+		},
+		{
+			name: "ListShareLinks",
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				shareLinks := &mockShareLinkRepository{}
+				_, err := NewListShareLinksUseCase(shareLinks, workspaces).Execute(ctx, ListShareLinksInput{
+					UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return shareLinks.access.WorkspaceID, err
+			},
+		},
+		{
+			name:  "RevokeShareLink",
+			write: true,
+			run: func(ctx context.Context, workspaces port.WorkspaceAccess) (string, error) {
+				shareLinks := &mockShareLinkRepository{revoked: true}
+				err := NewRevokeShareLinkUseCase(shareLinks, workspaces).Execute(ctx, RevokeShareLinkInput{
+					ShareLinkID: "link-1", UserID: "user-1", WorkspaceID: testWorkspaceID,
+				})
+				return shareLinks.access.WorkspaceID, err
+			},
+		},
+	}
+}
+
+func TestWorkspaceAccess_SpecViewEndpoints(t *testing.T) {
+	roles := []struct {
+		name     string
+		access   *mockWorkspaceAccess
+		canRead  bool
+		canWrite bool
+	}{
+		{
+			name:     "viewer",
+			access:   &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-1"}},
+			canRead:  true,
+			canWrite: false,
+		},
+		{
+			name:     "editor",
+			access:   &mockWorkspaceAccess{access: &entity.WorkspaceAccess{BillingUserID: "owner-1", CanGenerate: true}},
+			canRead:  true,
+			canWrite: true,
+		},
+		{
+			name:   "non-member",
+			access: &mockWorkspaceAccess{err: domain.ErrForbidden},
+		},
+	}
+
+	for _, endpoint := range workspaceEndpoints() {
+		for _, role := range roles {
+			t.Run(endpoint.name+"/"+role.name, func(t *testing.T) {
+				allowed := role.canRead
+				if endpoint.write {
+					allowed = role.canWrite
+				}
+
+				reached, err := endpoint.run(context.Background(), role.access)
+
+				if !allowed {
+					if !errors.Is(err, domain.ErrForbidden) {
+						t.Errorf("Execute() error = %v, want %v", err, domain.ErrForbidden)
+					}
+					if reached != "" {
+						t.Errorf("repository reached workspace %q, want no repository call", reached)
+					}
+					return
+				}
+				if !errors.Is(err, endpoint.allowedErr) {
+					t.Fatalf("Execute() error = %v, want %v", err, endpoint.allowedErr)
+				}
+				if reached != testWorkspaceID {
+					t.Errorf("repository reached workspace %q, want %q", reached, testWorkspaceID)
+				}
+			})
+		}
+	}
+}
+
+func TestWorkspaceAccess_PersonalDocuments(t *testing.T) {
+	t.Run("skips the membership check without a workspace", func(t *testing.T) {
+		access, err := writeAccess(context.Background(), &mockWorkspaceAccess{err: domain.ErrForbidden}, "user-1", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if access != (entity.DocumentAccess{UserID: "user-1"}) {
+			t.Errorf("access = %+v, want the user's own documents", access)
+		}
+	})
+
+	t.Run("rejects workspaces when workspace access is not wired", func(t *testing.T) {
+		_, err := readAccess(context.Background(), nil, "user-1", testWorkspaceID)
+		if !errors.Is(err, domain.ErrForbidden) {
+			t.Errorf("readAccess() error = %v, want %v", err, domain.ErrForbidden)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/modules/subscription/domain"
+	"github.com/specvital/web/src/backend/modules/subscription/domain/port"
+)
+
+const (
+	// DefaultAPIRateLimitPerMinute applies to users without an active
+	// subscription, matching the free plan.
+	DefaultAPIRateLimitPerMinute = 60
+
+	rateLimitCacheTTL     = time.Minute
+	rateLimitCacheMaxSize = 10000
+)
+
+var _ middleware.UserRateLimitLookup = (*RateLimitLookupAdapter)(nil)
+
+// RateLimitLookupAdapter resolves per-minute API limits from the user's plan.
+// Limits are cached briefly since the lookup runs on every authenticated request.
+type RateLimitLookupAdapter struct {
+	cache        map[string]cachedRateLimit
+	defaultLimit int
+	mu           sync.Mutex
+	now          func() time.Time
+	repo         port.SubscriptionRepository
+}
+
+type cachedRateLimit struct {
+	expiresAt time.Time
+	limit     int
+}
+
+func NewRateLimitLookupAdapter(repo port.SubscriptionRepository, defaultLimit int) *RateLimitLookupAdapter {
+	return &RateLimitLookupAdapter{
+		cache:        make(map[string]cachedRateLimit),
+		defaultLimit: defaultLimit,
+		now:          time.Now,
+		repo:         repo,
+	}
+}
+
+// GetUserRateLimit returns 0 for plans with an explicit limit of 0 (unlimited).
+// Plans whose limit is not set fall back to the default limit, so a missing
+// backfill never lifts the limit for paying users.
+func (a *RateLimitLookupAdapter) GetUserRateLimit(ctx context.Context, userID string) (int, error) {
+	now := a.now()
+
+	a.mu.Lock()
+	cached, ok := a.cache[userID]
+	a.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.limit, nil
+	}
+
+	limit, err := a.lookup(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	a.mu.Lock()
+	if len(a.cache) >= rateLimitCacheMaxSize {
+		a.cache = make(map[string]cachedRateLimit)
+	}
+	a.cache[userID] = cachedRateLimit{expiresAt: now.Add(rateLimitCacheTTL), limit: limit}
+	a.mu.Unlock()
+
+	return limit, nil
+}
+
+func (a *RateLimitLookupAdapter) lookup(ctx context.Context, userID string) (int, error) {
+	sub, err := a.repo.GetActiveSubscriptionWithPlan(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoActiveSubscription) {
+			return a.defaultLimit, nil
+		}
+		return 0, err
+	}
+
+	if sub.Plan.APIRateLimitPerMinute == nil {
+		return a.defaultLimit, nil
+	}
+	return int(*sub.Plan.APIRateLimitPerMinute), nil
+}
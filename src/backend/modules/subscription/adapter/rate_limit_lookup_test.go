@@ -0,0 +1,106 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/subscription/domain"
+	"github.com/specvital/web/src/backend/modules/subscription/domain/entity"
+)
+
+type countingSubRepo struct {
+	mockSubRepo
+	calls int
+}
+
+func (m *countingSubRepo) GetActiveSubscriptionWithPlan(ctx context.Context, userID string) (*entity.SubscriptionWithPlan, error) {
+	m.calls++
+	return m.mockSubRepo.GetActiveSubscriptionWithPlan(ctx, userID)
+}
+
+func TestRateLimitLookupAdapter_GetUserRateLimit(t *testing.T) {
+	planLimit := int32(300)
+
+	t.Run("returns limit from plan", func(t *testing.T) {
+		adapter := NewRateLimitLookupAdapter(&mockSubRepo{
+			sub: &entity.SubscriptionWithPlan{
+				Plan: entity.Plan{Tier: entity.PlanTierPro, APIRateLimitPerMinute: &planLimit},
+			},
+		}, DefaultAPIRateLimitPerMinute)
+
+		limit, err := adapter.GetUserRateLimit(context.Background(), "user-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if limit != 300 {
+			t.Errorf("expected 300, got %d", limit)
+		}
+	})
+
+	t.Run("returns zero for plans with an explicit unlimited limit", func(t *testing.T) {
+		unlimited := int32(0)
+		adapter := NewRateLimitLookupAdapter(&mockSubRepo{
+			sub: &entity.SubscriptionWithPlan{
+				Plan: entity.Plan{Tier: entity.PlanTierEnterprise, APIRateLimitPerMinute: &unlimited},
+			},
+		}, DefaultAPIRateLimitPerMinute)
+
+		limit, err := adapter.GetUserRateLimit(context.Background(), "user-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if limit != 0 {
+			t.Errorf("expected unlimited (0), got %d", limit)
+		}
+	})
+
+	t.Run("returns default for plans without a limit", func(t *testing.T) {
+		adapter := NewRateLimitLookupAdapter(&mockSubRepo{
+			sub: &entity.SubscriptionWithPlan{Plan: entity.Plan{Tier: entity.PlanTierPro}},
+		}, 42)
+
+		limit, err := adapter.GetUserRateLimit(context.Background(), "user-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if limit != 42 {
+			t.Errorf("expected default 42, got %d", limit)
+		}
+	})
+
+	t.Run("returns default when no active subscription", func(t *testing.T) {
+		adapter := NewRateLimitLookupAdapter(&mockSubRepo{err: domain.ErrNoActiveSubscription}, 42)
+
+		limit, err := adapter.GetUserRateLimit(context.Background(), "user-123")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if limit != 42 {
+			t.Errorf("expected default 42, got %d", limit)
+		}
+	})
+
+	t.Run("returns error for other repository errors", func(t *testing.T) {
+		dbErr := errors.New("database error")
+		adapter := NewRateLimitLookupAdapter(&mockSubRepo{err: dbErr}, DefaultAPIRateLimitPerMinute)
+
+		if _, err := adapter.GetUserRateLimit(context.Background(), "user-123"); !errors.Is(err, dbErr) {
+			t.Errorf("expected %v, got %v", dbErr, err)
+		}
+	})
+
+	t.Run("caches limits per user", func(t *testing.T) {
+		repo := &countingSubRepo{mockSubRepo: mockSubRepo{
+			sub: &entity.SubscriptionWithPlan{Plan: entity.Plan{APIRateLimitPerMinute: &planLimit}},
+		}}
+		adapter := NewRateLimitLookupAdapter(repo, DefaultAPIRateLimitPerMinute)
+
+		for range 3 {
+			_, _ = adapter.GetUserRateLimit(context.Background(), "user-123")
+		}
+		if repo.calls != 1 {
+			t.Errorf("expected 1 repository call, got %d", repo.calls)
+		}
+	})
+}
@@ -161,6 +161,9 @@ func (r *PostgresRepository) GetActiveSubscriptionWithPlan(ctx context.Context,
 	if row.PlanRetentionDays.Valid {
 		sub.Plan.RetentionDays = &row.PlanRetentionDays.Int32
 	}
+	if row.PlanApiRateLimitPerMinute.Valid {
+		sub.Plan.APIRateLimitPerMinute = &row.PlanApiRateLimitPerMinute.Int32
+	}
 
 	return sub, nil
 }
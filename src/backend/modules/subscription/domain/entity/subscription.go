@@ -3,11 +3,12 @@ package entity
 import "time"
 
 type Plan struct {
-	ID                   string
-	Tier                 PlanTier
-	SpecviewMonthlyLimit *int32
-	AnalysisMonthlyLimit *int32
-	RetentionDays        *int32
+	ID                    string
+	Tier                  PlanTier
+	SpecviewMonthlyLimit  *int32
+	AnalysisMonthlyLimit  *int32
+	RetentionDays         *int32
+	APIRateLimitPerMinute *int32
 }
 
 type PricingPlan struct {
@@ -62,7 +62,11 @@ func (r *QuotaReservationPostgresRepository) GetTotalReservedAmount(ctx context.
 }
 
 func (r *QuotaReservationPostgresRepository) DeleteReservationByJobID(ctx context.Context, jobID int64) error {
-	if err := r.queries.DeleteQuotaReservationByJobID(ctx, jobID); err != nil {
+	return r.DeleteReservationByJobIDTx(ctx, r.queries, jobID)
+}
+
+func (r *QuotaReservationPostgresRepository) DeleteReservationByJobIDTx(ctx context.Context, qtx *db.Queries, jobID int64) error {
+	if err := qtx.DeleteQuotaReservationByJobID(ctx, jobID); err != nil {
 		return fmt.Errorf("delete quota reservation: %w", err)
 	}
 	return nil
@@ -19,4 +19,6 @@ type QuotaReservationRepository interface {
 	CreateReservationTx(ctx context.Context, qtx *db.Queries, userID string, eventType entity.EventType, amount int32, jobID int64) error
 	GetTotalReservedAmount(ctx context.Context, userID string, eventType entity.EventType) (int64, error)
 	DeleteReservationByJobID(ctx context.Context, jobID int64) error
+	// DeleteReservationByJobIDTx deletes a reservation within a transaction.
+	DeleteReservationByJobIDTx(ctx context.Context, qtx *db.Queries, jobID int64) error
 }
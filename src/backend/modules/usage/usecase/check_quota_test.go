@@ -75,6 +75,10 @@ func (m *mockReservationRepo) DeleteReservationByJobID(_ context.Context, _ int6
 	return m.err
 }
 
+func (m *mockReservationRepo) DeleteReservationByJobIDTx(_ context.Context, _ *db.Queries, _ int64) error {
+	return m.err
+}
+
 func TestCheckQuotaUseCase_Execute(t *testing.T) {
 	now := time.Now()
 	periodStart := now.AddDate(0, -1, 0)
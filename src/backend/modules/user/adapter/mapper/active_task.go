@@ -28,9 +28,9 @@ func toActiveTask(task entity.ActiveTask) api.ActiveTask {
 func mapTaskStatus(status entity.TaskStatus) api.ActiveTaskStatus {
 	switch status {
 	case entity.TaskStatusAnalyzing:
-		return api.Analyzing
+		return api.ActiveTaskStatusAnalyzing
 	default:
-		return api.Queued
+		return api.ActiveTaskStatusQueued
 	}
 }
 
@@ -84,12 +84,16 @@ func (m *mockHistoryRepository) GetUserAnalyzedRepositories(_ context.Context, _
 func setupTestRouter(handler *Handler) *chi.Mux {
 	r := chi.NewRouter()
 	apiHandlers := api.NewAPIHandlers(
+		nil, // admin
 		&mockAnalyzerHandler{},
+		nil, // analysisBatch
 		handler,
+		nil, // analysisIngest
 		authhandler.NewMockHandler(),
 		handler,
 		&mockGitHubHandler{},
 		&mockGitHubAppHandler{},
+		nil, // orgDashboard
 		&mockPricingHandler{},
 		&mockRepositoryHandler{},
 		specviewhandler.NewMockHandler(),
@@ -97,6 +101,7 @@ func setupTestRouter(handler *Handler) *chi.Mux {
 		&mockUsageHandler{},
 		handler, // userActiveTasks
 		nil,     // webhook
+		nil,     // workspace
 	)
 	strictHandler := api.NewStrictHandler(apiHandlers, nil)
 	api.HandlerFromMux(strictHandler, r)
@@ -0,0 +1,64 @@
+package mapper
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+)
+
+func ToWorkspaceResponse(w *entity.Workspace) api.Workspace {
+	return api.Workspace{
+		CreatedAt:   w.CreatedAt,
+		ID:          uuid.MustParse(w.ID),
+		MemberCount: w.MemberCount,
+		Name:        w.Name,
+		Role:        api.WorkspaceRole(w.Role),
+	}
+}
+
+func ToWorkspaceListResponse(workspaces []entity.Workspace) api.WorkspaceListResponse {
+	data := make([]api.Workspace, len(workspaces))
+	for i := range workspaces {
+		data[i] = ToWorkspaceResponse(&workspaces[i])
+	}
+	return api.WorkspaceListResponse{Data: data}
+}
+
+func ToWorkspaceMemberResponse(m *entity.Member) api.WorkspaceMember {
+	return api.WorkspaceMember{
+		AvatarURL: m.AvatarURL,
+		JoinedAt:  m.JoinedAt,
+		Role:      api.WorkspaceRole(m.Role),
+		UserID:    uuid.MustParse(m.UserID),
+		Username:  m.Username,
+	}
+}
+
+func ToWorkspaceMemberListResponse(members []entity.Member) api.WorkspaceMemberListResponse {
+	data := make([]api.WorkspaceMember, len(members))
+	for i := range members {
+		data[i] = ToWorkspaceMemberResponse(&members[i])
+	}
+	return api.WorkspaceMemberListResponse{Data: data}
+}
+
+func ToWorkspaceInvitationResponse(inv *entity.Invitation) api.WorkspaceInvitation {
+	return api.WorkspaceInvitation{
+		CreatedAt:     inv.CreatedAt,
+		ID:            uuid.MustParse(inv.ID),
+		InvitedBy:     inv.InvitedBy,
+		Role:          api.WorkspaceRole(inv.Role),
+		Username:      inv.Username,
+		WorkspaceID:   uuid.MustParse(inv.WorkspaceID),
+		WorkspaceName: inv.WorkspaceName,
+	}
+}
+
+func ToWorkspaceInvitationListResponse(invitations []entity.Invitation) api.WorkspaceInvitationListResponse {
+	data := make([]api.WorkspaceInvitation, len(invitations))
+	for i := range invitations {
+		data[i] = ToWorkspaceInvitationResponse(&invitations[i])
+	}
+	return api.WorkspaceInvitationListResponse{Data: data}
+}
@@ -0,0 +1,322 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/specvital/web/src/backend/internal/db"
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type PostgresRepository struct {
+	queries *db.Queries
+}
+
+var _ port.WorkspaceRepository = (*PostgresRepository)(nil)
+
+func NewPostgresRepository(queries *db.Queries) *PostgresRepository {
+	return &PostgresRepository{queries: queries}
+}
+
+func (r *PostgresRepository) AcceptInvitation(ctx context.Context, invitationID, userID string) (*entity.Workspace, error) {
+	iid, err := parseUUID(invitationID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.AcceptWorkspaceInvitation(ctx, db.AcceptWorkspaceInvitationParams{
+		ID:     iid,
+		UserID: uid,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvitationNotFound
+		}
+		return nil, fmt.Errorf("accept workspace invitation: %w", err)
+	}
+
+	return &entity.Workspace{
+		CreatedAt:   row.CreatedAt.Time,
+		ID:          uuidToString(row.ID),
+		MemberCount: int(row.MemberCount),
+		Name:        row.Name,
+		Role:        entity.Role(row.Role),
+	}, nil
+}
+
+func (r *PostgresRepository) CreateInvitation(ctx context.Context, workspaceID, invitedBy, username string, role entity.Role) (*entity.Invitation, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	inviterID, err := parseUUID(invitedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.queries.GetWorkspaceUserByUsername(ctx, username)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("query user by username: %w", err)
+	}
+
+	_, err = r.queries.GetWorkspaceMembership(ctx, db.GetWorkspaceMembershipParams{
+		WorkspaceID: wid,
+		UserID:      user.ID,
+	})
+	if err == nil {
+		return nil, domain.ErrAlreadyMember
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("query workspace membership: %w", err)
+	}
+
+	row, err := r.queries.CreateWorkspaceInvitation(ctx, db.CreateWorkspaceInvitationParams{
+		WorkspaceID: wid,
+		UserID:      user.ID,
+		Role:        string(role),
+		InvitedBy:   inviterID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAlreadyInvited
+		}
+		return nil, fmt.Errorf("create workspace invitation: %w", err)
+	}
+
+	invitation := mapInvitation(db.ListWorkspaceInvitationsByUserIDRow(row))
+	return &invitation, nil
+}
+
+func (r *PostgresRepository) CreateWorkspace(ctx context.Context, ownerID, name string) (*entity.Workspace, error) {
+	oid, err := parseUUID(ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.CreateWorkspace(ctx, db.CreateWorkspaceParams{
+		Name:    name,
+		OwnerID: oid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create workspace: %w", err)
+	}
+
+	return &entity.Workspace{
+		CreatedAt:   row.CreatedAt.Time,
+		ID:          uuidToString(row.ID),
+		MemberCount: 1,
+		Name:        row.Name,
+		Role:        entity.RoleOwner,
+	}, nil
+}
+
+func (r *PostgresRepository) DeclineInvitation(ctx context.Context, invitationID, userID string) (bool, error) {
+	iid, err := parseUUID(invitationID)
+	if err != nil {
+		return false, err
+	}
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := r.queries.DeleteWorkspaceInvitation(ctx, db.DeleteWorkspaceInvitationParams{
+		ID:     iid,
+		UserID: uid,
+	})
+	if err != nil {
+		return false, fmt.Errorf("delete workspace invitation: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *PostgresRepository) GetMembership(ctx context.Context, workspaceID, userID string) (*entity.Membership, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.GetWorkspaceMembership(ctx, db.GetWorkspaceMembershipParams{
+		WorkspaceID: wid,
+		UserID:      uid,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("query workspace membership: %w", err)
+	}
+
+	return &entity.Membership{
+		OwnerID: uuidToString(row.OwnerID),
+		Role:    entity.Role(row.Role),
+	}, nil
+}
+
+func (r *PostgresRepository) ListInvitations(ctx context.Context, userID string) ([]entity.Invitation, error) {
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.ListWorkspaceInvitationsByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("list workspace invitations: %w", err)
+	}
+
+	invitations := make([]entity.Invitation, len(rows))
+	for i, row := range rows {
+		invitations[i] = mapInvitation(row)
+	}
+	return invitations, nil
+}
+
+func (r *PostgresRepository) ListMembers(ctx context.Context, workspaceID string) ([]entity.Member, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.ListWorkspaceMembers(ctx, wid)
+	if err != nil {
+		return nil, fmt.Errorf("list workspace members: %w", err)
+	}
+
+	members := make([]entity.Member, len(rows))
+	for i, row := range rows {
+		members[i] = mapMember(row)
+	}
+	return members, nil
+}
+
+func (r *PostgresRepository) ListWorkspaces(ctx context.Context, userID string) ([]entity.Workspace, error) {
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.ListWorkspacesByUserID(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("list workspaces: %w", err)
+	}
+
+	workspaces := make([]entity.Workspace, len(rows))
+	for i, row := range rows {
+		workspaces[i] = entity.Workspace{
+			CreatedAt:   row.CreatedAt.Time,
+			ID:          uuidToString(row.ID),
+			MemberCount: int(row.MemberCount),
+			Name:        row.Name,
+			Role:        entity.Role(row.Role),
+		}
+	}
+	return workspaces, nil
+}
+
+func (r *PostgresRepository) RemoveMember(ctx context.Context, workspaceID, userID string) (bool, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return false, err
+	}
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := r.queries.RemoveWorkspaceMember(ctx, db.RemoveWorkspaceMemberParams{
+		WorkspaceID: wid,
+		UserID:      uid,
+	})
+	if err != nil {
+		return false, fmt.Errorf("remove workspace member: %w", err)
+	}
+	return affected > 0, nil
+}
+
+func (r *PostgresRepository) UpdateMemberRole(ctx context.Context, workspaceID, userID string, role entity.Role) (*entity.Member, error) {
+	wid, err := parseUUID(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := parseUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := r.queries.UpdateWorkspaceMemberRole(ctx, db.UpdateWorkspaceMemberRoleParams{
+		Role:        string(role),
+		WorkspaceID: wid,
+		UserID:      uid,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrMemberNotFound
+		}
+		return nil, fmt.Errorf("update workspace member role: %w", err)
+	}
+
+	member := mapMember(db.ListWorkspaceMembersRow(row))
+	return &member, nil
+}
+
+func mapInvitation(row db.ListWorkspaceInvitationsByUserIDRow) entity.Invitation {
+	return entity.Invitation{
+		CreatedAt:     row.CreatedAt.Time,
+		ID:            uuidToString(row.ID),
+		InvitedBy:     row.InvitedBy,
+		Role:          entity.Role(row.Role),
+		Username:      row.Username,
+		WorkspaceID:   uuidToString(row.WorkspaceID),
+		WorkspaceName: row.WorkspaceName,
+	}
+}
+
+func mapMember(row db.ListWorkspaceMembersRow) entity.Member {
+	return entity.Member{
+		AvatarURL: optionalString(row.AvatarUrl),
+		JoinedAt:  row.CreatedAt.Time,
+		Role:      entity.Role(row.Role),
+		UserID:    uuidToString(row.UserID),
+		Username:  row.Username,
+	}
+}
+
+func optionalString(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+func parseUUID(s string) (pgtype.UUID, error) {
+	parsed, err := uuid.Parse(s)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	return pgtype.UUID{Bytes: parsed, Valid: true}, nil
+}
+
+func uuidToString(u pgtype.UUID) string {
+	if !u.Valid {
+		return ""
+	}
+	return uuid.UUID(u.Bytes).String()
+}
@@ -0,0 +1,38 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+
+	specviewdomain "github.com/specvital/web/src/backend/modules/spec-view/domain"
+	specviewentity "github.com/specvital/web/src/backend/modules/spec-view/domain/entity"
+	specviewport "github.com/specvital/web/src/backend/modules/spec-view/domain/port"
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+var _ specviewport.WorkspaceAccess = (*SpecViewAccessAdapter)(nil)
+
+// SpecViewAccessAdapter exposes workspace membership to spec-view.
+type SpecViewAccessAdapter struct {
+	repo port.WorkspaceRepository
+}
+
+func NewSpecViewAccessAdapter(repo port.WorkspaceRepository) *SpecViewAccessAdapter {
+	return &SpecViewAccessAdapter{repo: repo}
+}
+
+func (a *SpecViewAccessAdapter) GetWorkspaceAccess(ctx context.Context, workspaceID, userID string) (*specviewentity.WorkspaceAccess, error) {
+	membership, err := a.repo.GetMembership(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrWorkspaceNotFound) {
+			return nil, specviewdomain.ErrForbidden
+		}
+		return nil, err
+	}
+
+	return &specviewentity.WorkspaceAccess{
+		BillingUserID: membership.OwnerID,
+		CanGenerate:   membership.Role.CanGenerate(),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	specviewdomain "github.com/specvital/web/src/backend/modules/spec-view/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type mockMembershipRepo struct {
+	port.WorkspaceRepository
+	membership *entity.Membership
+	err        error
+}
+
+func (m *mockMembershipRepo) GetMembership(_ context.Context, _, _ string) (*entity.Membership, error) {
+	return m.membership, m.err
+}
+
+func TestSpecViewAccessAdapter_GetWorkspaceAccess(t *testing.T) {
+	t.Run("editor generates on the owner's quota", func(t *testing.T) {
+		adapter := NewSpecViewAccessAdapter(&mockMembershipRepo{
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleEditor},
+		})
+
+		access, err := adapter.GetWorkspaceAccess(context.Background(), "workspace-1", "editor-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if access.BillingUserID != "owner-1" || !access.CanGenerate {
+			t.Errorf("access = %+v, want owner-1 billing with generation allowed", access)
+		}
+	})
+
+	t.Run("viewer cannot generate", func(t *testing.T) {
+		adapter := NewSpecViewAccessAdapter(&mockMembershipRepo{
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleViewer},
+		})
+
+		access, err := adapter.GetWorkspaceAccess(context.Background(), "workspace-1", "viewer-1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if access.CanGenerate {
+			t.Error("viewer allowed to generate")
+		}
+	})
+
+	t.Run("non-member is forbidden", func(t *testing.T) {
+		adapter := NewSpecViewAccessAdapter(&mockMembershipRepo{err: domain.ErrWorkspaceNotFound})
+
+		_, err := adapter.GetWorkspaceAccess(context.Background(), "workspace-1", "stranger")
+		if !errors.Is(err, specviewdomain.ErrForbidden) {
+			t.Errorf("error = %v, want %v", err, specviewdomain.ErrForbidden)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package entity
+
+import "time"
+
+// MaxWorkspaceNameLength matches the workspaces.name column.
+const MaxWorkspaceNameLength = 100
+
+// Role is a member's role in a workspace.
+// Owners manage members and pay for the workspace's generations,
+// editors request generations, and viewers read spec documents.
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleOwner, RoleEditor, RoleViewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsAssignable reports whether the role can be granted to a member.
+// Each workspace has exactly one owner: its creator.
+func (r Role) IsAssignable() bool {
+	return r == RoleEditor || r == RoleViewer
+}
+
+func (r Role) CanGenerate() bool {
+	return r == RoleOwner || r == RoleEditor
+}
+
+func (r Role) CanManageMembers() bool {
+	return r == RoleOwner
+}
+
+type Workspace struct {
+	CreatedAt   time.Time
+	ID          string
+	MemberCount int
+	Name        string
+	// Role is the requesting user's role in the workspace.
+	Role Role
+}
+
+// Invitation is a pending offer for a user to join a workspace.
+// The user becomes a member only after accepting it.
+type Invitation struct {
+	CreatedAt time.Time
+	ID        string
+	// InvitedBy is the username of the owner who sent the invitation.
+	InvitedBy     string
+	Role          Role
+	Username      string
+	WorkspaceID   string
+	WorkspaceName string
+}
+
+type Member struct {
+	AvatarURL *string
+	JoinedAt  time.Time
+	Role      Role
+	UserID    string
+	Username  string
+}
+
+// Membership is a user's standing in a workspace.
+type Membership struct {
+	// OwnerID is the workspace owner, whose subscription is charged for the workspace's generations.
+	OwnerID string
+	Role    Role
+}
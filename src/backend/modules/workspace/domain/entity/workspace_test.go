@@ -0,0 +1,35 @@
+package entity
+
+import "testing"
+
+func TestRole_Permissions(t *testing.T) {
+	tests := []struct {
+		role           Role
+		valid          bool
+		assignable     bool
+		canGenerate    bool
+		canManageUsers bool
+	}{
+		{role: RoleOwner, valid: true, canGenerate: true, canManageUsers: true},
+		{role: RoleEditor, valid: true, assignable: true, canGenerate: true},
+		{role: RoleViewer, valid: true, assignable: true},
+		{role: "admin"},
+		{role: ""},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			if got := tt.role.IsValid(); got != tt.valid {
+				t.Errorf("IsValid() = %v, want %v", got, tt.valid)
+			}
+			if got := tt.role.IsAssignable(); got != tt.assignable {
+				t.Errorf("IsAssignable() = %v, want %v", got, tt.assignable)
+			}
+			if got := tt.role.CanGenerate(); got != tt.canGenerate {
+				t.Errorf("CanGenerate() = %v, want %v", got, tt.canGenerate)
+			}
+			if got := tt.role.CanManageMembers(); got != tt.canManageUsers {
+				t.Errorf("CanManageMembers() = %v, want %v", got, tt.canManageUsers)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "errors"
+
+var (
+	ErrAlreadyInvited       = errors.New("user already has a pending workspace invitation")
+	ErrAlreadyMember        = errors.New("user is already a workspace member")
+	ErrForbidden            = errors.New("insufficient workspace role")
+	ErrInvalidRole          = errors.New("invalid workspace role")
+	ErrInvalidWorkspaceName = errors.New("invalid workspace name")
+	ErrInvitationNotFound   = errors.New("workspace invitation not found")
+	ErrMemberNotFound       = errors.New("workspace member not found")
+	ErrOwnerImmutable       = errors.New("workspace owner cannot be changed or removed")
+	ErrUnauthorized         = errors.New("authentication required")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrWorkspaceNotFound    = errors.New("workspace not found")
+)
@@ -0,0 +1,33 @@
+package port
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+)
+
+type WorkspaceRepository interface {
+	// AcceptInvitation consumes the user's invitation and adds them to the workspace.
+	// Returns domain.ErrInvitationNotFound if the user has no such invitation.
+	AcceptInvitation(ctx context.Context, invitationID, userID string) (*entity.Workspace, error)
+	// CreateInvitation invites the user with the given username to the workspace.
+	// Returns domain.ErrUserNotFound, domain.ErrAlreadyMember or domain.ErrAlreadyInvited.
+	CreateInvitation(ctx context.Context, workspaceID, invitedBy, username string, role entity.Role) (*entity.Invitation, error)
+	// CreateWorkspace creates a workspace owned by the user.
+	CreateWorkspace(ctx context.Context, ownerID, name string) (*entity.Workspace, error)
+	// DeclineInvitation deletes the user's invitation. Returns false if no such invitation exists.
+	DeclineInvitation(ctx context.Context, invitationID, userID string) (bool, error)
+	// GetMembership returns the user's membership.
+	// Returns domain.ErrWorkspaceNotFound if the user is not a member.
+	GetMembership(ctx context.Context, workspaceID, userID string) (*entity.Membership, error)
+	// ListInvitations returns the user's pending invitations, newest first.
+	ListInvitations(ctx context.Context, userID string) ([]entity.Invitation, error)
+	ListMembers(ctx context.Context, workspaceID string) ([]entity.Member, error)
+	// ListWorkspaces returns the workspaces the user belongs to, newest first.
+	ListWorkspaces(ctx context.Context, userID string) ([]entity.Workspace, error)
+	// RemoveMember removes a non-owner member. Returns false if no such member exists.
+	RemoveMember(ctx context.Context, workspaceID, userID string) (bool, error)
+	// UpdateMemberRole changes a non-owner member's role.
+	// Returns domain.ErrMemberNotFound if no such member exists.
+	UpdateMemberRole(ctx context.Context, workspaceID, userID string, role entity.Role) (*entity.Member, error)
+}
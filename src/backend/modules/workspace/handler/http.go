@@ -0,0 +1,373 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/specvital/web/src/backend/common/logger"
+	"github.com/specvital/web/src/backend/common/middleware"
+	"github.com/specvital/web/src/backend/internal/api"
+	"github.com/specvital/web/src/backend/modules/workspace/adapter/mapper"
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/usecase"
+)
+
+type Handler struct {
+	acceptInvitation  *usecase.AcceptInvitationUseCase
+	createWorkspace   *usecase.CreateWorkspaceUseCase
+	declineInvitation *usecase.DeclineInvitationUseCase
+	inviteMember      *usecase.InviteMemberUseCase
+	listInvitations   *usecase.ListInvitationsUseCase
+	listMembers       *usecase.ListMembersUseCase
+	listWorkspaces    *usecase.ListWorkspacesUseCase
+	logger            *logger.Logger
+	removeMember      *usecase.RemoveMemberUseCase
+	updateMemberRole  *usecase.UpdateMemberRoleUseCase
+}
+
+var _ api.WorkspaceHandlers = (*Handler)(nil)
+
+type HandlerConfig struct {
+	AcceptInvitation  *usecase.AcceptInvitationUseCase
+	CreateWorkspace   *usecase.CreateWorkspaceUseCase
+	DeclineInvitation *usecase.DeclineInvitationUseCase
+	InviteMember      *usecase.InviteMemberUseCase
+	ListInvitations   *usecase.ListInvitationsUseCase
+	ListMembers       *usecase.ListMembersUseCase
+	ListWorkspaces    *usecase.ListWorkspacesUseCase
+	Logger            *logger.Logger
+	RemoveMember      *usecase.RemoveMemberUseCase
+	UpdateMemberRole  *usecase.UpdateMemberRoleUseCase
+}
+
+func NewHandler(cfg *HandlerConfig) (*Handler, error) {
+	if cfg.AcceptInvitation == nil {
+		return nil, errors.New("AcceptInvitation usecase is required")
+	}
+	if cfg.CreateWorkspace == nil {
+		return nil, errors.New("CreateWorkspace usecase is required")
+	}
+	if cfg.DeclineInvitation == nil {
+		return nil, errors.New("DeclineInvitation usecase is required")
+	}
+	if cfg.InviteMember == nil {
+		return nil, errors.New("InviteMember usecase is required")
+	}
+	if cfg.ListInvitations == nil {
+		return nil, errors.New("ListInvitations usecase is required")
+	}
+	if cfg.ListMembers == nil {
+		return nil, errors.New("ListMembers usecase is required")
+	}
+	if cfg.ListWorkspaces == nil {
+		return nil, errors.New("ListWorkspaces usecase is required")
+	}
+	if cfg.RemoveMember == nil {
+		return nil, errors.New("RemoveMember usecase is required")
+	}
+	if cfg.UpdateMemberRole == nil {
+		return nil, errors.New("UpdateMemberRole usecase is required")
+	}
+	if cfg.Logger == nil {
+		return nil, errors.New("Logger is required")
+	}
+
+	return &Handler{
+		acceptInvitation:  cfg.AcceptInvitation,
+		createWorkspace:   cfg.CreateWorkspace,
+		declineInvitation: cfg.DeclineInvitation,
+		inviteMember:      cfg.InviteMember,
+		listInvitations:   cfg.ListInvitations,
+		listMembers:       cfg.ListMembers,
+		listWorkspaces:    cfg.ListWorkspaces,
+		logger:            cfg.Logger,
+		removeMember:      cfg.RemoveMember,
+		updateMemberRole:  cfg.UpdateMemberRole,
+	}, nil
+}
+
+func (h *Handler) ListWorkspaces(ctx context.Context, _ api.ListWorkspacesRequestObject) (api.ListWorkspacesResponseObject, error) {
+	workspaces, err := h.listWorkspaces.Execute(ctx, usecase.ListWorkspacesInput{
+		UserID: middleware.GetUserID(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return api.ListWorkspaces401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to list workspaces", "error", err)
+		return api.ListWorkspaces500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list workspaces"),
+		}, nil
+	}
+
+	return api.ListWorkspaces200JSONResponse(mapper.ToWorkspaceListResponse(workspaces)), nil
+}
+
+func (h *Handler) CreateWorkspace(ctx context.Context, request api.CreateWorkspaceRequestObject) (api.CreateWorkspaceResponseObject, error) {
+	if request.Body == nil {
+		return api.CreateWorkspace400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	workspace, err := h.createWorkspace.Execute(ctx, usecase.CreateWorkspaceInput{
+		Name:   request.Body.Name,
+		UserID: middleware.GetUserID(ctx),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.CreateWorkspace401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidWorkspaceName):
+			return api.CreateWorkspace400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("workspace name must be 1-100 characters"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to create workspace", "error", err)
+		return api.CreateWorkspace500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to create workspace"),
+		}, nil
+	}
+
+	return api.CreateWorkspace201JSONResponse(mapper.ToWorkspaceResponse(workspace)), nil
+}
+
+func (h *Handler) ListWorkspaceMembers(ctx context.Context, request api.ListWorkspaceMembersRequestObject) (api.ListWorkspaceMembersResponseObject, error) {
+	members, err := h.listMembers.Execute(ctx, usecase.ListMembersInput{
+		UserID:      middleware.GetUserID(ctx),
+		WorkspaceID: request.WorkspaceID.String(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.ListWorkspaceMembers401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrWorkspaceNotFound):
+			return api.ListWorkspaceMembers404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace not found"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to list workspace members", "error", err)
+		return api.ListWorkspaceMembers500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list workspace members"),
+		}, nil
+	}
+
+	return api.ListWorkspaceMembers200JSONResponse(mapper.ToWorkspaceMemberListResponse(members)), nil
+}
+
+func (h *Handler) InviteWorkspaceMember(ctx context.Context, request api.InviteWorkspaceMemberRequestObject) (api.InviteWorkspaceMemberResponseObject, error) {
+	if request.Body == nil {
+		return api.InviteWorkspaceMember400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	invitation, err := h.inviteMember.Execute(ctx, usecase.InviteMemberInput{
+		Role:        entity.Role(request.Body.Role),
+		UserID:      middleware.GetUserID(ctx),
+		Username:    request.Body.Username,
+		WorkspaceID: request.WorkspaceID.String(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.InviteWorkspaceMember401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidRole):
+			return api.InviteWorkspaceMember400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("role must be editor or viewer"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.InviteWorkspaceMember403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("only the workspace owner can invite members"),
+			}, nil
+		case errors.Is(err, domain.ErrWorkspaceNotFound):
+			return api.InviteWorkspaceMember404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace not found"),
+			}, nil
+		case errors.Is(err, domain.ErrUserNotFound):
+			return api.InviteWorkspaceMember404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("user not found"),
+			}, nil
+		case errors.Is(err, domain.ErrAlreadyMember):
+			return api.InviteWorkspaceMember409ApplicationProblemPlusJSONResponse{
+				ConflictApplicationProblemPlusJSONResponse: api.NewConflict("user is already a workspace member"),
+			}, nil
+		case errors.Is(err, domain.ErrAlreadyInvited):
+			return api.InviteWorkspaceMember409ApplicationProblemPlusJSONResponse{
+				ConflictApplicationProblemPlusJSONResponse: api.NewConflict("user already has a pending invitation"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to invite workspace member", "error", err)
+		return api.InviteWorkspaceMember500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to invite workspace member"),
+		}, nil
+	}
+
+	return api.InviteWorkspaceMember201JSONResponse(mapper.ToWorkspaceInvitationResponse(invitation)), nil
+}
+
+func (h *Handler) ListWorkspaceInvitations(ctx context.Context, _ api.ListWorkspaceInvitationsRequestObject) (api.ListWorkspaceInvitationsResponseObject, error) {
+	invitations, err := h.listInvitations.Execute(ctx, usecase.ListInvitationsInput{
+		UserID: middleware.GetUserID(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrUnauthorized) {
+			return api.ListWorkspaceInvitations401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to list workspace invitations", "error", err)
+		return api.ListWorkspaceInvitations500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to list workspace invitations"),
+		}, nil
+	}
+
+	return api.ListWorkspaceInvitations200JSONResponse(mapper.ToWorkspaceInvitationListResponse(invitations)), nil
+}
+
+func (h *Handler) AcceptWorkspaceInvitation(ctx context.Context, request api.AcceptWorkspaceInvitationRequestObject) (api.AcceptWorkspaceInvitationResponseObject, error) {
+	workspace, err := h.acceptInvitation.Execute(ctx, usecase.AcceptInvitationInput{
+		InvitationID: request.InvitationID.String(),
+		UserID:       middleware.GetUserID(ctx),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.AcceptWorkspaceInvitation401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrInvitationNotFound):
+			return api.AcceptWorkspaceInvitation404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace invitation not found"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to accept workspace invitation", "error", err)
+		return api.AcceptWorkspaceInvitation500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to accept workspace invitation"),
+		}, nil
+	}
+
+	return api.AcceptWorkspaceInvitation200JSONResponse(mapper.ToWorkspaceResponse(workspace)), nil
+}
+
+func (h *Handler) DeclineWorkspaceInvitation(ctx context.Context, request api.DeclineWorkspaceInvitationRequestObject) (api.DeclineWorkspaceInvitationResponseObject, error) {
+	err := h.declineInvitation.Execute(ctx, usecase.DeclineInvitationInput{
+		InvitationID: request.InvitationID.String(),
+		UserID:       middleware.GetUserID(ctx),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.DeclineWorkspaceInvitation401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrInvitationNotFound):
+			return api.DeclineWorkspaceInvitation404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace invitation not found"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to decline workspace invitation", "error", err)
+		return api.DeclineWorkspaceInvitation500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to decline workspace invitation"),
+		}, nil
+	}
+
+	return api.DeclineWorkspaceInvitation204Response{}, nil
+}
+
+func (h *Handler) UpdateWorkspaceMemberRole(ctx context.Context, request api.UpdateWorkspaceMemberRoleRequestObject) (api.UpdateWorkspaceMemberRoleResponseObject, error) {
+	if request.Body == nil {
+		return api.UpdateWorkspaceMemberRole400ApplicationProblemPlusJSONResponse{
+			BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("request body is required"),
+		}, nil
+	}
+
+	member, err := h.updateMemberRole.Execute(ctx, usecase.UpdateMemberRoleInput{
+		MemberID:    request.UserID.String(),
+		Role:        entity.Role(request.Body.Role),
+		UserID:      middleware.GetUserID(ctx),
+		WorkspaceID: request.WorkspaceID.String(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.UpdateWorkspaceMemberRole401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrInvalidRole):
+			return api.UpdateWorkspaceMemberRole400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("role must be editor or viewer"),
+			}, nil
+		case errors.Is(err, domain.ErrOwnerImmutable):
+			return api.UpdateWorkspaceMemberRole400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("the workspace owner's role cannot be changed"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.UpdateWorkspaceMemberRole403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("only the workspace owner can change roles"),
+			}, nil
+		case errors.Is(err, domain.ErrWorkspaceNotFound):
+			return api.UpdateWorkspaceMemberRole404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace not found"),
+			}, nil
+		case errors.Is(err, domain.ErrMemberNotFound):
+			return api.UpdateWorkspaceMemberRole404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace member not found"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to update workspace member role", "error", err)
+		return api.UpdateWorkspaceMemberRole500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to update workspace member role"),
+		}, nil
+	}
+
+	return api.UpdateWorkspaceMemberRole200JSONResponse(mapper.ToWorkspaceMemberResponse(member)), nil
+}
+
+func (h *Handler) RemoveWorkspaceMember(ctx context.Context, request api.RemoveWorkspaceMemberRequestObject) (api.RemoveWorkspaceMemberResponseObject, error) {
+	err := h.removeMember.Execute(ctx, usecase.RemoveMemberInput{
+		MemberID:    request.UserID.String(),
+		UserID:      middleware.GetUserID(ctx),
+		WorkspaceID: request.WorkspaceID.String(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnauthorized):
+			return api.RemoveWorkspaceMember401ApplicationProblemPlusJSONResponse{
+				UnauthorizedApplicationProblemPlusJSONResponse: api.NewUnauthorized("authentication required"),
+			}, nil
+		case errors.Is(err, domain.ErrOwnerImmutable):
+			return api.RemoveWorkspaceMember400ApplicationProblemPlusJSONResponse{
+				BadRequestApplicationProblemPlusJSONResponse: api.NewBadRequest("the workspace owner cannot be removed"),
+			}, nil
+		case errors.Is(err, domain.ErrForbidden):
+			return api.RemoveWorkspaceMember403ApplicationProblemPlusJSONResponse{
+				ForbiddenApplicationProblemPlusJSONResponse: api.NewForbidden("only the workspace owner can remove other members"),
+			}, nil
+		case errors.Is(err, domain.ErrWorkspaceNotFound):
+			return api.RemoveWorkspaceMember404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace not found"),
+			}, nil
+		case errors.Is(err, domain.ErrMemberNotFound):
+			return api.RemoveWorkspaceMember404ApplicationProblemPlusJSONResponse{
+				NotFoundApplicationProblemPlusJSONResponse: api.NewNotFound("workspace member not found"),
+			}, nil
+		}
+		h.logger.Error(ctx, "failed to remove workspace member", "error", err)
+		return api.RemoveWorkspaceMember500ApplicationProblemPlusJSONResponse{
+			InternalErrorApplicationProblemPlusJSONResponse: api.NewInternalError("failed to remove workspace member"),
+		}, nil
+	}
+
+	return api.RemoveWorkspaceMember204Response{}, nil
+}
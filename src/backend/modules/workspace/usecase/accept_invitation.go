@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type AcceptInvitationInput struct {
+	InvitationID string
+	UserID       string
+}
+
+type AcceptInvitationUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewAcceptInvitationUseCase(repo port.WorkspaceRepository) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{repo: repo}
+}
+
+// Execute joins the workspace with the invited role. Only the invited user may accept.
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, input AcceptInvitationInput) (*entity.Workspace, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return uc.repo.AcceptInvitation(ctx, input.InvitationID, input.UserID)
+}
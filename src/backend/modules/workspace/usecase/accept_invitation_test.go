@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+)
+
+func TestAcceptInvitationUseCase_Execute(t *testing.T) {
+	t.Run("invitee joins the workspace", func(t *testing.T) {
+		repo := &mockWorkspaceRepository{invitations: map[string]string{"invitation-1": "invitee-1"}}
+
+		workspace, err := NewAcceptInvitationUseCase(repo).Execute(context.Background(), AcceptInvitationInput{
+			InvitationID: "invitation-1",
+			UserID:       "invitee-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if workspace.ID != "workspace-1" {
+			t.Errorf("workspace = %q, want workspace-1", workspace.ID)
+		}
+		if len(repo.joined) != 1 || repo.joined[0] != "invitee-1" {
+			t.Errorf("joined = %v, want [invitee-1]", repo.joined)
+		}
+		if _, ok := repo.invitations["invitation-1"]; ok {
+			t.Error("invitation still pending after accept")
+		}
+	})
+
+	t.Run("invitation cannot be accepted twice", func(t *testing.T) {
+		repo := &mockWorkspaceRepository{invitations: map[string]string{"invitation-1": "invitee-1"}}
+		uc := NewAcceptInvitationUseCase(repo)
+		input := AcceptInvitationInput{InvitationID: "invitation-1", UserID: "invitee-1"}
+
+		if _, err := uc.Execute(context.Background(), input); err != nil {
+			t.Fatalf("first Execute() error = %v", err)
+		}
+		if _, err := uc.Execute(context.Background(), input); !errors.Is(err, domain.ErrInvitationNotFound) {
+			t.Errorf("second Execute() error = %v, want %v", err, domain.ErrInvitationNotFound)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  AcceptInvitationInput
+		target error
+	}{
+		{
+			name:   "anonymous user",
+			input:  AcceptInvitationInput{InvitationID: "invitation-1"},
+			target: domain.ErrUnauthorized,
+		},
+		{
+			name:   "someone else's invitation",
+			input:  AcceptInvitationInput{InvitationID: "invitation-1", UserID: "stranger"},
+			target: domain.ErrInvitationNotFound,
+		},
+		{
+			name:   "unknown invitation",
+			input:  AcceptInvitationInput{InvitationID: "missing", UserID: "invitee-1"},
+			target: domain.ErrInvitationNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockWorkspaceRepository{invitations: map[string]string{"invitation-1": "invitee-1"}}
+
+			_, err := NewAcceptInvitationUseCase(repo).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.target)
+			}
+			if len(repo.joined) != 0 {
+				t.Errorf("joined = %v for rejected request", repo.joined)
+			}
+			if _, ok := repo.invitations["invitation-1"]; !ok {
+				t.Error("invitation consumed by rejected request")
+			}
+		})
+	}
+}
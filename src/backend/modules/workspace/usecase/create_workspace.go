@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type CreateWorkspaceInput struct {
+	Name   string
+	UserID string
+}
+
+type CreateWorkspaceUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewCreateWorkspaceUseCase(repo port.WorkspaceRepository) *CreateWorkspaceUseCase {
+	return &CreateWorkspaceUseCase{repo: repo}
+}
+
+// Execute creates a workspace with the requesting user as its owner.
+func (uc *CreateWorkspaceUseCase) Execute(ctx context.Context, input CreateWorkspaceInput) (*entity.Workspace, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" || utf8.RuneCountInString(name) > entity.MaxWorkspaceNameLength {
+		return nil, domain.ErrInvalidWorkspaceName
+	}
+
+	return uc.repo.CreateWorkspace(ctx, input.UserID, name)
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+)
+
+func TestCreateWorkspaceUseCase_Execute(t *testing.T) {
+	t.Run("trims the name", func(t *testing.T) {
+		workspace, err := NewCreateWorkspaceUseCase(&mockWorkspaceRepository{}).Execute(context.Background(), CreateWorkspaceInput{
+			Name:   "  Platform team ",
+			UserID: "user-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if workspace.Name != "Platform team" {
+			t.Errorf("Name = %q, want %q", workspace.Name, "Platform team")
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  CreateWorkspaceInput
+		target error
+	}{
+		{name: "anonymous user", input: CreateWorkspaceInput{Name: "Team"}, target: domain.ErrUnauthorized},
+		{name: "blank name", input: CreateWorkspaceInput{Name: "   ", UserID: "user-1"}, target: domain.ErrInvalidWorkspaceName},
+		{name: "name too long", input: CreateWorkspaceInput{Name: strings.Repeat("팀", 101), UserID: "user-1"}, target: domain.ErrInvalidWorkspaceName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCreateWorkspaceUseCase(&mockWorkspaceRepository{}).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.target)
+			}
+		})
+	}
+}
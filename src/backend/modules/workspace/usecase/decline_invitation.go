@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type DeclineInvitationInput struct {
+	InvitationID string
+	UserID       string
+}
+
+type DeclineInvitationUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewDeclineInvitationUseCase(repo port.WorkspaceRepository) *DeclineInvitationUseCase {
+	return &DeclineInvitationUseCase{repo: repo}
+}
+
+// Execute discards the invitation without joining the workspace. Only the invited user may decline.
+func (uc *DeclineInvitationUseCase) Execute(ctx context.Context, input DeclineInvitationInput) error {
+	if input.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	declined, err := uc.repo.DeclineInvitation(ctx, input.InvitationID, input.UserID)
+	if err != nil {
+		return err
+	}
+	if !declined {
+		return domain.ErrInvitationNotFound
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+)
+
+func TestDeclineInvitationUseCase_Execute(t *testing.T) {
+	t.Run("invitee declines without joining", func(t *testing.T) {
+		repo := &mockWorkspaceRepository{invitations: map[string]string{"invitation-1": "invitee-1"}}
+
+		err := NewDeclineInvitationUseCase(repo).Execute(context.Background(), DeclineInvitationInput{
+			InvitationID: "invitation-1",
+			UserID:       "invitee-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if _, ok := repo.invitations["invitation-1"]; ok {
+			t.Error("invitation still pending after decline")
+		}
+		if len(repo.joined) != 0 {
+			t.Errorf("joined = %v, want none", repo.joined)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		input  DeclineInvitationInput
+		target error
+	}{
+		{
+			name:   "anonymous user",
+			input:  DeclineInvitationInput{InvitationID: "invitation-1"},
+			target: domain.ErrUnauthorized,
+		},
+		{
+			name:   "someone else's invitation",
+			input:  DeclineInvitationInput{InvitationID: "invitation-1", UserID: "stranger"},
+			target: domain.ErrInvitationNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockWorkspaceRepository{invitations: map[string]string{"invitation-1": "invitee-1"}}
+
+			err := NewDeclineInvitationUseCase(repo).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.target)
+			}
+			if _, ok := repo.invitations["invitation-1"]; !ok {
+				t.Error("invitation removed by rejected request")
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type InviteMemberInput struct {
+	Role        entity.Role
+	UserID      string
+	Username    string
+	WorkspaceID string
+}
+
+type InviteMemberUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewInviteMemberUseCase(repo port.WorkspaceRepository) *InviteMemberUseCase {
+	return &InviteMemberUseCase{repo: repo}
+}
+
+// Execute invites a user to the workspace as an editor or viewer. Only the owner may invite,
+// and the user does not become a member until they accept.
+func (uc *InviteMemberUseCase) Execute(ctx context.Context, input InviteMemberInput) (*entity.Invitation, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if !input.Role.IsAssignable() {
+		return nil, domain.ErrInvalidRole
+	}
+
+	username := strings.TrimSpace(input.Username)
+	if username == "" {
+		return nil, domain.ErrUserNotFound
+	}
+
+	membership, err := uc.repo.GetMembership(ctx, input.WorkspaceID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !membership.Role.CanManageMembers() {
+		return nil, domain.ErrForbidden
+	}
+
+	return uc.repo.CreateInvitation(ctx, input.WorkspaceID, input.UserID, username, input.Role)
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+)
+
+type mockWorkspaceRepository struct {
+	membership *entity.Membership
+	removed    bool
+	err        error
+
+	// invitations maps pending invitation IDs to the invited user.
+	invitations map[string]string
+
+	invitedRole     entity.Role
+	invitedUsername string
+	joined          []string
+	removedMember   string
+}
+
+func (m *mockWorkspaceRepository) AcceptInvitation(_ context.Context, invitationID, userID string) (*entity.Workspace, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if invitee, ok := m.invitations[invitationID]; !ok || invitee != userID {
+		return nil, domain.ErrInvitationNotFound
+	}
+	delete(m.invitations, invitationID)
+	m.joined = append(m.joined, userID)
+	return &entity.Workspace{ID: "workspace-1", MemberCount: 2, Role: entity.RoleEditor}, nil
+}
+
+func (m *mockWorkspaceRepository) CreateInvitation(_ context.Context, workspaceID, invitedBy, username string, role entity.Role) (*entity.Invitation, error) {
+	m.invitedUsername = username
+	m.invitedRole = role
+	return &entity.Invitation{ID: "invitation-1", InvitedBy: invitedBy, Role: role, Username: username, WorkspaceID: workspaceID}, m.err
+}
+
+func (m *mockWorkspaceRepository) CreateWorkspace(_ context.Context, _, name string) (*entity.Workspace, error) {
+	return &entity.Workspace{MemberCount: 1, Name: name, Role: entity.RoleOwner}, m.err
+}
+
+func (m *mockWorkspaceRepository) DeclineInvitation(_ context.Context, invitationID, userID string) (bool, error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	if invitee, ok := m.invitations[invitationID]; !ok || invitee != userID {
+		return false, nil
+	}
+	delete(m.invitations, invitationID)
+	return true, nil
+}
+
+func (m *mockWorkspaceRepository) GetMembership(_ context.Context, _, _ string) (*entity.Membership, error) {
+	if m.membership == nil {
+		return nil, domain.ErrWorkspaceNotFound
+	}
+	return m.membership, nil
+}
+
+func (m *mockWorkspaceRepository) ListInvitations(_ context.Context, _ string) ([]entity.Invitation, error) {
+	return nil, m.err
+}
+
+func (m *mockWorkspaceRepository) ListMembers(_ context.Context, _ string) ([]entity.Member, error) {
+	return nil, m.err
+}
+
+func (m *mockWorkspaceRepository) ListWorkspaces(_ context.Context, _ string) ([]entity.Workspace, error) {
+	return nil, m.err
+}
+
+func (m *mockWorkspaceRepository) RemoveMember(_ context.Context, _, userID string) (bool, error) {
+	m.removedMember = userID
+	return m.removed, m.err
+}
+
+func (m *mockWorkspaceRepository) UpdateMemberRole(_ context.Context, _, userID string, role entity.Role) (*entity.Member, error) {
+	return &entity.Member{Role: role, UserID: userID}, m.err
+}
+
+func TestInviteMemberUseCase_Execute(t *testing.T) {
+	owner := &entity.Membership{OwnerID: "owner-1", Role: entity.RoleOwner}
+
+	t.Run("owner invites an editor without adding them", func(t *testing.T) {
+		repo := &mockWorkspaceRepository{membership: owner}
+
+		invitation, err := NewInviteMemberUseCase(repo).Execute(context.Background(), InviteMemberInput{
+			Role:        entity.RoleEditor,
+			UserID:      "owner-1",
+			Username:    " octocat ",
+			WorkspaceID: "workspace-1",
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if repo.invitedUsername != "octocat" || invitation.Role != entity.RoleEditor {
+			t.Errorf("invited (%q, %q), want (octocat, editor)", repo.invitedUsername, invitation.Role)
+		}
+		if invitation.InvitedBy != "owner-1" {
+			t.Errorf("InvitedBy = %q, want owner-1", invitation.InvitedBy)
+		}
+		if len(repo.joined) != 0 {
+			t.Errorf("joined = %v, want no members until the invitation is accepted", repo.joined)
+		}
+	})
+
+	t.Run("already invited", func(t *testing.T) {
+		repo := &mockWorkspaceRepository{membership: owner, err: domain.ErrAlreadyInvited}
+
+		_, err := NewInviteMemberUseCase(repo).Execute(context.Background(), InviteMemberInput{
+			Role:        entity.RoleViewer,
+			UserID:      "owner-1",
+			Username:    "octocat",
+			WorkspaceID: "workspace-1",
+		})
+		if !errors.Is(err, domain.ErrAlreadyInvited) {
+			t.Errorf("Execute() error = %v, want %v", err, domain.ErrAlreadyInvited)
+		}
+	})
+
+	tests := []struct {
+		name       string
+		membership *entity.Membership
+		input      InviteMemberInput
+		target     error
+	}{
+		{
+			name:   "anonymous user",
+			input:  InviteMemberInput{Role: entity.RoleViewer, Username: "octocat"},
+			target: domain.ErrUnauthorized,
+		},
+		{
+			name:       "second owner",
+			membership: owner,
+			input:      InviteMemberInput{Role: entity.RoleOwner, UserID: "owner-1", Username: "octocat"},
+			target:     domain.ErrInvalidRole,
+		},
+		{
+			name:       "blank username",
+			membership: owner,
+			input:      InviteMemberInput{Role: entity.RoleViewer, UserID: "owner-1", Username: "  "},
+			target:     domain.ErrUserNotFound,
+		},
+		{
+			name:       "editor cannot invite",
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleEditor},
+			input:      InviteMemberInput{Role: entity.RoleViewer, UserID: "editor-1", Username: "octocat"},
+			target:     domain.ErrForbidden,
+		},
+		{
+			name:   "not a member",
+			input:  InviteMemberInput{Role: entity.RoleViewer, UserID: "stranger", Username: "octocat"},
+			target: domain.ErrWorkspaceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockWorkspaceRepository{membership: tt.membership}
+
+			_, err := NewInviteMemberUseCase(repo).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.target)
+			}
+			if repo.invitedUsername != "" {
+				t.Error("invitation created for rejected request")
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type ListInvitationsInput struct {
+	UserID string
+}
+
+type ListInvitationsUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewListInvitationsUseCase(repo port.WorkspaceRepository) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{repo: repo}
+}
+
+// Execute returns the workspace invitations waiting for the user's answer.
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, input ListInvitationsInput) ([]entity.Invitation, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return uc.repo.ListInvitations(ctx, input.UserID)
+}
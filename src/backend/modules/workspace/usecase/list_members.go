@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type ListMembersInput struct {
+	UserID      string
+	WorkspaceID string
+}
+
+type ListMembersUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewListMembersUseCase(repo port.WorkspaceRepository) *ListMembersUseCase {
+	return &ListMembersUseCase{repo: repo}
+}
+
+// Execute lists the workspace's members. Any member may see who else belongs to the workspace.
+func (uc *ListMembersUseCase) Execute(ctx context.Context, input ListMembersInput) ([]entity.Member, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if _, err := uc.repo.GetMembership(ctx, input.WorkspaceID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListMembers(ctx, input.WorkspaceID)
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type ListWorkspacesInput struct {
+	UserID string
+}
+
+type ListWorkspacesUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewListWorkspacesUseCase(repo port.WorkspaceRepository) *ListWorkspacesUseCase {
+	return &ListWorkspacesUseCase{repo: repo}
+}
+
+func (uc *ListWorkspacesUseCase) Execute(ctx context.Context, input ListWorkspacesInput) ([]entity.Workspace, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	return uc.repo.ListWorkspaces(ctx, input.UserID)
+}
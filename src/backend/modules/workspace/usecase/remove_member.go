@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type RemoveMemberInput struct {
+	MemberID    string
+	UserID      string
+	WorkspaceID string
+}
+
+type RemoveMemberUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewRemoveMemberUseCase(repo port.WorkspaceRepository) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{repo: repo}
+}
+
+// Execute removes a member from the workspace. The owner may remove anyone but
+// themselves; other members may only leave.
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, input RemoveMemberInput) error {
+	if input.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	membership, err := uc.repo.GetMembership(ctx, input.WorkspaceID, input.UserID)
+	if err != nil {
+		return err
+	}
+	if input.MemberID == membership.OwnerID {
+		return domain.ErrOwnerImmutable
+	}
+	if input.MemberID != input.UserID && !membership.Role.CanManageMembers() {
+		return domain.ErrForbidden
+	}
+
+	removed, err := uc.repo.RemoveMember(ctx, input.WorkspaceID, input.MemberID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return domain.ErrMemberNotFound
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+)
+
+func TestRemoveMemberUseCase_Execute(t *testing.T) {
+	tests := []struct {
+		name       string
+		membership *entity.Membership
+		removed    bool
+		input      RemoveMemberInput
+		target     error
+	}{
+		{
+			name:       "owner removes a member",
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleOwner},
+			removed:    true,
+			input:      RemoveMemberInput{MemberID: "viewer-1", UserID: "owner-1"},
+		},
+		{
+			name:       "viewer leaves",
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleViewer},
+			removed:    true,
+			input:      RemoveMemberInput{MemberID: "viewer-1", UserID: "viewer-1"},
+		},
+		{
+			name:       "editor cannot remove others",
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleEditor},
+			input:      RemoveMemberInput{MemberID: "viewer-1", UserID: "editor-1"},
+			target:     domain.ErrForbidden,
+		},
+		{
+			name:       "owner cannot leave",
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleOwner},
+			input:      RemoveMemberInput{MemberID: "owner-1", UserID: "owner-1"},
+			target:     domain.ErrOwnerImmutable,
+		},
+		{
+			name:       "unknown member",
+			membership: &entity.Membership{OwnerID: "owner-1", Role: entity.RoleOwner},
+			input:      RemoveMemberInput{MemberID: "ghost", UserID: "owner-1"},
+			target:     domain.ErrMemberNotFound,
+		},
+		{
+			name:   "anonymous user",
+			input:  RemoveMemberInput{MemberID: "viewer-1"},
+			target: domain.ErrUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockWorkspaceRepository{membership: tt.membership, removed: tt.removed}
+
+			err := NewRemoveMemberUseCase(repo).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.target) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.target)
+			}
+			if tt.target == nil && repo.removedMember != tt.input.MemberID {
+				t.Errorf("removed %q, want %q", repo.removedMember, tt.input.MemberID)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/specvital/web/src/backend/modules/workspace/domain"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/entity"
+	"github.com/specvital/web/src/backend/modules/workspace/domain/port"
+)
+
+type UpdateMemberRoleInput struct {
+	MemberID    string
+	Role        entity.Role
+	UserID      string
+	WorkspaceID string
+}
+
+type UpdateMemberRoleUseCase struct {
+	repo port.WorkspaceRepository
+}
+
+func NewUpdateMemberRoleUseCase(repo port.WorkspaceRepository) *UpdateMemberRoleUseCase {
+	return &UpdateMemberRoleUseCase{repo: repo}
+}
+
+// Execute switches a member between editor and viewer. Only the owner may change roles,
+// and the owner's own role is fixed.
+func (uc *UpdateMemberRoleUseCase) Execute(ctx context.Context, input UpdateMemberRoleInput) (*entity.Member, error) {
+	if input.UserID == "" {
+		return nil, domain.ErrUnauthorized
+	}
+
+	if !input.Role.IsAssignable() {
+		return nil, domain.ErrInvalidRole
+	}
+
+	membership, err := uc.repo.GetMembership(ctx, input.WorkspaceID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !membership.Role.CanManageMembers() {
+		return nil, domain.ErrForbidden
+	}
+	if input.MemberID == membership.OwnerID {
+		return nil, domain.ErrOwnerImmutable
+	}
+
+	return uc.repo.UpdateMemberRole(ctx, input.WorkspaceID, input.MemberID, input.Role)
+}
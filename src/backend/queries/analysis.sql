@@ -1,4 +1,5 @@
 -- name: GetLatestCompletedAnalysis :one
+-- Analyses produced by the worker take precedence over ingested ones.
 SELECT
     a.id,
     a.commit_sha,
@@ -14,7 +15,7 @@ FROM analyses a
 JOIN codebases c ON c.id = a.codebase_id
 WHERE c.host = $1 AND c.owner = $2 AND c.name = $3
   AND a.status = 'completed'
-ORDER BY COALESCE(a.committed_at, a.created_at) DESC
+ORDER BY a.source = 'ingest', COALESCE(a.committed_at, a.created_at) DESC
 LIMIT 1;
 
 -- name: GetAnalysisStatus :one
@@ -38,6 +39,7 @@ SELECT EXISTS(
     WHERE c.host = $1 AND c.owner = $2 AND c.name = $3
       AND a.commit_sha = $4
       AND a.status = 'completed'
+      AND a.source = 'worker'
 ) AS exists;
 
 -- name: UpsertCodebase :one
@@ -78,10 +80,31 @@ FROM test_cases tc
 WHERE tc.suite_id = ANY($1::uuid[])
 ORDER BY tc.suite_id, tc.line_number;
 
--- name: UpdateCodebaseLastViewed :exec
-UPDATE codebases
-SET last_viewed_at = now()
-WHERE host = $1 AND owner = $2 AND name = $3;
+-- name: RecordCodebaseView :exec
+-- Touches last_viewed_at and counts the view in the per-day aggregate used for
+-- trending, once per viewer per UTC day. Viewer markers from earlier days are
+-- dropped whenever the codebase is viewed again.
+WITH viewed AS (
+    UPDATE codebases
+    SET last_viewed_at = now()
+    WHERE host = sqlc.arg(host) AND owner = sqlc.arg(owner) AND name = sqlc.arg(name) AND is_stale = false
+    RETURNING id
+),
+stale_viewers AS (
+    DELETE FROM codebase_daily_viewers d
+    USING viewed
+    WHERE d.codebase_id = viewed.id AND d.view_date < (now() AT TIME ZONE 'UTC')::date
+),
+first_view AS (
+    INSERT INTO codebase_daily_viewers (codebase_id, view_date, viewer)
+    SELECT id, (now() AT TIME ZONE 'UTC')::date, sqlc.arg(viewer)::text FROM viewed
+    ON CONFLICT DO NOTHING
+    RETURNING codebase_id, view_date
+)
+INSERT INTO codebase_daily_views (codebase_id, view_date, view_count)
+SELECT codebase_id, view_date, 1 FROM first_view
+ON CONFLICT (codebase_id, view_date) DO UPDATE SET
+    view_count = codebase_daily_views.view_count + 1;
 
 -- name: GetRepositoryStats :one
 SELECT
@@ -92,7 +115,7 @@ JOIN LATERAL (
     SELECT an.id, an.total_tests
     FROM analyses an
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -136,6 +159,7 @@ ORDER BY COALESCE(a.committed_at, a.completed_at) DESC
 LIMIT 50;
 
 -- name: GetCompletedAnalysisByCommitSHA :one
+-- Analyses produced by the worker take precedence over ingested ones.
 SELECT
     a.id,
     a.commit_sha,
@@ -153,7 +177,7 @@ WHERE c.host = $1 AND c.owner = $2 AND c.name = $3
   AND c.is_stale = false
   AND starts_with(a.commit_sha, sqlc.arg(commit_sha_prefix)::text)
   AND a.status = 'completed'
-ORDER BY COALESCE(a.committed_at, a.created_at) DESC
+ORDER BY a.source = 'ingest', COALESCE(a.committed_at, a.created_at) DESC
 LIMIT 1;
 
 -- name: GetPaginatedRepositoriesByRecent :many
@@ -209,7 +233,7 @@ JOIN LATERAL (
         WHERE tf.analysis_id = an.id
     ) tc_summary ON true
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -301,7 +325,7 @@ JOIN LATERAL (
         WHERE tf.analysis_id = an.id
     ) tc_summary ON true
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -393,7 +417,7 @@ JOIN LATERAL (
         WHERE tf.analysis_id = an.id
     ) tc_summary ON true
     WHERE an.codebase_id = c.id AND an.status = 'completed'
-    ORDER BY an.created_at DESC
+    ORDER BY an.source = 'ingest', an.created_at DESC
     LIMIT 1
 ) a ON true
 WHERE c.is_stale = false
@@ -431,3 +455,173 @@ ORDER BY
   CASE WHEN sqlc.arg(sort_order)::text = 'desc' THEN c.id END DESC,
   CASE WHEN sqlc.arg(sort_order)::text = 'asc' THEN c.id END ASC
 LIMIT sqlc.arg(page_limit);
+
+-- name: GetPaginatedRepositoriesByTrending :many
+-- Public repositories only: trending ranks by views plus completed analyses in
+-- the last trending_days UTC days, weighting an analysis like five views.
+WITH user_context AS (
+    SELECT username FROM users WHERE id = sqlc.arg(user_id)::uuid
+),
+user_orgs AS (
+    SELECT go.login
+    FROM user_github_org_memberships ugom
+    JOIN github_organizations go ON go.id = ugom.org_id
+    WHERE ugom.user_id = sqlc.arg(user_id)::uuid
+)
+SELECT
+    c.id AS codebase_id,
+    c.owner,
+    c.name,
+    a.id AS analysis_id,
+    a.commit_sha,
+    a.completed_at AS analyzed_at,
+    a.total_tests,
+    a.active_count,
+    a.focused_count,
+    a.skipped_count,
+    a.todo_count,
+    a.xfail_count,
+    EXISTS(
+        SELECT 1 FROM user_analysis_history uah
+        WHERE uah.analysis_id = a.id AND uah.user_id = sqlc.arg(user_id)::uuid
+    ) AS is_analyzed_by_me,
+    t.trending_score
+FROM codebases c
+JOIN LATERAL (
+    SELECT
+        an.id,
+        an.commit_sha,
+        an.completed_at,
+        an.total_tests,
+        COALESCE(tc_summary.active_count, 0)::int AS active_count,
+        COALESCE(tc_summary.focused_count, 0)::int AS focused_count,
+        COALESCE(tc_summary.skipped_count, 0)::int AS skipped_count,
+        COALESCE(tc_summary.todo_count, 0)::int AS todo_count,
+        COALESCE(tc_summary.xfail_count, 0)::int AS xfail_count
+    FROM analyses an
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*) FILTER (WHERE tc.status = 'active') AS active_count,
+            COUNT(*) FILTER (WHERE tc.status = 'focused') AS focused_count,
+            COUNT(*) FILTER (WHERE tc.status = 'skipped') AS skipped_count,
+            COUNT(*) FILTER (WHERE tc.status = 'todo') AS todo_count,
+            COUNT(*) FILTER (WHERE tc.status = 'xfail') AS xfail_count
+        FROM test_cases tc
+        JOIN test_suites ts ON ts.id = tc.suite_id
+        JOIN test_files tf ON ts.file_id = tf.id
+        WHERE tf.analysis_id = an.id
+    ) tc_summary ON true
+    WHERE an.codebase_id = c.id AND an.status = 'completed'
+    ORDER BY an.source = 'ingest', an.created_at DESC
+    LIMIT 1
+) a ON true
+JOIN LATERAL (
+    SELECT (
+        COALESCE((
+            SELECT SUM(v.view_count)
+            FROM codebase_daily_views v
+            WHERE v.codebase_id = c.id AND v.view_date >= (now() AT TIME ZONE 'UTC')::date - sqlc.arg(trending_days)::int
+        ), 0)
+        + 5 * (
+            SELECT COUNT(*)
+            FROM analyses an
+            WHERE an.codebase_id = c.id
+              AND an.status = 'completed'
+              AND an.completed_at >= ((now() AT TIME ZONE 'UTC')::date - sqlc.arg(trending_days)::int)::timestamp AT TIME ZONE 'UTC'
+        )
+    )::int AS trending_score
+) t ON true
+WHERE c.is_stale = false
+  AND c.is_private = false
+  AND (
+    sqlc.arg(view_filter)::text = 'all'
+    OR (sqlc.arg(view_filter)::text = 'my' AND EXISTS(
+        SELECT 1 FROM user_analysis_history uah
+        WHERE uah.analysis_id = a.id AND uah.user_id = sqlc.arg(user_id)::uuid
+    ))
+    OR (sqlc.arg(view_filter)::text = 'community'
+        AND c.is_private = false
+        AND NOT EXISTS(
+            SELECT 1 FROM user_analysis_history uah
+            WHERE uah.analysis_id = a.id AND uah.user_id = sqlc.arg(user_id)::uuid
+        ))
+  )
+  AND (
+    sqlc.arg(ownership_filter)::text = 'all'
+    OR (sqlc.arg(ownership_filter)::text = 'mine' AND c.owner = (SELECT username FROM user_context))
+    OR (sqlc.arg(ownership_filter)::text = 'organization' AND c.owner IN (SELECT login FROM user_orgs))
+    OR (sqlc.arg(ownership_filter)::text = 'others'
+        AND c.owner != (SELECT username FROM user_context)
+        AND c.owner NOT IN (SELECT login FROM user_orgs))
+  )
+  AND (
+    sqlc.arg(cursor_id)::uuid IS NULL
+    OR (
+      (sqlc.arg(sort_order)::text = 'desc' AND (t.trending_score, c.id) < (sqlc.arg(cursor_trending_score)::int, sqlc.arg(cursor_id)::uuid))
+      OR (sqlc.arg(sort_order)::text = 'asc' AND (t.trending_score, c.id) > (sqlc.arg(cursor_trending_score)::int, sqlc.arg(cursor_id)::uuid))
+    )
+  )
+ORDER BY
+  CASE WHEN sqlc.arg(sort_order)::text = 'desc' THEN t.trending_score END DESC,
+  CASE WHEN sqlc.arg(sort_order)::text = 'asc' THEN t.trending_score END ASC,
+  CASE WHEN sqlc.arg(sort_order)::text = 'desc' THEN c.id END DESC,
+  CASE WHEN sqlc.arg(sort_order)::text = 'asc' THEN c.id END ASC
+LIMIT sqlc.arg(page_limit);
+
+-- name: GetOrgRepositoryTestSummaries :many
+-- Baseline is the latest completed analysis at or before since, falling back to
+-- the oldest one for repositories first analyzed inside the window.
+SELECT
+    c.id AS codebase_id,
+    c.name,
+    a.id AS analysis_id,
+    a.completed_at AS analyzed_at,
+    a.total_tests,
+    a.active_count,
+    a.focused_count,
+    a.skipped_count,
+    a.todo_count,
+    a.xfail_count,
+    b.total_tests AS baseline_total_tests
+FROM codebases c
+JOIN LATERAL (
+    SELECT
+        an.id,
+        an.completed_at,
+        an.total_tests,
+        COALESCE(tc_summary.active_count, 0)::int AS active_count,
+        COALESCE(tc_summary.focused_count, 0)::int AS focused_count,
+        COALESCE(tc_summary.skipped_count, 0)::int AS skipped_count,
+        COALESCE(tc_summary.todo_count, 0)::int AS todo_count,
+        COALESCE(tc_summary.xfail_count, 0)::int AS xfail_count
+    FROM analyses an
+    LEFT JOIN LATERAL (
+        SELECT
+            COUNT(*) FILTER (WHERE tc.status = 'active') AS active_count,
+            COUNT(*) FILTER (WHERE tc.status = 'focused') AS focused_count,
+            COUNT(*) FILTER (WHERE tc.status = 'skipped') AS skipped_count,
+            COUNT(*) FILTER (WHERE tc.status = 'todo') AS todo_count,
+            COUNT(*) FILTER (WHERE tc.status = 'xfail') AS xfail_count
+        FROM test_cases tc
+        JOIN test_suites ts ON ts.id = tc.suite_id
+        JOIN test_files tf ON ts.file_id = tf.id
+        WHERE tf.analysis_id = an.id
+    ) tc_summary ON true
+    WHERE an.codebase_id = c.id AND an.status = 'completed'
+    ORDER BY an.source = 'ingest', an.created_at DESC
+    LIMIT 1
+) a ON true
+LEFT JOIN LATERAL (
+    SELECT an.total_tests
+    FROM analyses an
+    WHERE an.codebase_id = c.id AND an.status = 'completed'
+    ORDER BY
+        an.created_at <= sqlc.arg(since)::timestamptz DESC,
+        CASE WHEN an.created_at <= sqlc.arg(since)::timestamptz THEN an.created_at END DESC,
+        an.created_at ASC
+    LIMIT 1
+) b ON true
+WHERE c.host = 'github.com'
+  AND c.owner = sqlc.arg(owner)
+  AND c.is_stale = false
+ORDER BY c.name;
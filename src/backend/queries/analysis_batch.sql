@@ -0,0 +1,51 @@
+-- name: CreateAnalysisBatch :one
+INSERT INTO analysis_batches (user_id, org_login, total_count, skipped_count)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: CreateAnalysisBatchItems :exec
+INSERT INTO analysis_batch_items (batch_id, owner, repo)
+SELECT sqlc.arg(batch_id)::uuid, unnest(sqlc.arg(owners)::text[]), unnest(sqlc.arg(repos)::text[]);
+
+-- name: GetAnalysisBatch :one
+SELECT * FROM analysis_batches
+WHERE id = $1;
+
+-- name: ListAnalysisBatchItemsWithStatus :many
+SELECT
+    i.owner,
+    i.repo,
+    i.status,
+    i.commit_sha,
+    i.error_message,
+    a.status AS analysis_status
+FROM analysis_batch_items i
+LEFT JOIN LATERAL (
+    SELECT an.status
+    FROM analyses an
+    JOIN codebases c ON c.id = an.codebase_id
+    WHERE c.host = 'github.com'
+      AND c.owner = i.owner
+      AND c.name = i.repo
+      AND an.commit_sha = i.commit_sha
+    ORDER BY an.created_at DESC
+    LIMIT 1
+) a ON true
+WHERE i.batch_id = $1
+ORDER BY i.owner, i.repo;
+
+-- name: ListPendingAnalysisBatchItems :many
+SELECT id, owner, repo
+FROM analysis_batch_items
+WHERE batch_id = $1 AND status = 'pending'
+ORDER BY owner, repo;
+
+-- name: UpdateAnalysisBatchItem :exec
+UPDATE analysis_batch_items
+SET status = $2, commit_sha = $3, error_message = $4, updated_at = now()
+WHERE id = $1;
+
+-- name: MarkAnalysisBatchSubmitted :exec
+UPDATE analysis_batches
+SET submitted_at = now()
+WHERE id = $1;
@@ -0,0 +1,56 @@
+-- name: GetUserSyncedRepository :one
+-- GitHub owner and repository names are case-insensitive.
+SELECT github_repo_id, full_name, is_private
+FROM user_github_repositories
+WHERE user_id = sqlc.arg(user_id) AND lower(full_name) = lower(sqlc.arg(full_name)::text)
+LIMIT 1;
+
+-- name: UpsertIngestedCodebase :one
+INSERT INTO codebases (host, owner, name, external_repo_id, is_private)
+VALUES (sqlc.arg(host), sqlc.arg(owner), sqlc.arg(name), sqlc.arg(external_repo_id), sqlc.arg(is_private))
+ON CONFLICT (host, external_repo_id) DO UPDATE SET
+    is_private = EXCLUDED.is_private,
+    updated_at = now()
+RETURNING id;
+
+-- name: CreateIngestedAnalysis :one
+-- Returns no row when the commit was already ingested with the same parser version.
+-- Ingested analyses have their own unique slot, so they neither block nor replace
+-- an analysis produced by the worker for the same commit.
+INSERT INTO analyses (
+    codebase_id, commit_sha, branch_name, status, started_at, completed_at,
+    committed_at, parser_version, total_suites, total_tests, source, uploaded_by
+) VALUES (
+    sqlc.arg(codebase_id), sqlc.arg(commit_sha), sqlc.narg(branch_name), 'completed', now(), now(),
+    sqlc.narg(committed_at), sqlc.arg(parser_version), sqlc.arg(total_suites), sqlc.arg(total_tests), 'ingest', sqlc.arg(uploaded_by)
+)
+ON CONFLICT (codebase_id, commit_sha, parser_version) WHERE status = 'completed' AND source = 'ingest' DO NOTHING
+RETURNING id, completed_at;
+
+-- name: InsertIngestedTestFiles :exec
+INSERT INTO test_files (id, analysis_id, file_path, framework)
+SELECT unnest(sqlc.arg(ids)::uuid[]), sqlc.arg(analysis_id)::uuid, unnest(sqlc.arg(file_paths)::text[]), unnest(sqlc.arg(frameworks)::text[]);
+
+-- name: InsertIngestedTestSuites :exec
+INSERT INTO test_suites (id, file_id, parent_id, name, line_number, depth)
+SELECT u.id, u.file_id, u.parent_id, u.name, NULLIF(u.line_number, 0), u.depth
+FROM unnest(
+    sqlc.arg(ids)::uuid[],
+    sqlc.arg(file_ids)::uuid[],
+    sqlc.arg(parent_ids)::uuid[],
+    sqlc.arg(names)::text[],
+    sqlc.arg(line_numbers)::int[],
+    sqlc.arg(depths)::int[]
+) AS u(id, file_id, parent_id, name, line_number, depth);
+
+-- name: InsertIngestedTestCases :exec
+INSERT INTO test_cases (suite_id, name, line_number, status, tags, modifier)
+SELECT u.suite_id, u.name, NULLIF(u.line_number, 0), u.status::test_status, u.tags::jsonb, NULLIF(u.modifier, '')
+FROM unnest(
+    sqlc.arg(suite_ids)::uuid[],
+    sqlc.arg(names)::text[],
+    sqlc.arg(line_numbers)::int[],
+    sqlc.arg(statuses)::text[],
+    sqlc.arg(tags)::text[],
+    sqlc.arg(modifiers)::text[]
+) AS u(suite_id, name, line_number, status, tags, modifier);
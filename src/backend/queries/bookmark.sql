@@ -29,7 +29,7 @@ LEFT JOIN LATERAL (
     SELECT id, commit_sha, completed_at, total_tests
     FROM analyses
     WHERE codebase_id = c.id AND status = 'completed'
-    ORDER BY created_at DESC
+    ORDER BY source = 'ingest', created_at DESC
     LIMIT 1
 ) a ON true
 WHERE ub.user_id = $1 AND c.is_stale = false
@@ -0,0 +1,83 @@
+-- name: CountOutdatedCodebases :one
+SELECT COUNT(*)::bigint AS total
+FROM codebases c
+JOIN LATERAL (
+    SELECT an.parser_version
+    FROM analyses an
+    WHERE an.codebase_id = c.id AND an.status = 'completed' AND an.source = 'worker'
+    ORDER BY an.created_at DESC
+    LIMIT 1
+) a ON true
+WHERE c.is_stale = false
+  AND c.is_private = false
+  AND a.parser_version <> sqlc.arg(parser_version);
+
+-- name: ListOutdatedCodebases :many
+SELECT
+    c.id,
+    c.owner,
+    c.name,
+    a.commit_sha
+FROM codebases c
+JOIN LATERAL (
+    SELECT an.commit_sha, an.parser_version
+    FROM analyses an
+    WHERE an.codebase_id = c.id AND an.status = 'completed' AND an.source = 'worker'
+    ORDER BY an.created_at DESC
+    LIMIT 1
+) a ON true
+WHERE c.is_stale = false
+  AND c.is_private = false
+  AND a.parser_version <> sqlc.arg(parser_version)
+  AND c.id > sqlc.arg(after_id)::uuid
+ORDER BY c.id
+LIMIT sqlc.arg(page_limit);
+
+-- name: CreateBulkReanalysisRun :one
+INSERT INTO bulk_reanalysis_runs (parser_version, trigger, triggered_by, total_count)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetBulkReanalysisRun :one
+SELECT *
+FROM bulk_reanalysis_runs
+WHERE id = $1;
+
+-- name: GetActiveBulkReanalysisRun :one
+SELECT *
+FROM bulk_reanalysis_runs
+WHERE status IN ('pending', 'running')
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: GetLatestBulkReanalysisRun :one
+SELECT *
+FROM bulk_reanalysis_runs
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ListBulkReanalysisRuns :many
+SELECT *
+FROM bulk_reanalysis_runs
+ORDER BY created_at DESC
+LIMIT $1;
+
+-- name: MarkBulkReanalysisRunStarted :exec
+UPDATE bulk_reanalysis_runs
+SET status = 'running', started_at = COALESCE(started_at, now()), updated_at = now()
+WHERE id = $1;
+
+-- name: UpdateBulkReanalysisRunProgress :exec
+UPDATE bulk_reanalysis_runs
+SET enqueued_count = $2, failed_count = $3, last_codebase_id = $4, updated_at = now()
+WHERE id = $1;
+
+-- name: CompleteBulkReanalysisRun :exec
+UPDATE bulk_reanalysis_runs
+SET status = 'completed', completed_at = now(), updated_at = now()
+WHERE id = $1;
+
+-- name: FailBulkReanalysisRun :exec
+UPDATE bulk_reanalysis_runs
+SET status = 'failed', error_message = $2, completed_at = now(), updated_at = now()
+WHERE id = $1;
@@ -151,3 +151,23 @@ DELETE FROM user_github_org_memberships WHERE user_id = $1;
 SELECT EXISTS(
     SELECT 1 FROM user_github_org_memberships WHERE user_id = $1
 ) AS has_orgs;
+
+-- name: HasUserOrgAccess :one
+-- A user can view organization data when they are a synced member or installed
+-- the GitHub App on the organization themselves.
+SELECT (
+    EXISTS(
+        SELECT 1
+        FROM user_github_org_memberships m
+        JOIN github_organizations go ON go.id = m.org_id
+        WHERE m.user_id = $1 AND go.login = $2
+    )
+    OR EXISTS(
+        SELECT 1
+        FROM github_app_installations i
+        WHERE i.installer_user_id = $1
+          AND i.account_login = $2
+          AND i.account_type = 'organization'
+          AND i.suspended_at IS NULL
+    )
+)::boolean AS has_access;
@@ -35,6 +35,10 @@ WHERE installation_id = @installation_id;
 SELECT * FROM github_app_installations
 WHERE account_id = @account_id;
 
+-- name: GetGitHubAppInstallationByAccountLogin :one
+SELECT * FROM github_app_installations
+WHERE lower(account_login) = lower(@account_login);
+
 -- name: ListGitHubAppInstallationsByUserID :many
 SELECT * FROM github_app_installations
 WHERE installer_user_id = @user_id
@@ -0,0 +1,46 @@
+-- name: CountActivePersonalAccessTokens :one
+SELECT COUNT(*)
+FROM personal_access_tokens
+WHERE user_id = $1
+    AND revoked_at IS NULL
+    AND (expires_at IS NULL OR expires_at > now());
+
+-- name: CreatePersonalAccessToken :one
+INSERT INTO personal_access_tokens (user_id, name, token_hash, token_prefix, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetPersonalAccessTokenByHash :one
+SELECT
+    pat.id,
+    pat.user_id,
+    pat.name,
+    pat.token_hash,
+    pat.token_prefix,
+    pat.scopes,
+    pat.expires_at,
+    pat.last_used_at,
+    pat.created_at,
+    pat.revoked_at,
+    u.username
+FROM personal_access_tokens pat
+JOIN users u ON u.id = pat.user_id
+WHERE pat.token_hash = $1;
+
+-- name: ListPersonalAccessTokensByUserID :many
+SELECT *
+FROM personal_access_tokens
+WHERE user_id = $1 AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: RevokePersonalAccessToken :execrows
+UPDATE personal_access_tokens
+SET revoked_at = now()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
+-- name: TouchPersonalAccessToken :exec
+-- Throttled so a busy CI token does not write on every request.
+UPDATE personal_access_tokens
+SET last_used_at = now()
+WHERE id = $1
+    AND (last_used_at IS NULL OR last_used_at < now() - INTERVAL '1 minute');
@@ -0,0 +1,20 @@
+-- name: GetRateLimitHitCount :one
+SELECT hit_count
+FROM rate_limit_counters
+WHERE bucket_key = $1
+    AND window_start = $2;
+
+-- name: HitRateLimitCounter :one
+-- Records a hit in the window unless it already holds max_hits.
+-- Returns no row when the hit was rejected.
+INSERT INTO rate_limit_counters (bucket_key, window_start, hit_count, expires_at)
+SELECT @bucket_key::text, @window_start::timestamptz, 1, @expires_at::timestamptz
+WHERE @max_hits::int > 0
+ON CONFLICT (bucket_key, window_start) DO UPDATE
+SET hit_count = rate_limit_counters.hit_count + 1
+WHERE rate_limit_counters.hit_count < @max_hits::int
+RETURNING hit_count;
+
+-- name: DeleteExpiredRateLimitCounters :execrows
+DELETE FROM rate_limit_counters
+WHERE expires_at < @now::timestamptz;
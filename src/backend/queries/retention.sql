@@ -0,0 +1,67 @@
+-- name: CountExpiredUserAnalysisHistory :one
+SELECT COUNT(*)::bigint AS total
+FROM user_analysis_history
+WHERE retention_days_at_creation IS NOT NULL
+  AND created_at + make_interval(days => retention_days_at_creation) < sqlc.arg(now)::timestamptz;
+
+-- name: DeleteExpiredUserAnalysisHistory :execrows
+DELETE FROM user_analysis_history
+WHERE id IN (
+    SELECT uah.id
+    FROM user_analysis_history uah
+    WHERE uah.retention_days_at_creation IS NOT NULL
+      AND uah.created_at + make_interval(days => uah.retention_days_at_creation) < sqlc.arg(now)::timestamptz
+    LIMIT sqlc.arg(batch_size)
+);
+
+-- name: CountExpiredSpecDocuments :one
+SELECT COUNT(*)::bigint AS total
+FROM spec_documents
+WHERE retention_days_at_creation IS NOT NULL
+  AND created_at + make_interval(days => retention_days_at_creation) < sqlc.arg(now)::timestamptz;
+
+-- name: DeleteExpiredSpecDocuments :execrows
+-- Domains, features and behaviors cascade from spec_documents.
+DELETE FROM spec_documents
+WHERE id IN (
+    SELECT sd.id
+    FROM spec_documents sd
+    WHERE sd.retention_days_at_creation IS NOT NULL
+      AND sd.created_at + make_interval(days => sd.retention_days_at_creation) < sqlc.arg(now)::timestamptz
+    LIMIT sqlc.arg(batch_size)
+);
+
+-- name: CountUnreferencedAnalyses :one
+-- An analysis is unreferenced when no user history entry or spec document points at it
+-- and a newer completed analysis of the same codebase exists, so the latest result of
+-- every codebase is always kept.
+SELECT COUNT(*)::bigint AS total
+FROM analyses a
+WHERE a.status IN ('completed', 'failed')
+  AND a.created_at < sqlc.arg(created_before)::timestamptz
+  AND NOT EXISTS (SELECT 1 FROM user_analysis_history uah WHERE uah.analysis_id = a.id)
+  AND NOT EXISTS (SELECT 1 FROM spec_documents sd WHERE sd.analysis_id = a.id)
+  AND EXISTS (
+      SELECT 1 FROM analyses newer
+      WHERE newer.codebase_id = a.codebase_id
+        AND newer.status = 'completed'
+        AND newer.created_at > a.created_at
+  );
+
+-- name: DeleteUnreferencedAnalyses :execrows
+DELETE FROM analyses
+WHERE id IN (
+    SELECT a.id
+    FROM analyses a
+    WHERE a.status IN ('completed', 'failed')
+      AND a.created_at < sqlc.arg(created_before)::timestamptz
+      AND NOT EXISTS (SELECT 1 FROM user_analysis_history uah WHERE uah.analysis_id = a.id)
+      AND NOT EXISTS (SELECT 1 FROM spec_documents sd WHERE sd.analysis_id = a.id)
+      AND EXISTS (
+          SELECT 1 FROM analyses newer
+          WHERE newer.codebase_id = a.codebase_id
+            AND newer.status = 'completed'
+            AND newer.created_at > a.created_at
+      )
+    LIMIT sqlc.arg(batch_size)
+);
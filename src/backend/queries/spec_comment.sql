@@ -0,0 +1,147 @@
+-- name: GetSpecCommentDocument :one
+-- Resolves the comment scope of a spec document owned by the user,
+-- or generated for the workspace when workspace_id is set
+SELECT
+    sd.id,
+    a.codebase_id,
+    sd.language,
+    sd.created_at
+FROM spec_documents sd
+JOIN analyses a ON a.id = sd.analysis_id
+WHERE sd.id = @document_id
+  AND ((sd.user_id = @user_id AND sqlc.narg('workspace_id')::uuid IS NULL) OR sd.workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: GetSpecCommentThreads :many
+-- Threads visible on a document generated at @document_created_at: opened on that
+-- document or an earlier one, and not resolved before the document was generated.
+-- The user's own threads are kept apart from the workspace's.
+SELECT
+    t.id,
+    t.document_id,
+    t.target_type,
+    t.domain_name,
+    t.feature_name,
+    t.file_path,
+    t.test_name,
+    t.source_test_case_id,
+    t.resolved_at,
+    t.resolved_by,
+    ru.username AS resolved_by_username,
+    t.created_at
+FROM spec_comment_threads t
+LEFT JOIN users ru ON ru.id = t.resolved_by
+WHERE ((t.user_id = @user_id AND t.workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR t.workspace_id = sqlc.narg('workspace_id')::uuid)
+  AND t.codebase_id = @codebase_id AND t.language = @language
+  AND t.document_created_at <= @document_created_at
+  AND (t.resolved_at IS NULL OR t.resolved_at >= @document_created_at)
+ORDER BY t.created_at, t.id;
+
+-- name: GetSpecCommentsByThreadIDs :many
+SELECT
+    c.id,
+    c.thread_id,
+    c.author_id,
+    u.username AS author_username,
+    c.body,
+    c.created_at,
+    c.updated_at
+FROM spec_comments c
+JOIN users u ON u.id = c.author_id
+WHERE c.thread_id = ANY(@thread_ids::uuid[])
+ORDER BY c.created_at, c.id;
+
+-- name: GetSpecCommentByID :one
+SELECT
+    c.id,
+    c.thread_id,
+    c.author_id,
+    u.username AS author_username,
+    c.body,
+    c.created_at,
+    c.updated_at
+FROM spec_comments c
+JOIN users u ON u.id = c.author_id
+WHERE c.id = @id;
+
+-- name: GetSpecCommentMentionsByCommentIDs :many
+SELECT
+    m.comment_id,
+    m.user_id,
+    u.username
+FROM spec_comment_mentions m
+JOIN users u ON u.id = m.user_id
+WHERE m.comment_id = ANY(@comment_ids::uuid[])
+ORDER BY m.comment_id, u.username;
+
+-- name: CreateSpecCommentThread :one
+-- Opens a thread together with its first comment and returns the comment ID
+WITH thread AS (
+    INSERT INTO spec_comment_threads (
+        user_id, codebase_id, language, document_id, document_created_at,
+        target_type, domain_name, feature_name, file_path, test_name, source_test_case_id, workspace_id
+    ) VALUES (
+        @user_id, @codebase_id, @language, @document_id, @document_created_at,
+        @target_type, @domain_name, @feature_name, @file_path, @test_name, @source_test_case_id, sqlc.narg('workspace_id')
+    )
+    RETURNING id
+)
+INSERT INTO spec_comments (thread_id, author_id, body)
+SELECT thread.id, @user_id::uuid, @body::text FROM thread
+RETURNING id;
+
+-- name: AddSpecComment :one
+-- Adds a reply to a thread on the user's spec documents, or on the workspace's when workspace_id is set
+INSERT INTO spec_comments (thread_id, author_id, body)
+SELECT t.id, @user_id::uuid, @body::text
+FROM spec_comment_threads t
+WHERE t.id = @thread_id
+  AND ((t.user_id = @user_id AND t.workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR t.workspace_id = sqlc.narg('workspace_id')::uuid)
+RETURNING id;
+
+-- name: UpdateSpecComment :execrows
+UPDATE spec_comments c
+SET body = @body, updated_at = now()
+FROM spec_comment_threads t
+WHERE c.id = @id AND c.author_id = @user_id
+  AND t.id = c.thread_id
+  AND ((t.user_id = @user_id AND t.workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR t.workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: DeleteSpecComment :one
+-- Deletes a comment written by the user and returns its thread ID
+DELETE FROM spec_comments c
+USING spec_comment_threads t
+WHERE c.id = @id AND c.author_id = @user_id
+  AND t.id = c.thread_id
+  AND ((t.user_id = @user_id AND t.workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR t.workspace_id = sqlc.narg('workspace_id')::uuid)
+RETURNING c.thread_id;
+
+-- name: DeleteEmptySpecCommentThread :exec
+DELETE FROM spec_comment_threads t
+WHERE t.id = @id
+  AND NOT EXISTS (SELECT 1 FROM spec_comments c WHERE c.thread_id = t.id);
+
+-- name: ResolveSpecCommentThread :execrows
+-- Keeps the original resolver when the thread is already resolved
+UPDATE spec_comment_threads
+SET resolved_by = CASE WHEN resolved_at IS NULL THEN @user_id::uuid ELSE resolved_by END,
+    resolved_at = COALESCE(resolved_at, now()),
+    updated_at = now()
+WHERE id = @id
+  AND ((user_id = @user_id AND workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: ReopenSpecCommentThread :execrows
+UPDATE spec_comment_threads
+SET resolved_at = NULL, resolved_by = NULL, updated_at = now()
+WHERE id = @id
+  AND ((user_id = @user_id AND workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: DeleteSpecCommentMentions :exec
+DELETE FROM spec_comment_mentions WHERE comment_id = @comment_id;
+
+-- name: InsertSpecCommentMentions :exec
+-- Records mentions of existing users; @usernames must be lowercase
+INSERT INTO spec_comment_mentions (comment_id, user_id)
+SELECT @comment_id::uuid, u.id
+FROM users u
+WHERE lower(u.username) = ANY(@usernames::text[])
+ON CONFLICT DO NOTHING;
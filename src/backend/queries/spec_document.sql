@@ -113,6 +113,7 @@ WHERE b.id = ANY($1::uuid[]);
 -- Returns latest generation status for a specific user and analysis (any language)
 SELECT
     rj.state,
+    rj.metadata ? 'cancel_attempted_at' AS cancel_attempted,
     rj.created_at,
     rj.finalized_at,
     rj.errors
@@ -127,6 +128,7 @@ LIMIT 1;
 -- Returns generation status for a specific user, analysis, and language combination
 SELECT
     rj.state,
+    rj.metadata ? 'cancel_attempted_at' AS cancel_attempted,
     rj.created_at,
     rj.finalized_at,
     rj.errors
@@ -138,6 +140,20 @@ WHERE rj.kind = 'specview:generate'
 ORDER BY rj.created_at DESC
 LIMIT 1;
 
+-- name: GetActiveSpecGenerationJobID :one
+-- Returns the pending or running generation job for a user, analysis, and language.
+-- A running job already marked for cancellation is not active.
+SELECT rj.id
+FROM river_job rj
+WHERE rj.kind = 'specview:generate'
+  AND rj.args->>'analysis_id' = @analysis_id
+  AND rj.args->>'user_id' = @user_id
+  AND rj.args->>'language' = @language
+  AND rj.state IN ('available', 'pending', 'retryable', 'running', 'scheduled')
+  AND NOT rj.metadata ? 'cancel_attempted_at'
+ORDER BY rj.created_at DESC
+LIMIT 1;
+
 -- name: CheckSpecDocumentExistsByLanguage :one
 SELECT EXISTS(
     SELECT 1 FROM spec_documents WHERE analysis_id = $1 AND language = $2
@@ -153,6 +169,14 @@ SELECT EXISTS(
 -- Used for quota calculation before spec generation.
 SELECT total_tests FROM analyses WHERE id = $1 AND status = 'completed';
 
+-- name: GetAnalysisSource :one
+-- Returns the repository and commit an analysis was run against.
+-- Used to build source links when exporting spec documents.
+SELECT c.host, c.owner, c.name, a.commit_sha
+FROM analyses a
+JOIN codebases c ON c.id = a.codebase_id
+WHERE a.id = $1;
+
 -- name: GetAvailableLanguagesByAnalysisID :many
 -- Returns all available languages for an analysis with their latest version info (legacy - no user filter)
 SELECT
@@ -332,6 +356,43 @@ WHERE c.owner = @owner AND c.name = @repo
   AND sd.user_id = @user_id
   AND sd.id = @document_id;
 
+-- name: GetSpecDocumentRevisionByRepository :one
+-- Returns what a repository spec document response depends on without loading it:
+-- the document, the user's edits in its language and the repository's other documents
+SELECT
+    sd.version,
+    sd.updated_at,
+    docs.document_count,
+    docs.latest_created_at,
+    edits.edit_count,
+    edits.latest_edit_at
+FROM spec_documents sd
+JOIN analyses a ON a.id = sd.analysis_id
+JOIN codebases c ON c.id = a.codebase_id
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::int AS document_count, MAX(sd2.created_at)::timestamptz AS latest_created_at
+    FROM spec_documents sd2
+    JOIN analyses a2 ON a2.id = sd2.analysis_id
+    JOIN codebases c2 ON c2.id = a2.codebase_id
+    WHERE c2.owner = c.owner AND c2.name = c.name
+      AND sd2.user_id = sd.user_id
+) docs
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::int AS edit_count, MAX(e.updated_at)::timestamptz AS latest_edit_at
+    FROM (
+        SELECT be.updated_at FROM spec_behavior_edits be
+        WHERE be.codebase_id = c.id AND be.language = sd.language
+          AND be.user_id = sd.user_id AND be.workspace_id IS NULL
+        UNION ALL
+        SELECT fe.updated_at FROM spec_feature_edits fe
+        WHERE fe.codebase_id = c.id AND fe.language = sd.language
+          AND fe.user_id = sd.user_id AND fe.workspace_id IS NULL
+    ) e
+) edits
+WHERE c.owner = @owner AND c.name = @repo
+  AND sd.user_id = @user_id
+  AND sd.id = @document_id;
+
 -- name: GetVersionHistoryByRepository :many
 -- Returns spec versions for a repository (across analyses) with commit SHA
 -- Ordered by creation date descending to show most recent first
@@ -431,3 +492,94 @@ FROM test_cases tc
 JOIN test_suites ts ON ts.id = tc.suite_id
 JOIN test_files tf ON tf.id = ts.file_id
 WHERE tf.analysis_id = $1;
+
+-- name: GetWorkspaceSpecDocument :one
+-- Returns the most recent spec document generated for a workspace.
+-- If language is NULL, returns the most recent document regardless of language.
+SELECT
+    sd.id,
+    sd.analysis_id,
+    sd.user_id,
+    sd.language,
+    sd.version,
+    sd.executive_summary,
+    sd.model_id,
+    sd.created_at
+FROM spec_documents sd
+WHERE sd.workspace_id = @workspace_id
+  AND sd.analysis_id = @analysis_id
+  AND (sqlc.narg('language')::text IS NULL OR sd.language = sqlc.narg('language')::text)
+ORDER BY sd.created_at DESC
+LIMIT 1;
+
+-- name: GetAvailableLanguagesByWorkspaceAndAnalysis :many
+-- Returns the languages a workspace has spec documents in, with the most recent document's version
+SELECT DISTINCT ON (sd.language)
+    sd.language,
+    sd.version AS latest_version,
+    sd.created_at
+FROM spec_documents sd
+WHERE sd.workspace_id = @workspace_id AND sd.analysis_id = @analysis_id
+ORDER BY sd.language, sd.created_at DESC;
+
+-- name: GetWorkspaceSpecGenerationStatus :one
+-- Returns the latest generation status for a workspace and analysis.
+-- If language is NULL, matches jobs in any language.
+SELECT
+    rj.state,
+    rj.metadata ? 'cancel_attempted_at' AS cancel_attempted,
+    rj.created_at,
+    rj.finalized_at,
+    rj.errors
+FROM river_job rj
+WHERE rj.kind = 'specview:generate'
+  AND rj.args->>'analysis_id' = @analysis_id
+  AND rj.args->>'workspace_id' = @workspace_id
+  AND (sqlc.narg('language')::text IS NULL OR rj.args->>'language' = sqlc.narg('language')::text)
+ORDER BY rj.created_at DESC
+LIMIT 1;
+
+-- name: GetWorkspaceSpecDocumentByVersion :one
+-- Returns a specific version of a workspace's spec document.
+-- Versions are numbered per requesting member, so the most recent document with the version wins.
+SELECT
+    sd.id,
+    sd.analysis_id,
+    sd.user_id,
+    sd.language,
+    sd.version,
+    sd.executive_summary,
+    sd.model_id,
+    sd.created_at
+FROM spec_documents sd
+WHERE sd.workspace_id = @workspace_id
+  AND sd.analysis_id = @analysis_id
+  AND sd.language = @language
+  AND sd.version = @version
+ORDER BY sd.created_at DESC
+LIMIT 1;
+
+-- name: GetVersionsByWorkspaceAndLanguage :many
+-- Returns the versions of a workspace's spec documents for an analysis and language, ordered by version descending.
+-- Each version appears once, as its most recent document.
+SELECT DISTINCT ON (sd.version)
+    sd.version,
+    sd.created_at,
+    sd.model_id
+FROM spec_documents sd
+WHERE sd.workspace_id = @workspace_id AND sd.analysis_id = @analysis_id AND sd.language = @language
+ORDER BY sd.version DESC, sd.created_at DESC;
+
+-- name: GetActiveWorkspaceSpecGenerationJobID :one
+-- Returns the pending or running generation job for a workspace, analysis, and language,
+-- whichever member requested it. A running job already marked for cancellation is not active.
+SELECT rj.id
+FROM river_job rj
+WHERE rj.kind = 'specview:generate'
+  AND rj.args->>'analysis_id' = @analysis_id
+  AND rj.args->>'workspace_id' = @workspace_id
+  AND rj.args->>'language' = @language
+  AND rj.state IN ('available', 'pending', 'retryable', 'running', 'scheduled')
+  AND NOT rj.metadata ? 'cancel_attempted_at'
+ORDER BY rj.created_at DESC
+LIMIT 1;
@@ -0,0 +1,129 @@
+-- name: GetAnalysisCodebaseID :one
+SELECT codebase_id FROM analyses WHERE id = $1;
+
+-- name: GetSpecBehaviorEditTarget :one
+-- Resolves the edit key of a behavior in a spec document owned by the user,
+-- or in the workspace's documents when workspace_id is set
+SELECT
+    a.codebase_id,
+    sd.language,
+    b.original_name,
+    b.source_test_case_id,
+    COALESCE(tf.file_path, '')::text AS file_path
+FROM spec_behaviors b
+JOIN spec_features f ON f.id = b.feature_id
+JOIN spec_domains dm ON dm.id = f.domain_id
+JOIN spec_documents sd ON sd.id = dm.document_id
+JOIN analyses a ON a.id = sd.analysis_id
+LEFT JOIN test_cases tc ON tc.id = b.source_test_case_id
+LEFT JOIN test_suites ts ON ts.id = tc.suite_id
+LEFT JOIN test_files tf ON tf.id = ts.file_id
+WHERE b.id = @behavior_id
+  AND ((sqlc.narg('workspace_id')::uuid IS NULL AND sd.user_id = @user_id) OR sd.workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: GetSpecFeatureEditTarget :one
+-- Resolves the edit key of a feature in a spec document owned by the user,
+-- or in the workspace's documents when workspace_id is set
+SELECT
+    a.codebase_id,
+    sd.language,
+    dm.name AS domain_name,
+    f.name AS feature_name
+FROM spec_features f
+JOIN spec_domains dm ON dm.id = f.domain_id
+JOIN spec_documents sd ON sd.id = dm.document_id
+JOIN analyses a ON a.id = sd.analysis_id
+WHERE f.id = @feature_id
+  AND ((sqlc.narg('workspace_id')::uuid IS NULL AND sd.user_id = @user_id) OR sd.workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: GetSpecBehaviorEdits :many
+-- Returns the user's personal edits, or the workspace's shared edits when workspace_id is set
+SELECT
+    id,
+    file_path,
+    test_name,
+    source_test_case_id,
+    description,
+    hidden,
+    updated_at
+FROM spec_behavior_edits
+WHERE codebase_id = @codebase_id AND language = @language
+  AND ((sqlc.narg('workspace_id')::uuid IS NULL AND workspace_id IS NULL AND user_id = @user_id) OR workspace_id = sqlc.narg('workspace_id')::uuid)
+ORDER BY file_path, test_name;
+
+-- name: GetSpecFeatureEdits :many
+-- Returns the user's personal edits, or the workspace's shared edits when workspace_id is set
+SELECT
+    id,
+    domain_name,
+    feature_name,
+    new_name,
+    updated_at
+FROM spec_feature_edits
+WHERE codebase_id = @codebase_id AND language = @language
+  AND ((sqlc.narg('workspace_id')::uuid IS NULL AND workspace_id IS NULL AND user_id = @user_id) OR workspace_id = sqlc.narg('workspace_id')::uuid)
+ORDER BY domain_name, feature_name;
+
+-- name: UpsertSpecBehaviorEdit :one
+INSERT INTO spec_behavior_edits (
+    user_id, codebase_id, language, file_path, test_name, source_test_case_id, description, hidden
+) VALUES (
+    @user_id, @codebase_id, @language, @file_path, @test_name, @source_test_case_id, @description, @hidden
+)
+ON CONFLICT (user_id, codebase_id, language, file_path, test_name) WHERE workspace_id IS NULL DO UPDATE SET
+    source_test_case_id = EXCLUDED.source_test_case_id,
+    description = EXCLUDED.description,
+    hidden = EXCLUDED.hidden,
+    updated_at = now()
+RETURNING id, updated_at;
+
+-- name: UpsertWorkspaceSpecBehaviorEdit :one
+-- Shared by the workspace's members; user_id records who edited last
+INSERT INTO spec_behavior_edits (
+    workspace_id, user_id, codebase_id, language, file_path, test_name, source_test_case_id, description, hidden
+) VALUES (
+    @workspace_id, @user_id, @codebase_id, @language, @file_path, @test_name, @source_test_case_id, @description, @hidden
+)
+ON CONFLICT (workspace_id, codebase_id, language, file_path, test_name) WHERE workspace_id IS NOT NULL DO UPDATE SET
+    user_id = EXCLUDED.user_id,
+    source_test_case_id = EXCLUDED.source_test_case_id,
+    description = EXCLUDED.description,
+    hidden = EXCLUDED.hidden,
+    updated_at = now()
+RETURNING id, updated_at;
+
+-- name: UpsertSpecFeatureEdit :one
+INSERT INTO spec_feature_edits (
+    user_id, codebase_id, language, domain_name, feature_name, new_name
+) VALUES (
+    @user_id, @codebase_id, @language, @domain_name, @feature_name, @new_name
+)
+ON CONFLICT (user_id, codebase_id, language, domain_name, feature_name) WHERE workspace_id IS NULL DO UPDATE SET
+    new_name = EXCLUDED.new_name,
+    updated_at = now()
+RETURNING id, updated_at;
+
+-- name: UpsertWorkspaceSpecFeatureEdit :one
+-- Shared by the workspace's members; user_id records who edited last
+INSERT INTO spec_feature_edits (
+    workspace_id, user_id, codebase_id, language, domain_name, feature_name, new_name
+) VALUES (
+    @workspace_id, @user_id, @codebase_id, @language, @domain_name, @feature_name, @new_name
+)
+ON CONFLICT (workspace_id, codebase_id, language, domain_name, feature_name) WHERE workspace_id IS NOT NULL DO UPDATE SET
+    user_id = EXCLUDED.user_id,
+    new_name = EXCLUDED.new_name,
+    updated_at = now()
+RETURNING id, updated_at;
+
+-- name: DeleteSpecBehaviorEdit :execrows
+DELETE FROM spec_behavior_edits
+WHERE codebase_id = @codebase_id AND language = @language
+  AND file_path = @file_path AND test_name = @test_name
+  AND ((sqlc.narg('workspace_id')::uuid IS NULL AND workspace_id IS NULL AND user_id = @user_id) OR workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: DeleteSpecFeatureEdit :execrows
+DELETE FROM spec_feature_edits
+WHERE codebase_id = @codebase_id AND language = @language
+  AND domain_name = @domain_name AND feature_name = @feature_name
+  AND ((sqlc.narg('workspace_id')::uuid IS NULL AND workspace_id IS NULL AND user_id = @user_id) OR workspace_id = sqlc.narg('workspace_id')::uuid);
@@ -0,0 +1,100 @@
+-- name: SearchSpecDocuments :many
+-- Full-text search over the latest spec document per repository and language owned by a user,
+-- or generated for the workspace when workspace_id is set
+-- Domains, features, and behaviors are ranked together using each document's language configuration
+-- Highlights mark matched terms with chr(2) and chr(3) so callers can escape the surrounding text
+WITH documents AS (
+    SELECT DISTINCT ON (a.codebase_id, sd.language)
+        sd.id,
+        sd.analysis_id,
+        sd.language,
+        sd.version,
+        c.owner,
+        c.name AS repo
+    FROM spec_documents sd
+    JOIN analyses a ON a.id = sd.analysis_id
+    JOIN codebases c ON c.id = a.codebase_id
+    WHERE ((sd.user_id = @user_id AND sqlc.narg(workspace_id)::uuid IS NULL) OR sd.workspace_id = sqlc.narg(workspace_id)::uuid)
+      AND (sqlc.narg(owner)::text IS NULL OR c.owner = sqlc.narg(owner))
+      AND (sqlc.narg(repo)::text IS NULL OR c.name = sqlc.narg(repo))
+      AND (sqlc.narg(language)::text IS NULL OR sd.language = sqlc.narg(language))
+    ORDER BY a.codebase_id, sd.language, sd.created_at DESC, sd.version DESC
+),
+search_queries AS (
+    SELECT
+        d.id,
+        d.analysis_id,
+        d.language,
+        d.version,
+        d.owner,
+        d.repo,
+        websearch_to_tsquery(spec_search_config(d.language), @query) AS tsq
+    FROM documents d
+),
+matches AS (
+    SELECT
+        'domain'::text AS kind,
+        q.id AS document_id, q.analysis_id, q.owner, q.repo, q.language, q.version, q.tsq,
+        dm.id,
+        dm.id AS domain_id,
+        dm.name AS domain_name,
+        NULL::uuid AS feature_id,
+        NULL::text AS feature_name,
+        concat_ws(E'\n', dm.name, dm.description) AS content,
+        ts_rank(dm.search_vector, q.tsq) AS rank
+    FROM search_queries q
+    JOIN spec_domains dm ON dm.document_id = q.id
+    WHERE dm.search_vector @@ q.tsq
+    UNION ALL
+    SELECT
+        'feature'::text AS kind,
+        q.id, q.analysis_id, q.owner, q.repo, q.language, q.version, q.tsq,
+        f.id,
+        dm.id,
+        dm.name,
+        f.id,
+        f.name,
+        concat_ws(E'\n', f.name, f.description),
+        ts_rank(f.search_vector, q.tsq)
+    FROM search_queries q
+    JOIN spec_domains dm ON dm.document_id = q.id
+    JOIN spec_features f ON f.domain_id = dm.id
+    WHERE f.search_vector @@ q.tsq
+    UNION ALL
+    SELECT
+        'behavior'::text AS kind,
+        q.id, q.analysis_id, q.owner, q.repo, q.language, q.version, q.tsq,
+        b.id,
+        dm.id,
+        dm.name,
+        f.id,
+        f.name,
+        b.converted_description,
+        ts_rank(b.search_vector, q.tsq)
+    FROM search_queries q
+    JOIN spec_domains dm ON dm.document_id = q.id
+    JOIN spec_features f ON f.domain_id = dm.id
+    JOIN spec_behaviors b ON b.feature_id = f.id
+    WHERE b.search_vector @@ q.tsq
+)
+SELECT
+    m.kind,
+    m.id,
+    m.document_id,
+    m.analysis_id,
+    m.owner,
+    m.repo,
+    m.language,
+    m.version,
+    m.domain_id,
+    m.domain_name,
+    m.feature_id,
+    m.feature_name,
+    ts_headline(
+        spec_search_config(m.language), m.content, m.tsq,
+        'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', MaxWords=35, MinWords=15, MaxFragments=2'
+    )::text AS highlight,
+    m.rank::real AS rank
+FROM matches m
+ORDER BY m.rank DESC, m.owner, m.repo, m.language, m.id
+LIMIT @limit_count OFFSET @offset_count;
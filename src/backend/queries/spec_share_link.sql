@@ -0,0 +1,125 @@
+-- name: GetSpecShareLinkDocument :one
+-- Resolves the repository and language of a spec document owned by the user,
+-- or generated for the workspace when workspace_id is set
+SELECT
+    sd.id,
+    a.codebase_id,
+    sd.language
+FROM spec_documents sd
+JOIN analyses a ON a.id = sd.analysis_id
+WHERE sd.id = @document_id
+  AND ((sd.user_id = @user_id AND sqlc.narg('workspace_id')::uuid IS NULL) OR sd.workspace_id = sqlc.narg('workspace_id')::uuid);
+
+-- name: CreateSpecShareLink :one
+INSERT INTO spec_share_links (user_id, token_hash, token_prefix, codebase_id, language, document_id, expires_at, workspace_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
+
+-- name: GetSpecShareLinkByID :one
+SELECT
+    l.id,
+    l.user_id,
+    l.workspace_id,
+    l.token_prefix,
+    l.codebase_id,
+    c.owner,
+    c.name AS repo,
+    l.language,
+    l.document_id,
+    sd.version AS document_version,
+    l.expires_at,
+    l.revoked_at,
+    l.last_accessed_at,
+    l.created_at,
+    (SELECT COUNT(*) FROM spec_share_link_accesses x WHERE x.share_link_id = l.id) AS access_count
+FROM spec_share_links l
+JOIN codebases c ON c.id = l.codebase_id
+LEFT JOIN spec_documents sd ON sd.id = l.document_id
+WHERE l.id = $1;
+
+-- name: GetSpecShareLinkByHash :one
+SELECT
+    l.id,
+    l.user_id,
+    l.workspace_id,
+    l.token_prefix,
+    l.codebase_id,
+    c.owner,
+    c.name AS repo,
+    l.language,
+    l.document_id,
+    sd.version AS document_version,
+    l.expires_at,
+    l.revoked_at,
+    l.last_accessed_at,
+    l.created_at,
+    (SELECT COUNT(*) FROM spec_share_link_accesses x WHERE x.share_link_id = l.id) AS access_count
+FROM spec_share_links l
+JOIN codebases c ON c.id = l.codebase_id
+LEFT JOIN spec_documents sd ON sd.id = l.document_id
+WHERE l.token_hash = $1;
+
+-- name: ListSpecShareLinks :many
+-- Lists the user's own links, or the workspace's when workspace_id is set
+SELECT
+    l.id,
+    l.user_id,
+    l.workspace_id,
+    l.token_prefix,
+    l.codebase_id,
+    c.owner,
+    c.name AS repo,
+    l.language,
+    l.document_id,
+    sd.version AS document_version,
+    l.expires_at,
+    l.revoked_at,
+    l.last_accessed_at,
+    l.created_at,
+    (SELECT COUNT(*) FROM spec_share_link_accesses x WHERE x.share_link_id = l.id) AS access_count
+FROM spec_share_links l
+JOIN codebases c ON c.id = l.codebase_id
+LEFT JOIN spec_documents sd ON sd.id = l.document_id
+WHERE ((l.user_id = @user_id AND l.workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR l.workspace_id = sqlc.narg('workspace_id')::uuid)
+  AND l.revoked_at IS NULL
+ORDER BY l.created_at DESC;
+
+-- name: GetSharedSpecDocument :one
+-- Returns the document a share link points to: the pinned version, or the
+-- latest document of the owner, or of the workspace when workspace_id is set,
+-- for the repository and language
+SELECT
+    sd.id,
+    sd.analysis_id,
+    sd.user_id,
+    sd.language,
+    sd.version,
+    sd.executive_summary,
+    sd.model_id,
+    sd.created_at,
+    a.commit_sha
+FROM spec_documents sd
+JOIN analyses a ON a.id = sd.analysis_id
+WHERE ((sd.user_id = @user_id AND sqlc.narg('workspace_id')::uuid IS NULL) OR sd.workspace_id = sqlc.narg('workspace_id')::uuid)
+  AND a.codebase_id = @codebase_id
+  AND sd.language = @language
+  AND (sqlc.narg('document_id')::uuid IS NULL OR sd.id = sqlc.narg('document_id')::uuid)
+ORDER BY sd.created_at DESC, sd.version DESC
+LIMIT 1;
+
+-- name: RecordSpecShareLinkAccess :exec
+WITH link AS (
+    UPDATE spec_share_links
+    SET last_accessed_at = now()
+    WHERE id = @share_link_id
+    RETURNING id
+)
+INSERT INTO spec_share_link_accesses (share_link_id, ip_address)
+SELECT link.id, sqlc.narg('ip_address')::text FROM link;
+
+-- name: RevokeSpecShareLink :execrows
+UPDATE spec_share_links
+SET revoked_at = now()
+WHERE id = @id
+  AND ((user_id = @user_id AND workspace_id IS NULL AND sqlc.narg('workspace_id')::uuid IS NULL) OR workspace_id = sqlc.narg('workspace_id')::uuid)
+  AND revoked_at IS NULL;
@@ -61,7 +61,8 @@ SELECT
     sp.tier AS plan_tier,
     sp.specview_monthly_limit AS plan_specview_monthly_limit,
     sp.analysis_monthly_limit AS plan_analysis_monthly_limit,
-    sp.retention_days AS plan_retention_days
+    sp.retention_days AS plan_retention_days,
+    sp.api_rate_limit_per_minute AS plan_api_rate_limit_per_minute
 FROM user_subscriptions us
 JOIN subscription_plans sp ON us.plan_id = sp.id
 WHERE us.user_id = $1 AND us.status = 'active';
@@ -1,18 +1,22 @@
 -- name: GetMonthlyUsage :one
+-- Usage is charged to billed_user_id when set (workspace generations, recorded at
+-- charge time) and to the requesting user otherwise.
 SELECT
-    COALESCE(SUM(quota_amount), 0)::bigint AS total
-FROM usage_events
-WHERE user_id = $1
-    AND event_type = $2
-    AND created_at >= $3
-    AND created_at < $4;
+    COALESCE(SUM(ue.quota_amount), 0)::bigint AS total
+FROM usage_events ue
+WHERE COALESCE(ue.billed_user_id, ue.user_id) = sqlc.arg(user_id)::uuid
+    AND ue.event_type = $2
+    AND ue.created_at >= $3
+    AND ue.created_at < $4;
 
 -- name: GetUsageByPeriod :many
+-- Usage is charged to billed_user_id when set (workspace generations, recorded at
+-- charge time) and to the requesting user otherwise.
 SELECT
-    event_type,
-    COALESCE(SUM(quota_amount), 0)::bigint AS total
-FROM usage_events
-WHERE user_id = $1
-    AND created_at >= $2
-    AND created_at < $3
-GROUP BY event_type;
+    ue.event_type,
+    COALESCE(SUM(ue.quota_amount), 0)::bigint AS total
+FROM usage_events ue
+WHERE COALESCE(ue.billed_user_id, ue.user_id) = sqlc.arg(user_id)::uuid
+    AND ue.created_at >= $2
+    AND ue.created_at < $3
+GROUP BY ue.event_type;
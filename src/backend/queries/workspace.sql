@@ -0,0 +1,131 @@
+-- name: CreateWorkspace :one
+-- Creates a workspace and makes the creator its owner in a single statement
+WITH w AS (
+    INSERT INTO workspaces (name)
+    VALUES (@name)
+    RETURNING id, name, created_at
+), owner AS (
+    INSERT INTO workspace_members (workspace_id, user_id, role)
+    SELECT w.id, @owner_id, 'owner' FROM w
+)
+SELECT w.id, w.name, w.created_at FROM w;
+
+-- name: ListWorkspacesByUserID :many
+SELECT
+    w.id,
+    w.name,
+    m.role,
+    w.created_at,
+    (SELECT COUNT(*) FROM workspace_members x WHERE x.workspace_id = w.id) AS member_count
+FROM workspaces w
+JOIN workspace_members m ON m.workspace_id = w.id
+WHERE m.user_id = $1
+ORDER BY w.created_at DESC;
+
+-- name: GetWorkspaceMembership :one
+-- Returns the user's role in the workspace along with the workspace owner,
+-- whose subscription is charged for the workspace's generations
+SELECT
+    m.role,
+    o.user_id AS owner_id
+FROM workspace_members m
+JOIN workspace_members o ON o.workspace_id = m.workspace_id AND o.role = 'owner'
+WHERE m.workspace_id = $1 AND m.user_id = $2;
+
+-- name: ListWorkspaceMembers :many
+SELECT
+    m.user_id,
+    u.username,
+    u.avatar_url,
+    m.role,
+    m.created_at
+FROM workspace_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.workspace_id = $1
+ORDER BY m.created_at, u.username;
+
+-- name: GetWorkspaceUserByUsername :one
+-- Resolves the user being invited to a workspace
+SELECT id, username, avatar_url
+FROM users
+WHERE username = $1
+ORDER BY created_at
+LIMIT 1;
+
+-- name: CreateWorkspaceInvitation :one
+-- Returns no row if the user already has a pending invitation to the workspace
+WITH inv AS (
+    INSERT INTO workspace_invitations (workspace_id, user_id, role, invited_by)
+    VALUES (@workspace_id, @user_id, @role, @invited_by)
+    ON CONFLICT (workspace_id, user_id) DO NOTHING
+    RETURNING id, workspace_id, user_id, role, invited_by, created_at
+)
+SELECT
+    inv.id,
+    inv.workspace_id,
+    w.name AS workspace_name,
+    inv.role,
+    u.username,
+    i.username AS invited_by,
+    inv.created_at
+FROM inv
+JOIN workspaces w ON w.id = inv.workspace_id
+JOIN users u ON u.id = inv.user_id
+JOIN users i ON i.id = inv.invited_by;
+
+-- name: ListWorkspaceInvitationsByUserID :many
+SELECT
+    inv.id,
+    inv.workspace_id,
+    w.name AS workspace_name,
+    inv.role,
+    u.username,
+    i.username AS invited_by,
+    inv.created_at
+FROM workspace_invitations inv
+JOIN workspaces w ON w.id = inv.workspace_id
+JOIN users u ON u.id = inv.user_id
+JOIN users i ON i.id = inv.invited_by
+WHERE inv.user_id = $1
+ORDER BY inv.created_at DESC;
+
+-- name: AcceptWorkspaceInvitation :one
+-- Consumes the invitation and adds the invitee as a member in a single statement.
+-- Returns no row if the invitation does not exist or belongs to another user.
+WITH inv AS (
+    DELETE FROM workspace_invitations
+    WHERE id = @id AND user_id = @user_id
+    RETURNING workspace_id, user_id, role
+), member AS (
+    INSERT INTO workspace_members (workspace_id, user_id, role)
+    SELECT workspace_id, user_id, role FROM inv
+    ON CONFLICT (workspace_id, user_id) DO NOTHING
+    RETURNING workspace_id
+)
+SELECT
+    w.id,
+    w.name,
+    inv.role,
+    w.created_at,
+    ((SELECT COUNT(*) FROM workspace_members x WHERE x.workspace_id = w.id) + (SELECT COUNT(*) FROM member))::bigint AS member_count
+FROM inv
+JOIN workspaces w ON w.id = inv.workspace_id;
+
+-- name: DeleteWorkspaceInvitation :execrows
+DELETE FROM workspace_invitations
+WHERE id = $1 AND user_id = $2;
+
+-- name: UpdateWorkspaceMemberRole :one
+-- The owner's row is never updated; ownership cannot be handed over through a role change
+UPDATE workspace_members m
+SET role = @role, updated_at = now()
+FROM users u
+WHERE u.id = m.user_id
+  AND m.workspace_id = @workspace_id
+  AND m.user_id = @user_id
+  AND m.role <> 'owner'
+RETURNING m.user_id, u.username, u.avatar_url, m.role, m.created_at;
+
+-- name: RemoveWorkspaceMember :execrows
+DELETE FROM workspace_members
+WHERE workspace_id = $1 AND user_id = $2 AND role <> 'owner';